@@ -39,10 +39,40 @@ const (
 	// ClusterResourceSetResourcesAppliedWrongSecretTypeReason is the reason used when the Secret's type in the resource list is not supported.
 	ClusterResourceSetResourcesAppliedWrongSecretTypeReason = "WrongSecretType"
 
+	// ClusterResourceSetResourcesWaitingForReadinessReason is the reason used when applying the resources to one of the matching
+	// clusters is waiting for the readiness checks of a resource to succeed.
+	ClusterResourceSetResourcesWaitingForReadinessReason = "WaitingForReadiness"
+
+	// ClusterResourceSetResourcesWaitingForDependenciesReason is the reason used when applying the resources to one of the matching
+	// clusters is waiting for the ClusterResourceSets the ClusterResourceSet depends on.
+	ClusterResourceSetResourcesWaitingForDependenciesReason = "WaitingForDependencies"
+
 	// ClusterResourceSetResourcesAppliedInternalErrorReason surfaces unexpected failures when reconciling a ClusterResourceSet.
 	ClusterResourceSetResourcesAppliedInternalErrorReason = clusterv1.InternalErrorReason
 )
 
+// ClusterResourceSet's DependenciesReady condition and corresponding reasons.
+const (
+	// ClusterResourceSetDependenciesReadyCondition surfaces whether the ClusterResourceSets listed in dependsOn
+	// are applied and ready on all matching clusters.
+	ClusterResourceSetDependenciesReadyCondition = "DependenciesReady"
+
+	// ClusterResourceSetDependenciesReadyReason is the reason used when all the dependencies are ready on all matching clusters,
+	// or when the ClusterResourceSet has no dependencies.
+	ClusterResourceSetDependenciesReadyReason = "Ready"
+
+	// ClusterResourceSetDependenciesNotReadyReason is the reason used when at least one of the dependencies is not ready
+	// on one of the matching clusters.
+	ClusterResourceSetDependenciesNotReadyReason = "NotReady"
+
+	// ClusterResourceSetDependenciesCycleReason is the reason used when the ClusterResourceSet is part of a dependency cycle,
+	// e.g. when two ClusterResourceSets depend on each other.
+	ClusterResourceSetDependenciesCycleReason = "DependencyCycle"
+
+	// ClusterResourceSetDependenciesInternalErrorReason surfaces unexpected failures when checking the dependencies.
+	ClusterResourceSetDependenciesInternalErrorReason = clusterv1.InternalErrorReason
+)
+
 const (
 	// ClusterResourceSetSecretType is the only accepted type of secret in resources.
 	ClusterResourceSetSecretType corev1.SecretType = "addons.cluster.x-k8s.io/resource-set" //nolint:gosec
@@ -62,7 +92,8 @@ type ClusterResourceSetSpec struct {
 	// +required
 	ClusterSelector metav1.LabelSelector `json:"clusterSelector"`
 
-	// resources is a list of Secrets/ConfigMaps where each contains 1 or more resources to be applied to remote clusters.
+	// resources is a list of Secrets/ConfigMaps where each contains 1 or more resources to be applied to remote clusters,
+	// or of Helm charts and kustomizations which are rendered into resources to be applied to remote clusters.
 	// +optional
 	// +kubebuilder:validation:MaxItems=100
 	Resources []ResourceRef `json:"resources,omitempty"`
@@ -71,6 +102,23 @@ type ClusterResourceSetSpec struct {
 	// +kubebuilder:validation:Enum=ApplyOnce;Reconcile
 	// +optional
 	Strategy string `json:"strategy,omitempty"`
+
+	// dependsOn is a list of ClusterResourceSets in the same namespace which must be applied and ready
+	// on a Cluster before the resources of this ClusterResourceSet are applied to it.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	DependsOn []ClusterResourceSetDependency `json:"dependsOn,omitempty"`
+}
+
+// ClusterResourceSetDependency is a reference to a ClusterResourceSet another ClusterResourceSet depends on.
+type ClusterResourceSetDependency struct {
+	// name of the ClusterResourceSet.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
 }
 
 // ANCHOR_END: ClusterResourceSetSpec
@@ -80,8 +128,10 @@ type ClusterResourceSetResourceKind string
 
 // Define the ClusterResourceSetResourceKind constants.
 const (
-	SecretClusterResourceSetResourceKind    ClusterResourceSetResourceKind = "Secret"
-	ConfigMapClusterResourceSetResourceKind ClusterResourceSetResourceKind = "ConfigMap"
+	SecretClusterResourceSetResourceKind        ClusterResourceSetResourceKind = "Secret"
+	ConfigMapClusterResourceSetResourceKind     ClusterResourceSetResourceKind = "ConfigMap"
+	HelmChartClusterResourceSetResourceKind     ClusterResourceSetResourceKind = "HelmChart"
+	KustomizationClusterResourceSetResourceKind ClusterResourceSetResourceKind = "Kustomization"
 )
 
 // ResourceRef specifies a resource.
@@ -92,10 +142,154 @@ type ResourceRef struct {
 	// +kubebuilder:validation:MaxLength=253
 	Name string `json:"name"`
 
-	// kind of the resource. Supported kinds are: Secrets and ConfigMaps.
-	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// kind of the resource. Supported kinds are: Secrets, ConfigMaps, HelmCharts and Kustomizations.
+	// For HelmCharts and Kustomizations, name is the name of the Secret or ConfigMap the chart or the
+	// kustomization is stored in.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap;HelmChart;Kustomization
 	// +required
 	Kind string `json:"kind"`
+
+	// helmChart defines how to render the Helm chart stored in the Secret or ConfigMap.
+	// It must be set if kind is HelmChart.
+	// +optional
+	HelmChart *HelmChartResource `json:"helmChart,omitempty"`
+
+	// kustomization defines how to render the kustomization stored in the Secret or ConfigMap.
+	// It must be set if kind is Kustomization.
+	// +optional
+	Kustomization *KustomizationResource `json:"kustomization,omitempty"`
+
+	// variableSubstitution defines whether variables in the format ${VAR} or ${VAR:=default} in the content of the resource
+	// are substituted with values of the matching Cluster, e.g. ${CLUSTER_NAME} or ${CLUSTER_POD_CIDR}, before it is applied.
+	// For HelmCharts and Kustomizations variables are substituted in the rendered resources.
+	// Defaults to Disabled.
+	// +optional
+	VariableSubstitution ResourceVariableSubstitution `json:"variableSubstitution,omitempty"`
+
+	// readinessChecks are evaluated on the objects of the resource after it is applied.
+	// The following resources of the ClusterResourceSet are applied only once all readiness checks succeed,
+	// and ClusterResourceSets depending on this ClusterResourceSet wait for them as well.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=16
+	ReadinessChecks []ResourceReadinessCheck `json:"readinessChecks,omitempty"`
+}
+
+// ResourceReadinessCheckType is the type of a readiness check.
+// +kubebuilder:validation:Enum=CRDEstablished;DeploymentAvailable;Expression
+type ResourceReadinessCheckType string
+
+const (
+	// CRDEstablishedResourceReadinessCheckType checks that all CustomResourceDefinitions of the resource are established.
+	CRDEstablishedResourceReadinessCheckType ResourceReadinessCheckType = "CRDEstablished"
+
+	// DeploymentAvailableResourceReadinessCheckType checks that all Deployments of the resource are available.
+	DeploymentAvailableResourceReadinessCheckType ResourceReadinessCheckType = "DeploymentAvailable"
+
+	// ExpressionResourceReadinessCheckType checks that a CEL expression evaluates to true for the objects of the resource.
+	ExpressionResourceReadinessCheckType ResourceReadinessCheckType = "Expression"
+)
+
+// ResourceReadinessCheck defines a readiness check evaluated on the objects of a resource.
+type ResourceReadinessCheck struct {
+	// type of the readiness check.
+	// +required
+	Type ResourceReadinessCheckType `json:"type"`
+
+	// kind of the objects the Expression readiness check is evaluated on.
+	// If not set, the expression is evaluated on all objects of the resource.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Kind string `json:"kind,omitempty"`
+
+	// expression is a CEL expression which must evaluate to true for the objects to be ready.
+	// The object is available as self, e.g. self.status.readyReplicas == self.spec.replicas.
+	// It must be set if type is Expression.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Expression string `json:"expression,omitempty"`
+}
+
+// ResourceVariableSubstitution defines whether variables are substituted in the content of a resource.
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type ResourceVariableSubstitution string
+
+const (
+	// ResourceVariableSubstitutionEnabled substitutes variables in the content of a resource with values of the matching Cluster.
+	ResourceVariableSubstitutionEnabled ResourceVariableSubstitution = "Enabled"
+
+	// ResourceVariableSubstitutionDisabled applies the content of a resource as is.
+	ResourceVariableSubstitutionDisabled ResourceVariableSubstitution = "Disabled"
+)
+
+// HelmChartResource defines a Helm chart to be rendered into resources by the ClusterResourceSet controller.
+type HelmChartResource struct {
+	// sourceKind is the kind of the resource the chart is stored in. Supported kinds are: Secrets and ConfigMaps.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// +required
+	SourceKind string `json:"sourceKind"`
+
+	// key is the key in the Secret or ConfigMap the chart is stored in, either as a packaged chart
+	// or as a local OCI artifact, i.e. an archive with an OCI image layout holding a Helm chart.
+	// Charts stored in ConfigMaps must be stored in binaryData.
+	// Defaults to chart.tgz.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key,omitempty"`
+
+	// releaseName is the name of the release used when rendering the chart.
+	// Defaults to the name of the chart.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=53
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// releaseNamespace is the namespace of the release used when rendering the chart.
+	// Please note that the namespace is only applied to resources whose template uses .Release.Namespace.
+	// Defaults to default.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	ReleaseNamespace string `json:"releaseNamespace,omitempty"`
+
+	// values are the values in YAML format used when rendering the chart.
+	// values are a Go template which is executed with the Cluster the chart is rendered for,
+	// e.g. {{ .Cluster.metadata.name }} or {{ .Cluster.spec.clusterNetwork.pods.cidrBlocks }}.
+	// +optional
+	// +kubebuilder:validation:MaxLength=102400
+	Values string `json:"values,omitempty"`
+}
+
+// KustomizationResource defines a kustomization to be rendered into resources by the ClusterResourceSet controller.
+type KustomizationResource struct {
+	// sourceKind is the kind of the resource the kustomization is stored in. Supported kinds are: Secrets and ConfigMaps.
+	// Each key of the Secret or ConfigMap is a file of the kustomization directory, except for keys
+	// ending with .tar.gz or .tgz which are archives extracted into the kustomization directory.
+	// +kubebuilder:validation:Enum=Secret;ConfigMap
+	// +required
+	SourceKind string `json:"sourceKind"`
+
+	// path is the path of the directory with the kustomization file to build, relative to the kustomization directory.
+	// Defaults to the kustomization directory.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Path string `json:"path,omitempty"`
+}
+
+// GetSourceKind returns the kind of the Secret or ConfigMap the resource is stored in.
+func (r ResourceRef) GetSourceKind() string {
+	switch {
+	case r.Kind == string(HelmChartClusterResourceSetResourceKind) && r.HelmChart != nil:
+		return r.HelmChart.SourceKind
+	case r.Kind == string(KustomizationClusterResourceSetResourceKind) && r.Kustomization != nil:
+		return r.Kustomization.SourceKind
+	default:
+		return r.Kind
+	}
 }
 
 // ClusterResourceSetStrategy is a string representation of a ClusterResourceSet Strategy.
@@ -120,7 +314,7 @@ func (c *ClusterResourceSetSpec) SetTypedStrategy(p ClusterResourceSetStrategy)
 // ClusterResourceSetStatus defines the observed state of ClusterResourceSet.
 type ClusterResourceSetStatus struct {
 	// conditions represents the observations of a ClusterResourceSet's current state.
-	// Known condition types are ResourcesApplied.
+	// Known condition types are ResourcesApplied, DependenciesReady.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
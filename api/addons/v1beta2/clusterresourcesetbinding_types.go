@@ -17,11 +17,26 @@ limitations under the License.
 package v1beta2
 
 import (
-	"reflect"
+	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// ClusterResourceSetBinding's ResourcesInSync condition and corresponding reasons.
+const (
+	// ClusterResourceSetBindingResourcesInSyncCondition surfaces whether the objects applied to the cluster
+	// by "Reconcile" ClusterResourceSets matched the desired state when they were last re-asserted.
+	ClusterResourceSetBindingResourcesInSyncCondition = "ResourcesInSync"
+
+	// ClusterResourceSetBindingResourcesInSyncReason is the reason used when no drift was detected
+	// on the objects applied to the cluster.
+	ClusterResourceSetBindingResourcesInSyncReason = "InSync"
+
+	// ClusterResourceSetBindingResourcesDriftedReason is the reason used when at least one of the objects applied
+	// to the cluster was modified or deleted out-of-band, and it has been re-applied.
+	ClusterResourceSetBindingResourcesDriftedReason = "Drifted"
+)
+
 // ANCHOR: ResourceBinding
 
 // ResourceBinding shows the status of a resource that belongs to a ClusterResourceSet matched by the owner cluster of the ClusterResourceSetBinding object.
@@ -43,6 +58,61 @@ type ResourceBinding struct {
 	// applied is to track if a resource is applied to the cluster or not.
 	// +required
 	Applied bool `json:"applied"`
+
+	// ready is to track if the readiness checks of a resource succeeded.
+	// It is only set for resources with readiness checks.
+	// +optional
+	Ready *bool `json:"ready,omitempty"`
+
+	// appliedObjects is the list of objects applied to the cluster for this resource.
+	// It is used to prune objects that are removed from the resource.
+	// For "ApplyOnce" ClusterResourceSet.spec.strategy, this is not tracked as that strategy does not act on change.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=1000
+	AppliedObjects []ClusterResourceSetObjectReference `json:"appliedObjects,omitempty"`
+
+	// driftedObjects is the list of objects that were modified or deleted out-of-band and
+	// have been re-applied the last time the resource was re-asserted.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=1000
+	DriftedObjects []ClusterResourceSetObjectReference `json:"driftedObjects,omitempty"`
+}
+
+// ClusterResourceSetObjectReference identifies an object applied to a cluster by a ClusterResourceSet.
+type ClusterResourceSetObjectReference struct {
+	// apiVersion of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	APIVersion string `json:"apiVersion"`
+
+	// kind of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Kind string `json:"kind"`
+
+	// namespace of the object, empty for cluster-scoped objects.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace,omitempty"`
+
+	// name of the object.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// String returns a string representation of the object reference.
+func (r ClusterResourceSetObjectReference) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s %s", r.Kind, r.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
 }
 
 // ANCHOR_END: ResourceBinding
@@ -67,10 +137,20 @@ func (r *ResourceSetBinding) IsApplied(resourceRef ResourceRef) bool {
 	return resourceBinding != nil && resourceBinding.Applied
 }
 
+// IsReady returns true if the resource is applied to the cluster and its readiness checks, if any, succeeded.
+func (r *ResourceSetBinding) IsReady(resourceRef ResourceRef) bool {
+	resourceBinding := r.GetResource(resourceRef)
+	if resourceBinding == nil || !resourceBinding.Applied {
+		return false
+	}
+	return len(resourceRef.ReadinessChecks) == 0 || (resourceBinding.Ready != nil && *resourceBinding.Ready)
+}
+
 // GetResource returns a ResourceBinding for a resource ref if present.
+// Resources are matched by kind and name, so the binding is found also after other fields of the resource ref changed.
 func (r *ResourceSetBinding) GetResource(resourceRef ResourceRef) *ResourceBinding {
 	for _, resource := range r.Resources {
-		if reflect.DeepEqual(resource.ResourceRef, resourceRef) {
+		if resource.Kind == resourceRef.Kind && resource.Name == resourceRef.Name {
 			return &resource
 		}
 	}
@@ -81,7 +161,7 @@ func (r *ResourceSetBinding) GetResource(resourceRef ResourceRef) *ResourceBindi
 // creating a new one.
 func (r *ResourceSetBinding) SetBinding(resourceBinding ResourceBinding) {
 	for i := range r.Resources {
-		if reflect.DeepEqual(r.Resources[i].ResourceRef, resourceBinding.ResourceRef) {
+		if r.Resources[i].Kind == resourceBinding.Kind && r.Resources[i].Name == resourceBinding.Name {
 			r.Resources[i] = resourceBinding
 			return
 		}
@@ -102,6 +182,16 @@ func (c *ClusterResourceSetBinding) GetOrCreateBinding(clusterResourceSet *Clust
 	return binding
 }
 
+// GetBinding returns the ResourceSetBinding for a given ClusterResourceSet name if exists.
+func (c *ClusterResourceSetBinding) GetBinding(clusterResourceSetName string) *ResourceSetBinding {
+	for _, binding := range c.Spec.Bindings {
+		if binding != nil && binding.ClusterResourceSetName == clusterResourceSetName {
+			return binding
+		}
+	}
+	return nil
+}
+
 // RemoveBinding removes the ClusterResourceSet from the ClusterResourceSetBinding Bindings list.
 func (c *ClusterResourceSetBinding) RemoveBinding(clusterResourceSet *ClusterResourceSet) {
 	for i, binding := range c.Spec.Bindings {
@@ -129,6 +219,19 @@ type ClusterResourceSetBinding struct {
 	// spec is the desired state of ClusterResourceSetBinding.
 	// +optional
 	Spec ClusterResourceSetBindingSpec `json:"spec,omitempty"`
+	// status is the observed state of ClusterResourceSetBinding.
+	// +optional
+	Status ClusterResourceSetBindingStatus `json:"status,omitempty"`
+}
+
+// GetConditions returns the set of conditions for this object.
+func (c *ClusterResourceSetBinding) GetConditions() []metav1.Condition {
+	return c.Status.Conditions
+}
+
+// SetConditions sets conditions for an API object.
+func (c *ClusterResourceSetBinding) SetConditions(conditions []metav1.Condition) {
+	c.Status.Conditions = conditions
 }
 
 // ANCHOR: ClusterResourceSetBindingSpec
@@ -149,6 +252,21 @@ type ClusterResourceSetBindingSpec struct {
 
 // ANCHOR_END: ClusterResourceSetBindingSpec
 
+// ANCHOR: ClusterResourceSetBindingStatus
+
+// ClusterResourceSetBindingStatus defines the observed state of ClusterResourceSetBinding.
+type ClusterResourceSetBindingStatus struct {
+	// conditions represents the observations of a ClusterResourceSetBinding's current state.
+	// Known condition types are ResourcesInSync.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=32
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ANCHOR_END: ClusterResourceSetBindingStatus
+
 // +kubebuilder:object:root=true
 
 // ClusterResourceSetBindingList contains a list of ClusterResourceSetBinding.
@@ -141,6 +141,25 @@ func TestResourceSetBindingGetResourceBinding(t *testing.T) {
 			resourceRef:        resourceRefApplyFailed,
 			want:               &resourceRefApplyFailedBinding,
 		},
+		{
+			name:               "ResourceRef exists with other fields changed",
+			resourceSetBinding: crsBinding,
+			resourceRef: ResourceRef{
+				Name:            resourceRefApplyFailed.Name,
+				Kind:            resourceRefApplyFailed.Kind,
+				ReadinessChecks: []ResourceReadinessCheck{{Type: DeploymentAvailableResourceReadinessCheckType}},
+			},
+			want: &resourceRefApplyFailedBinding,
+		},
+		{
+			name:               "ResourceRef with the same name and another kind doesn't exist",
+			resourceSetBinding: crsBinding,
+			resourceRef: ResourceRef{
+				Name: resourceRefApplyFailed.Name,
+				Kind: "ConfigMap",
+			},
+			want: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -199,6 +218,20 @@ func TestSetResourceBinding(t *testing.T) {
 			resourceSetBinding: CRSBinding,
 			resourceBinding:    updateFailedResourceBinding,
 		},
+		{
+			name:               "should update the resource binding if other fields of the resource ref changed",
+			resourceSetBinding: CRSBinding,
+			resourceBinding: ResourceBinding{
+				ResourceRef: ResourceRef{
+					Name:            resourceRefApplyFailed.Name,
+					Kind:            resourceRefApplyFailed.Kind,
+					ReadinessChecks: []ResourceReadinessCheck{{Type: DeploymentAvailableResourceReadinessCheckType}},
+				},
+				Applied:         false,
+				Hash:            "abc",
+				LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -213,6 +246,7 @@ func TestSetResourceBinding(t *testing.T) {
 				}
 			}
 			gs.Expect(exist).To(BeTrue())
+			gs.Expect(tt.resourceSetBinding.Resources).To(HaveLen(2))
 		})
 	}
 }
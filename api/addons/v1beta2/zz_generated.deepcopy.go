@@ -59,6 +59,7 @@ func (in *ClusterResourceSetBinding) DeepCopyInto(out *ClusterResourceSetBinding
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetBinding.
@@ -137,6 +138,43 @@ func (in *ClusterResourceSetBindingSpec) DeepCopy() *ClusterResourceSetBindingSp
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetBindingStatus) DeepCopyInto(out *ClusterResourceSetBindingStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetBindingStatus.
+func (in *ClusterResourceSetBindingStatus) DeepCopy() *ClusterResourceSetBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetDependency) DeepCopyInto(out *ClusterResourceSetDependency) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetDependency.
+func (in *ClusterResourceSetDependency) DeepCopy() *ClusterResourceSetDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceSetDeprecatedStatus) DeepCopyInto(out *ClusterResourceSetDeprecatedStatus) {
 	*out = *in
@@ -189,6 +227,21 @@ func (in *ClusterResourceSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceSetObjectReference) DeepCopyInto(out *ClusterResourceSetObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceSetObjectReference.
+func (in *ClusterResourceSetObjectReference) DeepCopy() *ClusterResourceSetObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceSetObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterResourceSetSpec) DeepCopyInto(out *ClusterResourceSetSpec) {
 	*out = *in
@@ -196,6 +249,13 @@ func (in *ClusterResourceSetSpec) DeepCopyInto(out *ClusterResourceSetSpec) {
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = make([]ResourceRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ClusterResourceSetDependency, len(*in))
 		copy(*out, *in)
 	}
 }
@@ -259,14 +319,59 @@ func (in *ClusterResourceSetV1Beta1DeprecatedStatus) DeepCopy() *ClusterResource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmChartResource) DeepCopyInto(out *HelmChartResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmChartResource.
+func (in *HelmChartResource) DeepCopy() *HelmChartResource {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmChartResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KustomizationResource) DeepCopyInto(out *KustomizationResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KustomizationResource.
+func (in *KustomizationResource) DeepCopy() *KustomizationResource {
+	if in == nil {
+		return nil
+	}
+	out := new(KustomizationResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceBinding) DeepCopyInto(out *ResourceBinding) {
 	*out = *in
-	out.ResourceRef = in.ResourceRef
+	in.ResourceRef.DeepCopyInto(&out.ResourceRef)
 	if in.LastAppliedTime != nil {
 		in, out := &in.LastAppliedTime, &out.LastAppliedTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Ready != nil {
+		in, out := &in.Ready, &out.Ready
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AppliedObjects != nil {
+		in, out := &in.AppliedObjects, &out.AppliedObjects
+		*out = make([]ClusterResourceSetObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftedObjects != nil {
+		in, out := &in.DriftedObjects, &out.DriftedObjects
+		*out = make([]ClusterResourceSetObjectReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBinding.
@@ -279,9 +384,39 @@ func (in *ResourceBinding) DeepCopy() *ResourceBinding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReadinessCheck) DeepCopyInto(out *ResourceReadinessCheck) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReadinessCheck.
+func (in *ResourceReadinessCheck) DeepCopy() *ResourceReadinessCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceReadinessCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
 	*out = *in
+	if in.HelmChart != nil {
+		in, out := &in.HelmChart, &out.HelmChart
+		*out = new(HelmChartResource)
+		**out = **in
+	}
+	if in.Kustomization != nil {
+		in, out := &in.Kustomization, &out.Kustomization
+		*out = new(KustomizationResource)
+		**out = **in
+	}
+	if in.ReadinessChecks != nil {
+		in, out := &in.ReadinessChecks, &out.ReadinessChecks
+		*out = make([]ResourceReadinessCheck, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRef.
@@ -38,6 +38,26 @@ const (
 	Ignition Format = "ignition"
 )
 
+const (
+	// RestoreEtcdSnapshotSecretAnnotation is set by the KubeadmControlPlane controller on the KubeadmConfig of the
+	// Machine re-initializing a control plane from an etcd snapshot; its value is the name of the Secret containing
+	// the location of the snapshot.
+	// When this annotation is set, bootstrap data for kubeadm init is generated even if the control plane is already initialized,
+	// and the etcd data directory is restored from the snapshot using etcdutl before running kubeadm init.
+	// NOTE: The snapshot is downloaded by the Machine, so the Machine image must provide curl, sha256sum and etcdutl.
+	// NOTE: initConfiguration.nodeRegistration.name and initConfiguration.localAPIEndpoint.advertiseAddress must be set,
+	// because they are used as the name and the peer address of the restored etcd member.
+	RestoreEtcdSnapshotSecretAnnotation = "bootstrap.cluster.x-k8s.io/restore-etcd-snapshot-secret"
+
+	// EtcdSnapshotURLSecretKey is the key of the Secret referenced by RestoreEtcdSnapshotSecretAnnotation
+	// containing the URL the etcd snapshot is downloaded from; the URL may embed the credentials required to access it.
+	EtcdSnapshotURLSecretKey = "url"
+
+	// EtcdSnapshotSHA256SecretKey is the key of the Secret referenced by RestoreEtcdSnapshotSecretAnnotation
+	// containing the hex encoded SHA-256 checksum of the etcd snapshot, used to verify the download.
+	EtcdSnapshotSHA256SecretKey = "sha256"
+)
+
 var (
 	cannotUseWithIgnition                            = fmt.Sprintf("not supported when spec.format is set to: %q", Ignition)
 	conflictingFileSourceMsg                         = "only one of content or contentFrom may be specified for a single file"
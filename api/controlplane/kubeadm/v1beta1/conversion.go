@@ -44,6 +44,13 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	if ok {
 		bootstrapv1beta1.RestoreKubeadmConfigSpec(&restored.Spec.KubeadmConfigSpec, &dst.Spec.KubeadmConfigSpec)
+		dst.Spec.EtcdSnapshot = restored.Spec.EtcdSnapshot
+		dst.Spec.EtcdDefragmentation = restored.Spec.EtcdDefragmentation
+		if restored.Spec.RolloutStrategy != nil && restored.Spec.RolloutStrategy.RollingUpdate != nil &&
+			dst.Spec.RolloutStrategy != nil && dst.Spec.RolloutStrategy.RollingUpdate != nil {
+			dst.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = restored.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable
+		}
+		dst.Status.EtcdSnapshot = restored.Status.EtcdSnapshot
 	}
 
 	// Override restored data with timeouts values already existing in v1beta1 but in other structs.
@@ -78,6 +85,12 @@ func (src *KubeadmControlPlaneTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 	if ok {
 		bootstrapv1beta1.RestoreKubeadmConfigSpec(&restored.Spec.Template.Spec.KubeadmConfigSpec, &dst.Spec.Template.Spec.KubeadmConfigSpec)
+		dst.Spec.Template.Spec.EtcdSnapshot = restored.Spec.Template.Spec.EtcdSnapshot
+		dst.Spec.Template.Spec.EtcdDefragmentation = restored.Spec.Template.Spec.EtcdDefragmentation
+		if restored.Spec.Template.Spec.RolloutStrategy != nil && restored.Spec.Template.Spec.RolloutStrategy.RollingUpdate != nil &&
+			dst.Spec.Template.Spec.RolloutStrategy != nil && dst.Spec.Template.Spec.RolloutStrategy.RollingUpdate != nil {
+			dst.Spec.Template.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = restored.Spec.Template.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable
+		}
 	}
 
 	// Override restored data with timeouts values already existing in v1beta1 but in other structs.
@@ -188,6 +201,14 @@ func Convert_v1beta1_KubeadmControlPlaneStatus_To_v1beta2_KubeadmControlPlaneSta
 	return nil
 }
 
+func Convert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec(in *controlplanev1.KubeadmControlPlaneSpec, out *KubeadmControlPlaneSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec(in, out, s)
+}
+
+func Convert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec(in *controlplanev1.KubeadmControlPlaneTemplateResourceSpec, out *KubeadmControlPlaneTemplateResourceSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec(in, out, s)
+}
+
 func Convert_v1beta1_KubeadmControlPlaneMachineTemplate_To_v1beta2_KubeadmControlPlaneMachineTemplate(in *KubeadmControlPlaneMachineTemplate, out *controlplanev1.KubeadmControlPlaneMachineTemplate, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta1_KubeadmControlPlaneMachineTemplate_To_v1beta2_KubeadmControlPlaneMachineTemplate(in, out, s); err != nil {
 		return err
@@ -245,6 +266,11 @@ func Convert_v1beta2_RemediationStrategy_To_v1beta1_RemediationStrategy(in *cont
 	return nil
 }
 
+func Convert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate(in *controlplanev1.RollingUpdate, out *RollingUpdate, s apimachineryconversion.Scope) error {
+	// .MaxUnavailable was added in v1beta2.
+	return autoConvert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate(in, out, s)
+}
+
 // Implement local conversion func because conversion-gen is not aware of conversion func in other packages (see https://github.com/kubernetes/code-generator/issues/94)
 
 func Convert_v1beta1_ObjectMeta_To_v1beta2_ObjectMeta(in *clusterv1beta1.ObjectMeta, out *clusterv1.ObjectMeta, s apimachineryconversion.Scope) error {
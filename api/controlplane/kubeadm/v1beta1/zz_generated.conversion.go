@@ -67,11 +67,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.KubeadmControlPlaneSpec)(nil), (*KubeadmControlPlaneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec(a.(*v1beta2.KubeadmControlPlaneSpec), b.(*KubeadmControlPlaneSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*KubeadmControlPlaneTemplate)(nil), (*v1beta2.KubeadmControlPlaneTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeadmControlPlaneTemplate_To_v1beta2_KubeadmControlPlaneTemplate(a.(*KubeadmControlPlaneTemplate), b.(*v1beta2.KubeadmControlPlaneTemplate), scope)
 	}); err != nil {
@@ -107,11 +102,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.KubeadmControlPlaneTemplateResourceSpec)(nil), (*KubeadmControlPlaneTemplateResourceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec(a.(*v1beta2.KubeadmControlPlaneTemplateResourceSpec), b.(*KubeadmControlPlaneTemplateResourceSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*KubeadmControlPlaneTemplateSpec)(nil), (*v1beta2.KubeadmControlPlaneTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeadmControlPlaneTemplateSpec_To_v1beta2_KubeadmControlPlaneTemplateSpec(a.(*KubeadmControlPlaneTemplateSpec), b.(*v1beta2.KubeadmControlPlaneTemplateSpec), scope)
 	}); err != nil {
@@ -222,6 +212,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.KubeadmControlPlaneSpec)(nil), (*KubeadmControlPlaneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec(a.(*v1beta2.KubeadmControlPlaneSpec), b.(*KubeadmControlPlaneSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.KubeadmControlPlaneStatus)(nil), (*KubeadmControlPlaneStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_KubeadmControlPlaneStatus_To_v1beta1_KubeadmControlPlaneStatus(a.(*v1beta2.KubeadmControlPlaneStatus), b.(*KubeadmControlPlaneStatus), scope)
 	}); err != nil {
@@ -232,6 +227,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.KubeadmControlPlaneTemplateResourceSpec)(nil), (*KubeadmControlPlaneTemplateResourceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec(a.(*v1beta2.KubeadmControlPlaneTemplateResourceSpec), b.(*KubeadmControlPlaneTemplateResourceSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*corev1beta2.ObjectMeta)(nil), (*corev1beta1.ObjectMeta)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ObjectMeta_To_v1beta1_ObjectMeta(a.(*corev1beta2.ObjectMeta), b.(*corev1beta1.ObjectMeta), scope)
 	}); err != nil {
@@ -354,7 +354,15 @@ func autoConvert_v1beta1_KubeadmControlPlaneSpec_To_v1beta2_KubeadmControlPlaneS
 	}
 	out.RolloutBefore = (*v1beta2.RolloutBefore)(unsafe.Pointer(in.RolloutBefore))
 	out.RolloutAfter = (*v1.Time)(unsafe.Pointer(in.RolloutAfter))
-	out.RolloutStrategy = (*v1beta2.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(v1beta2.RolloutStrategy)
+		if err := Convert_v1beta1_RolloutStrategy_To_v1beta2_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	if in.RemediationStrategy != nil {
 		in, out := &in.RemediationStrategy, &out.RemediationStrategy
 		*out = new(v1beta2.RemediationStrategy)
@@ -384,7 +392,15 @@ func autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneS
 	}
 	out.RolloutBefore = (*RolloutBefore)(unsafe.Pointer(in.RolloutBefore))
 	out.RolloutAfter = (*v1.Time)(unsafe.Pointer(in.RolloutAfter))
-	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		if err := Convert_v1beta2_RolloutStrategy_To_v1beta1_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	if in.RemediationStrategy != nil {
 		in, out := &in.RemediationStrategy, &out.RemediationStrategy
 		*out = new(RemediationStrategy)
@@ -395,14 +411,11 @@ func autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneS
 		out.RemediationStrategy = nil
 	}
 	out.MachineNamingStrategy = (*MachineNamingStrategy)(unsafe.Pointer(in.MachineNamingStrategy))
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdDefragmentation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec is an autogenerated conversion function.
-func Convert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec(in *v1beta2.KubeadmControlPlaneSpec, out *KubeadmControlPlaneSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1beta1_KubeadmControlPlaneSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_KubeadmControlPlaneStatus_To_v1beta2_KubeadmControlPlaneStatus(in *KubeadmControlPlaneStatus, out *v1beta2.KubeadmControlPlaneStatus, s conversion.Scope) error {
 	out.Selector = in.Selector
 	if err := v1.Convert_int32_To_Pointer_int32(&in.Replicas, &out.Replicas, s); err != nil {
@@ -460,6 +473,7 @@ func autoConvert_v1beta2_KubeadmControlPlaneStatus_To_v1beta1_KubeadmControlPlan
 	out.Version = (*string)(unsafe.Pointer(in.Version))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.LastRemediation = (*LastRemediationStatus)(unsafe.Pointer(in.LastRemediation))
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -597,7 +611,15 @@ func autoConvert_v1beta1_KubeadmControlPlaneTemplateResourceSpec_To_v1beta2_Kube
 	}
 	out.RolloutBefore = (*v1beta2.RolloutBefore)(unsafe.Pointer(in.RolloutBefore))
 	out.RolloutAfter = (*v1.Time)(unsafe.Pointer(in.RolloutAfter))
-	out.RolloutStrategy = (*v1beta2.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(v1beta2.RolloutStrategy)
+		if err := Convert_v1beta1_RolloutStrategy_To_v1beta2_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	if in.RemediationStrategy != nil {
 		in, out := &in.RemediationStrategy, &out.RemediationStrategy
 		*out = new(v1beta2.RemediationStrategy)
@@ -631,7 +653,15 @@ func autoConvert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_Kube
 	}
 	out.RolloutBefore = (*RolloutBefore)(unsafe.Pointer(in.RolloutBefore))
 	out.RolloutAfter = (*v1.Time)(unsafe.Pointer(in.RolloutAfter))
-	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		if err := Convert_v1beta2_RolloutStrategy_To_v1beta1_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	if in.RemediationStrategy != nil {
 		in, out := &in.RemediationStrategy, &out.RemediationStrategy
 		*out = new(RemediationStrategy)
@@ -642,14 +672,11 @@ func autoConvert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_Kube
 		out.RemediationStrategy = nil
 	}
 	out.MachineNamingStrategy = (*MachineNamingStrategy)(unsafe.Pointer(in.MachineNamingStrategy))
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdDefragmentation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec is an autogenerated conversion function.
-func Convert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec(in *v1beta2.KubeadmControlPlaneTemplateResourceSpec, out *KubeadmControlPlaneTemplateResourceSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_KubeadmControlPlaneTemplateResourceSpec_To_v1beta1_KubeadmControlPlaneTemplateResourceSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_KubeadmControlPlaneTemplateSpec_To_v1beta2_KubeadmControlPlaneTemplateSpec(in *KubeadmControlPlaneTemplateSpec, out *v1beta2.KubeadmControlPlaneTemplateSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubeadmControlPlaneTemplateResource_To_v1beta2_KubeadmControlPlaneTemplateResource(&in.Template, &out.Template, s); err != nil {
 		return err
@@ -744,14 +771,10 @@ func Convert_v1beta1_RollingUpdate_To_v1beta2_RollingUpdate(in *RollingUpdate, o
 
 func autoConvert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate(in *v1beta2.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	// WARNING: in.MaxUnavailable requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate is an autogenerated conversion function.
-func Convert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate(in *v1beta2.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
-	return autoConvert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate(in, out, s)
-}
-
 func autoConvert_v1beta1_RolloutBefore_To_v1beta2_RolloutBefore(in *RolloutBefore, out *v1beta2.RolloutBefore, s conversion.Scope) error {
 	out.CertificatesExpiryDays = (*int32)(unsafe.Pointer(in.CertificatesExpiryDays))
 	return nil
@@ -774,7 +797,15 @@ func Convert_v1beta2_RolloutBefore_To_v1beta1_RolloutBefore(in *v1beta2.RolloutB
 
 func autoConvert_v1beta1_RolloutStrategy_To_v1beta2_RolloutStrategy(in *RolloutStrategy, out *v1beta2.RolloutStrategy, s conversion.Scope) error {
 	out.Type = v1beta2.RolloutStrategyType(in.Type)
-	out.RollingUpdate = (*v1beta2.RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(v1beta2.RollingUpdate)
+		if err := Convert_v1beta1_RollingUpdate_To_v1beta2_RollingUpdate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -785,7 +816,15 @@ func Convert_v1beta1_RolloutStrategy_To_v1beta2_RolloutStrategy(in *RolloutStrat
 
 func autoConvert_v1beta2_RolloutStrategy_To_v1beta1_RolloutStrategy(in *v1beta2.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
 	out.Type = RolloutStrategyType(in.Type)
-	out.RollingUpdate = (*RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdate)
+		if err := Convert_v1beta2_RollingUpdate_To_v1beta1_RollingUpdate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
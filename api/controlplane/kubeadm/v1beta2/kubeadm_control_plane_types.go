@@ -70,9 +70,51 @@ const (
 	// ensure it runs last (thus ensuring that kubelet is still working while other pre-terminate hooks run).
 	PreTerminateHookCleanupAnnotation = clusterv1.PreTerminateDeleteHookAnnotationPrefix + "/kcp-cleanup"
 
+	// RestoreEtcdSnapshotAnnotation can be set on a KubeadmControlPlane to request a restore of the etcd cluster
+	// from the snapshot with the given name, e.g. after quorum loss.
+	// When a restore is requested, KubeadmControlPlane deletes all the existing control plane machines, creates a single
+	// machine bootstrapped from the snapshot, and then scales back out to the desired number of replicas.
+	// The annotation is removed by KubeadmControlPlane once the restore is completed.
+	// NOTE: A restore is supported only when etcd is managed by KubeadmControlPlane (stacked etcd).
+	RestoreEtcdSnapshotAnnotation = "controlplane.cluster.x-k8s.io/restore-etcd-snapshot"
+
+	// InPlaceUpdateExtensionAnnotation is the annotation KCP sets on Machines being updated in-place to keep track
+	// of the Runtime Extension performing the update.
+	// The annotation is removed by KubeadmControlPlane once the in-place update is completed or failed.
+	// NOTE: In-place updates are supported only when the InPlaceUpdates feature gate is enabled.
+	InPlaceUpdateExtensionAnnotation = "controlplane.cluster.x-k8s.io/in-place-update-extension"
+
+	// CertificatesRenewalExtensionAnnotation is the annotation KCP sets on Machines whose certificates are being renewed
+	// in-place to keep track of the Runtime Extension performing the renewal.
+	// The annotation is removed by KubeadmControlPlane once the renewal is completed or failed.
+	// NOTE: In-place certificates renewal is supported only when the InPlaceUpdates feature gate is enabled.
+	CertificatesRenewalExtensionAnnotation = "controlplane.cluster.x-k8s.io/certificates-renewal-extension"
+
+	// RotateCertificateAuthorityAnnotation can be set on a KubeadmControlPlane to request a rotation of the cluster CA.
+	// The rotation goes through the Trust, Sign and Cleanup phases; in each phase control plane machines and worker machines
+	// owned by MachineDeployments are rolled out by KubeadmControlPlane, while the other worker machines must be rolled out
+	// by the user before moving to the next phase, see CertificateAuthorityRotationWorkersRolledOutAnnotation.
+	// The annotation is removed by KubeadmControlPlane once the rotation is started.
+	// NOTE: A rotation is supported only when the cluster CA is generated by KubeadmControlPlane.
+	RotateCertificateAuthorityAnnotation = "controlplane.cluster.x-k8s.io/rotate-certificate-authority"
+
+	// CertificateAuthorityRotationWorkersRolledOutAnnotation can be set on a KubeadmControlPlane to acknowledge that the
+	// worker machines KubeadmControlPlane cannot roll out, e.g. stand-alone Machines or Machines of MachinePools, have been
+	// rolled out by the user during a phase of a cluster CA rotation; its value is the name of the phase, e.g. Trust.
+	// KubeadmControlPlane does not move to the next phase until those machines are rolled out or the rollout is acknowledged.
+	// The annotation is removed by KubeadmControlPlane when moving to the next phase.
+	CertificateAuthorityRotationWorkersRolledOutAnnotation = "controlplane.cluster.x-k8s.io/certificate-authority-rotation-workers-rolled-out"
+
 	// DefaultMinHealthyPeriodSeconds defines the default minimum period before we consider a remediation on a
 	// machine unrelated from the previous remediation.
 	DefaultMinHealthyPeriodSeconds = int32(60 * 60)
+
+	// DefaultEtcdSnapshotRetention defines the default number of etcd snapshots to keep.
+	DefaultEtcdSnapshotRetention = int32(3)
+
+	// DefaultEtcdDefragmentationThresholdPercent defines the default fragmentation percentage of an etcd member
+	// database above which the member is defragmented.
+	DefaultEtcdDefragmentationThresholdPercent = int32(50)
 )
 
 // KubeadmControlPlane's Available condition and corresponding reasons.
@@ -301,6 +343,41 @@ const (
 	KubeadmControlPlaneRemediatingInternalErrorReason = clusterv1.InternalErrorReason
 )
 
+// KubeadmControlPlane's EtcdDefragmenting condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneEtcdDefragmentingCondition surfaces details about ongoing defragmentation of etcd members, if any.
+	// Note: this condition is set only when spec.etcdDefragmentation is set.
+	KubeadmControlPlaneEtcdDefragmentingCondition = "EtcdDefragmenting"
+
+	// KubeadmControlPlaneEtcdDefragmentingReason surfaces when at least one etcd member is being defragmented,
+	// or when a NOSPACE alarm is still raised.
+	KubeadmControlPlaneEtcdDefragmentingReason = "Defragmenting"
+
+	// KubeadmControlPlaneEtcdNotDefragmentingReason surfaces when no etcd member requires defragmentation.
+	KubeadmControlPlaneEtcdNotDefragmentingReason = "NotDefragmenting"
+
+	// KubeadmControlPlaneEtcdDefragmentingInspectionFailedReason surfaces when it is not possible to inspect etcd members
+	// and thus to determine if defragmentation is required.
+	KubeadmControlPlaneEtcdDefragmentingInspectionFailedReason = clusterv1.InspectionFailedReason
+)
+
+// KubeadmControlPlane's CertificateAuthorityRotating condition and corresponding reasons.
+const (
+	// KubeadmControlPlaneCertificateAuthorityRotatingCondition surfaces details about an ongoing rotation of the cluster CA, if any.
+	// Note: this condition is set only after a CA rotation has been requested.
+	KubeadmControlPlaneCertificateAuthorityRotatingCondition = "CertificateAuthorityRotating"
+
+	// KubeadmControlPlaneCertificateAuthorityRotatingReason surfaces when a rotation of the cluster CA is in progress.
+	KubeadmControlPlaneCertificateAuthorityRotatingReason = "Rotating"
+
+	// KubeadmControlPlaneCertificateAuthorityNotRotatingReason surfaces when no rotation of the cluster CA is in progress.
+	KubeadmControlPlaneCertificateAuthorityNotRotatingReason = "NotRotating"
+
+	// KubeadmControlPlaneCertificateAuthorityRotationNotSupportedReason surfaces when a rotation of the cluster CA
+	// has been requested, but the cluster CA is not managed by KubeadmControlPlane.
+	KubeadmControlPlaneCertificateAuthorityRotationNotSupportedReason = "RotationNotSupported"
+)
+
 // Reasons that will be used for the OwnerRemediated condition set by MachineHealthCheck on KubeadmControlPlane controlled machines
 // being remediated in v1Beta2 API version.
 const (
@@ -416,6 +493,45 @@ const (
 	KubeadmControlPlaneMachineEtcdMemberDeletingReason = "Deleting"
 )
 
+// UpdatingInPlace condition and corresponding reasons that will be used for KubeadmControlPlane controlled machines in v1Beta2 API version.
+// NOTE: This condition is set only on machines updated in-place, which requires the InPlaceUpdates feature gate to be enabled.
+const (
+	// KubeadmControlPlaneMachineUpdatingInPlaceCondition surfaces the status of the in-place update of a KubeadmControlPlane controlled machine.
+	KubeadmControlPlaneMachineUpdatingInPlaceCondition = "UpdatingInPlace"
+
+	// KubeadmControlPlaneMachineInPlaceUpdateInProgressReason surfaces when the in-place update of a KubeadmControlPlane
+	// controlled machine is in progress.
+	KubeadmControlPlaneMachineInPlaceUpdateInProgressReason = "InPlaceUpdateInProgress"
+
+	// KubeadmControlPlaneMachineInPlaceUpdateCompletedReason surfaces when the in-place update of a KubeadmControlPlane
+	// controlled machine is completed.
+	KubeadmControlPlaneMachineInPlaceUpdateCompletedReason = "InPlaceUpdateCompleted"
+
+	// KubeadmControlPlaneMachineInPlaceUpdateFailedReason surfaces when the in-place update of a KubeadmControlPlane
+	// controlled machine failed; machines with a failed in-place update are rolled out.
+	KubeadmControlPlaneMachineInPlaceUpdateFailedReason = "InPlaceUpdateFailed"
+)
+
+// RenewingCertificates condition and corresponding reasons that will be used for KubeadmControlPlane controlled machines in v1Beta2 API version.
+// NOTE: This condition is set only on machines whose certificates are renewed in-place, which requires the InPlaceUpdates feature gate to be enabled.
+const (
+	// KubeadmControlPlaneMachineRenewingCertificatesCondition surfaces the status of the in-place certificates renewal
+	// of a KubeadmControlPlane controlled machine.
+	KubeadmControlPlaneMachineRenewingCertificatesCondition = "RenewingCertificates"
+
+	// KubeadmControlPlaneMachineCertificatesRenewalInProgressReason surfaces when the certificates renewal of a
+	// KubeadmControlPlane controlled machine is in progress.
+	KubeadmControlPlaneMachineCertificatesRenewalInProgressReason = "CertificatesRenewalInProgress"
+
+	// KubeadmControlPlaneMachineCertificatesRenewalCompletedReason surfaces when the certificates renewal of a
+	// KubeadmControlPlane controlled machine is completed.
+	KubeadmControlPlaneMachineCertificatesRenewalCompletedReason = "CertificatesRenewalCompleted"
+
+	// KubeadmControlPlaneMachineCertificatesRenewalFailedReason surfaces when the certificates renewal of a
+	// KubeadmControlPlane controlled machine failed; machines with a failed certificates renewal are rolled out.
+	KubeadmControlPlaneMachineCertificatesRenewalFailedReason = "CertificatesRenewalFailed"
+)
+
 // KubeadmControlPlaneSpec defines the desired state of KubeadmControlPlane.
 type KubeadmControlPlaneSpec struct {
 	// replicas is the number of desired machines. Defaults to 1. When stacked etcd is used only
@@ -473,6 +589,20 @@ type KubeadmControlPlaneSpec struct {
 	// InfraMachines & KubeadmConfigs will use the same name as the corresponding Machines.
 	// +optional
 	MachineNamingStrategy *MachineNamingStrategy `json:"machineNamingStrategy,omitempty"`
+
+	// etcdSnapshot configures scheduled snapshots of the etcd cluster hosted on control plane machines.
+	// NOTE: Snapshots are taken only when etcd is managed by KubeadmControlPlane (stacked etcd) and
+	// the KubeadmControlPlane controller is configured with a snapshot storage location.
+	// +optional
+	EtcdSnapshot *EtcdSnapshotPolicy `json:"etcdSnapshot,omitempty"`
+
+	// etcdDefragmentation configures automatic defragmentation of the etcd members hosted on control plane machines.
+	// When set, KubeadmControlPlane defragments members one at a time, leader last, when the fragmentation of
+	// the member database exceeds the configured threshold or when a NOSPACE alarm is raised; NOSPACE alarms
+	// are disarmed once all the members have been defragmented.
+	// NOTE: Defragmentation is performed only when etcd is managed by KubeadmControlPlane (stacked etcd).
+	// +optional
+	EtcdDefragmentation *EtcdDefragmentationPolicy `json:"etcdDefragmentation,omitempty"`
 }
 
 // KubeadmControlPlaneMachineTemplate defines the template for Machines
@@ -552,11 +682,19 @@ type RollingUpdate struct {
 	// maxSurge is the maximum number of control planes that can be scheduled above or under the
 	// desired number of control planes.
 	// Value can be an absolute number 1 or 0.
-	// Defaults to 1.
+	// Defaults to 1, or to 0 if maxUnavailable is set to 1.
 	// Example: when this is set to 1, the control plane can be scaled
 	// up immediately when the rolling update starts.
 	// +optional
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+
+	// maxUnavailable is the maximum number of control planes that can be unavailable during the rolling update.
+	// Value can be an absolute number 1 or 0; exactly one of maxSurge and maxUnavailable must be 1.
+	// Defaults to 0, or to 1 if maxSurge is set to 0.
+	// Example: when this is set to 1, an outdated control plane machine is deleted before
+	// its replacement is created (scale-in-first); this requires at least 3 replicas.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 // RemediationStrategy allows to define how control plane machine remediation happens.
@@ -624,11 +762,37 @@ type MachineNamingStrategy struct {
 	Template string `json:"template,omitempty"`
 }
 
+// EtcdSnapshotPolicy defines the schedule and the retention policy for etcd snapshots.
+type EtcdSnapshotPolicy struct {
+	// intervalSeconds is the minimum amount of time between two consecutive etcd snapshots.
+	// +required
+	// +kubebuilder:validation:Minimum=300
+	IntervalSeconds int32 `json:"intervalSeconds"`
+
+	// retention is the number of etcd snapshots to keep; older snapshots are deleted
+	// after a new snapshot has been successfully taken.
+	// If not set, this value is defaulted to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Retention *int32 `json:"retention,omitempty"`
+}
+
+// EtcdDefragmentationPolicy defines when etcd members should be defragmented.
+type EtcdDefragmentationPolicy struct {
+	// thresholdPercent is the percentage of the etcd member database size which is allocated but not in use
+	// above which the member is defragmented.
+	// If not set, this value is defaulted to 50.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ThresholdPercent *int32 `json:"thresholdPercent,omitempty"`
+}
+
 // KubeadmControlPlaneStatus defines the observed state of KubeadmControlPlane.
 type KubeadmControlPlaneStatus struct {
 	// conditions represents the observations of a KubeadmControlPlane's current state.
 	// Known condition types are Available, CertificatesAvailable, EtcdClusterAvailable, MachinesReady, MachinesUpToDate,
-	// ScalingUp, ScalingDown, Remediating, Deleting, Paused.
+	// ScalingUp, ScalingDown, Remediating, EtcdDefragmenting, Deleting, Paused.
 	// +optional
 	// +listType=map
 	// +listMapKey=type
@@ -682,6 +846,10 @@ type KubeadmControlPlaneStatus struct {
 	// +optional
 	LastRemediation *LastRemediationStatus `json:"lastRemediation,omitempty"`
 
+	// etcdSnapshot reports info about etcd snapshots and about an etcd restore in progress, if any.
+	// +optional
+	EtcdSnapshot *EtcdSnapshotStatus `json:"etcdSnapshot,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *KubeadmControlPlaneDeprecatedStatus `json:"deprecated,omitempty"`
@@ -781,6 +949,37 @@ type LastRemediationStatus struct {
 	RetryCount int32 `json:"retryCount"`
 }
 
+// EtcdSnapshotStatus reports info about etcd snapshots and about an etcd restore in progress, if any.
+type EtcdSnapshotStatus struct {
+	// lastSnapshotName is the name of the last etcd snapshot successfully taken.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	LastSnapshotName string `json:"lastSnapshotName,omitempty"`
+
+	// lastSnapshotTime is when the last etcd snapshot has been successfully taken.
+	// +optional
+	LastSnapshotTime *metav1.Time `json:"lastSnapshotTime,omitempty"`
+
+	// restore reports info about an etcd restore in progress.
+	// +optional
+	Restore *EtcdRestoreStatus `json:"restore,omitempty"`
+}
+
+// EtcdRestoreStatus reports info about an etcd restore in progress.
+type EtcdRestoreStatus struct {
+	// snapshotName is the name of the etcd snapshot being restored.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SnapshotName string `json:"snapshotName"`
+
+	// startTime is when the restore has been started. Machines created before this time
+	// are deleted as part of the restore process.
+	// +required
+	StartTime metav1.Time `json:"startTime"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=kubeadmcontrolplanes,shortName=kcp,scope=Namespaced,categories=cluster-api
 // +kubebuilder:storageversion
@@ -121,6 +121,17 @@ type KubeadmControlPlaneTemplateResourceSpec struct {
 	// InfraMachines & KubeadmConfigs will use the same name as the corresponding Machines.
 	// +optional
 	MachineNamingStrategy *MachineNamingStrategy `json:"machineNamingStrategy,omitempty"`
+
+	// etcdSnapshot configures scheduled snapshots of the etcd cluster hosted on control plane machines.
+	// NOTE: Snapshots are taken only when etcd is managed by KubeadmControlPlane (stacked etcd) and
+	// the KubeadmControlPlane controller is configured with a snapshot storage location.
+	// +optional
+	EtcdSnapshot *EtcdSnapshotPolicy `json:"etcdSnapshot,omitempty"`
+
+	// etcdDefragmentation configures automatic defragmentation of the etcd members hosted on control plane machines.
+	// NOTE: Defragmentation is performed only when etcd is managed by KubeadmControlPlane (stacked etcd).
+	// +optional
+	EtcdDefragmentation *EtcdDefragmentationPolicy `json:"etcdDefragmentation,omitempty"`
 }
 
 // KubeadmControlPlaneTemplateMachineTemplate defines the template for Machines
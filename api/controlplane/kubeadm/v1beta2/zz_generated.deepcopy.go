@@ -27,6 +27,86 @@ import (
 	corev1beta2 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdDefragmentationPolicy) DeepCopyInto(out *EtcdDefragmentationPolicy) {
+	*out = *in
+	if in.ThresholdPercent != nil {
+		in, out := &in.ThresholdPercent, &out.ThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdDefragmentationPolicy.
+func (in *EtcdDefragmentationPolicy) DeepCopy() *EtcdDefragmentationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdDefragmentationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdRestoreStatus) DeepCopyInto(out *EtcdRestoreStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdRestoreStatus.
+func (in *EtcdRestoreStatus) DeepCopy() *EtcdRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotPolicy) DeepCopyInto(out *EtcdSnapshotPolicy) {
+	*out = *in
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotPolicy.
+func (in *EtcdSnapshotPolicy) DeepCopy() *EtcdSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshotStatus) DeepCopyInto(out *EtcdSnapshotStatus) {
+	*out = *in
+	if in.LastSnapshotTime != nil {
+		in, out := &in.LastSnapshotTime, &out.LastSnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(EtcdRestoreStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshotStatus.
+func (in *EtcdSnapshotStatus) DeepCopy() *EtcdSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeadmControlPlane) DeepCopyInto(out *KubeadmControlPlane) {
 	*out = *in
@@ -192,6 +272,16 @@ func (in *KubeadmControlPlaneSpec) DeepCopyInto(out *KubeadmControlPlaneSpec) {
 		*out = new(MachineNamingStrategy)
 		**out = **in
 	}
+	if in.EtcdSnapshot != nil {
+		in, out := &in.EtcdSnapshot, &out.EtcdSnapshot
+		*out = new(EtcdSnapshotPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdDefragmentation != nil {
+		in, out := &in.EtcdDefragmentation, &out.EtcdDefragmentation
+		*out = new(EtcdDefragmentationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneSpec.
@@ -249,6 +339,11 @@ func (in *KubeadmControlPlaneStatus) DeepCopyInto(out *KubeadmControlPlaneStatus
 		*out = new(LastRemediationStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EtcdSnapshot != nil {
+		in, out := &in.EtcdSnapshot, &out.EtcdSnapshot
+		*out = new(EtcdSnapshotStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(KubeadmControlPlaneDeprecatedStatus)
@@ -405,6 +500,16 @@ func (in *KubeadmControlPlaneTemplateResourceSpec) DeepCopyInto(out *KubeadmCont
 		*out = new(MachineNamingStrategy)
 		**out = **in
 	}
+	if in.EtcdSnapshot != nil {
+		in, out := &in.EtcdSnapshot, &out.EtcdSnapshot
+		*out = new(EtcdSnapshotPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdDefragmentation != nil {
+		in, out := &in.EtcdDefragmentation, &out.EtcdDefragmentation
+		*out = new(EtcdDefragmentationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeadmControlPlaneTemplateResourceSpec.
@@ -524,6 +629,11 @@ func (in *RollingUpdate) DeepCopyInto(out *RollingUpdate) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdate.
@@ -161,6 +161,9 @@ const (
 
 	// UnhealthyNodeConditionReason is the reason used when a machine's node has one of the MachineHealthCheck's unhealthy conditions.
 	UnhealthyNodeConditionReason = "UnhealthyNode"
+
+	// UnhealthyNodeTaintReason is the reason used when a machine's node has one of the MachineHealthCheck's unhealthy taints.
+	UnhealthyNodeTaintReason = "UnhealthyNodeTaint"
 )
 
 const (
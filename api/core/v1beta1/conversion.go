@@ -32,25 +32,94 @@ import (
 func (src *Cluster) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*clusterv1.Cluster)
 
-	return Convert_v1beta1_Cluster_To_v1beta2_Cluster(src, dst, nil)
+	if err := Convert_v1beta1_Cluster_To_v1beta2_Cluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &clusterv1.Cluster{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	if restored.Spec.Topology != nil && dst.Spec.Topology != nil {
+		if restored.Spec.Topology.ControlPlane.MachineHealthCheck != nil && dst.Spec.Topology.ControlPlane.MachineHealthCheck != nil {
+			restoreMachineHealthCheckClass(&restored.Spec.Topology.ControlPlane.MachineHealthCheck.MachineHealthCheckClass, &dst.Spec.Topology.ControlPlane.MachineHealthCheck.MachineHealthCheckClass)
+		}
+		if restored.Spec.Topology.Workers != nil && dst.Spec.Topology.Workers != nil {
+			for i := range dst.Spec.Topology.Workers.MachineDeployments {
+				dstMD := &dst.Spec.Topology.Workers.MachineDeployments[i]
+				for _, restoredMD := range restored.Spec.Topology.Workers.MachineDeployments {
+					if restoredMD.Name != dstMD.Name {
+						continue
+					}
+					if restoredMD.MachineHealthCheck != nil && dstMD.MachineHealthCheck != nil {
+						restoreMachineHealthCheckClass(&restoredMD.MachineHealthCheck.MachineHealthCheckClass, &dstMD.MachineHealthCheck.MachineHealthCheckClass)
+					}
+					restoreMachineDeploymentStrategy(restoredMD.Strategy, dstMD.Strategy)
+				}
+			}
+		}
+	}
+
+	return nil
 }
 
 func (dst *Cluster) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*clusterv1.Cluster)
 
-	return Convert_v1beta2_Cluster_To_v1beta1_Cluster(src, dst, nil)
+	if err := Convert_v1beta2_Cluster_To_v1beta1_Cluster(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 func (src *ClusterClass) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*clusterv1.ClusterClass)
 
-	return Convert_v1beta1_ClusterClass_To_v1beta2_ClusterClass(src, dst, nil)
+	if err := Convert_v1beta1_ClusterClass_To_v1beta2_ClusterClass(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &clusterv1.ClusterClass{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	if restored.Spec.ControlPlane.MachineHealthCheck != nil && dst.Spec.ControlPlane.MachineHealthCheck != nil {
+		restoreMachineHealthCheckClass(restored.Spec.ControlPlane.MachineHealthCheck, dst.Spec.ControlPlane.MachineHealthCheck)
+	}
+	for i := range dst.Spec.Workers.MachineDeployments {
+		dstMD := &dst.Spec.Workers.MachineDeployments[i]
+		for _, restoredMD := range restored.Spec.Workers.MachineDeployments {
+			if restoredMD.Class != dstMD.Class {
+				continue
+			}
+			if restoredMD.MachineHealthCheck != nil && dstMD.MachineHealthCheck != nil {
+				restoreMachineHealthCheckClass(restoredMD.MachineHealthCheck, dstMD.MachineHealthCheck)
+			}
+			restoreMachineDeploymentStrategy(restoredMD.Strategy, dstMD.Strategy)
+		}
+	}
+
+	return nil
 }
 
 func (dst *ClusterClass) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*clusterv1.ClusterClass)
 
-	return Convert_v1beta2_ClusterClass_To_v1beta1_ClusterClass(src, dst, nil)
+	if err := Convert_v1beta2_ClusterClass_To_v1beta1_ClusterClass(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
+}
+
+// restoreMachineHealthCheckClass restores the fields of a MachineHealthCheckClass which do not exist in v1beta1.
+func restoreMachineHealthCheckClass(restored, dst *clusterv1.MachineHealthCheckClass) {
+	dst.UnhealthyMachineConditions = restored.UnhealthyMachineConditions
+	dst.UnhealthyNodeTaints = restored.UnhealthyNodeTaints
+	dst.RemediationBudget = restored.RemediationBudget
 }
 
 func (src *Machine) ConvertTo(dstRaw conversion.Hub) error {
@@ -117,6 +186,14 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 
 	dst.Spec.Template.Spec.MinReadySeconds = src.Spec.MinReadySeconds
 
+	restored := &clusterv1.MachineDeployment{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	restoreMachineDeploymentStrategy(restored.Spec.Strategy, dst.Spec.Strategy)
+	dst.Status.Rollout = restored.Status.Rollout
+
 	return nil
 }
 
@@ -129,19 +206,48 @@ func (dst *MachineDeployment) ConvertFrom(srcRaw conversion.Hub) error {
 
 	dst.Spec.MinReadySeconds = src.Spec.Template.Spec.MinReadySeconds
 
-	return nil
+	return utilconversion.MarshalData(src, dst)
+}
+
+// restoreMachineDeploymentStrategy restores the fields of a MachineDeploymentStrategy which do not exist in v1beta1.
+func restoreMachineDeploymentStrategy(restored, dst *clusterv1.MachineDeploymentStrategy) {
+	if restored == nil || dst == nil {
+		return
+	}
+	dst.BlueGreen = restored.BlueGreen
+	if restored.RollingUpdate != nil && dst.RollingUpdate != nil {
+		dst.RollingUpdate.Steps = restored.RollingUpdate.Steps
+	}
 }
 
 func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*clusterv1.MachineHealthCheck)
 
-	return Convert_v1beta1_MachineHealthCheck_To_v1beta2_MachineHealthCheck(src, dst, nil)
+	if err := Convert_v1beta1_MachineHealthCheck_To_v1beta2_MachineHealthCheck(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &clusterv1.MachineHealthCheck{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.UnhealthyMachineConditions = restored.Spec.UnhealthyMachineConditions
+	dst.Spec.UnhealthyNodeTaints = restored.Spec.UnhealthyNodeTaints
+	dst.Spec.RemediationBudget = restored.Spec.RemediationBudget
+	dst.Status.Remediation = restored.Status.Remediation
+
+	return nil
 }
 
 func (dst *MachineHealthCheck) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*clusterv1.MachineHealthCheck)
 
-	return Convert_v1beta2_MachineHealthCheck_To_v1beta1_MachineHealthCheck(src, dst, nil)
+	if err := Convert_v1beta2_MachineHealthCheck_To_v1beta1_MachineHealthCheck(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 func (src *MachinePool) ConvertTo(dstRaw conversion.Hub) error {
@@ -170,12 +276,31 @@ func (dst *MachinePool) ConvertFrom(srcRaw conversion.Hub) error {
 
 func (src *MachineDrainRule) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*clusterv1.MachineDrainRule)
-	return Convert_v1beta1_MachineDrainRule_To_v1beta2_MachineDrainRule(src, dst, nil)
+
+	if err := Convert_v1beta1_MachineDrainRule_To_v1beta2_MachineDrainRule(src, dst, nil); err != nil {
+		return err
+	}
+
+	restored := &clusterv1.MachineDrainRule{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+
+	dst.Spec.Drain.EvictionTimeoutSeconds = restored.Spec.Drain.EvictionTimeoutSeconds
+	dst.Spec.Drain.GracePeriodSeconds = restored.Spec.Drain.GracePeriodSeconds
+	dst.Spec.Drain.DeleteOwnerKinds = restored.Spec.Drain.DeleteOwnerKinds
+
+	return nil
 }
 
 func (dst *MachineDrainRule) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*clusterv1.MachineDrainRule)
-	return Convert_v1beta2_MachineDrainRule_To_v1beta1_MachineDrainRule(src, dst, nil)
+
+	if err := Convert_v1beta2_MachineDrainRule_To_v1beta1_MachineDrainRule(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 func Convert_v1beta2_ClusterClass_To_v1beta1_ClusterClass(in *clusterv1.ClusterClass, out *ClusterClass, s apimachineryconversion.Scope) error {
@@ -267,6 +392,8 @@ func Convert_v1beta2_MachineHealthCheckClass_To_v1beta1_MachineHealthCheckClass(
 		return err
 	}
 
+	// .UnhealthyMachineConditions, .UnhealthyNodeTaints and .RemediationBudget were added in v1beta2.
+
 	for _, c := range in.UnhealthyNodeConditions {
 		out.UnhealthyConditions = append(out.UnhealthyConditions, UnhealthyCondition{
 			Type:    c.Type,
@@ -725,11 +852,28 @@ func Convert_v1beta1_MachineHealthCheckSpec_To_v1beta2_MachineHealthCheckSpec(in
 	return nil
 }
 
+func Convert_v1beta2_MachineDrainRuleDrainConfig_To_v1beta1_MachineDrainRuleDrainConfig(in *clusterv1.MachineDrainRuleDrainConfig, out *MachineDrainRuleDrainConfig, s apimachineryconversion.Scope) error {
+	// .EvictionTimeoutSeconds, .GracePeriodSeconds and .DeleteOwnerKinds were added in v1beta2.
+	return autoConvert_v1beta2_MachineDrainRuleDrainConfig_To_v1beta1_MachineDrainRuleDrainConfig(in, out, s)
+}
+
+func Convert_v1beta2_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(in *clusterv1.MachineDeploymentStrategy, out *MachineDeploymentStrategy, s apimachineryconversion.Scope) error {
+	// .BlueGreen was added in v1beta2.
+	return autoConvert_v1beta2_MachineDeploymentStrategy_To_v1beta1_MachineDeploymentStrategy(in, out, s)
+}
+
+func Convert_v1beta2_MachineRollingUpdateDeployment_To_v1beta1_MachineRollingUpdateDeployment(in *clusterv1.MachineRollingUpdateDeployment, out *MachineRollingUpdateDeployment, s apimachineryconversion.Scope) error {
+	// .Steps was added in v1beta2.
+	return autoConvert_v1beta2_MachineRollingUpdateDeployment_To_v1beta1_MachineRollingUpdateDeployment(in, out, s)
+}
+
 func Convert_v1beta2_MachineHealthCheckSpec_To_v1beta1_MachineHealthCheckSpec(in *clusterv1.MachineHealthCheckSpec, out *MachineHealthCheckSpec, s apimachineryconversion.Scope) error {
 	if err := autoConvert_v1beta2_MachineHealthCheckSpec_To_v1beta1_MachineHealthCheckSpec(in, out, s); err != nil {
 		return err
 	}
 
+	// .UnhealthyMachineConditions, .UnhealthyNodeTaints and .RemediationBudget were added in v1beta2.
+
 	for _, c := range in.UnhealthyNodeConditions {
 		out.UnhealthyConditions = append(out.UnhealthyConditions, UnhealthyCondition{
 			Type:    c.Type,
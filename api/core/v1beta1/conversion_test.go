@@ -74,11 +74,16 @@ func TestFuzzyConversion(t *testing.T) {
 		Spoke:       &MachinePool{},
 		FuzzerFuncs: []fuzzer.FuzzerFuncs{MachinePoolFuzzFuncs},
 	}))
+	t.Run("for MachineDrainRule", utilconversion.FuzzTestFunc(utilconversion.FuzzTestFuncInput{
+		Hub:   &clusterv1.MachineDrainRule{},
+		Spoke: &MachineDrainRule{},
+	}))
 }
 
 func ClusterFuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
 	return []interface{}{
 		hubClusterStatus,
+		hubClusterVariable,
 		spokeClusterTopology,
 		spokeClusterStatus,
 		spokeClusterVariable,
@@ -107,6 +112,13 @@ func hubClusterStatus(in *clusterv1.ClusterStatus, c randfill.Continue) {
 	}
 }
 
+func hubClusterVariable(in *clusterv1.ClusterVariable, c randfill.Continue) {
+	c.FillNoCustom(in)
+
+	// Not every random byte array is valid JSON, e.g. a string without `""`,so we're setting a valid value.
+	in.Value = apiextensionsv1.JSON{Raw: []byte("\"test-string\"")}
+}
+
 func spokeClusterTopology(in *Topology, c randfill.Continue) {
 	c.FillNoCustom(in)
 
@@ -129,14 +141,19 @@ func spokeClusterVariable(in *ClusterVariable, c randfill.Continue) {
 
 	// Drop DefinitionFrom as we intentionally don't preserve it.
 	in.DefinitionFrom = ""
+
+	// Not every random byte array is valid JSON, e.g. a string without `""`,so we're setting a valid value.
+	in.Value = apiextensionsv1.JSON{Raw: []byte("\"test-string\"")}
 }
 
 func ClusterClassFuncs(_ runtimeserializer.CodecFactory) []interface{} {
 	return []interface{}{
 		hubClusterClassStatus,
+		hubJSONPatch,
 		hubJSONSchemaProps,
 		spokeClusterClass,
 		spokeClusterClassStatus,
+		spokeJSONPatch,
 		spokeJSONSchemaProps,
 		spokeControlPlaneClass,
 		spokeMachineDeploymentClass,
@@ -157,6 +174,13 @@ func hubClusterClassStatus(in *clusterv1.ClusterClassStatus, c randfill.Continue
 	}
 }
 
+func hubJSONPatch(in *clusterv1.JSONPatch, c randfill.Continue) {
+	c.FillNoCustom(in)
+
+	// Not every random byte array is valid JSON, e.g. a string without `""`,so we're setting a valid value.
+	in.Value = &apiextensionsv1.JSON{Raw: []byte("5")}
+}
+
 func hubJSONSchemaProps(in *clusterv1.JSONSchemaProps, c randfill.Continue) {
 	// NOTE: We have to fuzz the individual fields manually,
 	// because we cannot call `FillNoCustom` as it would lead
@@ -216,6 +240,13 @@ func spokeClusterClassStatus(in *ClusterClassStatus, c randfill.Continue) {
 	}
 }
 
+func spokeJSONPatch(in *JSONPatch, c randfill.Continue) {
+	c.FillNoCustom(in)
+
+	// Not every random byte array is valid JSON, e.g. a string without `""`,so we're setting a valid value.
+	in.Value = &apiextensionsv1.JSON{Raw: []byte("5")}
+}
+
 func spokeJSONSchemaProps(in *JSONSchemaProps, c randfill.Continue) {
 	// NOTE: We have to fuzz the individual fields manually,
 	// because we cannot call `FillNoCustom` as it would lead
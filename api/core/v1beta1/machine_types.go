@@ -276,6 +276,10 @@ const (
 	// defined by a MachineHealthCheck object.
 	MachineHealthCheckUnhealthyNodeV1Beta2Reason = "UnhealthyNode"
 
+	// MachineHealthCheckUnhealthyNodeTaintV1Beta2Reason surfaces when the node hosted on the machine has one of the unhealthy
+	// node taints defined by a MachineHealthCheck object for longer than the corresponding timeout.
+	MachineHealthCheckUnhealthyNodeTaintV1Beta2Reason = "UnhealthyNodeTaint"
+
 	// MachineHealthCheckNodeStartupTimeoutV1Beta2Reason surfaces when the node hosted on the machine does not appear within
 	// the timeout defined by a MachineHealthCheck object.
 	MachineHealthCheckNodeStartupTimeoutV1Beta2Reason = "NodeStartupTimeout"
@@ -160,6 +160,12 @@ const (
 	// MachineDeploymentNotRollingOutV1Beta2Reason surfaces when all the machines are up-to-date.
 	MachineDeploymentNotRollingOutV1Beta2Reason = NotRollingOutV1Beta2Reason
 
+	// MachineDeploymentRollingOutPausedV1Beta2Reason surfaces when a staged rollout is paused after a step.
+	MachineDeploymentRollingOutPausedV1Beta2Reason = "RolloutPaused"
+
+	// MachineDeploymentRollingOutRolledBackV1Beta2Reason surfaces when a staged rollout has been rolled back to the previous revision.
+	MachineDeploymentRollingOutRolledBackV1Beta2Reason = "RolledBack"
+
 	// MachineDeploymentRollingOutInternalErrorV1Beta2Reason surfaces unexpected failures when listing machines.
 	MachineDeploymentRollingOutInternalErrorV1Beta2Reason = InternalErrorV1Beta2Reason
 )
@@ -392,6 +392,23 @@ type MachineHealthCheckClass struct {
 	// +kubebuilder:validation:MaxItems=100
 	UnhealthyNodeConditions []UnhealthyNodeCondition `json:"unhealthyNodeConditions,omitempty"`
 
+	// unhealthyMachineConditions contains a list of Machine conditions that determine
+	// whether a Machine is considered unhealthy, e.g. conditions surfaced by infrastructure providers
+	// from workload-side probes. The conditions are combined in a logical OR, i.e. if any of the
+	// conditions is met, the Machine is unhealthy.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyMachineConditions []UnhealthyMachineCondition `json:"unhealthyMachineConditions,omitempty"`
+
+	// unhealthyNodeTaints contains a list of taints that determine whether a node is
+	// considered unhealthy. The taints are combined in a logical OR, i.e. if any of the
+	// taints is found on the node, the node is unhealthy.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyNodeTaints []UnhealthyNodeTaint `json:"unhealthyNodeTaints,omitempty"`
+
 	// maxUnhealthy specifies the maximum number of unhealthy machines allowed.
 	// Any further remediation is only allowed if at most "maxUnhealthy" machines selected by
 	// "selector" are not healthy.
@@ -434,6 +451,12 @@ type MachineHealthCheckClass struct {
 	// a controller that lives outside of Cluster API.
 	// +optional
 	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate,omitempty"`
+
+	// remediationBudget limits the number of remediations triggered by this MachineHealthCheck over time,
+	// e.g. to prevent a flapping Node from being remediated every few minutes forever.
+	// If not set, remediation is only limited by maxUnhealthy or unhealthyRange.
+	// +optional
+	RemediationBudget *MachineHealthCheckRemediationBudget `json:"remediationBudget,omitempty"`
 }
 
 // MachinePoolClass serves as a template to define a pool of worker nodes of the cluster
@@ -119,6 +119,12 @@ const (
 	// when KCP or a machineset scales down. This annotation is given top priority on all delete policies.
 	DeleteMachineAnnotation = "cluster.x-k8s.io/delete-machine"
 
+	// DeletePriorityAnnotation is the annotation or label used to rank Machines for deletion when a MachineSet
+	// with the "Priority" delete policy scales down; Machines with a higher integer value are deleted first.
+	// The value is read from the Machine annotations, then from the Machine labels and finally from the labels
+	// of the Node of the Machine.
+	DeletePriorityAnnotation = "cluster.x-k8s.io/delete-priority"
+
 	// TemplateClonedFromNameAnnotation is the infrastructure machine annotation that stores the name of the infrastructure template resource
 	// that was cloned for the machine. This annotation is set only during cloning a template. Older/adopted machines will not have this annotation.
 	TemplateClonedFromNameAnnotation = "cluster.x-k8s.io/cloned-from-name"
@@ -133,12 +139,18 @@ const (
 	// RemediateMachineAnnotation request the MachineHealthCheck reconciler to mark a Machine as unhealthy. CAPI builtin remediation will prioritize Machines with the annotation to be remediated.
 	RemediateMachineAnnotation = "cluster.x-k8s.io/remediate-machine"
 
+	// ResetRemediationCircuitBreakerAnnotation is the annotation used to reset the remediation circuit breaker of a MachineHealthCheck.
+	// When the annotation is added, the MachineHealthCheck controller resets the remediation status and removes the annotation.
+	ResetRemediationCircuitBreakerAnnotation = "cluster.x-k8s.io/reset-remediation-circuit-breaker"
+
 	// MachineSetSkipPreflightChecksAnnotation is the annotation used to provide a comma-separated list of
 	// preflight checks that should be skipped during the MachineSet reconciliation.
 	// Supported items are:
 	// - KubeadmVersion (skips the kubeadm version skew preflight check)
 	// - KubernetesVersion (skips the kubernetes version skew preflight check)
 	// - ControlPlaneStable (skips checking that the control plane is neither provisioning nor upgrading)
+	// - DrainCapacity (skips checking that Pods can be rescheduled on the remaining Nodes on scale down)
+	// - <handler>.<ExtensionConfig> (skips the preflight check implemented by the extension handler of a Runtime Extension)
 	// - All (skips all preflight checks)
 	// Example: "machineset.cluster.x-k8s.io/skip-preflight-checks": "ControlPlaneStable,KubernetesVersion".
 	// Note: The annotation can also be set on a MachineDeployment as MachineDeployment annotations are synced to
@@ -249,6 +261,14 @@ const (
 	// The preflight check is only run if the Cluster has a managed topology, a ControlPlane is used (controlPlaneRef
 	// must exist in the Cluster), the ControlPlane has a version and the MachineSet has a version.
 	MachineSetPreflightCheckControlPlaneVersionSkew MachineSetPreflightCheck = "ControlPlaneVersionSkew"
+
+	// MachineSetPreflightCheckDrainCapacity is the name of the preflight check
+	// that verifies if the Pods which would be evicted when draining the Nodes of the Machines being deleted on scale down
+	// can be rescheduled on the remaining Nodes of the Cluster, based on their resource requests.
+	// Differently from the other preflight checks, this preflight check is not enabled by "All" and it must be
+	// explicitly enabled.
+	// The preflight check is only run on scale down and if the control plane of the Cluster is initialized.
+	MachineSetPreflightCheckDrainCapacity MachineSetPreflightCheck = "DrainCapacity"
 )
 
 // NodeOutdatedRevisionTaint can be added to Nodes at rolling updates in general triggered by updating MachineDeployment
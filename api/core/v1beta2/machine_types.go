@@ -276,6 +276,14 @@ const (
 	// defined by a MachineHealthCheck object.
 	MachineHealthCheckUnhealthyNodeReason = "UnhealthyNode"
 
+	// MachineHealthCheckUnhealthyNodeTaintReason surfaces when the node hosted on the machine has one of the unhealthy
+	// node taints defined by a MachineHealthCheck object for longer than the corresponding timeout.
+	MachineHealthCheckUnhealthyNodeTaintReason = "UnhealthyNodeTaint"
+
+	// MachineHealthCheckUnhealthyMachineReason surfaces when the machine does not pass the health checks
+	// on Machine conditions defined by a MachineHealthCheck object.
+	MachineHealthCheckUnhealthyMachineReason = "UnhealthyMachine"
+
 	// MachineHealthCheckNodeStartupTimeoutReason surfaces when the node hosted on the machine does not appear within
 	// the timeout defined by a MachineHealthCheck object.
 	MachineHealthCheckNodeStartupTimeoutReason = "NodeStartupTimeout"
@@ -42,6 +42,21 @@ const (
 	// OnDeleteMachineDeploymentStrategyType replaces old MachineSets when the deletion of the associated machines are completed.
 	OnDeleteMachineDeploymentStrategyType MachineDeploymentStrategyType = "OnDelete"
 
+	// BlueGreenMachineDeploymentStrategyType replaces the old MachineSets by a new one as a whole
+	// i.e. scale up the new MachineSet to the desired replicas and scale down the old MachineSets
+	// only after all the Machines of the new MachineSet are available and the new MachineSet is promoted.
+	BlueGreenMachineDeploymentStrategyType MachineDeploymentStrategyType = "BlueGreen"
+
+	// MachineDeploymentPromoteAnnotation is the annotation used to promote the new MachineSet of a MachineDeployment
+	// using the BlueGreen strategy with manualPromotion enabled. The value of the annotation must be the name
+	// of the new MachineSet; the old MachineSets are scaled down only after the new MachineSet has been promoted.
+	MachineDeploymentPromoteAnnotation = "machinedeployment.cluster.x-k8s.io/promote"
+
+	// MachineDeploymentResumeRolloutStepAnnotation is the annotation used to resume a staged rollout paused after a step.
+	// The value of the annotation must be the name of the new MachineSet and the number of the completed step,
+	// separated by a slash, e.g. `md-abcde/1`; the expected value is also reported in the MachineDeployment's status.rollout.message.
+	MachineDeploymentResumeRolloutStepAnnotation = "machinedeployment.cluster.x-k8s.io/resume-rollout-step"
+
 	// RevisionAnnotation is the revision annotation of a machine deployment's machine sets which records its rollout sequence.
 	RevisionAnnotation = "machinedeployment.clusters.x-k8s.io/revision"
 
@@ -155,6 +170,12 @@ const (
 	// MachineDeploymentNotRollingOutReason surfaces when all the machines are up-to-date.
 	MachineDeploymentNotRollingOutReason = NotRollingOutReason
 
+	// MachineDeploymentRollingOutPausedReason surfaces when a staged rollout is paused after a step.
+	MachineDeploymentRollingOutPausedReason = "RolloutPaused"
+
+	// MachineDeploymentRollingOutRolledBackReason surfaces when a staged rollout has been rolled back to the previous revision.
+	MachineDeploymentRollingOutRolledBackReason = "RolledBack"
+
 	// MachineDeploymentRollingOutInternalErrorReason surfaces unexpected failures when listing machines.
 	MachineDeploymentRollingOutInternalErrorReason = InternalErrorReason
 )
@@ -302,9 +323,9 @@ type MachineDeploymentSpec struct {
 // MachineDeploymentStrategy describes how to replace existing machines
 // with new ones.
 type MachineDeploymentStrategy struct {
-	// type of deployment. Allowed values are RollingUpdate and OnDelete.
+	// type of deployment. Allowed values are RollingUpdate, OnDelete and BlueGreen.
 	// The default is RollingUpdate.
-	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete;BlueGreen
 	// +optional
 	Type MachineDeploymentStrategyType `json:"type,omitempty"`
 
@@ -313,6 +334,11 @@ type MachineDeploymentStrategy struct {
 	// +optional
 	RollingUpdate *MachineRollingUpdateDeployment `json:"rollingUpdate,omitempty"`
 
+	// blueGreen is the blue/green deployment config params. Present only if
+	// MachineDeploymentStrategyType = BlueGreen.
+	// +optional
+	BlueGreen *MachineBlueGreenDeployment `json:"blueGreen,omitempty"`
+
 	// remediation controls the strategy of remediating unhealthy machines
 	// and how remediating operations should occur during the lifecycle of the dependant MachineSets.
 	// +optional
@@ -357,15 +383,78 @@ type MachineRollingUpdateDeployment struct {
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 
 	// deletePolicy defines the policy used by the MachineDeployment to identify nodes to delete when downscaling.
-	// Valid values are "Random, "Newest", "Oldest"
+	// Valid values are "Random, "Newest", "Oldest", "LeastUtilized", "Priority"
 	// When no value is supplied, the default DeletePolicy of MachineSet is used
-	// +kubebuilder:validation:Enum=Random;Newest;Oldest
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;LeastUtilized;Priority
 	// +optional
 	DeletePolicy *string `json:"deletePolicy,omitempty"`
+
+	// steps defines the steps of a staged rollout, e.g. to roll out a canary Machine first.
+	// During each step the new MachineSet is scaled up to the number of replicas of the step only;
+	// once all those Machines are available the rollout is paused automatically, and it continues with the next
+	// step when the step is resumed using the machinedeployment.cluster.x-k8s.io/resume-rollout-step annotation,
+	// e.g. via `clusterctl alpha rollout resume`, or when the analysis condition of the step becomes true.
+	// After the last step the rollout completes as usual.
+	// If the new MachineSet remediates any of its Machines while the rollout is in progress, the rollout
+	// is rolled back automatically to the previous revision, unless the MachineDeployment is managed by a Cluster topology.
+	// Steps are not used when a MachineDeployment is scaled up from zero or does not have old MachineSets.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=10
+	Steps []MachineRolloutStep `json:"steps,omitempty"`
 }
 
 // ANCHOR_END: MachineRollingUpdateDeployment
 
+// ANCHOR: MachineRolloutStep
+
+// MachineRolloutStep defines a step of a staged rollout.
+type MachineRolloutStep struct {
+	// replicas is the number of Machines of the new MachineSet to roll out in this step.
+	// Value can be an absolute number (ex: 1) or a percentage of desired machines (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// +required
+	Replicas intstr.IntOrString `json:"replicas"`
+
+	// analysisCondition is the type of a condition on the MachineDeployment, e.g. set by an external analysis tool,
+	// which allows the rollout to continue with the next step as soon as it is true, without waiting for a manual resume.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	AnalysisCondition string `json:"analysisCondition,omitempty"`
+}
+
+// ANCHOR_END: MachineRolloutStep
+
+// ANCHOR: MachineBlueGreenDeployment
+
+// MachineBlueGreenDeployment is used to control the desired behavior of blue/green deployment.
+//
+// With the BlueGreen strategy the new MachineSet is scaled up to the desired replicas at once, while the
+// old MachineSets keep all their Machines. Only after all the Machines of the new MachineSet are available
+// and the new MachineSet is promoted, the old MachineSets are scaled down to zero and deleted; this drains
+// the Nodes of all the old Machines.
+// Until then, it is possible to roll back by reverting the MachineDeployment's template to the one of an old MachineSet.
+type MachineBlueGreenDeployment struct {
+	// promotionDelaySeconds is the number of seconds to wait after all the Machines of the new MachineSet
+	// are available before promoting the new MachineSet.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	PromotionDelaySeconds *int32 `json:"promotionDelaySeconds,omitempty"`
+
+	// manualPromotion requires the new MachineSet to be promoted explicitly by setting the
+	// machinedeployment.cluster.x-k8s.io/promote annotation on the MachineDeployment to the name
+	// of the new MachineSet. Manual promotion is not required when rolling back to a MachineSet
+	// which is older than all the MachineSets to be scaled down.
+	// Defaults to false.
+	// +optional
+	ManualPromotion *bool `json:"manualPromotion,omitempty"`
+}
+
+// ANCHOR_END: MachineBlueGreenDeployment
+
 // ANCHOR: RemediationStrategy
 
 // RemediationStrategy allows to define how the MachineSet can control scaling operations.
@@ -463,11 +552,70 @@ type MachineDeploymentStatus struct {
 	// +kubebuilder:validation:Enum=ScalingUp;ScalingDown;Running;Failed;Unknown
 	Phase string `json:"phase,omitempty"`
 
+	// rollout reports the progress of a staged rollout, if the MachineDeployment's rollingUpdate strategy defines steps.
+	// +optional
+	Rollout *MachineDeploymentRolloutStatus `json:"rollout,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *MachineDeploymentDeprecatedStatus `json:"deprecated,omitempty"`
 }
 
+// MachineDeploymentRolloutPhase is the phase of a staged rollout.
+type MachineDeploymentRolloutPhase string
+
+const (
+	// MachineDeploymentRolloutProgressingPhase is the phase of a staged rollout while the current step is being rolled out.
+	MachineDeploymentRolloutProgressingPhase MachineDeploymentRolloutPhase = "Progressing"
+
+	// MachineDeploymentRolloutPausedPhase is the phase of a staged rollout after the current step has been rolled out,
+	// while waiting for the step to be resumed or for the analysis condition of the step to become true.
+	MachineDeploymentRolloutPausedPhase MachineDeploymentRolloutPhase = "Paused"
+
+	// MachineDeploymentRolloutCompletedPhase is the phase of a staged rollout after all the old MachineSets have been scaled down.
+	MachineDeploymentRolloutCompletedPhase MachineDeploymentRolloutPhase = "Completed"
+
+	// MachineDeploymentRolloutRolledBackPhase is the phase of a staged rollout which has been rolled back
+	// to the previous revision because Machines of the new MachineSet have been remediated.
+	// The previous MachineSet is rolled out again, until the MachineDeployment's machine template is changed.
+	MachineDeploymentRolloutRolledBackPhase MachineDeploymentRolloutPhase = "RolledBack"
+)
+
+// MachineDeploymentRolloutStatus reports the progress of a staged rollout.
+type MachineDeploymentRolloutStatus struct {
+	// machineSetName is the name of the new MachineSet being rolled out.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	MachineSetName string `json:"machineSetName"`
+
+	// revision is the revision of the new MachineSet being rolled out.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	Revision string `json:"revision,omitempty"`
+
+	// currentStep is the index of the current step; it is equal to the number of steps after all the steps have been rolled out.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	CurrentStep int32 `json:"currentStep"`
+
+	// phase is the phase of the rollout (Progressing, Paused, Completed or RolledBack).
+	// +required
+	// +kubebuilder:validation:Enum=Progressing;Paused;Completed;RolledBack
+	Phase MachineDeploymentRolloutPhase `json:"phase"`
+
+	// currentStepStartTime is the time when the current step started.
+	// +required
+	CurrentStepStartTime metav1.Time `json:"currentStepStartTime"`
+
+	// message is a human readable message about the rollout, e.g. the reason for a rollback.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=10240
+	Message string `json:"message,omitempty"`
+}
+
 // MachineDeploymentDeprecatedStatus groups all the status fields that are deprecated and will be removed in a future version.
 // See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
 type MachineDeploymentDeprecatedStatus struct {
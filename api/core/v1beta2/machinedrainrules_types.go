@@ -25,6 +25,11 @@ const (
 	// The only valid values are "skip" and "wait-completed".
 	// This label takes precedence over MachineDrainRules defined in the management cluster.
 	PodDrainLabel = "cluster.x-k8s.io/drain"
+
+	// PodEvictionStartTimeAnnotation is the annotation set on Pods in workload clusters with an eviction timeout
+	// when their eviction fails for the first time; its value is a RFC3339 timestamp. The eviction timeout of the Pod
+	// is measured from this time.
+	PodEvictionStartTimeAnnotation = "cluster.x-k8s.io/eviction-start-time"
 )
 
 // MachineDrainRuleDrainBehavior defines the drain behavior. Can be either "Drain", "Skip", or "WaitCompleted".
@@ -138,6 +143,34 @@ type MachineDrainRuleDrainConfig struct {
 	// Valid values for order are from -2147483648 to 2147483647 (inclusive).
 	// +optional
 	Order *int32 `json:"order,omitempty"`
+
+	// evictionTimeoutSeconds is the maximum time to wait for Pods to be evicted, measured from the time
+	// the eviction of each Pod failed for the first time. If a Pod still cannot be evicted after this time, e.g. because
+	// a PodDisruptionBudget blocks the eviction, the Pod is deleted without using the Eviction API.
+	// evictionTimeoutSeconds can only be set if behavior is set to "Drain".
+	// If evictionTimeoutSeconds is not set, eviction is retried until it succeeds.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	EvictionTimeoutSeconds *int32 `json:"evictionTimeoutSeconds,omitempty"`
+
+	// gracePeriodSeconds overrides the terminationGracePeriodSeconds of Pods when they are evicted or deleted.
+	// gracePeriodSeconds can only be set if behavior is set to "Drain".
+	// If gracePeriodSeconds is not set, the terminationGracePeriodSeconds of the Pods is used.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GracePeriodSeconds *int32 `json:"gracePeriodSeconds,omitempty"`
+
+	// deleteOwnerKinds is a list of controller kinds, e.g. "Job", for which Pods are deleted
+	// instead of evicted. Pods are matched by the kind of the owner reference with controller set to true.
+	// Deleting Pods does not use the Eviction API, and thus it does not respect PodDisruptionBudgets.
+	// deleteOwnerKinds can only be set if behavior is set to "Drain".
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=256
+	DeleteOwnerKinds []string `json:"deleteOwnerKinds,omitempty"`
 }
 
 // MachineDrainRuleMachineSelector defines to which Machines this MachineDrainRule should be applied.
@@ -35,6 +35,11 @@ const (
 	// MachineHealthCheckRemediationAllowedReason is the reason used when the number of unhealthy machine
 	// is within the limits defined by the MachineHealthCheck, and thus remediation is allowed.
 	MachineHealthCheckRemediationAllowedReason = "RemediationAllowed"
+
+	// MachineHealthCheckRemediationCircuitBreakerTrippedReason is the reason used when the number of remediations
+	// exceeded the remediation budget, and the MachineHealthCheck is blocked from making any further remediation
+	// until the circuit breaker is reset.
+	MachineHealthCheckRemediationCircuitBreakerTrippedReason = "RemediationCircuitBreakerTripped"
 )
 
 var (
@@ -67,6 +72,23 @@ type MachineHealthCheckSpec struct {
 	// +kubebuilder:validation:MaxItems=100
 	UnhealthyNodeConditions []UnhealthyNodeCondition `json:"unhealthyNodeConditions,omitempty"`
 
+	// unhealthyMachineConditions contains a list of Machine conditions that determine
+	// whether a Machine is considered unhealthy, e.g. conditions surfaced by infrastructure providers
+	// from workload-side probes. The conditions are combined in a logical OR, i.e. if any of the
+	// conditions is met, the Machine is unhealthy.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyMachineConditions []UnhealthyMachineCondition `json:"unhealthyMachineConditions,omitempty"`
+
+	// unhealthyNodeTaints contains a list of taints that determine whether a node is
+	// considered unhealthy. The taints are combined in a logical OR, i.e. if any of the
+	// taints is found on the node, the node is unhealthy.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=100
+	UnhealthyNodeTaints []UnhealthyNodeTaint `json:"unhealthyNodeTaints,omitempty"`
+
 	// maxUnhealthy specifies the maximum number of unhealthy machines allowed.
 	// Any further remediation is only allowed if at most "maxUnhealthy" machines selected by
 	// "selector" are not healthy.
@@ -115,6 +137,12 @@ type MachineHealthCheckSpec struct {
 	// a controller that lives outside of Cluster API.
 	// +optional
 	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate,omitempty"`
+
+	// remediationBudget limits the number of remediations triggered by this MachineHealthCheck over time,
+	// e.g. to prevent a flapping Node from being remediated every few minutes forever.
+	// If not set, remediation is only limited by maxUnhealthy or unhealthyRange.
+	// +optional
+	RemediationBudget *MachineHealthCheckRemediationBudget `json:"remediationBudget,omitempty"`
 }
 
 // ANCHOR_END: MachineHealthCHeckSpec
@@ -148,6 +176,107 @@ type UnhealthyNodeCondition struct {
 
 // ANCHOR_END: UnhealthyNodeCondition
 
+// ANCHOR: UnhealthyMachineCondition
+
+// UnhealthyMachineCondition represents a Machine condition type and value with a timeout
+// specified as a duration. When the named condition has been in the given
+// status for at least the timeout value, a Machine is considered unhealthy.
+type UnhealthyMachineCondition struct {
+	// type of Machine condition.
+	// +required
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])$`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Type string `json:"type"`
+
+	// status of the condition, one of True, False, Unknown.
+	// +required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status metav1.ConditionStatus `json:"status"`
+
+	// timeoutSeconds is the duration that a Machine condition must be in a given status for,
+	// after which the Machine is considered unhealthy.
+	// For example, with a value of "3600", the condition must match the status
+	// for at least 1 hour before the Machine is considered unhealthy.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	TimeoutSeconds int32 `json:"timeoutSeconds"`
+}
+
+// ANCHOR_END: UnhealthyMachineCondition
+
+// ANCHOR: UnhealthyNodeTaint
+
+// UnhealthyNodeTaint represents a Node taint with a timeout specified as a duration.
+// When the taint has been on the node for at least the timeout value, a node is considered unhealthy.
+type UnhealthyNodeTaint struct {
+	// key of the taint.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	Key string `json:"key"`
+
+	// value of the taint. If not set, taints with any value match.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Value string `json:"value,omitempty"`
+
+	// effect of the taint.
+	// Only NoExecute is supported, because only NoExecute taints record the time they have been added to the node.
+	// +required
+	// +kubebuilder:validation:Enum=NoExecute
+	Effect corev1.TaintEffect `json:"effect"`
+
+	// timeoutSeconds is the duration that a taint must be on the node for,
+	// after which the node is considered unhealthy. The duration is computed from
+	// the time the taint has been added to the node.
+	// +required
+	// +kubebuilder:validation:Minimum=0
+	TimeoutSeconds int32 `json:"timeoutSeconds"`
+}
+
+// ANCHOR_END: UnhealthyNodeTaint
+
+// MachineHealthCheckRemediationBudget defines limits to the number of remediations triggered by a MachineHealthCheck.
+type MachineHealthCheckRemediationBudget struct {
+	// maxRemediations is the maximum number of remediations allowed within windowSeconds.
+	// When this number is reached, further remediations are delayed until previous remediations
+	// fall out of the window.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	MaxRemediations int32 `json:"maxRemediations"`
+
+	// windowSeconds is the duration of the time window used to count remediations.
+	// +required
+	// +kubebuilder:validation:Minimum=1
+	WindowSeconds int32 `json:"windowSeconds"`
+
+	// backoffSeconds is the time to wait before remediating a Machine if a Machine with the same owner,
+	// e.g. the same MachineSet or the same control plane, has been previously remediated within windowSeconds;
+	// the time to wait doubles for each subsequent remediation of Machines with the same owner, up to maxBackoffSeconds.
+	// If not set, Machines are remediated without waiting.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BackoffSeconds *int32 `json:"backoffSeconds,omitempty"`
+
+	// maxBackoffSeconds is the maximum time to wait before remediating a Machine.
+	// It must be less than or equal to windowSeconds; if not set, it defaults to windowSeconds.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxBackoffSeconds *int32 `json:"maxBackoffSeconds,omitempty"`
+
+	// circuitBreakerThreshold is the number of consecutive windows in which maxRemediations is reached
+	// after which the remediation circuit breaker trips. When the circuit breaker trips, further remediations
+	// are blocked until the circuit breaker is reset by adding the `cluster.x-k8s.io/reset-remediation-circuit-breaker`
+	// annotation to the MachineHealthCheck.
+	// If not set, the circuit breaker never trips and remediations are only delayed when maxRemediations is reached.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	CircuitBreakerThreshold *int32 `json:"circuitBreakerThreshold,omitempty"`
+}
+
 // ANCHOR: MachineHealthCheckStatus
 
 // MachineHealthCheckStatus defines the observed state of MachineHealthCheck.
@@ -187,11 +316,60 @@ type MachineHealthCheckStatus struct {
 	// +kubebuilder:validation:items:MaxLength=253
 	Targets []string `json:"targets,omitempty"`
 
+	// remediation reports info about remediations triggered by this machine health check when remediationBudget is set.
+	// +optional
+	Remediation *MachineHealthCheckRemediationStatus `json:"remediation,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *MachineHealthCheckDeprecatedStatus `json:"deprecated,omitempty"`
 }
 
+// MachineHealthCheckRemediationStatus reports info about remediations triggered by a MachineHealthCheck.
+// NOTE: if for any reason information about remediations are lost, the remediation budget and backoff restart from 0 and thus
+// more remediations than expected might happen.
+type MachineHealthCheckRemediationStatus struct {
+	// history is the list of remediations triggered within remediationBudget.windowSeconds.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=1000
+	History []MachineHealthCheckRemediationRecord `json:"history,omitempty"`
+
+	// exhaustedWindows is the number of consecutive windows in which remediationBudget.maxRemediations has been reached.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	ExhaustedWindows int32 `json:"exhaustedWindows,omitempty"`
+
+	// lastExhaustedTime is the time when remediationBudget.maxRemediations has been reached in the last exhausted window.
+	// +optional
+	LastExhaustedTime *metav1.Time `json:"lastExhaustedTime,omitempty"`
+
+	// circuitBreakerTrippedTime is the time when the remediation circuit breaker tripped.
+	// When set, further remediations are blocked until the circuit breaker is reset by adding the
+	// `cluster.x-k8s.io/reset-remediation-circuit-breaker` annotation to the MachineHealthCheck.
+	// +optional
+	CircuitBreakerTrippedTime *metav1.Time `json:"circuitBreakerTrippedTime,omitempty"`
+}
+
+// MachineHealthCheckRemediationRecord stores info about a remediation triggered by a MachineHealthCheck.
+type MachineHealthCheckRemediationRecord struct {
+	// machine is the name of the remediated machine.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Machine string `json:"machine"`
+
+	// owner is the kind and the name of the controller owner of the remediated machine, e.g. MachineSet/md-1-abcde, if any.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=317
+	Owner string `json:"owner,omitempty"`
+
+	// timestamp is when the remediation has been triggered. It is represented in RFC3339 form and is in UTC.
+	// +required
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
 // MachineHealthCheckDeprecatedStatus groups all the status fields that are deprecated and will be removed in a future version.
 // See https://github.com/kubernetes-sigs/cluster-api/blob/main/docs/proposals/20240916-improve-status-in-CAPI-resources.md for more context.
 type MachineHealthCheckDeprecatedStatus struct {
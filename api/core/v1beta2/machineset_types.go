@@ -66,8 +66,8 @@ type MachineSetSpec struct {
 	Replicas *int32 `json:"replicas,omitempty"`
 
 	// deletePolicy defines the policy used to identify nodes to delete when downscaling.
-	// Defaults to "Random".  Valid values are "Random, "Newest", "Oldest"
-	// +kubebuilder:validation:Enum=Random;Newest;Oldest
+	// Defaults to "Random".  Valid values are "Random, "Newest", "Oldest", "LeastUtilized", "Priority"
+	// +kubebuilder:validation:Enum=Random;Newest;Oldest;LeastUtilized;Priority
 	// +optional
 	DeletePolicy string `json:"deletePolicy,omitempty"`
 
@@ -272,6 +272,24 @@ const (
 	// or NodeHealthy type of Status.Conditions is not true).
 	// It then prioritizes the oldest Machines for deletion based on the Machine's CreationTimestamp.
 	OldestMachineSetDeletePolicy MachineSetDeletePolicy = "Oldest"
+
+	// LeastUtilizedMachineSetDeletePolicy prioritizes both Machines that have the annotation
+	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
+	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
+	// or NodeHealthy type of Status.Conditions is not true).
+	// It then prioritizes Machines whose Nodes are the least utilized, based on the CPU and memory
+	// requested by the Pods running on the Nodes compared to the Node allocatable resources.
+	// If the utilization of the Nodes cannot be read, Machines are prioritized as with the Oldest delete policy.
+	LeastUtilizedMachineSetDeletePolicy MachineSetDeletePolicy = "LeastUtilized"
+
+	// PriorityMachineSetDeletePolicy prioritizes both Machines that have the annotation
+	// "cluster.x-k8s.io/delete-machine=yes" and Machines that are unhealthy
+	// (Status.FailureReason or Status.FailureMessage are set to a non-empty value
+	// or NodeHealthy type of Status.Conditions is not true).
+	// It then prioritizes Machines with the highest integer value of the "cluster.x-k8s.io/delete-priority"
+	// annotation or label on the Machine, or label on the Node of the Machine; Machines without a value
+	// are considered to have priority 0.
+	PriorityMachineSetDeletePolicy MachineSetDeletePolicy = "Priority"
 )
 
 // ANCHOR: MachineSetStatus
@@ -161,6 +161,12 @@ const (
 
 	// UnhealthyNodeConditionV1Beta1Reason is the reason used when a machine's node has one of the MachineHealthCheck's unhealthy conditions.
 	UnhealthyNodeConditionV1Beta1Reason = "UnhealthyNode"
+
+	// UnhealthyNodeTaintV1Beta1Reason is the reason used when a machine's node has one of the MachineHealthCheck's unhealthy taints.
+	UnhealthyNodeTaintV1Beta1Reason = "UnhealthyNodeTaint"
+
+	// UnhealthyMachineConditionV1Beta1Reason is the reason used when a machine has one of the MachineHealthCheck's unhealthy Machine conditions.
+	UnhealthyMachineConditionV1Beta1Reason = "UnhealthyMachine"
 )
 
 const (
@@ -228,6 +234,10 @@ const (
 	// TooManyUnhealthyV1Beta1Reason is the reason used when too many Machines are unhealthy and the MachineHealthCheck is blocked
 	// from making any further remediations.
 	TooManyUnhealthyV1Beta1Reason = "TooManyUnhealthy"
+
+	// RemediationCircuitBreakerTrippedV1Beta1Reason is the reason used when the number of remediations exceeded the remediation budget
+	// and the MachineHealthCheck is blocked from making any further remediations until the circuit breaker is reset.
+	RemediationCircuitBreakerTrippedV1Beta1Reason = "RemediationCircuitBreakerTripped"
 )
 
 // Conditions and condition Reasons for  MachineDeployments.
@@ -1248,6 +1248,31 @@ func (in MachineAddresses) DeepCopy() MachineAddresses {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineBlueGreenDeployment) DeepCopyInto(out *MachineBlueGreenDeployment) {
+	*out = *in
+	if in.PromotionDelaySeconds != nil {
+		in, out := &in.PromotionDelaySeconds, &out.PromotionDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ManualPromotion != nil {
+		in, out := &in.ManualPromotion, &out.ManualPromotion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineBlueGreenDeployment.
+func (in *MachineBlueGreenDeployment) DeepCopy() *MachineBlueGreenDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineBlueGreenDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeletionStatus) DeepCopyInto(out *MachineDeletionStatus) {
 	*out = *in
@@ -1449,6 +1474,22 @@ func (in *MachineDeploymentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineDeploymentRolloutStatus) DeepCopyInto(out *MachineDeploymentRolloutStatus) {
+	*out = *in
+	in.CurrentStepStartTime.DeepCopyInto(&out.CurrentStepStartTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDeploymentRolloutStatus.
+func (in *MachineDeploymentRolloutStatus) DeepCopy() *MachineDeploymentRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineDeploymentRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineDeploymentSpec) DeepCopyInto(out *MachineDeploymentSpec) {
 	*out = *in
@@ -1515,6 +1556,11 @@ func (in *MachineDeploymentStatus) DeepCopyInto(out *MachineDeploymentStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(MachineDeploymentRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineDeploymentDeprecatedStatus)
@@ -1540,6 +1586,11 @@ func (in *MachineDeploymentStrategy) DeepCopyInto(out *MachineDeploymentStrategy
 		*out = new(MachineRollingUpdateDeployment)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(MachineBlueGreenDeployment)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Remediation != nil {
 		in, out := &in.Remediation, &out.Remediation
 		*out = new(RemediationStrategy)
@@ -1721,6 +1772,21 @@ func (in *MachineDrainRuleDrainConfig) DeepCopyInto(out *MachineDrainRuleDrainCo
 		*out = new(int32)
 		**out = **in
 	}
+	if in.EvictionTimeoutSeconds != nil {
+		in, out := &in.EvictionTimeoutSeconds, &out.EvictionTimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.GracePeriodSeconds != nil {
+		in, out := &in.GracePeriodSeconds, &out.GracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeleteOwnerKinds != nil {
+		in, out := &in.DeleteOwnerKinds, &out.DeleteOwnerKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineDrainRuleDrainConfig.
@@ -1880,6 +1946,16 @@ func (in *MachineHealthCheckClass) DeepCopyInto(out *MachineHealthCheckClass) {
 		*out = make([]UnhealthyNodeCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnhealthyMachineConditions != nil {
+		in, out := &in.UnhealthyMachineConditions, &out.UnhealthyMachineConditions
+		*out = make([]UnhealthyMachineCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyNodeTaints != nil {
+		in, out := &in.UnhealthyNodeTaints, &out.UnhealthyNodeTaints
+		*out = make([]UnhealthyNodeTaint, len(*in))
+		copy(*out, *in)
+	}
 	if in.MaxUnhealthy != nil {
 		in, out := &in.MaxUnhealthy, &out.MaxUnhealthy
 		*out = new(intstr.IntOrString)
@@ -1900,6 +1976,11 @@ func (in *MachineHealthCheckClass) DeepCopyInto(out *MachineHealthCheckClass) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.RemediationBudget != nil {
+		in, out := &in.RemediationBudget, &out.RemediationBudget
+		*out = new(MachineHealthCheckRemediationBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckClass.
@@ -1964,6 +2045,82 @@ func (in *MachineHealthCheckList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationBudget) DeepCopyInto(out *MachineHealthCheckRemediationBudget) {
+	*out = *in
+	if in.BackoffSeconds != nil {
+		in, out := &in.BackoffSeconds, &out.BackoffSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBackoffSeconds != nil {
+		in, out := &in.MaxBackoffSeconds, &out.MaxBackoffSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CircuitBreakerThreshold != nil {
+		in, out := &in.CircuitBreakerThreshold, &out.CircuitBreakerThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationBudget.
+func (in *MachineHealthCheckRemediationBudget) DeepCopy() *MachineHealthCheckRemediationBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationRecord) DeepCopyInto(out *MachineHealthCheckRemediationRecord) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationRecord.
+func (in *MachineHealthCheckRemediationRecord) DeepCopy() *MachineHealthCheckRemediationRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineHealthCheckRemediationStatus) DeepCopyInto(out *MachineHealthCheckRemediationStatus) {
+	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]MachineHealthCheckRemediationRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastExhaustedTime != nil {
+		in, out := &in.LastExhaustedTime, &out.LastExhaustedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CircuitBreakerTrippedTime != nil {
+		in, out := &in.CircuitBreakerTrippedTime, &out.CircuitBreakerTrippedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckRemediationStatus.
+func (in *MachineHealthCheckRemediationStatus) DeepCopy() *MachineHealthCheckRemediationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineHealthCheckRemediationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineHealthCheckSpec) DeepCopyInto(out *MachineHealthCheckSpec) {
 	*out = *in
@@ -1973,6 +2130,16 @@ func (in *MachineHealthCheckSpec) DeepCopyInto(out *MachineHealthCheckSpec) {
 		*out = make([]UnhealthyNodeCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnhealthyMachineConditions != nil {
+		in, out := &in.UnhealthyMachineConditions, &out.UnhealthyMachineConditions
+		*out = make([]UnhealthyMachineCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyNodeTaints != nil {
+		in, out := &in.UnhealthyNodeTaints, &out.UnhealthyNodeTaints
+		*out = make([]UnhealthyNodeTaint, len(*in))
+		copy(*out, *in)
+	}
 	if in.MaxUnhealthy != nil {
 		in, out := &in.MaxUnhealthy, &out.MaxUnhealthy
 		*out = new(intstr.IntOrString)
@@ -1993,6 +2160,11 @@ func (in *MachineHealthCheckSpec) DeepCopyInto(out *MachineHealthCheckSpec) {
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.RemediationBudget != nil {
+		in, out := &in.RemediationBudget, &out.RemediationBudget
+		*out = new(MachineHealthCheckRemediationBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineHealthCheckSpec.
@@ -2020,6 +2192,11 @@ func (in *MachineHealthCheckStatus) DeepCopyInto(out *MachineHealthCheckStatus)
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(MachineHealthCheckRemediationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(MachineHealthCheckDeprecatedStatus)
@@ -2561,6 +2738,11 @@ func (in *MachineRollingUpdateDeployment) DeepCopyInto(out *MachineRollingUpdate
 		*out = new(string)
 		**out = **in
 	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]MachineRolloutStep, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRollingUpdateDeployment.
@@ -2573,6 +2755,22 @@ func (in *MachineRollingUpdateDeployment) DeepCopy() *MachineRollingUpdateDeploy
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineRolloutStep) DeepCopyInto(out *MachineRolloutStep) {
+	*out = *in
+	out.Replicas = in.Replicas
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineRolloutStep.
+func (in *MachineRolloutStep) DeepCopy() *MachineRolloutStep {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineRolloutStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineSet) DeepCopyInto(out *MachineSet) {
 	*out = *in
@@ -3126,6 +3324,21 @@ func (in *Topology) DeepCopy() *Topology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyMachineCondition) DeepCopyInto(out *UnhealthyMachineCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyMachineCondition.
+func (in *UnhealthyMachineCondition) DeepCopy() *UnhealthyMachineCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyMachineCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnhealthyNodeCondition) DeepCopyInto(out *UnhealthyNodeCondition) {
 	*out = *in
@@ -3141,6 +3354,21 @@ func (in *UnhealthyNodeCondition) DeepCopy() *UnhealthyNodeCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyNodeTaint) DeepCopyInto(out *UnhealthyNodeTaint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyNodeTaint.
+func (in *UnhealthyNodeTaint) DeepCopy() *UnhealthyNodeTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyNodeTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidationRule) DeepCopyInto(out *ValidationRule) {
 	*out = *in
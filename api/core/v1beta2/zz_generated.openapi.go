@@ -69,6 +69,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.JSONSchemaProps":                           schema_cluster_api_api_core_v1beta2_JSONSchemaProps(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.Machine":                                   schema_cluster_api_api_core_v1beta2_Machine(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineAddress":                            schema_cluster_api_api_core_v1beta2_MachineAddress(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineBlueGreenDeployment":                schema_cluster_api_api_core_v1beta2_MachineBlueGreenDeployment(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeletionStatus":                     schema_cluster_api_api_core_v1beta2_MachineDeletionStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeployment":                         schema_cluster_api_api_core_v1beta2_MachineDeployment(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentClass":                    schema_cluster_api_api_core_v1beta2_MachineDeploymentClass(ref),
@@ -76,6 +77,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentClassTemplate":            schema_cluster_api_api_core_v1beta2_MachineDeploymentClassTemplate(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeprecatedStatus":         schema_cluster_api_api_core_v1beta2_MachineDeploymentDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentList":                     schema_cluster_api_api_core_v1beta2_MachineDeploymentList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStatus":            schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentSpec":                     schema_cluster_api_api_core_v1beta2_MachineDeploymentSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentStatus":                   schema_cluster_api_api_core_v1beta2_MachineDeploymentStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentStrategy":                 schema_cluster_api_api_core_v1beta2_MachineDeploymentStrategy(ref),
@@ -93,6 +95,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckClass":                   schema_cluster_api_api_core_v1beta2_MachineHealthCheckClass(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckDeprecatedStatus":        schema_cluster_api_api_core_v1beta2_MachineHealthCheckDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckList":                    schema_cluster_api_api_core_v1beta2_MachineHealthCheckList(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget":       schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationBudget(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationRecord":       schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationRecord(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationStatus":       schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckSpec":                    schema_cluster_api_api_core_v1beta2_MachineHealthCheckSpec(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckStatus":                  schema_cluster_api_api_core_v1beta2_MachineHealthCheckStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckTopology":                schema_cluster_api_api_core_v1beta2_MachineHealthCheckTopology(ref),
@@ -115,6 +120,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachinePoolVariables":                      schema_cluster_api_api_core_v1beta2_MachinePoolVariables(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineReadinessGate":                      schema_cluster_api_api_core_v1beta2_MachineReadinessGate(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRollingUpdateDeployment":            schema_cluster_api_api_core_v1beta2_MachineRollingUpdateDeployment(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRolloutStep":                        schema_cluster_api_api_core_v1beta2_MachineRolloutStep(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSet":                                schema_cluster_api_api_core_v1beta2_MachineSet(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetDeprecatedStatus":                schema_cluster_api_api_core_v1beta2_MachineSetDeprecatedStatus(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineSetList":                            schema_cluster_api_api_core_v1beta2_MachineSetList(ref),
@@ -134,7 +140,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.PatchSelectorMatchMachinePoolClass":        schema_cluster_api_api_core_v1beta2_PatchSelectorMatchMachinePoolClass(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.RemediationStrategy":                       schema_cluster_api_api_core_v1beta2_RemediationStrategy(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.Topology":                                  schema_cluster_api_api_core_v1beta2_Topology(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition":                 schema_cluster_api_api_core_v1beta2_UnhealthyMachineCondition(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition":                    schema_cluster_api_api_core_v1beta2_UnhealthyNodeCondition(ref),
+		"sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint":                        schema_cluster_api_api_core_v1beta2_UnhealthyNodeTaint(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.ValidationRule":                            schema_cluster_api_api_core_v1beta2_ValidationRule(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchema":                            schema_cluster_api_api_core_v1beta2_VariableSchema(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta2.VariableSchemaMetadata":                    schema_cluster_api_api_core_v1beta2_VariableSchemaMetadata(ref),
@@ -2208,6 +2216,33 @@ func schema_cluster_api_api_core_v1beta2_MachineAddress(ref common.ReferenceCall
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineBlueGreenDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineBlueGreenDeployment is used to control the desired behavior of blue/green deployment.\n\nWith the BlueGreen strategy the new MachineSet is scaled up to the desired replicas at once, while the old MachineSets keep all their Machines. Only after all the Machines of the new MachineSet are available and the new MachineSet is promoted, the old MachineSets are scaled down to zero and deleted; this drains the Nodes of all the old Machines. Until then, it is possible to roll back by reverting the MachineDeployment's template to the one of an old MachineSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"promotionDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "promotionDelaySeconds is the number of seconds to wait after all the Machines of the new MachineSet are available before promoting the new MachineSet. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"manualPromotion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "manualPromotion requires the new MachineSet to be promoted explicitly by setting the machinedeployment.cluster.x-k8s.io/promote annotation on the MachineDeployment to the name of the new MachineSet. Manual promotion is not required when rolling back to a MachineSet which is older than all the MachineSets to be scaled down. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineDeletionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2520,6 +2555,66 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentList(ref common.Refere
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineDeploymentRolloutStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineDeploymentRolloutStatus reports the progress of a staged rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineSetName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machineSetName is the name of the new MachineSet being rolled out.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "revision is the revision of the new MachineSet being rolled out.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStep": {
+						SchemaProps: spec.SchemaProps{
+							Description: "currentStep is the index of the current step; it is equal to the number of steps after all the steps have been rolled out.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "phase is the phase of the rollout (Progressing, Paused, Completed or RolledBack).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentStepStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "currentStepStartTime is the time when the current step started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is a human readable message about the rollout, e.g. the reason for a rollback.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"machineSetName", "currentStep", "phase", "currentStepStartTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineDeploymentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2668,6 +2763,12 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentStatus(ref common.Refe
 							Format:      "",
 						},
 					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "rollout reports the progress of a staged rollout, if the MachineDeployment's rollingUpdate strategy defines steps.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStatus"),
+						},
+					},
 					"deprecated": {
 						SchemaProps: spec.SchemaProps{
 							Description: "deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.",
@@ -2678,7 +2779,7 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentStatus(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeprecatedStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineDeploymentRolloutStatus"},
 	}
 }
 
@@ -2691,7 +2792,7 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentStrategy(ref common.Re
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type of deployment. Allowed values are RollingUpdate and OnDelete. The default is RollingUpdate.",
+							Description: "type of deployment. Allowed values are RollingUpdate, OnDelete and BlueGreen. The default is RollingUpdate.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2702,6 +2803,12 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentStrategy(ref common.Re
 							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRollingUpdateDeployment"),
 						},
 					},
+					"blueGreen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "blueGreen is the blue/green deployment config params. Present only if MachineDeploymentStrategyType = BlueGreen.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineBlueGreenDeployment"),
+						},
+					},
 					"remediation": {
 						SchemaProps: spec.SchemaProps{
 							Description: "remediation controls the strategy of remediating unhealthy machines and how remediating operations should occur during the lifecycle of the dependant MachineSets.",
@@ -2712,7 +2819,7 @@ func schema_cluster_api_api_core_v1beta2_MachineDeploymentStrategy(ref common.Re
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRollingUpdateDeployment", "sigs.k8s.io/cluster-api/api/core/v1beta2.RemediationStrategy"},
+			"sigs.k8s.io/cluster-api/api/core/v1beta2.MachineBlueGreenDeployment", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRollingUpdateDeployment", "sigs.k8s.io/cluster-api/api/core/v1beta2.RemediationStrategy"},
 	}
 }
 
@@ -3022,6 +3129,40 @@ func schema_cluster_api_api_core_v1beta2_MachineDrainRuleDrainConfig(ref common.
 							Format:      "int32",
 						},
 					},
+					"evictionTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "evictionTimeoutSeconds is the maximum time to wait for Pods to be evicted, measured from the time the eviction of each Pod failed for the first time. If a Pod still cannot be evicted after this time, e.g. because a PodDisruptionBudget blocks the eviction, the Pod is deleted without using the Eviction API. evictionTimeoutSeconds can only be set if behavior is set to \"Drain\". If evictionTimeoutSeconds is not set, eviction is retried until it succeeds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"gracePeriodSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "gracePeriodSeconds overrides the terminationGracePeriodSeconds of Pods when they are evicted or deleted. gracePeriodSeconds can only be set if behavior is set to \"Drain\". If gracePeriodSeconds is not set, the terminationGracePeriodSeconds of the Pods is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"deleteOwnerKinds": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "deleteOwnerKinds is a list of controller kinds, e.g. \"Job\", for which Pods are deleted instead of evicted. Pods are matched by the kind of the owner reference with controller set to true. Deleting Pods does not use the Eviction API, and thus it does not respect PodDisruptionBudgets. deleteOwnerKinds can only be set if behavior is set to \"Drain\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"behavior"},
 			},
@@ -3266,6 +3407,34 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckClass(ref common.Refe
 							},
 						},
 					},
+					"unhealthyMachineConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyMachineConditions contains a list of Machine conditions that determine whether a Machine is considered unhealthy, e.g. conditions surfaced by infrastructure providers from workload-side probes. The conditions are combined in a logical OR, i.e. if any of the conditions is met, the Machine is unhealthy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition"),
+									},
+								},
+							},
+						},
+					},
+					"unhealthyNodeTaints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyNodeTaints contains a list of taints that determine whether a node is considered unhealthy. The taints are combined in a logical OR, i.e. if any of the taints is found on the node, the node is unhealthy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint"),
+									},
+								},
+							},
+						},
+					},
 					"maxUnhealthy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "maxUnhealthy specifies the maximum number of unhealthy machines allowed. Any further remediation is only allowed if at most \"maxUnhealthy\" machines selected by \"selector\" are not healthy.",
@@ -3292,11 +3461,17 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckClass(ref common.Refe
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"remediationBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "remediationBudget limits the number of remediations triggered by this MachineHealthCheck over time, e.g. to prevent a flapping Node from being remediated every few minutes forever. If not set, remediation is only limited by maxUnhealthy or unhealthyRange.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint"},
 	}
 }
 
@@ -3372,6 +3547,147 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckList(ref common.Refer
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationBudget defines limits to the number of remediations triggered by a MachineHealthCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxRemediations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxRemediations is the maximum number of remediations allowed within windowSeconds. When this number is reached, further remediations are delayed until previous remediations fall out of the window.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"windowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "windowSeconds is the duration of the time window used to count remediations.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "backoffSeconds is the time to wait before remediating a Machine if a Machine with the same owner, e.g. the same MachineSet or the same control plane, has been previously remediated within windowSeconds; the time to wait doubles for each subsequent remediation of Machines with the same owner, up to maxBackoffSeconds. If not set, Machines are remediated without waiting.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBackoffSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxBackoffSeconds is the maximum time to wait before remediating a Machine. It must be less than or equal to windowSeconds; if not set, it defaults to windowSeconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"circuitBreakerThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "circuitBreakerThreshold is the number of consecutive windows in which maxRemediations is reached after which the remediation circuit breaker trips. When the circuit breaker trips, further remediations are blocked until the circuit breaker is reset by adding the `cluster.x-k8s.io/reset-remediation-circuit-breaker` annotation to the MachineHealthCheck. If not set, the circuit breaker never trips and remediations are only delayed when maxRemediations is reached.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"maxRemediations", "windowSeconds"},
+			},
+		},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationRecord(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationRecord stores info about a remediation triggered by a MachineHealthCheck.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machine is the name of the remediated machine.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "owner is the kind and the name of the controller owner of the remediated machine, e.g. MachineSet/md-1-abcde, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timestamp is when the remediation has been triggered. It is represented in RFC3339 form and is in UTC.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"machine", "timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineHealthCheckRemediationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineHealthCheckRemediationStatus reports info about remediations triggered by a MachineHealthCheck. NOTE: if for any reason information about remediations are lost, the remediation budget and backoff restart from 0 and thus more remediations than expected might happen.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"history": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "history is the list of remediations triggered within remediationBudget.windowSeconds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationRecord"),
+									},
+								},
+							},
+						},
+					},
+					"exhaustedWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "exhaustedWindows is the number of consecutive windows in which remediationBudget.maxRemediations has been reached.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastExhaustedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastExhaustedTime is the time when remediationBudget.maxRemediations has been reached in the last exhausted window.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"circuitBreakerTrippedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "circuitBreakerTrippedTime is the time when the remediation circuit breaker tripped. When set, further remediations are blocked until the circuit breaker is reset by adding the `cluster.x-k8s.io/reset-remediation-circuit-breaker` annotation to the MachineHealthCheck.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationRecord"},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_MachineHealthCheckSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3408,6 +3724,34 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckSpec(ref common.Refer
 							},
 						},
 					},
+					"unhealthyMachineConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyMachineConditions contains a list of Machine conditions that determine whether a Machine is considered unhealthy, e.g. conditions surfaced by infrastructure providers from workload-side probes. The conditions are combined in a logical OR, i.e. if any of the conditions is met, the Machine is unhealthy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition"),
+									},
+								},
+							},
+						},
+					},
+					"unhealthyNodeTaints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyNodeTaints contains a list of taints that determine whether a node is considered unhealthy. The taints are combined in a logical OR, i.e. if any of the taints is found on the node, the node is unhealthy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint"),
+									},
+								},
+							},
+						},
+					},
 					"maxUnhealthy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "maxUnhealthy specifies the maximum number of unhealthy machines allowed. Any further remediation is only allowed if at most \"maxUnhealthy\" machines selected by \"selector\" are not healthy.\n\nDeprecated: This field is deprecated and is going to be removed in the next apiVersion. Please see https://github.com/kubernetes-sigs/cluster-api/issues/10722 for more details.",
@@ -3434,12 +3778,18 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckSpec(ref common.Refer
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"remediationBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "remediationBudget limits the number of remediations triggered by this MachineHealthCheck over time, e.g. to prevent a flapping Node from being remediated every few minutes forever. If not set, remediation is only limited by maxUnhealthy or unhealthyRange.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget"),
+						},
+					},
 				},
 				Required: []string{"clusterName", "selector"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint"},
 	}
 }
 
@@ -3518,6 +3868,12 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckStatus(ref common.Ref
 							},
 						},
 					},
+					"remediation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "remediation reports info about remediations triggered by this machine health check when remediationBudget is set.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationStatus"),
+						},
+					},
 					"deprecated": {
 						SchemaProps: spec.SchemaProps{
 							Description: "deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.",
@@ -3528,7 +3884,7 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckStatus(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckDeprecatedStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckDeprecatedStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationStatus"},
 	}
 }
 
@@ -3560,6 +3916,34 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckTopology(ref common.R
 							},
 						},
 					},
+					"unhealthyMachineConditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyMachineConditions contains a list of Machine conditions that determine whether a Machine is considered unhealthy, e.g. conditions surfaced by infrastructure providers from workload-side probes. The conditions are combined in a logical OR, i.e. if any of the conditions is met, the Machine is unhealthy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition"),
+									},
+								},
+							},
+						},
+					},
+					"unhealthyNodeTaints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "unhealthyNodeTaints contains a list of taints that determine whether a node is considered unhealthy. The taints are combined in a logical OR, i.e. if any of the taints is found on the node, the node is unhealthy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint"),
+									},
+								},
+							},
+						},
+					},
 					"maxUnhealthy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "maxUnhealthy specifies the maximum number of unhealthy machines allowed. Any further remediation is only allowed if at most \"maxUnhealthy\" machines selected by \"selector\" are not healthy.",
@@ -3586,11 +3970,17 @@ func schema_cluster_api_api_core_v1beta2_MachineHealthCheckTopology(ref common.R
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"remediationBudget": {
+						SchemaProps: spec.SchemaProps{
+							Description: "remediationBudget limits the number of remediations triggered by this MachineHealthCheck over time, e.g. to prevent a flapping Node from being remediated every few minutes forever. If not set, remediation is only limited by maxUnhealthy or unhealthyRange.",
+							Ref:         ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineHealthCheckRemediationBudget", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyMachineCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeCondition", "sigs.k8s.io/cluster-api/api/core/v1beta2.UnhealthyNodeTaint"},
 	}
 }
 
@@ -4452,14 +4842,62 @@ func schema_cluster_api_api_core_v1beta2_MachineRollingUpdateDeployment(ref comm
 					},
 					"deletePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "deletePolicy defines the policy used by the MachineDeployment to identify nodes to delete when downscaling. Valid values are \"Random, \"Newest\", \"Oldest\" When no value is supplied, the default DeletePolicy of MachineSet is used",
+							Description: "deletePolicy defines the policy used by the MachineDeployment to identify nodes to delete when downscaling. Valid values are \"Random, \"Newest\", \"Oldest\", \"LeastUtilized\", \"Priority\" When no value is supplied, the default DeletePolicy of MachineSet is used",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"steps": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "steps defines the steps of a staged rollout, e.g. to roll out a canary Machine first. During each step the new MachineSet is scaled up to the number of replicas of the step only; once all those Machines are available the rollout is paused automatically, and it continues with the next step when the step is resumed using the machinedeployment.cluster.x-k8s.io/resume-rollout-step annotation, e.g. via `clusterctl alpha rollout resume`, or when the analysis condition of the step becomes true. After the last step the rollout completes as usual. If the new MachineSet remediates any of its Machines while the rollout is in progress, the rollout is rolled back automatically to the previous revision, unless the MachineDeployment is managed by a Cluster topology. Steps are not used when a MachineDeployment is scaled up from zero or does not have old MachineSets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRolloutStep"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString", "sigs.k8s.io/cluster-api/api/core/v1beta2.MachineRolloutStep"},
+	}
+}
+
+func schema_cluster_api_api_core_v1beta2_MachineRolloutStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineRolloutStep defines a step of a staged rollout.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicas is the number of Machines of the new MachineSet to roll out in this step. Value can be an absolute number (ex: 1) or a percentage of desired machines (ex: 10%). Absolute number is calculated from percentage by rounding up.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"analysisCondition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "analysisCondition is the type of a condition on the MachineDeployment, e.g. set by an external analysis tool, which allows the rollout to continue with the next step as soon as it is true, without waiting for a manual resume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"replicas"},
+			},
+		},
 		Dependencies: []string{
 			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
@@ -4611,7 +5049,7 @@ func schema_cluster_api_api_core_v1beta2_MachineSetSpec(ref common.ReferenceCall
 					},
 					"deletePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "deletePolicy defines the policy used to identify nodes to delete when downscaling. Defaults to \"Random\".  Valid values are \"Random, \"Newest\", \"Oldest\"",
+							Description: "deletePolicy defines the policy used to identify nodes to delete when downscaling. Defaults to \"Random\".  Valid values are \"Random, \"Newest\", \"Oldest\", \"LeastUtilized\", \"Priority\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5419,6 +5857,44 @@ func schema_cluster_api_api_core_v1beta2_Topology(ref common.ReferenceCallback)
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_UnhealthyMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UnhealthyMachineCondition represents a Machine condition type and value with a timeout specified as a duration. When the named condition has been in the given status for at least the timeout value, a Machine is considered unhealthy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type of Machine condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeoutSeconds is the duration that a Machine condition must be in a given status for, after which the Machine is considered unhealthy. For example, with a value of \"3600\", the condition must match the status for at least 1 hour before the Machine is considered unhealthy.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type", "status", "timeoutSeconds"},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_UnhealthyNodeCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5457,6 +5933,51 @@ func schema_cluster_api_api_core_v1beta2_UnhealthyNodeCondition(ref common.Refer
 	}
 }
 
+func schema_cluster_api_api_core_v1beta2_UnhealthyNodeTaint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UnhealthyNodeTaint represents a Node taint with a timeout specified as a duration. When the taint has been on the node for at least the timeout value, a node is considered unhealthy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key of the taint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value of the taint. If not set, taints with any value match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"effect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "effect of the taint. Only NoExecute is supported, because only NoExecute taints record the time they have been added to the node.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "timeoutSeconds is the duration that a taint must be on the node for, after which the node is considered unhealthy. The duration is computed from the time the taint has been added to the node.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"key", "effect", "timeoutSeconds"},
+			},
+		},
+	}
+}
+
 func schema_cluster_api_api_core_v1beta2_ValidationRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -0,0 +1,179 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+)
+
+// CanUpdateMachineRequest is the request of the CanUpdateMachine hook.
+// +kubebuilder:object:root=true
+type CanUpdateMachineRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// current contains the current state of the Machine and related objects.
+	// +required
+	Current MachineUpdateState `json:"current"`
+
+	// desired contains the desired state of the Machine and related objects.
+	// +required
+	Desired MachineUpdateState `json:"desired"`
+}
+
+// MachineUpdateState contains the state of a Machine and of the related objects.
+type MachineUpdateState struct {
+	// machine is the Machine object.
+	// +required
+	Machine clusterv1beta1.Machine `json:"machine"`
+
+	// bootstrapConfig is the bootstrap config object referenced by the Machine.
+	// +optional
+	BootstrapConfig runtime.RawExtension `json:"bootstrapConfig,omitempty"`
+}
+
+var _ ResponseObject = &CanUpdateMachineResponse{}
+
+// CanUpdateMachineResponse is the response of the CanUpdateMachine hook.
+// +kubebuilder:object:root=true
+type CanUpdateMachineResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonResponse contains Status and Message fields common to all response types.
+	CommonResponse `json:",inline"`
+
+	// canUpdate is true if the Runtime Extension is able to update the Machine in-place from
+	// the current to the desired state.
+	// If false, the Machine will be updated by other Runtime Extensions or rolled out.
+	// +optional
+	CanUpdate bool `json:"canUpdate,omitempty"`
+}
+
+// CanUpdateMachine is the hook that will be called to determine if a Machine can be updated in-place.
+func CanUpdateMachine(*CanUpdateMachineRequest, *CanUpdateMachineResponse) {}
+
+// UpdateMachineRequest is the request of the UpdateMachine hook.
+// +kubebuilder:object:root=true
+type UpdateMachineRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// desired contains the desired state of the Machine and related objects.
+	// +required
+	Desired MachineUpdateState `json:"desired"`
+}
+
+var _ RetryResponseObject = &UpdateMachineResponse{}
+
+// UpdateMachineResponse is the response of the UpdateMachine hook.
+// The status of the update operation is determined by the CommonRetryResponse fields:
+// - Status=Success, RetryAfterSeconds > 0: update in progress
+// - Status=Success, RetryAfterSeconds = 0: update completed
+// - Status=Failure: update failed.
+// +kubebuilder:object:root=true
+type UpdateMachineResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// UpdateMachine is the hook that will be called to update a Machine in-place.
+func UpdateMachine(*UpdateMachineRequest, *UpdateMachineResponse) {}
+
+// RenewCertificatesRequest is the request of the RenewCertificates hook.
+// +kubebuilder:object:root=true
+type RenewCertificatesRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// machine is the Machine whose certificates must be renewed.
+	// +required
+	Machine clusterv1beta1.Machine `json:"machine"`
+}
+
+var _ RetryResponseObject = &RenewCertificatesResponse{}
+
+// RenewCertificatesResponse is the response of the RenewCertificates hook.
+// The status of the renewal is determined by the CommonRetryResponse fields:
+// - Status=Success, RetryAfterSeconds > 0: renewal in progress
+// - Status=Success, RetryAfterSeconds = 0: renewal completed
+// - Status=Failure: renewal failed.
+// +kubebuilder:object:root=true
+type RenewCertificatesResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// RenewCertificates is the hook that will be called to renew the certificates of a Machine in-place.
+func RenewCertificates(*RenewCertificatesRequest, *RenewCertificatesResponse) {}
+
+func init() {
+	catalogBuilder.RegisterHook(CanUpdateMachine, &runtimecatalog.HookMeta{
+		Tags:    []string{"In-Place Update Hooks"},
+		Summary: "Cluster API Runtime will call this hook to determine if a Machine can be updated in-place",
+		Description: "Cluster API Runtime will call this hook when a Machine is not up-to-date and the changes " +
+			"required to bring it up-to-date are eligible for an in-place update (e.g. a Kubernetes patch version upgrade).\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only when the InPlaceUpdates feature gate is enabled\n" +
+			"- The call's request contains the current and the desired state of the Machine and of its bootstrap config\n" +
+			"- Runtime Extension implementers must set canUpdate to true only if they are able to perform the update; " +
+			"if all Runtime Extensions decline, the Machine is rolled out",
+	})
+
+	catalogBuilder.RegisterHook(UpdateMachine, &runtimecatalog.HookMeta{
+		Tags:    []string{"In-Place Update Hooks"},
+		Summary: "Cluster API Runtime will call this hook to update a Machine in-place",
+		Description: "Cluster API Runtime will call this hook after the Runtime Extension accepted to update the Machine " +
+			"in the CanUpdateMachine hook, and until the Runtime Extension reports the update as completed or failed.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only when the InPlaceUpdates feature gate is enabled\n" +
+			"- The call's request contains the desired state of the Machine and of its bootstrap config\n" +
+			"- Runtime Extension implementers must implement this hook in an idempotent way; the hook is called " +
+			"with the same request until the update completes or fails\n" +
+			"- This is a blocking hook; Runtime Extension implementers can set retryAfterSeconds to signal that the update is still in progress",
+	})
+
+	catalogBuilder.RegisterHook(RenewCertificates, &runtimecatalog.HookMeta{
+		Tags:    []string{"In-Place Update Hooks"},
+		Summary: "Cluster API Runtime will call this hook to renew the certificates of a Machine in-place",
+		Description: "Cluster API Runtime will call this hook when the certificates of a Machine are about to expire, " +
+			"and until the Runtime Extension reports the renewal as completed or failed.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only when the InPlaceUpdates feature gate is enabled\n" +
+			"- Runtime Extension implementers are expected to renew all the certificates managed by kubeadm, e.g. " +
+			"using kubeadm certs renew, and to restart the components using them\n" +
+			"- Runtime Extension implementers must implement this hook in an idempotent way; the hook is called " +
+			"with the same request until the renewal completes or fails\n" +
+			"- This is a blocking hook; Runtime Extension implementers can set retryAfterSeconds to signal that the renewal is still in progress",
+	})
+}
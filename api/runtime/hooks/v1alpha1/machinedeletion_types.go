@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+)
+
+// SelectMachinesForDeletionRequest is the request of the SelectMachinesForDeletion hook.
+// +kubebuilder:object:root=true
+type SelectMachinesForDeletionRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the MachineSet belongs to.
+	// +required
+	Cluster clusterv1beta1.Cluster `json:"cluster"`
+
+	// machineDeployment is the MachineDeployment owning the MachineSet, if any.
+	// +optional
+	MachineDeployment *clusterv1beta1.MachineDeployment `json:"machineDeployment,omitempty"`
+
+	// machineSet is the MachineSet which is scaling down.
+	// +required
+	MachineSet clusterv1beta1.MachineSet `json:"machineSet"`
+
+	// machines are the Machines of the MachineSet which can be selected for deletion.
+	// Machines which are already being deleted are not included.
+	// +required
+	Machines []clusterv1beta1.Machine `json:"machines"`
+
+	// machinesToDeleteCount is the number of Machines to delete.
+	// +required
+	MachinesToDeleteCount int32 `json:"machinesToDeleteCount"`
+}
+
+var _ ResponseObject = &SelectMachinesForDeletionResponse{}
+
+// SelectMachinesForDeletionResponse is the response of the SelectMachinesForDeletion hook.
+// +kubebuilder:object:root=true
+type SelectMachinesForDeletionResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonResponse contains Status and Message fields common to all response types.
+	CommonResponse `json:",inline"`
+
+	// machines are the names of the Machines to delete, ordered from the Machine that should be deleted first.
+	// Names which do not match any of the Machines in the request are ignored.
+	// If fewer Machines than machinesToDeleteCount are returned, the remaining Machines are selected
+	// using the deletePolicy of the MachineSet; if no Machines are returned, the next Runtime Extension is called.
+	// +optional
+	Machines []string `json:"machines,omitempty"`
+}
+
+// SelectMachinesForDeletion is the hook that will be called to select the Machines to delete when a MachineSet scales down.
+func SelectMachinesForDeletion(*SelectMachinesForDeletionRequest, *SelectMachinesForDeletionResponse) {
+}
+
+func init() {
+	catalogBuilder.RegisterHook(SelectMachinesForDeletion, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Deletion Hooks"},
+		Summary: "Cluster API Runtime will call this hook to select the Machines to delete when a MachineSet scales down",
+		Description: "Cluster API Runtime will call this hook when a MachineSet, including a MachineSet owned by a MachineDeployment, " +
+			"scales down and before the Machines to delete are selected using the deletePolicy of the MachineSet.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- The call's request contains the Cluster, the MachineDeployment, the MachineSet, the candidate Machines " +
+			"and the number of Machines to delete\n" +
+			"- Runtime Extensions are called in order until one of them returns a non-empty list of Machines\n" +
+			"- If the call fails, e.g. because of a timeout and a failurePolicy set to Fail, or if no Runtime Extension " +
+			"selects any Machine, the Machines are selected using the deletePolicy of the MachineSet\n" +
+			"- This is a non-blocking hook",
+	})
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+)
+
+// BeforeMachineCreateRequest is the request of the BeforeMachineCreate hook.
+// +kubebuilder:object:root=true
+type BeforeMachineCreateRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1beta1.Cluster `json:"cluster"`
+
+	// machine is the machine object the lifecycle hook corresponds to.
+	// +required
+	Machine clusterv1beta1.Machine `json:"machine"`
+}
+
+var _ RetryResponseObject = &BeforeMachineCreateResponse{}
+
+// BeforeMachineCreateResponse is the response of the BeforeMachineCreate hook.
+// +kubebuilder:object:root=true
+type BeforeMachineCreateResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeMachineCreate is the hook that will be called after a Machine is created and before
+// the provisioning of the Machine starts.
+func BeforeMachineCreate(*BeforeMachineCreateRequest, *BeforeMachineCreateResponse) {}
+
+// AfterMachineProvisionedRequest is the request of the AfterMachineProvisioned hook.
+// +kubebuilder:object:root=true
+type AfterMachineProvisionedRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1beta1.Cluster `json:"cluster"`
+
+	// machine is the machine object the lifecycle hook corresponds to.
+	// +required
+	Machine clusterv1beta1.Machine `json:"machine"`
+}
+
+var _ ResponseObject = &AfterMachineProvisionedResponse{}
+
+// AfterMachineProvisionedResponse is the response of the AfterMachineProvisioned hook.
+// +kubebuilder:object:root=true
+type AfterMachineProvisionedResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonResponse contains Status and Message fields common to all response types.
+	CommonResponse `json:",inline"`
+}
+
+// AfterMachineProvisioned is the hook that will be called after the Node hosted on a Machine
+// joined the Cluster for the first time.
+func AfterMachineProvisioned(*AfterMachineProvisionedRequest, *AfterMachineProvisionedResponse) {}
+
+// BeforeMachineDrainRequest is the request of the BeforeMachineDrain hook.
+// +kubebuilder:object:root=true
+type BeforeMachineDrainRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1beta1.Cluster `json:"cluster"`
+
+	// machine is the machine object the lifecycle hook corresponds to.
+	// +required
+	Machine clusterv1beta1.Machine `json:"machine"`
+}
+
+var _ RetryResponseObject = &BeforeMachineDrainResponse{}
+
+// BeforeMachineDrainResponse is the response of the BeforeMachineDrain hook.
+// +kubebuilder:object:root=true
+type BeforeMachineDrainResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeMachineDrain is the hook that will be called after a Machine is deleted and before the
+// Node hosted on the Machine is drained.
+func BeforeMachineDrain(*BeforeMachineDrainRequest, *BeforeMachineDrainResponse) {}
+
+// BeforeMachineDeleteRequest is the request of the BeforeMachineDelete hook.
+// +kubebuilder:object:root=true
+type BeforeMachineDeleteRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the Machine belongs to.
+	// +required
+	Cluster clusterv1beta1.Cluster `json:"cluster"`
+
+	// machine is the machine object the lifecycle hook corresponds to.
+	// +required
+	Machine clusterv1beta1.Machine `json:"machine"`
+}
+
+var _ RetryResponseObject = &BeforeMachineDeleteResponse{}
+
+// BeforeMachineDeleteResponse is the response of the BeforeMachineDelete hook.
+// +kubebuilder:object:root=true
+type BeforeMachineDeleteResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	CommonRetryResponse `json:",inline"`
+}
+
+// BeforeMachineDelete is the hook that will be called after a Machine is deleted and before the
+// infrastructure of the Machine is deleted.
+func BeforeMachineDelete(*BeforeMachineDeleteRequest, *BeforeMachineDeleteResponse) {}
+
+func init() {
+	catalogBuilder.RegisterHook(BeforeMachineCreate, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before a Machine is provisioned",
+		Description: "Cluster API Runtime will call this hook after a Machine is created and immediately before " +
+			"the BootstrapConfig and the InfrastructureMachine are linked to the Machine, which starts the provisioning of the Machine.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only for Machines created while the RuntimeSDK feature flag is enabled\n" +
+			"- The call's request contains the Cluster and the Machine objects\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks before the Machine is provisioned",
+	})
+
+	catalogBuilder.RegisterHook(AfterMachineProvisioned, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook after a Machine is provisioned",
+		Description: "Cluster API Runtime will call this hook after the Node hosted on a Machine joined the Cluster for the first time.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only for Machines created while the RuntimeSDK feature flag is enabled\n" +
+			"- The call's request contains the Cluster and the Machine objects\n" +
+			"- This is a non-blocking hook",
+	})
+
+	catalogBuilder.RegisterHook(BeforeMachineDrain, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before the Node hosted on a Machine is drained",
+		Description: "Cluster API Runtime will call this hook after the Machine deletion has been triggered, after pre-drain " +
+			"hooks defined using annotations succeeded, and immediately before the Node hosted on the Machine is drained.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only if the Node is going to be drained\n" +
+			"- The call's request contains the Cluster and the Machine objects\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks before the Node is drained",
+	})
+
+	catalogBuilder.RegisterHook(BeforeMachineDelete, &runtimecatalog.HookMeta{
+		Tags:    []string{"Machine Lifecycle Hooks"},
+		Summary: "Cluster API Runtime will call this hook before the infrastructure of a Machine is deleted",
+		Description: "Cluster API Runtime will call this hook after the Node hosted on the Machine has been drained, after pre-terminate " +
+			"hooks defined using annotations succeeded, and immediately before the InfrastructureMachine and the BootstrapConfig are deleted.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- The call's request contains the Cluster and the Machine objects\n" +
+			"- This is a blocking hook; Runtime Extension implementers can use this hook to execute " +
+			"tasks before the infrastructure of the Machine is deleted",
+	})
+}
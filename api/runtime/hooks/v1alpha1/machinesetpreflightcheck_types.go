@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+)
+
+// MachineSetPreflightCheckRequest is the request of the MachineSetPreflightCheck hook.
+// +kubebuilder:object:root=true
+type MachineSetPreflightCheckRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRequest contains fields common to all request types.
+	CommonRequest `json:",inline"`
+
+	// cluster is the cluster object the MachineSet belongs to.
+	// +required
+	Cluster clusterv1beta1.Cluster `json:"cluster"`
+
+	// machineSet is the MachineSet which is going to create Machines.
+	// +required
+	MachineSet clusterv1beta1.MachineSet `json:"machineSet"`
+}
+
+var _ RetryResponseObject = &MachineSetPreflightCheckResponse{}
+
+// MachineSetPreflightCheckResponse is the response of the MachineSetPreflightCheck hook.
+// +kubebuilder:object:root=true
+type MachineSetPreflightCheckResponse struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CommonRetryResponse contains Status, Message and RetryAfterSeconds fields.
+	// A response with RetryAfterSeconds set to a value greater than 0 means that the preflight check did not pass;
+	// in this case Message should explain why the MachineSet must not create Machines.
+	CommonRetryResponse `json:",inline"`
+}
+
+// MachineSetPreflightCheck is the hook that will be called before a MachineSet creates Machines.
+func MachineSetPreflightCheck(*MachineSetPreflightCheckRequest, *MachineSetPreflightCheckResponse) {}
+
+func init() {
+	catalogBuilder.RegisterHook(MachineSetPreflightCheck, &runtimecatalog.HookMeta{
+		Tags:    []string{"MachineSet Preflight Check Hooks"},
+		Summary: "Cluster API Runtime will call this hook before a MachineSet creates Machines",
+		Description: "Cluster API Runtime will call this hook before a MachineSet creates new Machines, e.g. when scaling up " +
+			"or when replacing Machines after remediation, together with the built-in MachineSet preflight checks.\n" +
+			"\n" +
+			"Notes:\n" +
+			"- This hook will be called only when the MachineSetPreflightChecks feature gate is enabled\n" +
+			"- Every extension handler registered for this hook is a preflight check identified by the name of the extension handler, " +
+			"which can be skipped using the machineset.cluster.x-k8s.io/skip-preflight-checks annotation\n" +
+			"- The call's request contains the Cluster and the MachineSet objects\n" +
+			"- This is a blocking hook; Runtime Extension implementers can return a retryAfterSeconds greater than 0 " +
+			"and a message to prevent the MachineSet from creating Machines",
+	})
+}
@@ -178,6 +178,58 @@ func (in *AfterControlPlaneUpgradeResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterMachineProvisionedRequest) DeepCopyInto(out *AfterMachineProvisionedRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Machine.DeepCopyInto(&out.Machine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterMachineProvisionedRequest.
+func (in *AfterMachineProvisionedRequest) DeepCopy() *AfterMachineProvisionedRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterMachineProvisionedRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AfterMachineProvisionedRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AfterMachineProvisionedResponse) DeepCopyInto(out *AfterMachineProvisionedResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonResponse = in.CommonResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AfterMachineProvisionedResponse.
+func (in *AfterMachineProvisionedResponse) DeepCopy() *AfterMachineProvisionedResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AfterMachineProvisionedResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AfterMachineProvisionedResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BeforeClusterCreateRequest) DeepCopyInto(out *BeforeClusterCreateRequest) {
 	*out = *in
@@ -331,6 +383,162 @@ func (in *BeforeClusterUpgradeResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineCreateRequest) DeepCopyInto(out *BeforeMachineCreateRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Machine.DeepCopyInto(&out.Machine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineCreateRequest.
+func (in *BeforeMachineCreateRequest) DeepCopy() *BeforeMachineCreateRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineCreateRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineCreateRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineCreateResponse) DeepCopyInto(out *BeforeMachineCreateResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineCreateResponse.
+func (in *BeforeMachineCreateResponse) DeepCopy() *BeforeMachineCreateResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineCreateResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineCreateResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineDeleteRequest) DeepCopyInto(out *BeforeMachineDeleteRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Machine.DeepCopyInto(&out.Machine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineDeleteRequest.
+func (in *BeforeMachineDeleteRequest) DeepCopy() *BeforeMachineDeleteRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineDeleteRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineDeleteRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineDeleteResponse) DeepCopyInto(out *BeforeMachineDeleteResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineDeleteResponse.
+func (in *BeforeMachineDeleteResponse) DeepCopy() *BeforeMachineDeleteResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineDeleteResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineDeleteResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineDrainRequest) DeepCopyInto(out *BeforeMachineDrainRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.Machine.DeepCopyInto(&out.Machine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineDrainRequest.
+func (in *BeforeMachineDrainRequest) DeepCopy() *BeforeMachineDrainRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineDrainRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineDrainRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BeforeMachineDrainResponse) DeepCopyInto(out *BeforeMachineDrainResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BeforeMachineDrainResponse.
+func (in *BeforeMachineDrainResponse) DeepCopy() *BeforeMachineDrainResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(BeforeMachineDrainResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BeforeMachineDrainResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Builtins) DeepCopyInto(out *Builtins) {
 	*out = *in
@@ -366,6 +574,58 @@ func (in *Builtins) DeepCopy() *Builtins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanUpdateMachineRequest) DeepCopyInto(out *CanUpdateMachineRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Current.DeepCopyInto(&out.Current)
+	in.Desired.DeepCopyInto(&out.Desired)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanUpdateMachineRequest.
+func (in *CanUpdateMachineRequest) DeepCopy() *CanUpdateMachineRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CanUpdateMachineRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanUpdateMachineRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanUpdateMachineResponse) DeepCopyInto(out *CanUpdateMachineResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonResponse = in.CommonResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanUpdateMachineResponse.
+func (in *CanUpdateMachineResponse) DeepCopy() *CanUpdateMachineResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CanUpdateMachineResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CanUpdateMachineResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterBuiltins) DeepCopyInto(out *ClusterBuiltins) {
 	*out = *in
@@ -975,6 +1235,246 @@ func (in *MachinePoolBuiltins) DeepCopy() *MachinePoolBuiltins {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetPreflightCheckRequest) DeepCopyInto(out *MachineSetPreflightCheckRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	in.MachineSet.DeepCopyInto(&out.MachineSet)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetPreflightCheckRequest.
+func (in *MachineSetPreflightCheckRequest) DeepCopy() *MachineSetPreflightCheckRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetPreflightCheckRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineSetPreflightCheckRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSetPreflightCheckResponse) DeepCopyInto(out *MachineSetPreflightCheckResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSetPreflightCheckResponse.
+func (in *MachineSetPreflightCheckResponse) DeepCopy() *MachineSetPreflightCheckResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSetPreflightCheckResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineSetPreflightCheckResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineUpdateState) DeepCopyInto(out *MachineUpdateState) {
+	*out = *in
+	in.Machine.DeepCopyInto(&out.Machine)
+	in.BootstrapConfig.DeepCopyInto(&out.BootstrapConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineUpdateState.
+func (in *MachineUpdateState) DeepCopy() *MachineUpdateState {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineUpdateState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenewCertificatesRequest) DeepCopyInto(out *RenewCertificatesRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Machine.DeepCopyInto(&out.Machine)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewCertificatesRequest.
+func (in *RenewCertificatesRequest) DeepCopy() *RenewCertificatesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(RenewCertificatesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RenewCertificatesRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenewCertificatesResponse) DeepCopyInto(out *RenewCertificatesResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenewCertificatesResponse.
+func (in *RenewCertificatesResponse) DeepCopy() *RenewCertificatesResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(RenewCertificatesResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RenewCertificatesResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectMachinesForDeletionRequest) DeepCopyInto(out *SelectMachinesForDeletionRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Cluster.DeepCopyInto(&out.Cluster)
+	if in.MachineDeployment != nil {
+		in, out := &in.MachineDeployment, &out.MachineDeployment
+		*out = new(v1beta1.MachineDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	in.MachineSet.DeepCopyInto(&out.MachineSet)
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]v1beta1.Machine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectMachinesForDeletionRequest.
+func (in *SelectMachinesForDeletionRequest) DeepCopy() *SelectMachinesForDeletionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectMachinesForDeletionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SelectMachinesForDeletionRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectMachinesForDeletionResponse) DeepCopyInto(out *SelectMachinesForDeletionResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonResponse = in.CommonResponse
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectMachinesForDeletionResponse.
+func (in *SelectMachinesForDeletionResponse) DeepCopy() *SelectMachinesForDeletionResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectMachinesForDeletionResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SelectMachinesForDeletionResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateMachineRequest) DeepCopyInto(out *UpdateMachineRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.CommonRequest.DeepCopyInto(&out.CommonRequest)
+	in.Desired.DeepCopyInto(&out.Desired)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateMachineRequest.
+func (in *UpdateMachineRequest) DeepCopy() *UpdateMachineRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateMachineRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpdateMachineRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateMachineResponse) DeepCopyInto(out *UpdateMachineResponse) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.CommonRetryResponse = in.CommonRetryResponse
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateMachineResponse.
+func (in *UpdateMachineResponse) DeepCopy() *UpdateMachineResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateMachineResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UpdateMachineResponse) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ValidateTopologyRequest) DeepCopyInto(out *ValidateTopologyRequest) {
 	*out = *in
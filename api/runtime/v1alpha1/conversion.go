@@ -23,18 +23,41 @@ import (
 
 	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	utilconversion "sigs.k8s.io/cluster-api/util/conversion"
 )
 
 func (src *ExtensionConfig) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*runtimev1.ExtensionConfig)
 
-	return Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(src, dst, nil)
+	if err := Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &runtimev1.ExtensionConfig{}
+	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
+		return err
+	}
+	dst.Spec.ClientConfig.ClientCertificate = restored.Spec.ClientConfig.ClientCertificate
+	dst.Spec.ClientConfig.BearerToken = restored.Spec.ClientConfig.BearerToken
+
+	return nil
 }
 
 func (dst *ExtensionConfig) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*runtimev1.ExtensionConfig)
 
-	return Convert_v1beta2_ExtensionConfig_To_v1alpha1_ExtensionConfig(src, dst, nil)
+	if err := Convert_v1beta2_ExtensionConfig_To_v1alpha1_ExtensionConfig(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Preserve Hub data on down-conversion except for metadata.
+	return utilconversion.MarshalData(src, dst)
+}
+
+func Convert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(in *runtimev1.ClientConfig, out *ClientConfig, s apimachineryconversion.Scope) error {
+	// .ClientCertificate and .BearerToken were added in v1beta2.
+	return autoConvert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(in, out, s)
 }
 
 func Convert_v1beta2_ExtensionConfigStatus_To_v1alpha1_ExtensionConfigStatus(in *runtimev1.ExtensionConfigStatus, out *ExtensionConfigStatus, s apimachineryconversion.Scope) error {
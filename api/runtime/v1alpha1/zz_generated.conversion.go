@@ -43,11 +43,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClientConfig)(nil), (*ClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(a.(*v1beta2.ClientConfig), b.(*ClientConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ExtensionConfig)(nil), (*v1beta2.ExtensionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(a.(*ExtensionConfig), b.(*v1beta2.ExtensionConfig), scope)
 	}); err != nil {
@@ -123,6 +118,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ClientConfig)(nil), (*ClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(a.(*v1beta2.ClientConfig), b.(*ClientConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ExtensionConfigStatus)(nil), (*ExtensionConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ExtensionConfigStatus_To_v1alpha1_ExtensionConfigStatus(a.(*v1beta2.ExtensionConfigStatus), b.(*ExtensionConfigStatus), scope)
 	}); err != nil {
@@ -147,14 +147,11 @@ func autoConvert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(in *v1beta2.Clien
 	out.URL = (*string)(unsafe.Pointer(in.URL))
 	out.Service = (*ServiceReference)(unsafe.Pointer(in.Service))
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	// WARNING: in.ClientCertificate requires manual conversion: does not exist in peer-type
+	// WARNING: in.BearerToken requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig is an autogenerated conversion function.
-func Convert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(in *v1beta2.ClientConfig, out *ClientConfig, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClientConfig_To_v1alpha1_ClientConfig(in, out, s)
-}
-
 func autoConvert_v1alpha1_ExtensionConfig_To_v1beta2_ExtensionConfig(in *ExtensionConfig, out *v1beta2.ExtensionConfig, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ExtensionConfigSpec_To_v1beta2_ExtensionConfigSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -80,6 +80,33 @@ type ClientConfig struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=51200
 	CABundle []byte `json:"caBundle,omitempty"`
+
+	// clientCertificate is a reference to a Secret holding the client certificate and key which will be used
+	// to authenticate to the Extension server with mutual TLS. The Secret must contain the tls.crt and tls.key entries,
+	// e.g. a Secret of type kubernetes.io/tls. Changes to the Secret are picked up without restarting the controllers.
+	// +optional
+	ClientCertificate *SecretReference `json:"clientCertificate,omitempty"`
+
+	// bearerToken is a reference to a Secret holding a token which will be sent to the Extension server
+	// in the Authorization header. The Secret must contain the token entry.
+	// Changes to the Secret are picked up without restarting the controllers.
+	// +optional
+	BearerToken *SecretReference `json:"bearerToken,omitempty"`
+}
+
+// SecretReference holds a reference to a Secret holding credentials for an Extension server.
+type SecretReference struct {
+	// namespace is the namespace of the Secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	Namespace string `json:"namespace"`
+
+	// name is the name of the Secret.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
 }
 
 // ServiceReference holds a reference to a Kubernetes Service of an Extension server.
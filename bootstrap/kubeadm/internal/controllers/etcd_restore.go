@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+)
+
+const (
+	// etcdSnapshotPath is the path where the etcd snapshot is downloaded on the Machine re-initializing the control plane.
+	etcdSnapshotPath = "/run/cluster-api/etcd-snapshot.db"
+
+	// etcdSnapshotDownloadConfigPath is the path of the curl config file with the URL the etcd snapshot is downloaded from.
+	// NOTE: The URL is written to a file readable only by root, because it may embed credentials.
+	etcdSnapshotDownloadConfigPath = "/run/cluster-api/etcd-snapshot.curlrc"
+
+	// defaultEtcdDataDir is the etcd data directory used by kubeadm if not otherwise specified.
+	defaultEtcdDataDir = "/var/lib/etcd"
+
+	// etcdPeerPort is the port kubeadm configures the local etcd member to listen on for peer traffic.
+	etcdPeerPort = "2380"
+
+	// etcdSnapshotRestoreCommand downloads the etcd snapshot, verifies its checksum and restores the etcd data
+	// directory from it as a single member cluster.
+	// The member name and peer URL must match the ones kubeadm init uses for the local etcd member, so the
+	// member can be reached by the etcd members joining afterwards.
+	etcdSnapshotRestoreCommand = `curl --fail --silent --show-error --location --retry 5 --config %[1]s --output %[2]s && ` +
+		`echo "%[3]s  %[2]s" | sha256sum --check --status && ` +
+		`etcdutl snapshot restore %[2]s --data-dir %[4]s --name "%[5]s" ` +
+		`--initial-cluster "%[5]s=%[6]s" --initial-advertise-peer-urls "%[6]s" && ` +
+		`rm -f %[1]s %[2]s`
+)
+
+// curlConfigQuoter escapes a value for a double quoted string in a curl config file.
+var curlConfigQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// etcdSnapshotRestore defines the additions to the kubeadm init bootstrap data required to restore
+// the etcd data directory from a snapshot.
+type etcdSnapshotRestore struct {
+	file                 bootstrapv1.File
+	command              string
+	ignorePreflightError string
+}
+
+// resolveEtcdSnapshotRestore resolves the location of the etcd snapshot stored in the Secret with the given name and returns
+// the additions to the kubeadm init bootstrap data required to download the snapshot and restore the etcd data directory from it.
+// NOTE: The snapshot itself is not embedded in the bootstrap data, because its size usually exceeds the user data limits
+// of infrastructure providers.
+func (r *KubeadmConfigReconciler) resolveEtcdSnapshotRestore(ctx context.Context, config *bootstrapv1.KubeadmConfig, secretName string) (*etcdSnapshotRestore, error) {
+	resolve := func(key string) (string, error) {
+		data, err := r.resolveSecretFileContent(ctx, config.Namespace, bootstrapv1.File{
+			ContentFrom: &bootstrapv1.FileSource{
+				Secret: bootstrapv1.SecretFileSource{Name: secretName, Key: key},
+			},
+		})
+		if err != nil {
+			return "", errors.Wrap(err, "failed to resolve etcd snapshot")
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	snapshotURL, err := resolve(bootstrapv1.EtcdSnapshotURLSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	if u, err := url.Parse(snapshotURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, errors.Errorf("failed to resolve etcd snapshot: invalid URL in Secret %s, it must be an http or https URL", secretName)
+	}
+
+	checksum, err := resolve(bootstrapv1.EtcdSnapshotSHA256SecretKey)
+	if err != nil {
+		return nil, err
+	}
+	if decoded, err := hex.DecodeString(checksum); err != nil || len(decoded) != sha256.Size {
+		return nil, errors.Errorf("failed to resolve etcd snapshot: invalid SHA-256 checksum %q in Secret %s", checksum, secretName)
+	}
+
+	// kubeadm init names the local etcd member after the node and advertises the peer URL on the API server
+	// advertise address; both are required, because the values kubeadm detects on the Machine are not known here.
+	// NOTE: The values are used as is, so they can be templated by the bootstrap format like in the kubeadm configuration.
+	initConfiguration := config.Spec.InitConfiguration
+	if initConfiguration == nil || initConfiguration.NodeRegistration.Name == "" {
+		return nil, errors.New("failed to restore etcd snapshot: initConfiguration.nodeRegistration.name must be set")
+	}
+	if initConfiguration.LocalAPIEndpoint.AdvertiseAddress == "" {
+		return nil, errors.New("failed to restore etcd snapshot: initConfiguration.localAPIEndpoint.advertiseAddress must be set")
+	}
+	memberName := initConfiguration.NodeRegistration.Name
+	peerURL := "https://" + net.JoinHostPort(initConfiguration.LocalAPIEndpoint.AdvertiseAddress, etcdPeerPort)
+
+	dataDir := defaultEtcdDataDir
+	if config.Spec.ClusterConfiguration != nil && config.Spec.ClusterConfiguration.Etcd.Local != nil && config.Spec.ClusterConfiguration.Etcd.Local.DataDir != "" {
+		dataDir = config.Spec.ClusterConfiguration.Etcd.Local.DataDir
+	}
+
+	return &etcdSnapshotRestore{
+		file: bootstrapv1.File{
+			Path:        etcdSnapshotDownloadConfigPath,
+			Owner:       "root:root",
+			Permissions: "0600",
+			Content:     fmt.Sprintf("url = \"%s\"\n", curlConfigQuoter.Replace(snapshotURL)),
+		},
+		command: fmt.Sprintf(etcdSnapshotRestoreCommand, etcdSnapshotDownloadConfigPath, etcdSnapshotPath, checksum, dataDir, memberName, peerURL),
+		// kubeadm init fails if the etcd data directory is not empty, unless the corresponding preflight error is ignored.
+		ignorePreflightError: "DirAvailable-" + strings.ReplaceAll(dataDir, "/", "-"),
+	}, nil
+}
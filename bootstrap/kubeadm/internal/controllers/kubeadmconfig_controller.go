@@ -19,6 +19,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -362,6 +363,12 @@ func (r *KubeadmConfigReconciler) reconcile(ctx context.Context, scope *Scope, c
 		return r.handleClusterNotInitialized(ctx, scope)
 	}
 
+	// If the control plane is being re-initialized from an etcd snapshot, it's an init scenario
+	// even if the control plane has already been initialized.
+	if _, ok := config.Annotations[bootstrapv1.RestoreEtcdSnapshotSecretAnnotation]; ok && configOwner.IsControlPlaneMachine() {
+		return r.handleClusterNotInitialized(ctx, scope)
+	}
+
 	// Every other case it's a join scenario
 	// Nb. in this case ClusterConfiguration and InitConfiguration should not be defined by users, but in case of misconfigurations, CABPK simply ignore them
 
@@ -546,7 +553,29 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		scope.Config.Spec.InitConfiguration = &bootstrapv1.InitConfiguration{}
 	}
 
-	additionalData := r.computeClusterConfigurationAdditionalData(scope.Cluster, machine, scope.Config.Spec.InitConfiguration)
+	// If the control plane is being re-initialized from an etcd snapshot, add what is required to restore the
+	// etcd data directory before kubeadm init.
+	// NOTE: The KubeadmConfig spec is not modified, so KubeadmControlPlane does not detect the Machine as outdated.
+	initConfiguration := scope.Config.Spec.InitConfiguration
+	var etcdRestore *etcdSnapshotRestore
+	if secretName, ok := scope.Config.Annotations[bootstrapv1.RestoreEtcdSnapshotSecretAnnotation]; ok {
+		scope.Info("Restoring etcd from snapshot", "Secret", klog.KRef(scope.Config.Namespace, secretName))
+		etcdRestore, err = r.resolveEtcdSnapshotRestore(ctx, scope.Config, secretName)
+		if err != nil {
+			v1beta1conditions.MarkFalse(scope.Config, bootstrapv1.DataSecretAvailableV1Beta1Condition, bootstrapv1.DataSecretGenerationFailedV1Beta1Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+			conditions.Set(scope.Config, metav1.Condition{
+				Type:    bootstrapv1.KubeadmConfigDataSecretAvailableCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  bootstrapv1.KubeadmConfigDataSecretNotAvailableReason,
+				Message: "Failed to resolve etcd snapshot restore",
+			})
+			return ctrl.Result{}, err
+		}
+		initConfiguration = initConfiguration.DeepCopy()
+		initConfiguration.NodeRegistration.IgnorePreflightErrors = append(initConfiguration.NodeRegistration.IgnorePreflightErrors, etcdRestore.ignorePreflightError)
+	}
+
+	additionalData := r.computeClusterConfigurationAdditionalData(scope.Cluster, machine, initConfiguration)
 
 	clusterdata, err := kubeadmtypes.MarshalClusterConfigurationForVersion(scope.Config.Spec.ClusterConfiguration, parsedVersion, additionalData)
 	if err != nil {
@@ -554,7 +583,7 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		return ctrl.Result{}, err
 	}
 
-	initdata, err := kubeadmtypes.MarshalInitConfigurationForVersion(scope.Config.Spec.InitConfiguration, parsedVersion)
+	initdata, err := kubeadmtypes.MarshalInitConfigurationForVersion(initConfiguration, parsedVersion)
 	if err != nil {
 		scope.Error(err, "Failed to marshal init configuration")
 		return ctrl.Result{}, err
@@ -625,12 +654,18 @@ func (r *KubeadmConfigReconciler) handleClusterNotInitialized(ctx context.Contex
 		return ctrl.Result{}, err
 	}
 
+	preKubeadmCommands := scope.Config.Spec.PreKubeadmCommands
+	if etcdRestore != nil {
+		files = append(files, etcdRestore.file)
+		preKubeadmCommands = append(slices.Clone(preKubeadmCommands), etcdRestore.command)
+	}
+
 	controlPlaneInput := &cloudinit.ControlPlaneInput{
 		BaseUserData: cloudinit.BaseUserData{
 			AdditionalFiles:     files,
 			NTP:                 scope.Config.Spec.NTP,
 			BootCommands:        scope.Config.Spec.BootCommands,
-			PreKubeadmCommands:  scope.Config.Spec.PreKubeadmCommands,
+			PreKubeadmCommands:  preKubeadmCommands,
 			PostKubeadmCommands: scope.Config.Spec.PostKubeadmCommands,
 			Users:               users,
 			Mounts:              scope.Config.Spec.Mounts,
@@ -543,6 +543,97 @@ func TestKubeadmConfigReconciler_Reconcile_GenerateCloudConfigData(t *testing.T)
 	g.Expect(err).ToNot(HaveOccurred())
 }
 
+func TestKubeadmConfigReconciler_Reconcile_GenerateInitDataForEtcdSnapshotRestore(t *testing.T) {
+	g := NewWithT(t)
+
+	configName := "control-plane-restore-cfg"
+	cluster := builder.Cluster(metav1.NamespaceDefault, "cluster").Build()
+	cluster.Spec.ControlPlaneEndpoint = clusterv1.APIEndpoint{Host: "validhost", Port: 6443}
+	cluster.Status.Initialization = &clusterv1.ClusterInitializationStatus{InfrastructureProvisioned: true}
+	cluster.Status.Conditions = []metav1.Condition{{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue}}
+
+	controlPlaneRestoreMachine := newControlPlaneMachine(cluster, "control-plane-restore-machine")
+	controlPlaneRestoreConfig := newControlPlaneInitKubeadmConfig(controlPlaneRestoreMachine.Namespace, configName)
+	controlPlaneRestoreConfig.Annotations = map[string]string{
+		bootstrapv1.RestoreEtcdSnapshotSecretAnnotation: "etcd-snapshot",
+	}
+	controlPlaneRestoreConfig.Spec.InitConfiguration.NodeRegistration.Name = "{{ ds.meta_data.local_hostname }}"
+	controlPlaneRestoreConfig.Spec.InitConfiguration.LocalAPIEndpoint.AdvertiseAddress = "fd00::10"
+
+	addKubeadmConfigToMachine(controlPlaneRestoreConfig, controlPlaneRestoreMachine)
+
+	snapshotSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "etcd-snapshot",
+		},
+		Data: map[string][]byte{
+			bootstrapv1.EtcdSnapshotURLSecretKey:    []byte("https://snapshots.example.com/default/kcp/kcp-1.db?token=secret"),
+			bootstrapv1.EtcdSnapshotSHA256SecretKey: []byte("4c2c1f0c0de6a6b5d3f8ebf4a4bbdc2db3f0e6a2b6c8e6d94c3e3b0a3e1c5f7a"),
+		},
+	}
+
+	objects := []client.Object{
+		cluster,
+		controlPlaneRestoreMachine,
+		controlPlaneRestoreConfig,
+		snapshotSecret,
+	}
+	objects = append(objects, createSecrets(t, cluster, controlPlaneRestoreConfig)...)
+
+	myclient := fake.NewClientBuilder().WithObjects(objects...).WithStatusSubresource(&bootstrapv1.KubeadmConfig{}).Build()
+
+	k := &KubeadmConfigReconciler{
+		Client:              myclient,
+		SecretCachingClient: myclient,
+		ClusterCache:        clustercache.NewFakeClusterCache(myclient, client.ObjectKey{Name: cluster.Name, Namespace: cluster.Namespace}),
+		KubeadmInitLock:     &myInitLocker{},
+	}
+
+	request := ctrl.Request{
+		NamespacedName: client.ObjectKey{
+			Namespace: metav1.NamespaceDefault,
+			Name:      configName,
+		},
+	}
+	result, err := k.Reconcile(ctx, request)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.IsZero()).To(BeTrue())
+
+	cfg, err := getKubeadmConfig(myclient, configName, metav1.NamespaceDefault)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cfg.Status.Initialization).ToNot(BeNil())
+	g.Expect(cfg.Status.Initialization.DataSecretCreated).To(BeTrue())
+	// The restore must not be persisted in the KubeadmConfig spec.
+	g.Expect(cfg.Spec.Files).To(BeEmpty())
+	g.Expect(cfg.Spec.PreKubeadmCommands).To(BeEmpty())
+	g.Expect(cfg.Spec.InitConfiguration.NodeRegistration.IgnorePreflightErrors).To(BeEmpty())
+
+	s := &corev1.Secret{}
+	g.Expect(myclient.Get(ctx, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: configName}, s)).To(Succeed())
+	g.Expect(string(s.Data["value"])).To(ContainSubstring("kubeadm init"))
+	// The snapshot is downloaded by the Machine, it is not embedded in the bootstrap data.
+	g.Expect(string(s.Data["value"])).To(ContainSubstring(`url = "https://snapshots.example.com/default/kcp/kcp-1.db?token=secret"`))
+	g.Expect(string(s.Data["value"])).To(ContainSubstring("curl --fail --silent --show-error --location --retry 5 --config /run/cluster-api/etcd-snapshot.curlrc --output /run/cluster-api/etcd-snapshot.db"))
+	g.Expect(string(s.Data["value"])).To(ContainSubstring("4c2c1f0c0de6a6b5d3f8ebf4a4bbdc2db3f0e6a2b6c8e6d94c3e3b0a3e1c5f7a  /run/cluster-api/etcd-snapshot.db"))
+	g.Expect(string(s.Data["value"])).To(ContainSubstring("etcdutl snapshot restore /run/cluster-api/etcd-snapshot.db --data-dir /var/lib/etcd"))
+	// The member name and the peer URL match the ones kubeadm init uses for the local etcd member.
+	g.Expect(string(s.Data["value"])).To(ContainSubstring(`--name \"{{ ds.meta_data.local_hostname }}\" --initial-cluster \"{{ ds.meta_data.local_hostname }}=https://[fd00::10]:2380\" --initial-advertise-peer-urls \"https://[fd00::10]:2380\"`))
+	g.Expect(string(s.Data["value"])).To(ContainSubstring("DirAvailable--var-lib-etcd"))
+
+	// Bootstrap data is not generated if the member name or the peer URL of the local etcd member are not known.
+	for _, initConfiguration := range []bootstrapv1.InitConfiguration{
+		{LocalAPIEndpoint: bootstrapv1.APIEndpoint{AdvertiseAddress: "10.0.0.10"}},
+		{NodeRegistration: bootstrapv1.NodeRegistrationOptions{Name: "control-plane-restore-machine"}},
+	} {
+		config := controlPlaneRestoreConfig.DeepCopy()
+		config.Spec.InitConfiguration = initConfiguration.DeepCopy()
+		_, err := k.resolveEtcdSnapshotRestore(ctx, config, "etcd-snapshot")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("must be set"))
+	}
+}
+
 // If a control plane has no JoinConfiguration, then we will create a default and no error will occur.
 func TestKubeadmConfigReconciler_Reconcile_ErrorIfJoiningControlPlaneHasInvalidConfiguration(t *testing.T) {
 	g := NewWithT(t)
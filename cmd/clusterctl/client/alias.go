@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/alpha"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
@@ -45,6 +46,13 @@ type UpgradePlan cluster.UpgradePlan
 // upgraded to a different version.
 type CertManagerUpgradePlan cluster.CertManagerUpgradePlan
 
+// TopologyPlanOutput defines the changes the topology controller would apply to the Clusters affected by
+// new or modified ClusterClasses, templates or Clusters.
+type TopologyPlanOutput cluster.TopologyPlanOutput
+
+// RolloutRevision describes a revision of a cluster-api resource.
+type RolloutRevision alpha.RolloutRevision
+
 // Kubeconfig is a type that specifies inputs related to the actual kubeconfig.
 type Kubeconfig cluster.Kubeconfig
 
@@ -18,15 +18,27 @@ package alpha
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/internal/util/compare"
 )
 
 // getKubeadmControlPlane retrieves the KubeadmControlPlane object corresponding to the name and namespace specified.
@@ -73,3 +85,161 @@ func patchKubeadmControlPlane(ctx context.Context, proxy cluster.Proxy, name, na
 	}
 	return nil
 }
+
+// controlPlaneRevision is a revision of a KubeadmControlPlane, built from the specs recorded on its Machines.
+type controlPlaneRevision struct {
+	RolloutRevision
+	spec controlPlaneRevisionSpec
+}
+
+// controlPlaneRevisionSpec is the part of the KubeadmControlPlane spec which is recorded on its Machines.
+type controlPlaneRevisionSpec struct {
+	Version                       string                            `json:"version"`
+	InfrastructureMachineTemplate corev1.ObjectReference            `json:"infrastructureMachineTemplate"`
+	ClusterConfiguration          *bootstrapv1.ClusterConfiguration `json:"clusterConfiguration,omitempty"`
+}
+
+// versionedClusterConfiguration is the format of the KubeadmClusterConfigurationAnnotation on Machines.
+type versionedClusterConfiguration struct {
+	MarshalVersion string `json:"marshalVersion,omitempty"`
+	*bootstrapv1.ClusterConfiguration
+}
+
+// kubeadmControlPlaneRevisions returns the revisions of a KubeadmControlPlane, computed by grouping its current Machines
+// by Kubernetes version, infrastructure machine template and ClusterConfiguration, sorted by the creation time of their
+// oldest Machine.
+// NOTE: KubeadmControlPlane deletes Machines as soon as they are replaced, so only the revisions of existing Machines,
+// e.g. the ones of a rollout in progress, are available.
+func kubeadmControlPlaneRevisions(ctx context.Context, proxy cluster.Proxy, kcp *controlplanev1.KubeadmControlPlane) ([]controlPlaneRevision, error) {
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	machines := &clusterv1.MachineList{}
+	if err := c.List(ctx, machines, client.InNamespace(kcp.Namespace), client.MatchingLabels{clusterv1.MachineControlPlaneNameLabel: kcp.Name}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Machines for KubeadmControlPlane %s/%s", kcp.Namespace, kcp.Name)
+	}
+	sort.Slice(machines.Items, func(i, j int) bool {
+		return machines.Items[i].CreationTimestamp.Before(&machines.Items[j].CreationTimestamp)
+	})
+
+	revisions := []controlPlaneRevision{}
+	for i := range machines.Items {
+		machine := &machines.Items[i]
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		spec, err := getControlPlaneRevisionSpec(ctx, c, machine)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for j := range revisions {
+			if equality.Semantic.DeepEqual(revisions[j].spec, spec) {
+				revisions[j].Machines++
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		revisions = append(revisions, controlPlaneRevision{
+			RolloutRevision: RolloutRevision{
+				Revision:          int64(len(revisions) + 1),
+				Name:              spec.InfrastructureMachineTemplate.Name,
+				CreationTimestamp: machine.CreationTimestamp,
+				Machines:          1,
+			},
+			spec: spec,
+		})
+	}
+
+	for i := range revisions {
+		revision := &revisions[i]
+		revision.Current = revision.spec.Version == kcp.Spec.Version &&
+			revision.spec.InfrastructureMachineTemplate.Name == kcp.Spec.MachineTemplate.InfrastructureRef.Name &&
+			(revision.spec.ClusterConfiguration == nil || equality.Semantic.DeepEqual(revision.spec.ClusterConfiguration, kcp.Spec.KubeadmConfigSpec.ClusterConfiguration))
+		if i == 0 {
+			continue
+		}
+
+		_, diff, err := compare.Diff(revisions[i-1].spec, revision.spec)
+		if err != nil {
+			return nil, err
+		}
+		templateDiff, err := referencedTemplateDiff(ctx, c, kcp.Namespace, &revisions[i-1].spec.InfrastructureMachineTemplate, &revision.spec.InfrastructureMachineTemplate)
+		if err != nil {
+			return nil, err
+		}
+		if templateDiff != "" {
+			diff = fmt.Sprintf("%s\nInfrastructure template:\n%s", diff, templateDiff)
+		}
+		revision.Diff = diff
+	}
+	return revisions, nil
+}
+
+// getControlPlaneRevisionSpec returns the part of the KubeadmControlPlane spec a control plane Machine has been created with.
+func getControlPlaneRevisionSpec(ctx context.Context, c client.Client, machine *clusterv1.Machine) (controlPlaneRevisionSpec, error) {
+	spec := controlPlaneRevisionSpec{
+		Version: ptr.Deref(machine.Spec.Version, ""),
+	}
+
+	// The infrastructure machine template is recorded in the cloned-from annotations of the infrastructure machine.
+	infraRef := machine.Spec.InfrastructureRef.DeepCopy()
+	if infraRef.Namespace == "" {
+		infraRef.Namespace = machine.Namespace
+	}
+	infraMachine, err := external.Get(ctx, c, infraRef)
+	if err != nil && !apierrors.IsNotFound(errors.Cause(err)) {
+		return spec, err
+	}
+	if infraMachine != nil {
+		groupKind := schema.ParseGroupKind(infraMachine.GetAnnotations()[clusterv1.TemplateClonedFromGroupKindAnnotation])
+		gv, err := schema.ParseGroupVersion(infraMachine.GetAPIVersion())
+		if err != nil {
+			return spec, errors.Wrapf(err, "failed to parse apiVersion of %s %s", infraMachine.GetKind(), klog.KObj(infraMachine))
+		}
+		if groupKind.Group == gv.Group {
+			spec.InfrastructureMachineTemplate = corev1.ObjectReference{
+				APIVersion: gv.String(),
+				Kind:       groupKind.Kind,
+				Name:       infraMachine.GetAnnotations()[clusterv1.TemplateClonedFromNameAnnotation],
+			}
+		}
+	}
+
+	// The ClusterConfiguration is recorded in an annotation on the Machine.
+	// NOTE: Annotations written with older API versions are ignored.
+	if value, ok := machine.Annotations[controlplanev1.KubeadmClusterConfigurationAnnotation]; ok {
+		clusterConfiguration := &versionedClusterConfiguration{}
+		if err := json.Unmarshal([]byte(value), clusterConfiguration); err != nil {
+			return spec, errors.Wrapf(err, "failed to unmarshal ClusterConfiguration from Machine %s", klog.KObj(machine))
+		}
+		if clusterConfiguration.MarshalVersion == bootstrapv1.GroupVersion.Version {
+			spec.ClusterConfiguration = clusterConfiguration.ClusterConfiguration
+		}
+	}
+	return spec, nil
+}
+
+// findKubeadmControlPlaneRevision returns the specified revision.
+// If toRevision is 0, the latest revision which is not the current one is returned.
+func findKubeadmControlPlaneRevision(toRevision int64, revisions []controlPlaneRevision) (*controlPlaneRevision, error) {
+	if toRevision == 0 {
+		for i := len(revisions) - 1; i >= 0; i-- {
+			if !revisions[i].Current {
+				return &revisions[i], nil
+			}
+		}
+		return nil, errors.New("no rollout history found for KubeadmControlPlane")
+	}
+	for i := range revisions {
+		if revisions[i].Revision == toRevision {
+			return &revisions[i], nil
+		}
+	}
+	return nil, errors.Errorf("unable to find specified KubeadmControlPlane revision: %v", toRevision)
+}
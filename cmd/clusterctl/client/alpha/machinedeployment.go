@@ -19,9 +19,12 @@ package alpha
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -73,3 +76,56 @@ func patchMachineDeployment(ctx context.Context, proxy cluster.Proxy, name, name
 	}
 	return nil
 }
+
+// machineSetRevision is a revision of a MachineDeployment, i.e. one of its MachineSets.
+type machineSetRevision struct {
+	revision   int64
+	machineSet *clusterv1.MachineSet
+}
+
+// getMachineDeploymentRevisions returns the revisions of a MachineDeployment sorted by revision number.
+// MachineSets without a valid revision annotation are ignored.
+func getMachineDeploymentRevisions(ctx context.Context, proxy cluster.Proxy, md *clusterv1.MachineDeployment) ([]machineSetRevision, error) {
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	machineSets := &clusterv1.MachineSetList{}
+	if err := c.List(ctx, machineSets, client.InNamespace(md.Namespace), client.MatchingLabels{clusterv1.MachineDeploymentNameLabel: md.Name}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list MachineSets for MachineDeployment %s/%s", md.Namespace, md.Name)
+	}
+
+	revisions := make([]machineSetRevision, 0, len(machineSets.Items))
+	for i := range machineSets.Items {
+		ms := &machineSets.Items[i]
+		if !metav1.IsControlledBy(ms, md) {
+			continue
+		}
+		revision, err := strconv.ParseInt(ms.Annotations[clusterv1.RevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		revisions = append(revisions, machineSetRevision{revision: revision, machineSet: ms})
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].revision < revisions[j].revision
+	})
+	return revisions, nil
+}
+
+// findMachineDeploymentRevision returns the MachineSet of the specified revision.
+// If toRevision is 0, the MachineSet of the revision before the latest one is returned.
+func findMachineDeploymentRevision(toRevision int64, revisions []machineSetRevision) (*clusterv1.MachineSet, error) {
+	if toRevision == 0 {
+		if len(revisions) < 2 {
+			return nil, errors.New("no rollout history found for MachineDeployment")
+		}
+		return revisions[len(revisions)-2].machineSet, nil
+	}
+	for _, revision := range revisions {
+		if revision.revision == toRevision {
+			return revision.machineSet, nil
+		}
+	}
+	return nil, errors.Errorf("unable to find specified MachineDeployment revision: %v", toRevision)
+}
@@ -41,6 +41,8 @@ type Rollout interface {
 	ObjectRestarter(context.Context, cluster.Proxy, corev1.ObjectReference) error
 	ObjectPauser(context.Context, cluster.Proxy, corev1.ObjectReference) error
 	ObjectResumer(context.Context, cluster.Proxy, corev1.ObjectReference) error
+	ObjectRollbacker(context.Context, cluster.Proxy, corev1.ObjectReference, int64) error
+	ObjectHistoryViewer(context.Context, cluster.Proxy, corev1.ObjectReference) ([]RolloutRevision, error)
 }
 
 var _ Rollout = &rollout{}
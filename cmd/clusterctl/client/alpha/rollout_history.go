@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/controllers/external"
+	"sigs.k8s.io/cluster-api/internal/util/compare"
+)
+
+// RolloutRevision describes a revision of a cluster-api resource.
+type RolloutRevision struct {
+	// Revision is the number identifying the revision.
+	Revision int64
+
+	// Name is the name of the MachineSet for a MachineDeployment revision, or the name of the
+	// infrastructure machine template for a KubeadmControlPlane revision.
+	Name string
+
+	// CreationTimestamp is the time the revision has been created.
+	CreationTimestamp metav1.Time
+
+	// Machines is the number of Machines of the revision.
+	Machines int32
+
+	// Current is true for the revision the resource is currently rolling out.
+	Current bool
+
+	// Diff describes the changes of the revision compared to the previous revision.
+	Diff string
+}
+
+// ObjectHistoryViewer returns the rollout history of the specified cluster-api resource.
+func (r *rollout) ObjectHistoryViewer(ctx context.Context, proxy cluster.Proxy, ref corev1.ObjectReference) ([]RolloutRevision, error) {
+	switch ref.Kind {
+	case MachineDeployment:
+		deployment, err := getMachineDeployment(ctx, proxy, ref.Name, ref.Namespace)
+		if err != nil || deployment == nil {
+			return nil, errors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
+		}
+		return machineDeploymentHistory(ctx, proxy, deployment)
+	case KubeadmControlPlane:
+		kcp, err := getKubeadmControlPlane(ctx, proxy, ref.Name, ref.Namespace)
+		if err != nil || kcp == nil {
+			return nil, errors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
+		}
+		revisions, err := kubeadmControlPlaneRevisions(ctx, proxy, kcp)
+		if err != nil {
+			return nil, err
+		}
+		history := make([]RolloutRevision, 0, len(revisions))
+		for _, revision := range revisions {
+			history = append(history, revision.RolloutRevision)
+		}
+		return history, nil
+	default:
+		return nil, errors.Errorf("invalid resource type %q, valid values are %v", ref.Kind, validResourceTypes)
+	}
+}
+
+// machineDeploymentHistory returns the revisions of a MachineDeployment, one for each of its MachineSets.
+func machineDeploymentHistory(ctx context.Context, proxy cluster.Proxy, md *clusterv1.MachineDeployment) ([]RolloutRevision, error) {
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := getMachineDeploymentRevisions(ctx, proxy, md)
+	if err != nil {
+		return nil, err
+	}
+
+	history := make([]RolloutRevision, 0, len(revisions))
+	for i, revision := range revisions {
+		rolloutRevision := RolloutRevision{
+			Revision:          revision.revision,
+			Name:              revision.machineSet.Name,
+			CreationTimestamp: revision.machineSet.CreationTimestamp,
+			Machines:          ptr.Deref(revision.machineSet.Status.Replicas, 0),
+			Current:           i == len(revisions)-1,
+		}
+		if i > 0 {
+			diff, err := machineSetTemplateDiff(ctx, c, revisions[i-1].machineSet, revision.machineSet)
+			if err != nil {
+				return nil, err
+			}
+			rolloutRevision.Diff = diff
+		}
+		history = append(history, rolloutRevision)
+	}
+	return history, nil
+}
+
+// machineSetTemplateDiff returns the changes of the machine template of a MachineSet compared to another MachineSet,
+// including the changes of the referenced infrastructure machine template and bootstrap config template.
+func machineSetTemplateDiff(ctx context.Context, c client.Client, from, to *clusterv1.MachineSet) (string, error) {
+	diffs := []string{}
+
+	_, diff, err := compare.Diff(machineSetTemplate(from), machineSetTemplate(to))
+	if err != nil {
+		return "", err
+	}
+	if diff != "" {
+		diffs = append(diffs, fmt.Sprintf("Machine template:\n%s", diff))
+	}
+
+	diff, err = referencedTemplateDiff(ctx, c, from.Namespace, &from.Spec.Template.Spec.InfrastructureRef, &to.Spec.Template.Spec.InfrastructureRef)
+	if err != nil {
+		return "", err
+	}
+	if diff != "" {
+		diffs = append(diffs, fmt.Sprintf("Infrastructure template:\n%s", diff))
+	}
+
+	diff, err = referencedTemplateDiff(ctx, c, from.Namespace, from.Spec.Template.Spec.Bootstrap.ConfigRef, to.Spec.Template.Spec.Bootstrap.ConfigRef)
+	if err != nil {
+		return "", err
+	}
+	if diff != "" {
+		diffs = append(diffs, fmt.Sprintf("Bootstrap config template:\n%s", diff))
+	}
+
+	return strings.Join(diffs, "\n"), nil
+}
+
+// machineSetTemplate returns the machine template of a MachineSet without the labels added by the MachineDeployment controller.
+func machineSetTemplate(ms *clusterv1.MachineSet) *clusterv1.MachineTemplateSpec {
+	template := ms.Spec.Template.DeepCopy()
+	delete(template.Labels, clusterv1.MachineDeploymentUniqueLabel)
+	return template
+}
+
+// referencedTemplateDiff returns the changes of the spec of a template compared to another template.
+// If the templates have the same name, or if one of them does not exist anymore, no diff is computed.
+func referencedTemplateDiff(ctx context.Context, c client.Client, namespace string, fromRef, toRef *corev1.ObjectReference) (string, error) {
+	if fromRef == nil || toRef == nil || fromRef.Name == "" || toRef.Name == "" {
+		return "", nil
+	}
+	if fromRef.APIVersion == toRef.APIVersion && fromRef.Kind == toRef.Kind && fromRef.Name == toRef.Name {
+		return "", nil
+	}
+
+	fromSpec, err := getTemplateSpec(ctx, c, namespace, fromRef)
+	if err != nil || fromSpec == nil {
+		return "", err
+	}
+	toSpec, err := getTemplateSpec(ctx, c, namespace, toRef)
+	if err != nil || toSpec == nil {
+		return "", err
+	}
+
+	_, diff, err := compare.Diff(fromSpec, toSpec)
+	return diff, err
+}
+
+// getTemplateSpec returns the spec of a template, or nil if the template does not exist anymore.
+func getTemplateSpec(ctx context.Context, c client.Client, namespace string, ref *corev1.ObjectReference) (any, error) {
+	ref = ref.DeepCopy()
+	if ref.Namespace == "" {
+		ref.Namespace = namespace
+	}
+	obj, err := external.Get(ctx, c, ref)
+	if err != nil {
+		if apierrors.IsNotFound(errors.Cause(err)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return obj.Object["spec"], nil
+}
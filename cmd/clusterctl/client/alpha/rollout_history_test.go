@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_ObjectHistoryViewer(t *testing.T) {
+	now := time.Now()
+
+	t.Run("machinedeployment history should list the revisions of its machinesets", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := newRolloutClient()
+		proxy := test.NewFakeProxy().WithObjs(
+			testMachineDeployment("v1.31.0", nil),
+			testMachineSet("ms-3", 3, "v1.31.0"),
+			testMachineSet("ms-1", 1, "v1.30.0"),
+			testMachineSet("ms-2", 2, "v1.30.0"),
+		)
+		history, err := r.ObjectHistoryViewer(context.Background(), proxy, corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(HaveLen(3))
+
+		g.Expect(history[0].Revision).To(Equal(int64(1)))
+		g.Expect(history[0].Name).To(Equal("ms-1"))
+		g.Expect(history[0].Machines).To(Equal(int32(1)))
+		g.Expect(history[0].Current).To(BeFalse())
+		g.Expect(history[0].Diff).To(BeEmpty())
+
+		// The MachineDeploymentUniqueLabel is not considered a change.
+		g.Expect(history[1].Revision).To(Equal(int64(2)))
+		g.Expect(history[1].Diff).To(BeEmpty())
+
+		g.Expect(history[2].Revision).To(Equal(int64(3)))
+		g.Expect(history[2].Name).To(Equal("ms-3"))
+		g.Expect(history[2].Current).To(BeTrue())
+		g.Expect(history[2].Diff).To(ContainSubstring("v1.30.0"))
+		g.Expect(history[2].Diff).To(ContainSubstring("v1.31.0"))
+	})
+
+	t.Run("kubeadmcontrolplane history should group its machines by revision", func(t *testing.T) {
+		g := NewWithT(t)
+
+		objs := []client.Object{testKubeadmControlPlane("v1.31.0", "infra-new")}
+		objs = append(objs, testControlPlaneMachine("m-old-1", "v1.30.0", "infra-old", "/old", now.Add(-2*time.Hour))...)
+		objs = append(objs, testControlPlaneMachine("m-old-2", "v1.30.0", "infra-old", "/old", now.Add(-time.Hour))...)
+		objs = append(objs, testControlPlaneMachine("m-new", "v1.31.0", "infra-new", "/new", now)...)
+
+		r := newRolloutClient()
+		proxy := test.NewFakeProxy().WithObjs(objs...)
+		history, err := r.ObjectHistoryViewer(context.Background(), proxy, corev1.ObjectReference{Kind: KubeadmControlPlane, Name: "kcp", Namespace: "default"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(HaveLen(2))
+
+		g.Expect(history[0].Revision).To(Equal(int64(1)))
+		g.Expect(history[0].Name).To(Equal("infra-old"))
+		g.Expect(history[0].Machines).To(Equal(int32(2)))
+		g.Expect(history[0].Current).To(BeFalse())
+
+		g.Expect(history[1].Revision).To(Equal(int64(2)))
+		g.Expect(history[1].Name).To(Equal("infra-new"))
+		g.Expect(history[1].Machines).To(Equal(int32(1)))
+		g.Expect(history[1].Current).To(BeTrue())
+		g.Expect(history[1].Diff).To(ContainSubstring("v1.31.0"))
+		g.Expect(history[1].Diff).To(ContainSubstring("/new"))
+	})
+
+	t.Run("history of an invalid resource type should return error", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := newRolloutClient()
+		proxy := test.NewFakeProxy()
+		_, err := r.ObjectHistoryViewer(context.Background(), proxy, corev1.ObjectReference{Kind: "machineset", Name: "ms-1", Namespace: "default"})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -39,11 +39,20 @@ func (r *rollout) ObjectResumer(ctx context.Context, proxy cluster.Proxy, ref co
 		if err != nil || deployment == nil {
 			return errors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
 		}
-		if !deployment.Spec.Paused {
+		rollout := deployment.Status.Rollout
+		rolloutStepPaused := rollout != nil && rollout.Phase == clusterv1.MachineDeploymentRolloutPausedPhase
+		if !deployment.Spec.Paused && !rolloutStepPaused {
 			return errors.Errorf("MachineDeployment is not currently paused: %v/%v\n", ref.Kind, ref.Name) //nolint:revive // MachineDeployment is intentionally capitalized.
 		}
-		if err := resumeMachineDeployment(ctx, proxy, ref.Name, ref.Namespace); err != nil {
-			return err
+		if deployment.Spec.Paused {
+			if err := resumeMachineDeployment(ctx, proxy, ref.Name, ref.Namespace); err != nil {
+				return err
+			}
+		}
+		if rolloutStepPaused {
+			if err := resumeMachineDeploymentRolloutStep(ctx, proxy, ref.Name, ref.Namespace, rollout); err != nil {
+				return err
+			}
 		}
 	case KubeadmControlPlane:
 		kcp, err := getKubeadmControlPlane(ctx, proxy, ref.Name, ref.Namespace)
@@ -69,6 +78,14 @@ func resumeMachineDeployment(ctx context.Context, proxy cluster.Proxy, name, nam
 	return patchMachineDeployment(ctx, proxy, name, namespace, patch)
 }
 
+// resumeMachineDeploymentRolloutStep sets the annotation which resumes the current step of a staged rollout.
+func resumeMachineDeploymentRolloutStep(ctx context.Context, proxy cluster.Proxy, name, namespace string, rollout *clusterv1.MachineDeploymentRolloutStatus) error {
+	step := fmt.Sprintf("%s/%d", rollout.MachineSetName, rollout.CurrentStep+1)
+	patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf("{\"metadata\":{\"annotations\":{%q:%q}}}", clusterv1.MachineDeploymentResumeRolloutStepAnnotation, step)))
+
+	return patchMachineDeployment(ctx, proxy, name, namespace, patch)
+}
+
 // resumeKubeadmControlPlane removes paused annotation.
 func resumeKubeadmControlPlane(ctx context.Context, proxy cluster.Proxy, name, namespace string) error {
 	// In the paused annotation we must replace slashes to ~1, see https://datatracker.ietf.org/doc/html/rfc6901#section-3.
@@ -37,10 +37,11 @@ func Test_ObjectResumer(t *testing.T) {
 		ref  corev1.ObjectReference
 	}
 	tests := []struct {
-		name       string
-		fields     fields
-		wantErr    bool
-		wantPaused bool
+		name                 string
+		fields               fields
+		wantErr              bool
+		wantPaused           bool
+		wantResumeAnnotation string
 	}{
 		{
 			name: "paused machinedeployment should be unpaused",
@@ -94,6 +95,37 @@ func Test_ObjectResumer(t *testing.T) {
 			wantErr:    true,
 			wantPaused: false,
 		},
+		{
+			name: "machinedeployment with a staged rollout paused after a step should be resumed",
+			fields: fields{
+				objs: []client.Object{
+					&clusterv1.MachineDeployment{
+						TypeMeta: metav1.TypeMeta{
+							Kind: "MachineDeployment",
+						},
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "md-1",
+						},
+						Status: clusterv1.MachineDeploymentStatus{
+							Rollout: &clusterv1.MachineDeploymentRolloutStatus{
+								MachineSetName: "md-1-abcde",
+								CurrentStep:    1,
+								Phase:          clusterv1.MachineDeploymentRolloutPausedPhase,
+							},
+						},
+					},
+				},
+				ref: corev1.ObjectReference{
+					Kind:      MachineDeployment,
+					Name:      "md-1",
+					Namespace: "default",
+				},
+			},
+			wantErr:              false,
+			wantPaused:           false,
+			wantResumeAnnotation: "md-1-abcde/2",
+		},
 		{
 			name: "paused kubeadmcontrolplane should be unpaused",
 			fields: fields{
@@ -165,6 +197,7 @@ func Test_ObjectResumer(t *testing.T) {
 					err = cl.Get(context.TODO(), key, md)
 					g.Expect(err).ToNot(HaveOccurred())
 					g.Expect(md.Spec.Paused).To(Equal(tt.wantPaused))
+					g.Expect(md.Annotations[clusterv1.MachineDeploymentResumeRolloutStepAnnotation]).To(Equal(tt.wantResumeAnnotation))
 				case *controlplanev1.KubeadmControlPlane:
 					kcp := &controlplanev1.KubeadmControlPlane{}
 					err = cl.Get(context.TODO(), key, kcp)
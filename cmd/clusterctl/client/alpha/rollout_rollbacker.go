@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// ObjectRollbacker will issue a rollback on the specified cluster-api resource.
+// If toRevision is 0, the resource is rolled back to the previous revision.
+func (r *rollout) ObjectRollbacker(ctx context.Context, proxy cluster.Proxy, ref corev1.ObjectReference, toRevision int64) error {
+	if toRevision < 0 {
+		return errors.Errorf("revision number cannot be negative: %v", toRevision)
+	}
+
+	switch ref.Kind {
+	case MachineDeployment:
+		deployment, err := getMachineDeployment(ctx, proxy, ref.Name, ref.Namespace)
+		if err != nil || deployment == nil {
+			return errors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
+		}
+		if _, ok := deployment.Labels[clusterv1.ClusterTopologyOwnedLabel]; ok {
+			return errors.Errorf("can't roll back MachineDeployment managed by a Cluster topology (revert the Cluster topology instead): %v/%v", ref.Kind, ref.Name)
+		}
+		if err := rollbackMachineDeployment(ctx, proxy, deployment, toRevision); err != nil {
+			return err
+		}
+	case KubeadmControlPlane:
+		kcp, err := getKubeadmControlPlane(ctx, proxy, ref.Name, ref.Namespace)
+		if err != nil || kcp == nil {
+			return errors.Wrapf(err, "failed to fetch %v/%v", ref.Kind, ref.Name)
+		}
+		if _, ok := kcp.Labels[clusterv1.ClusterTopologyOwnedLabel]; ok {
+			return errors.Errorf("can't roll back KubeadmControlPlane managed by a Cluster topology (revert the Cluster topology instead): %v/%v", ref.Kind, ref.Name)
+		}
+		if err := rollbackKubeadmControlPlane(ctx, proxy, kcp, toRevision); err != nil {
+			return err
+		}
+	default:
+		return errors.Errorf("invalid resource type %q, valid values are %v", ref.Kind, validResourceTypes)
+	}
+	return nil
+}
+
+// rollbackMachineDeployment rolls back a MachineDeployment to the machine template of the MachineSet of a previous revision.
+func rollbackMachineDeployment(ctx context.Context, proxy cluster.Proxy, md *clusterv1.MachineDeployment, toRevision int64) error {
+	log := logf.Log
+
+	revisions, err := getMachineDeploymentRevisions(ctx, proxy, md)
+	if err != nil {
+		return err
+	}
+	ms, err := findMachineDeploymentRevision(toRevision, revisions)
+	if err != nil {
+		return err
+	}
+	log.V(5).Info("Found revision", "MachineDeployment", md.Name, "MachineSet", ms.Name)
+
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	patchHelper, err := patch.NewHelper(md, c)
+	if err != nil {
+		return err
+	}
+	md.Spec.Template = *machineSetTemplate(ms)
+	if err := patchHelper.Patch(ctx, md); err != nil {
+		return errors.Wrapf(err, "failed while patching MachineDeployment %s/%s", md.Namespace, md.Name)
+	}
+	return nil
+}
+
+// rollbackKubeadmControlPlane rolls back the Kubernetes version, the infrastructure machine template and the
+// ClusterConfiguration of a KubeadmControlPlane to the ones recorded on the Machines of a previous revision.
+func rollbackKubeadmControlPlane(ctx context.Context, proxy cluster.Proxy, kcp *controlplanev1.KubeadmControlPlane, toRevision int64) error {
+	log := logf.Log
+
+	revisions, err := kubeadmControlPlaneRevisions(ctx, proxy, kcp)
+	if err != nil {
+		return err
+	}
+	revision, err := findKubeadmControlPlaneRevision(toRevision, revisions)
+	if err != nil {
+		return err
+	}
+	log.V(5).Info("Found revision", "KubeadmControlPlane", kcp.Name, "revision", revision.Revision)
+
+	c, err := proxy.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+	patchHelper, err := patch.NewHelper(kcp, c)
+	if err != nil {
+		return err
+	}
+	if revision.spec.Version != "" {
+		kcp.Spec.Version = revision.spec.Version
+	}
+	if revision.spec.InfrastructureMachineTemplate.Name != "" {
+		kcp.Spec.MachineTemplate.InfrastructureRef.APIVersion = revision.spec.InfrastructureMachineTemplate.APIVersion
+		kcp.Spec.MachineTemplate.InfrastructureRef.Kind = revision.spec.InfrastructureMachineTemplate.Kind
+		kcp.Spec.MachineTemplate.InfrastructureRef.Name = revision.spec.InfrastructureMachineTemplate.Name
+	}
+	if revision.spec.ClusterConfiguration != nil {
+		kcp.Spec.KubeadmConfigSpec.ClusterConfiguration = revision.spec.ClusterConfiguration
+	}
+	if err := patchHelper.Patch(ctx, kcp); err != nil {
+		return errors.Wrapf(err, "failed while patching KubeadmControlPlane %s/%s", kcp.Namespace, kcp.Name)
+	}
+	return nil
+}
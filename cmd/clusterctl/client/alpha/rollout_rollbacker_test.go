@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	fakeinfrastructure "sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test/providers/infrastructure"
+)
+
+var testCreationTimestamp = metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+func testMachineDeployment(version string, labels map[string]string) *clusterv1.MachineDeployment {
+	return &clusterv1.MachineDeployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "MachineDeployment",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "md-1",
+			UID:       types.UID("md-1-uid"),
+			Labels:    labels,
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			ClusterName: "cluster-1",
+			Template: clusterv1.MachineTemplateSpec{
+				ObjectMeta: clusterv1.ObjectMeta{
+					Labels: map[string]string{"foo": "bar"},
+				},
+				Spec: clusterv1.MachineSpec{
+					ClusterName: "cluster-1",
+					Version:     ptr.To(version),
+				},
+			},
+		},
+	}
+}
+
+func testMachineSet(name string, revision int64, version string) *clusterv1.MachineSet {
+	return &clusterv1.MachineSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "MachineSet",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels:    map[string]string{clusterv1.MachineDeploymentNameLabel: "md-1"},
+			Annotations: map[string]string{
+				clusterv1.RevisionAnnotation: fmt.Sprintf("%d", revision),
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "MachineDeployment",
+				Name:       "md-1",
+				UID:        types.UID("md-1-uid"),
+				Controller: ptr.To(true),
+			}},
+			CreationTimestamp: testCreationTimestamp,
+		},
+		Spec: clusterv1.MachineSetSpec{
+			ClusterName: "cluster-1",
+			Template: clusterv1.MachineTemplateSpec{
+				ObjectMeta: clusterv1.ObjectMeta{
+					Labels: map[string]string{
+						"foo":                                  "bar",
+						clusterv1.MachineDeploymentUniqueLabel: name,
+					},
+				},
+				Spec: clusterv1.MachineSpec{
+					ClusterName: "cluster-1",
+					Version:     ptr.To(version),
+				},
+			},
+		},
+		Status: clusterv1.MachineSetStatus{
+			Replicas: ptr.To[int32](1),
+		},
+	}
+}
+
+func testKubeadmControlPlane(version, infraTemplate string) *controlplanev1.KubeadmControlPlane {
+	return &controlplanev1.KubeadmControlPlane{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "KubeadmControlPlane",
+			APIVersion: controlplanev1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "kcp",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: version,
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				InfrastructureRef: corev1.ObjectReference{
+					APIVersion: fakeinfrastructure.GroupVersion.String(),
+					Kind:       "GenericInfrastructureMachineTemplate",
+					Namespace:  "default",
+					Name:       infraTemplate,
+				},
+			},
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: &bootstrapv1.ClusterConfiguration{
+					CertificatesDir: "/new",
+				},
+			},
+		},
+	}
+}
+
+func testControlPlaneMachine(name, version, infraTemplate, certificatesDir string, created time.Time) []client.Object {
+	infraMachine := &fakeinfrastructure.GenericInfrastructureMachine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "GenericInfrastructureMachine",
+			APIVersion: fakeinfrastructure.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Annotations: map[string]string{
+				clusterv1.TemplateClonedFromNameAnnotation:      infraTemplate,
+				clusterv1.TemplateClonedFromGroupKindAnnotation: "GenericInfrastructureMachineTemplate.infrastructure.cluster.x-k8s.io",
+			},
+		},
+	}
+	machine := &clusterv1.Machine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Machine",
+			APIVersion: clusterv1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "default",
+			Name:              name,
+			Labels:            map[string]string{clusterv1.MachineControlPlaneNameLabel: "kcp"},
+			Annotations:       map[string]string{controlplanev1.KubeadmClusterConfigurationAnnotation: fmt.Sprintf(`{"marshalVersion":"v1beta2","certificatesDir":%q}`, certificatesDir)},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: "cluster-1",
+			Version:     ptr.To(version),
+			InfrastructureRef: corev1.ObjectReference{
+				APIVersion: fakeinfrastructure.GroupVersion.String(),
+				Kind:       "GenericInfrastructureMachine",
+				Name:       name,
+			},
+		},
+	}
+	return []client.Object{machine, infraMachine}
+}
+
+func Test_ObjectRollbacker(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name                string
+		objs                []client.Object
+		ref                 corev1.ObjectReference
+		toRevision          int64
+		wantErr             bool
+		wantVersion         string
+		wantInfraTemplate   string
+		wantCertificatesDir string
+	}{
+		{
+			name: "machinedeployment should be rolled back to the previous revision",
+			objs: []client.Object{
+				testMachineDeployment("v1.31.0", nil),
+				testMachineSet("ms-1", 1, "v1.29.0"),
+				testMachineSet("ms-2", 2, "v1.30.0"),
+				testMachineSet("ms-3", 3, "v1.31.0"),
+			},
+			ref:         corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"},
+			wantVersion: "v1.30.0",
+		},
+		{
+			name: "machinedeployment should be rolled back to the specified revision",
+			objs: []client.Object{
+				testMachineDeployment("v1.31.0", nil),
+				testMachineSet("ms-1", 1, "v1.29.0"),
+				testMachineSet("ms-2", 2, "v1.30.0"),
+				testMachineSet("ms-3", 3, "v1.31.0"),
+			},
+			ref:         corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"},
+			toRevision:  1,
+			wantVersion: "v1.29.0",
+		},
+		{
+			name: "rolling back a machinedeployment to a missing revision should return error",
+			objs: []client.Object{
+				testMachineDeployment("v1.31.0", nil),
+				testMachineSet("ms-3", 3, "v1.31.0"),
+			},
+			ref:        corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"},
+			toRevision: 2,
+			wantErr:    true,
+		},
+		{
+			name: "rolling back a machinedeployment without previous revision should return error",
+			objs: []client.Object{
+				testMachineDeployment("v1.31.0", nil),
+				testMachineSet("ms-3", 3, "v1.31.0"),
+			},
+			ref:     corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"},
+			wantErr: true,
+		},
+		{
+			name: "rolling back a machinedeployment managed by a cluster topology should return error",
+			objs: []client.Object{
+				testMachineDeployment("v1.31.0", map[string]string{clusterv1.ClusterTopologyOwnedLabel: ""}),
+				testMachineSet("ms-2", 2, "v1.30.0"),
+				testMachineSet("ms-3", 3, "v1.31.0"),
+			},
+			ref:     corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"},
+			wantErr: true,
+		},
+		{
+			name: "kubeadmcontrolplane should be rolled back to the revision of its old machines",
+			objs: append(append([]client.Object{
+				testKubeadmControlPlane("v1.31.0", "infra-new"),
+			}, testControlPlaneMachine("m-old", "v1.30.0", "infra-old", "/old", now.Add(-time.Hour))...),
+				testControlPlaneMachine("m-new", "v1.31.0", "infra-new", "/new", now)...),
+			ref:                 corev1.ObjectReference{Kind: KubeadmControlPlane, Name: "kcp", Namespace: "default"},
+			wantVersion:         "v1.30.0",
+			wantInfraTemplate:   "infra-old",
+			wantCertificatesDir: "/old",
+		},
+		{
+			name: "rolling back a kubeadmcontrolplane without old machines should return error",
+			objs: append([]client.Object{
+				testKubeadmControlPlane("v1.31.0", "infra-new"),
+			}, testControlPlaneMachine("m-new", "v1.31.0", "infra-new", "/new", now)...),
+			ref:     corev1.ObjectReference{Kind: KubeadmControlPlane, Name: "kcp", Namespace: "default"},
+			wantErr: true,
+		},
+		{
+			name:       "rolling back to a negative revision should return error",
+			objs:       []client.Object{testMachineDeployment("v1.31.0", nil)},
+			ref:        corev1.ObjectReference{Kind: MachineDeployment, Name: "md-1", Namespace: "default"},
+			toRevision: -1,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			r := newRolloutClient()
+			proxy := test.NewFakeProxy().WithObjs(tt.objs...)
+			err := r.ObjectRollbacker(context.Background(), proxy, tt.ref, tt.toRevision)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			cl, err := proxy.NewClient(context.Background())
+			g.Expect(err).ToNot(HaveOccurred())
+			key := client.ObjectKey{Namespace: tt.ref.Namespace, Name: tt.ref.Name}
+			switch tt.ref.Kind {
+			case MachineDeployment:
+				md := &clusterv1.MachineDeployment{}
+				g.Expect(cl.Get(context.TODO(), key, md)).To(Succeed())
+				g.Expect(md.Spec.Template.Spec.Version).To(HaveValue(Equal(tt.wantVersion)))
+				g.Expect(md.Spec.Template.Labels).To(Equal(map[string]string{"foo": "bar"}))
+			case KubeadmControlPlane:
+				kcp := &controlplanev1.KubeadmControlPlane{}
+				g.Expect(cl.Get(context.TODO(), key, kcp)).To(Succeed())
+				g.Expect(kcp.Spec.Version).To(Equal(tt.wantVersion))
+				g.Expect(kcp.Spec.MachineTemplate.InfrastructureRef.Name).To(Equal(tt.wantInfraTemplate))
+				g.Expect(kcp.Spec.MachineTemplate.InfrastructureRef.Kind).To(Equal("GenericInfrastructureMachineTemplate"))
+				g.Expect(kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificatesDir).To(Equal(tt.wantCertificatesDir))
+			}
+		})
+	}
+}
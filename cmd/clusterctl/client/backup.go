@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// BackupCreateOptions carries the options supported by backup create.
+type BackupCreateOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Namespace where the objects to back up exist. If unspecified, objects from all the namespaces
+	// will be backed up.
+	Namespace string
+
+	// File is the path of the backup archive to write.
+	File string
+}
+
+// BackupRestoreOptions carries the options supported by backup restore.
+type BackupRestoreOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// File is the path of the backup archive to read.
+	File string
+}
+
+func (c *clusterctlClient) BackupCreate(ctx context.Context, options BackupCreateOptions) error {
+	if options.File == "" {
+		return errors.New("file must be set")
+	}
+
+	clusterClient, err := c.getClusterClient(ctx, options.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(options.File, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create backup file %s", options.File)
+	}
+	defer f.Close()
+
+	if err := clusterClient.ObjectBackup().Create(ctx, options.Namespace, f); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func (c *clusterctlClient) BackupRestore(ctx context.Context, options BackupRestoreOptions) error {
+	if options.File == "" {
+		return errors.New("file must be set")
+	}
+
+	clusterClient, err := c.getClusterClient(ctx, options.Kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(options.File)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open backup file %s", options.File)
+	}
+	defer f.Close()
+
+	return clusterClient.ObjectBackup().Restore(ctx, f)
+}
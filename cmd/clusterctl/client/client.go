@@ -59,6 +59,17 @@ type Client interface {
 	// Move moves all the Cluster API objects existing in a namespace (or from all the namespaces if empty) to a target management cluster.
 	Move(ctx context.Context, options MoveOptions) error
 
+	// BackupCreate writes an archive with all the Cluster API objects existing in a namespace (or from all the namespaces if empty),
+	// the related secrets and the provider inventory, without pausing Clusters.
+	BackupCreate(ctx context.Context, options BackupCreateOptions) error
+
+	// BackupRestore restores all the Cluster API objects stored in a backup archive into a management cluster.
+	BackupRestore(ctx context.Context, options BackupRestoreOptions) error
+
+	// TopologyPlan returns the changes the topology controller would apply to the Clusters affected by
+	// new or modified ClusterClasses, templates or Clusters, without applying any change.
+	TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error)
+
 	// PlanUpgrade returns a set of suggested Upgrade plans for the cluster.
 	PlanUpgrade(ctx context.Context, options PlanUpgradeOptions) ([]UpgradePlan, error)
 
@@ -87,6 +98,13 @@ type AlphaClient interface {
 	RolloutPause(ctx context.Context, options RolloutPauseOptions) error
 	// RolloutResume provides rollout resume of paused cluster-api resources
 	RolloutResume(ctx context.Context, options RolloutResumeOptions) error
+	// RolloutUndo provides rollout rollback of cluster-api resources
+	RolloutUndo(ctx context.Context, options RolloutUndoOptions) error
+	// RolloutHistory provides rollout history of a cluster-api resource
+	RolloutHistory(ctx context.Context, options RolloutHistoryOptions) ([]RolloutRevision, error)
+	// DrainSimulate simulates the drain of the Nodes of a set of Machines and checks if the Pods to evict
+	// can be rescheduled on the remaining Nodes, without cordoning Nodes or evicting Pods.
+	DrainSimulate(ctx context.Context, options DrainSimulateOptions) (*DrainSimulationOutput, error)
 }
 
 // YamlPrinter exposes methods that prints the processed template and
@@ -113,6 +113,18 @@ func (f fakeClient) Move(ctx context.Context, options MoveOptions) error {
 	return f.internalClient.Move(ctx, options)
 }
 
+func (f fakeClient) BackupCreate(ctx context.Context, options BackupCreateOptions) error {
+	return f.internalClient.BackupCreate(ctx, options)
+}
+
+func (f fakeClient) BackupRestore(ctx context.Context, options BackupRestoreOptions) error {
+	return f.internalClient.BackupRestore(ctx, options)
+}
+
+func (f fakeClient) TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error) {
+	return f.internalClient.TopologyPlan(ctx, options)
+}
+
 func (f fakeClient) PlanUpgrade(ctx context.Context, options PlanUpgradeOptions) ([]UpgradePlan, error) {
 	return f.internalClient.PlanUpgrade(ctx, options)
 }
@@ -145,6 +157,18 @@ func (f fakeClient) RolloutResume(ctx context.Context, options RolloutResumeOpti
 	return f.internalClient.RolloutResume(ctx, options)
 }
 
+func (f fakeClient) RolloutUndo(ctx context.Context, options RolloutUndoOptions) error {
+	return f.internalClient.RolloutUndo(ctx, options)
+}
+
+func (f fakeClient) RolloutHistory(ctx context.Context, options RolloutHistoryOptions) ([]RolloutRevision, error) {
+	return f.internalClient.RolloutHistory(ctx, options)
+}
+
+func (f fakeClient) DrainSimulate(ctx context.Context, options DrainSimulateOptions) (*DrainSimulationOutput, error) {
+	return f.internalClient.DrainSimulate(ctx, options)
+}
+
 // newFakeClient returns a clusterctl client that allows to execute tests on a set of fake config, fake repositories and fake clusters.
 // you can use WithCluster and WithRepository to prepare for the test case.
 func newFakeClient(ctx context.Context, configClient config.Client) *fakeClient {
@@ -306,6 +330,10 @@ func (f *fakeClusterClient) ObjectMover() cluster.ObjectMover {
 	return f.fakeObjectMover
 }
 
+func (f *fakeClusterClient) ObjectBackup() cluster.ObjectBackup {
+	return f.internalclient.ObjectBackup()
+}
+
 func (f *fakeClusterClient) ProviderUpgrader() cluster.ProviderUpgrader {
 	return f.internalclient.ProviderUpgrader()
 }
@@ -314,6 +342,10 @@ func (f *fakeClusterClient) Template() cluster.TemplateClient {
 	return f.internalclient.Template()
 }
 
+func (f *fakeClusterClient) Topology() cluster.TopologyClient {
+	return f.internalclient.Topology()
+}
+
 func (f *fakeClusterClient) WorkloadCluster() cluster.WorkloadCluster {
 	return f.internalclient.WorkloadCluster()
 }
@@ -0,0 +1,383 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+const (
+	// BackupFormatVersion is the version of the archive format written by clusterctl backup create.
+	BackupFormatVersion = "v1"
+
+	// backupManifestFile is the name of the file in the archive holding the BackupManifest.
+	backupManifestFile = "manifest.yaml"
+
+	// backupObjectsDir is the name of the directory in the archive holding the Kubernetes objects.
+	backupObjectsDir = "objects"
+)
+
+// BackupManifest describes the content of a backup archive.
+type BackupManifest struct {
+	// Version is the version of the archive format.
+	Version string `json:"version"`
+
+	// CreatedAt is the time the backup has been created.
+	CreatedAt metav1.Time `json:"createdAt"`
+
+	// Namespace is the namespace the backup has been taken from; empty means all the namespaces.
+	Namespace string `json:"namespace,omitempty"`
+
+	// Contract is the Cluster API contract of the management cluster the backup has been taken from.
+	Contract string `json:"contract"`
+
+	// Providers is the provider inventory of the management cluster the backup has been taken from.
+	Providers []clusterctlv1.Provider `json:"providers,omitempty"`
+
+	// Objects is the list of objects stored in the archive, in the order they should be restored.
+	Objects []BackupObject `json:"objects,omitempty"`
+}
+
+// BackupObject describes an object stored in a backup archive.
+type BackupObject struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+
+	// File is the path of the file in the archive holding the object.
+	File string `json:"file"`
+}
+
+// ObjectBackup defines methods for backing up and restoring the Cluster API objects of a management cluster.
+type ObjectBackup interface {
+	// Create writes an archive with all the Cluster API objects existing in a namespace (or in all the namespaces if empty),
+	// the related secrets and the provider inventory.
+	// NOTE: Differently from move, Clusters are not paused while taking the backup.
+	Create(ctx context.Context, namespace string, w io.Writer) error
+
+	// Restore recreates all the objects stored in an archive, including their status, after checking that the
+	// Cluster API contract and the providers installed in the management cluster are compatible with the backup.
+	Restore(ctx context.Context, r io.Reader) error
+}
+
+// objectBackup implements the ObjectBackup interface.
+type objectBackup struct {
+	proxy                         Proxy
+	providerInventory             InventoryClient
+	currentContractVersion        string
+	getCompatibleContractVersions func(string) sets.Set[string]
+}
+
+// ensure objectBackup implements the ObjectBackup interface.
+var _ ObjectBackup = &objectBackup{}
+
+func newObjectBackup(proxy Proxy, providerInventory InventoryClient, currentContractVersion string, getCompatibleContractVersions func(string) sets.Set[string]) *objectBackup {
+	return &objectBackup{
+		proxy:                         proxy,
+		providerInventory:             providerInventory,
+		currentContractVersion:        currentContractVersion,
+		getCompatibleContractVersions: getCompatibleContractVersions,
+	}
+}
+
+func (b *objectBackup) Create(ctx context.Context, namespace string, w io.Writer) error {
+	log := logf.Log
+	log.Info("Creating backup...")
+
+	providers, err := b.providerInventory.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get provider list")
+	}
+
+	// Discovery the object graph; differently from move the provisioning is not required to be completed
+	// given that objects are only read and Clusters are not paused.
+	graph := newObjectGraph(b.proxy, b.providerInventory)
+	if err := graph.getDiscoveryTypes(ctx); err != nil {
+		return errors.Wrap(err, "failed to retrieve discovery types")
+	}
+	if err := graph.Discovery(ctx, namespace); err != nil {
+		return errors.Wrap(err, "failed to discover the object graph")
+	}
+	graph.checkVirtualNode()
+
+	cFrom, err := b.proxy.NewClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	manifest := &BackupManifest{
+		Version:   BackupFormatVersion,
+		CreatedAt: metav1.Now(),
+		Namespace: namespace,
+		Contract:  b.currentContractVersion,
+		Providers: providers.Items,
+	}
+
+	// Read objects following the move sequence, so objects are stored after their owners.
+	files := map[string][]byte{}
+	moveSequence := getMoveSequence(graph)
+	readObjectBackoff := newReadBackoff()
+	for groupIndex := range len(moveSequence.groups) {
+		for _, n := range moveSequence.getGroup(groupIndex) {
+			obj := &unstructured.Unstructured{}
+			obj.SetAPIVersion(n.identity.APIVersion)
+			obj.SetKind(n.identity.Kind)
+			objKey := client.ObjectKey{
+				Namespace: n.identity.Namespace,
+				Name:      n.identity.Name,
+			}
+
+			// Nb. The operation is wrapped in a retry loop to make backup more resilient to unexpected conditions.
+			if err := retryWithExponentialBackoff(ctx, readObjectBackoff, func(ctx context.Context) error {
+				return cFrom.Get(ctx, objKey, obj)
+			}); err != nil {
+				return errors.Wrapf(err, "error reading %q %s/%s",
+					obj.GroupVersionKind(), n.identity.Namespace, n.identity.Name)
+			}
+
+			byObj, err := obj.MarshalJSON()
+			if err != nil {
+				return err
+			}
+
+			file := path.Join(backupObjectsDir, n.getFilename())
+			files[file] = byObj
+			manifest.Objects = append(manifest.Objects, BackupObject{
+				APIVersion: n.identity.APIVersion,
+				Kind:       n.identity.Kind,
+				Namespace:  n.identity.Namespace,
+				Name:       n.identity.Name,
+				File:       file,
+			})
+		}
+	}
+
+	byManifest, err := yaml.Marshal(manifest)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backup manifest")
+	}
+
+	// Write the archive with the manifest first, then all the objects.
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	if err := writeBackupFile(tw, backupManifestFile, byManifest, manifest.CreatedAt.Time); err != nil {
+		return err
+	}
+	for _, o := range manifest.Objects {
+		if err := writeBackupFile(tw, o.File, files[o.File], manifest.CreatedAt.Time); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, "failed to write backup archive")
+	}
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "failed to write backup archive")
+	}
+
+	log.Info(fmt.Sprintf("Backup created with %d objects", len(manifest.Objects)))
+	return nil
+}
+
+func (b *objectBackup) Restore(ctx context.Context, r io.Reader) error {
+	log := logf.Log
+	log.Info("Restoring backup...")
+
+	manifest, files, err := readBackupArchive(r)
+	if err != nil {
+		return err
+	}
+
+	if err := b.checkCompatibility(ctx, manifest); err != nil {
+		return err
+	}
+
+	// Build an object graph from the objects stored in the archive, not tied to a specific namespace.
+	graph := newObjectGraph(b.proxy, b.providerInventory)
+	if err := graph.getDiscoveryTypes(ctx); err != nil {
+		return errors.Wrap(err, "failed to retrieve discovery types")
+	}
+
+	for _, o := range manifest.Objects {
+		byObj, ok := files[o.File]
+		if !ok {
+			return errors.Errorf("invalid backup: file %s for %s %s/%s is missing", o.File, o.Kind, o.Namespace, o.Name)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(byObj); err != nil {
+			return errors.Wrapf(err, "invalid backup: failed to read %s", o.File)
+		}
+		if err := graph.addRestoredObj(obj); err != nil {
+			return err
+		}
+	}
+
+	// Completes rebuilding the graph by searching for soft ownership relations and tenants.
+	graph.setSoftOwnership()
+	graph.setTenants()
+	graph.checkVirtualNode()
+
+	// Clusters and ClusterClasses are not paused when taking a backup, so they are restored paused to prevent
+	// controllers from acting on them while the owner references are re-created; at the end of the restore,
+	// only the ones which were not paused in the backup are resumed.
+	clustersToResume := []*node{}
+	for _, n := range graph.getClusters() {
+		paused, _, err := unstructured.NestedBool(n.restoreObject.Object, "spec", "paused")
+		if err != nil {
+			return errors.Wrapf(err, "invalid backup: failed to read spec.paused for Cluster %s/%s", n.identity.Namespace, n.identity.Name)
+		}
+		if !paused {
+			clustersToResume = append(clustersToResume, n)
+		}
+		if err := unstructured.SetNestedField(n.restoreObject.Object, true, "spec", "paused"); err != nil {
+			return err
+		}
+	}
+	clusterClassesToResume := []*node{}
+	for _, n := range graph.getClusterClasses() {
+		annotations := n.restoreObject.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		if _, paused := annotations[clusterv1.PausedAnnotation]; !paused {
+			clusterClassesToResume = append(clusterClassesToResume, n)
+		}
+		annotations[clusterv1.PausedAnnotation] = ""
+		n.restoreObject.SetAnnotations(annotations)
+	}
+
+	mover := &objectMover{
+		fromProxy:             b.proxy,
+		fromProviderInventory: b.providerInventory,
+		restoreStatus:         true,
+	}
+
+	log.V(1).Info("Creating target namespaces, if missing")
+	if err := mover.ensureNamespaces(ctx, graph, b.proxy); err != nil {
+		return err
+	}
+
+	// Create all objects group by group, ensuring all the ownerReferences are re-created.
+	log.Info("Restoring objects into the management cluster")
+	moveSequence := getMoveSequence(graph)
+	for groupIndex := range len(moveSequence.groups) {
+		if err := mover.restoreGroup(ctx, moveSequence.getGroup(groupIndex), b.proxy); err != nil {
+			return err
+		}
+	}
+
+	log.V(1).Info("Resuming the restored ClusterClasses")
+	if err := setClusterClassPause(ctx, b.proxy, clusterClassesToResume, false, false); err != nil {
+		return errors.Wrap(err, "error resuming ClusterClasses")
+	}
+
+	log.V(1).Info("Resuming the restored Clusters")
+	return setClusterPause(ctx, b.proxy, clustersToResume, false, false)
+}
+
+// checkCompatibility checks that the Cluster API contract and the providers installed in the management cluster
+// are compatible with the ones recorded in the backup manifest.
+func (b *objectBackup) checkCompatibility(ctx context.Context, manifest *BackupManifest) error {
+	if manifest.Version != BackupFormatVersion {
+		return errors.Errorf("unsupported backup format version %q, only %q is supported", manifest.Version, BackupFormatVersion)
+	}
+
+	if !b.getCompatibleContractVersions(b.currentContractVersion).Has(manifest.Contract) {
+		return errors.Errorf("the backup has been taken from a management cluster using the %s Cluster API contract, which is not compatible with the current contract %s", manifest.Contract, b.currentContractVersion)
+	}
+
+	providers, err := b.providerInventory.List(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get provider list")
+	}
+
+	if err := checkProvidersCompatibility(manifest.Providers, providers.Items, "backup"); err != nil {
+		return errors.Wrap(err, "providers installed in the management cluster are not compatible with the backup")
+	}
+	return nil
+}
+
+func writeBackupFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to write %s to the backup archive", name)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %s to the backup archive", name)
+	}
+	return nil
+}
+
+func readBackupArchive(r io.Reader) (*BackupManifest, map[string][]byte, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid backup: failed to read archive")
+	}
+	defer gzr.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "invalid backup: failed to read archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid backup: failed to read %s", header.Name)
+		}
+		files[header.Name] = data
+	}
+
+	byManifest, ok := files[backupManifestFile]
+	if !ok {
+		return nil, nil, errors.Errorf("invalid backup: %s is missing", backupManifestFile)
+	}
+	manifest := &BackupManifest{}
+	if err := yaml.Unmarshal(byManifest, manifest); err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid backup: failed to read %s", backupManifestFile)
+	}
+	return manifest, files, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_objectBackup_CreateAndRestore(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx := context.Background()
+
+	objs := []client.Object{}
+	objs = append(objs, test.NewFakeCluster("ns1", "foo").Objs()...)
+	objs = append(objs, test.NewFakeCluster("ns2", "bar").Objs()...)
+	for _, o := range objs {
+		if c, ok := o.(*clusterv1.Cluster); ok {
+			c.Status.Phase = string(clusterv1.ClusterPhaseProvisioned)
+			if c.Name == "bar" {
+				c.Spec.Paused = true
+			}
+		}
+	}
+
+	fromProxy := getFakeProxyWithCRDs().WithObjs(objs...)
+	fromProxy.WithProviderInventory("infra1", clusterctlv1.InfrastructureProviderType, "v1.2.3", "infra1-system")
+	fromBackup := newObjectBackup(fromProxy, newInventoryClient(fromProxy, fakePollImmediateWaiter, currentContractVersion), currentContractVersion, getCompatibleContractVersions)
+
+	archive := &bytes.Buffer{}
+	g.Expect(fromBackup.Create(ctx, "", archive)).To(Succeed())
+
+	// Check the manifest describes the content of the archive.
+	manifest, files, err := readBackupArchive(bytes.NewReader(archive.Bytes()))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(manifest.Version).To(Equal(BackupFormatVersion))
+	g.Expect(manifest.Contract).To(Equal(currentContractVersion))
+	g.Expect(manifest.Providers).To(HaveLen(1))
+	g.Expect(manifest.Providers[0].ProviderName).To(Equal("infra1"))
+	g.Expect(manifest.Objects).To(HaveLen(8))
+	for _, o := range manifest.Objects {
+		g.Expect(files).To(HaveKey(o.File))
+	}
+	g.Expect(manifest.Objects[0].Kind).To(Equal("Cluster"))
+
+	// Check Clusters in the source management cluster are not paused by the backup.
+	cFrom, err := fromProxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	fromCluster := &clusterv1.Cluster{}
+	g.Expect(cFrom.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, fromCluster)).To(Succeed())
+	g.Expect(fromCluster.Spec.Paused).To(BeFalse())
+
+	toProxy := getFakeProxyWithCRDs()
+	toProxy.WithProviderInventory("infra1", clusterctlv1.InfrastructureProviderType, "v1.2.4", "infra1-system")
+	toBackup := newObjectBackup(toProxy, newInventoryClient(toProxy, fakePollImmediateWaiter, currentContractVersion), currentContractVersion, getCompatibleContractVersions)
+
+	g.Expect(toBackup.Restore(ctx, bytes.NewReader(archive.Bytes()))).To(Succeed())
+
+	cTo, err := toProxy.NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+	for _, o := range manifest.Objects {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(o.APIVersion)
+		obj.SetKind(o.Kind)
+		g.Expect(cTo.Get(ctx, client.ObjectKey{Namespace: o.Namespace, Name: o.Name}, obj)).To(Succeed())
+	}
+
+	// Check status is restored and Clusters are resumed only if they were not paused in the backup.
+	toCluster := &clusterv1.Cluster{}
+	g.Expect(cTo.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "foo"}, toCluster)).To(Succeed())
+	g.Expect(toCluster.Status.Phase).To(Equal(string(clusterv1.ClusterPhaseProvisioned)))
+	g.Expect(toCluster.Spec.Paused).To(BeFalse())
+
+	g.Expect(cTo.Get(ctx, client.ObjectKey{Namespace: "ns2", Name: "bar"}, toCluster)).To(Succeed())
+	g.Expect(toCluster.Spec.Paused).To(BeTrue())
+}
+
+func Test_objectBackup_checkCompatibility(t *testing.T) {
+	tests := []struct {
+		name              string
+		manifest          *BackupManifest
+		targetVersions    []string
+		wantErrorContains string
+	}{
+		{
+			name: "pass when providers in the management cluster are the same or newer",
+			manifest: &BackupManifest{
+				Version:  BackupFormatVersion,
+				Contract: currentContractVersion,
+				Providers: []clusterctlv1.Provider{
+					newBackupTestProvider("v1.2.3"),
+				},
+			},
+			targetVersions: []string{"v1.3.0"},
+		},
+		{
+			name: "fails for unknown format version",
+			manifest: &BackupManifest{
+				Version:  "v0",
+				Contract: currentContractVersion,
+			},
+			wantErrorContains: "unsupported backup format version",
+		},
+		{
+			name: "fails for incompatible contract",
+			manifest: &BackupManifest{
+				Version:  BackupFormatVersion,
+				Contract: "v1alpha3",
+			},
+			wantErrorContains: "not compatible with the current contract",
+		},
+		{
+			name: "fails when a provider is missing",
+			manifest: &BackupManifest{
+				Version:  BackupFormatVersion,
+				Contract: currentContractVersion,
+				Providers: []clusterctlv1.Provider{
+					newBackupTestProvider("v1.2.3"),
+				},
+			},
+			wantErrorContains: "provider infrastructure-infra1 not found in the target cluster",
+		},
+		{
+			name: "fails when a provider is older",
+			manifest: &BackupManifest{
+				Version:  BackupFormatVersion,
+				Contract: currentContractVersion,
+				Providers: []clusterctlv1.Provider{
+					newBackupTestProvider("v1.2.3"),
+				},
+			},
+			targetVersions:    []string{"v1.2.0"},
+			wantErrorContains: "older than in the backup",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			proxy := test.NewFakeProxy()
+			for _, v := range tt.targetVersions {
+				proxy.WithProviderInventory("infra1", clusterctlv1.InfrastructureProviderType, v, "infra1-system")
+			}
+			b := newObjectBackup(proxy, newInventoryClient(proxy, fakePollImmediateWaiter, currentContractVersion), currentContractVersion, getCompatibleContractVersions)
+
+			err := b.checkCompatibility(context.Background(), tt.manifest)
+			if tt.wantErrorContains != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErrorContains))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func newBackupTestProvider(version string) clusterctlv1.Provider {
+	return clusterctlv1.Provider{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "infra1-system",
+			Name:      clusterctlv1.ManifestLabel("infra1", clusterctlv1.InfrastructureProviderType),
+		},
+		ProviderName: "infra1",
+		Type:         string(clusterctlv1.InfrastructureProviderType),
+		Version:      version,
+	}
+}
@@ -73,12 +73,19 @@ type Client interface {
 	// from one management cluster to another management cluster.
 	ObjectMover() ObjectMover
 
+	// ObjectBackup returns an ObjectBackup that implements support for backing up and restoring Cluster API objects
+	// (e.g. clusters, AWS clusters, machines, etc.) of a management cluster.
+	ObjectBackup() ObjectBackup
+
 	// ProviderUpgrader returns a ProviderUpgrader that supports upgrading Cluster API providers.
 	ProviderUpgrader() ProviderUpgrader
 
 	// Template has methods to work with templates stored in the cluster.
 	Template() TemplateClient
 
+	// Topology has methods to work with ClusterClasses and managed topologies.
+	Topology() TopologyClient
+
 	// WorkloadCluster has methods for fetching kubeconfig of workload cluster from management cluster.
 	WorkloadCluster() WorkloadCluster
 }
@@ -132,6 +139,10 @@ func (c *clusterClient) ObjectMover() ObjectMover {
 	return newObjectMover(c.proxy, c.ProviderInventory())
 }
 
+func (c *clusterClient) ObjectBackup() ObjectBackup {
+	return newObjectBackup(c.proxy, c.ProviderInventory(), c.currentContractVersion, c.getCompatibleContractVersions)
+}
+
 func (c *clusterClient) ProviderUpgrader() ProviderUpgrader {
 	return newProviderUpgrader(c.configClient, c.proxy, c.repositoryClientFactory, c.ProviderInventory(), c.ProviderComponents(), c.currentContractVersion, c.getCompatibleContractVersions)
 }
@@ -140,6 +151,10 @@ func (c *clusterClient) Template() TemplateClient {
 	return newTemplateClient(TemplateClientInput{c.proxy, c.configClient, c.processor})
 }
 
+func (c *clusterClient) Topology() TopologyClient {
+	return newTopologyClient(c.proxy)
+}
+
 func (c *clusterClient) WorkloadCluster() WorkloadCluster {
 	return newWorkloadCluster(c.proxy)
 }
@@ -314,6 +314,9 @@ func (i *providerInstaller) getProviderContract(ctx context.Context, providerIns
 	// quickly pinpoint the root cause.
 	latestMetadata, err := providerRepository.Metadata(provider.Version).Get(ctx)
 	if err != nil {
+		if rejectedErr := repository.ProviderRejectedError(err); rejectedErr != nil {
+			return "", rejectedErr
+		}
 		return "", errors.Wrapf(err,
 			"failed to fetch metadata for provider %q (version %s). "+
 				"Check that the release tag exists and the repository URL is correct — the project may have moved or the tag may be missing metadata.yaml",
@@ -65,6 +65,9 @@ type objectMover struct {
 	fromProxy             Proxy
 	fromProviderInventory InventoryClient
 	dryRun                bool
+
+	// restoreStatus is set to true when objects restored from a backup should get their status restored as well.
+	restoreStatus bool
 }
 
 // ensure objectMover implements the ObjectMover interface.
@@ -1123,11 +1126,22 @@ func (o *objectMover) restoreTargetObject(ctx context.Context, nodeToCreate *nod
 	// Rebuild the owner reference chain
 	o.buildOwnerChain(obj, nodeToCreate)
 
+	// Keep track of the status, given that it is dropped by the API server on create.
+	status, hasStatus, err := unstructured.NestedFieldCopy(obj.Object, "status")
+	if err != nil {
+		return errors.Wrapf(err, "error reading status of %q %s/%s",
+			obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+	}
+
 	if err := cTo.Create(ctx, obj); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
 			return errors.Wrapf(err, "error creating %q %s/%s",
 				obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
 		}
+	} else if o.restoreStatus && hasStatus {
+		if err := restoreTargetObjectStatus(ctx, cTo, obj, status); err != nil {
+			return err
+		}
 	}
 
 	// Stores the newUID assigned to the newly created object.
@@ -1136,6 +1150,21 @@ func (o *objectMover) restoreTargetObject(ctx context.Context, nodeToCreate *nod
 	return nil
 }
 
+// restoreTargetObjectStatus restores the status of an object just created in the target management cluster.
+// NOTE: Objects without a status subresource get their status persisted on create, so NotFound errors are ignored.
+func restoreTargetObjectStatus(ctx context.Context, cTo client.Client, obj *unstructured.Unstructured, status interface{}) error {
+	if err := unstructured.SetNestedField(obj.Object, status, "status"); err != nil {
+		return errors.Wrapf(err, "error setting status of %q %s/%s",
+			obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+	}
+
+	if err := cTo.Status().Update(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "error restoring status of %q %s/%s",
+			obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+	}
+	return nil
+}
+
 // Recreate all the OwnerReferences using the newUID of the owner nodes.
 func (o *objectMover) buildOwnerChain(obj *unstructured.Unstructured, n *node) {
 	if len(n.owners) > 0 {
@@ -1261,17 +1290,23 @@ func (o *objectMover) checkTargetProviders(ctx context.Context, toInventory Inve
 		return errors.Wrapf(err, "failed to get provider list from the target cluster")
 	}
 
-	// Checks all the providers installed in the source cluster
+	return checkProvidersCompatibility(fromProviders.Items, toProviders.Items, "source cluster")
+}
+
+// checkProvidersCompatibility checks that all the providers in fromProviders exists in toProviders as well (with a version >= of the from version).
+// source is used to describe where fromProviders have been read from in error messages.
+func checkProvidersCompatibility(fromProviders, toProviders []clusterctlv1.Provider, source string) error {
+	// Checks all the providers installed in the source.
 	errList := []error{}
-	for _, sourceProvider := range fromProviders.Items {
+	for _, sourceProvider := range fromProviders {
 		sourceVersion, err := version.ParseSemantic(sourceProvider.Version)
 		if err != nil {
-			return errors.Wrapf(err, "unable to parse version %q for the %s provider in the source cluster", sourceProvider.Version, sourceProvider.InstanceName())
+			return errors.Wrapf(err, "unable to parse version %q for the %s provider in the %s", sourceProvider.Version, sourceProvider.InstanceName(), source)
 		}
 
 		// Check corresponding providers in the target cluster and gets the latest version installed.
 		var maxTargetVersion *version.Version
-		for _, targetProvider := range toProviders.Items {
+		for _, targetProvider := range toProviders {
 			// Skips other providers.
 			if !sourceProvider.SameAs(targetProvider) {
 				continue
@@ -1291,7 +1326,7 @@ func (o *objectMover) checkTargetProviders(ctx context.Context, toInventory Inve
 		}
 
 		if !maxTargetVersion.AtLeast(sourceVersion) {
-			errList = append(errList, errors.Errorf("provider %s in the target cluster is older than in the source cluster (source: %s, target: %s)", sourceProvider.Name, sourceVersion.String(), maxTargetVersion.String()))
+			errList = append(errList, errors.Errorf("provider %s in the target cluster is older than in the %s (source: %s, target: %s)", sourceProvider.Name, source, sourceVersion.String(), maxTargetVersion.String()))
 		}
 	}
 
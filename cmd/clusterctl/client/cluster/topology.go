@@ -0,0 +1,618 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/controllers/remote"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/internal/controllers/clusterclass"
+	topologycluster "sigs.k8s.io/cluster-api/internal/controllers/topology/cluster"
+	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/structuredmerge"
+	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// TopologyClient has methods to work with ClusterClasses and managed topologies.
+type TopologyClient interface {
+	// Plan computes the changes the topology controller would apply to the Clusters affected by the
+	// input objects, e.g. new or modified ClusterClasses, templates or Clusters, without applying any change.
+	Plan(ctx context.Context, in *TopologyPlanInput) (*TopologyPlanOutput, error)
+}
+
+// TopologyPlanInput defines the input for the Plan function.
+type TopologyPlanInput struct {
+	// Objs is the list of new or modified objects, e.g. ClusterClasses, templates or Clusters.
+	Objs []*unstructured.Unstructured
+
+	// TargetClusterName restricts the plan to the Cluster with this name.
+	TargetClusterName string
+
+	// TargetNamespace restricts the plan to Clusters in this namespace; it is also used
+	// as a namespace for input objects without a namespace.
+	TargetNamespace string
+}
+
+// TopologyPlanOutput defines the output of the Plan function.
+type TopologyPlanOutput struct {
+	// Clusters is the list of Clusters affected by the input objects, with the changes planned for each of them.
+	Clusters []*ClusterTopologyPlan
+}
+
+// ClusterTopologyPlan defines the changes the topology controller would apply to a Cluster.
+type ClusterTopologyPlan struct {
+	// Cluster is the Cluster affected by the input objects.
+	Cluster client.ObjectKey
+
+	// Created is the list of objects which would be created.
+	Created []*unstructured.Unstructured
+
+	// Modified is the list of objects which would be modified.
+	// NOTE: When the spec of a template changes, the topology controller creates a new template and
+	// deletes the old one; in this case the change is reported as a modification of the existing template.
+	Modified []*TopologyPlanModifiedObject
+
+	// Deleted is the list of objects which would be deleted.
+	Deleted []*unstructured.Unstructured
+
+	// ControlPlaneRollout is true if the control plane would roll out machines.
+	ControlPlaneRollout bool
+
+	// MachineDeploymentRollouts is the list of names of the MachineDeployment topologies which would roll out machines.
+	MachineDeploymentRollouts []string
+}
+
+// TopologyPlanModifiedObject defines an object which would be modified.
+type TopologyPlanModifiedObject struct {
+	// Object is the current object.
+	Object *unstructured.Unstructured
+
+	// Diff is the change which would be applied to the object, in the JSON merge patch format.
+	Diff []byte
+}
+
+// topologyClient implements TopologyClient.
+type topologyClient struct {
+	proxy Proxy
+}
+
+// ensure topologyClient implements TopologyClient.
+var _ TopologyClient = &topologyClient{}
+
+// newTopologyClient returns a topologyClient.
+func newTopologyClient(proxy Proxy) *topologyClient {
+	return &topologyClient{
+		proxy: proxy,
+	}
+}
+
+func (t *topologyClient) Plan(ctx context.Context, in *TopologyPlanInput) (*TopologyPlanOutput, error) {
+	log := logf.Log
+
+	if len(in.Objs) == 0 {
+		return nil, errors.New("at least one input object is required")
+	}
+	objs, err := prepareTopologyPlanInputObjects(in)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := t.proxy.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// All the reads go through a dry run client, which returns the input objects in place of
+	// the corresponding objects in the management cluster.
+	dryRunClient := newTopologyPlanDryRunClient(c, objs)
+
+	clusters, err := topologyPlanAffectedClusters(ctx, dryRunClient, objs, in)
+	if err != nil {
+		return nil, err
+	}
+	out := &TopologyPlanOutput{}
+	if len(clusters) == 0 {
+		log.Info("No Clusters with a managed topology are affected by the input objects")
+		return out, nil
+	}
+
+	runtimeClient, err := topologyPlanRuntimeClient(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterClassReconciler := &clusterclass.Reconciler{
+		Client:        dryRunClient,
+		RuntimeClient: runtimeClient,
+	}
+	topologyReconciler := &topologycluster.Reconciler{
+		Client:        dryRunClient,
+		APIReader:     dryRunClient,
+		ClusterCache:  &topologyPlanClusterCache{client: c},
+		RuntimeClient: runtimeClient,
+	}
+	ssaCache := ssa.NewCache("clusterctl-topology-plan")
+
+	clusterClasses := map[client.ObjectKey]*clusterv1.ClusterClass{}
+	for _, cluster := range clusters {
+		log.V(1).Info("Planning changes", "Cluster", klog.KObj(cluster))
+
+		classKey := cluster.GetClassKey()
+		clusterClass, ok := clusterClasses[classKey]
+		if !ok {
+			clusterClass = &clusterv1.ClusterClass{}
+			if err := dryRunClient.Get(ctx, classKey, clusterClass); err != nil {
+				return nil, errors.Wrapf(err, "failed to get ClusterClass %s for Cluster %s", classKey, klog.KObj(cluster))
+			}
+			// Variables in the ClusterClass status must be computed, given that the ClusterClass could be
+			// new or modified and thus not yet reconciled by the ClusterClass controller.
+			if err := clusterClassReconciler.ReconcileVariables(ctx, clusterClass); err != nil {
+				return nil, errors.Wrapf(err, "failed to compute variables for ClusterClass %s", classKey)
+			}
+			clusterClasses[classKey] = clusterClass
+		}
+
+		s, err := topologyReconciler.ComputeDesiredState(ctx, cluster, clusterClass)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute the desired state for Cluster %s", klog.KObj(cluster))
+		}
+
+		plan, err := planClusterTopologyChanges(ctx, c, ssaCache, s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute changes for Cluster %s", klog.KObj(cluster))
+		}
+		out.Clusters = append(out.Clusters, plan)
+	}
+	return out, nil
+}
+
+// prepareTopologyPlanInputObjects validates the input objects and sets a namespace for objects without one.
+func prepareTopologyPlanInputObjects(in *TopologyPlanInput) ([]*unstructured.Unstructured, error) {
+	namespace := in.TargetNamespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	objs := make([]*unstructured.Unstructured, 0, len(in.Objs))
+	for _, o := range in.Objs {
+		if o.GetKind() == "" || o.GetName() == "" {
+			return nil, errors.New("input objects must have kind and name set")
+		}
+		gvk := o.GroupVersionKind()
+		if gvk.Group == clusterv1.GroupVersion.Group && gvk.Version != clusterv1.GroupVersion.Version {
+			return nil, errors.Errorf("%s %s must use the %s API version", o.GetKind(), o.GetName(), clusterv1.GroupVersion.String())
+		}
+
+		obj := o.DeepCopy()
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		if in.TargetNamespace != "" && obj.GetNamespace() != in.TargetNamespace {
+			return nil, errors.Errorf("%s %s must be in the %s namespace", obj.GetKind(), klog.KObj(obj), in.TargetNamespace)
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// topologyPlanAffectedClusters returns the Clusters with a managed topology affected by the input objects, i.e.
+// Clusters in the input, Clusters using a ClusterClass in the input, or Clusters using a ClusterClass
+// referencing a template in the input.
+func topologyPlanAffectedClusters(ctx context.Context, c client.Client, objs []*unstructured.Unstructured, in *TopologyPlanInput) ([]*clusterv1.Cluster, error) {
+	inputObjs := sets.Set[string]{}
+	for _, o := range objs {
+		inputObjs.Insert(topologyPlanObjectKey(o.GroupVersionKind().GroupKind(), o.GetNamespace(), o.GetName()))
+	}
+
+	clusterClassList := &clusterv1.ClusterClassList{}
+	if err := c.List(ctx, clusterClassList); err != nil {
+		return nil, errors.Wrap(err, "failed to list ClusterClasses")
+	}
+	affectedClusterClasses := sets.Set[client.ObjectKey]{}
+	for i := range clusterClassList.Items {
+		clusterClass := &clusterClassList.Items[i]
+		if inputObjs.Has(topologyPlanObjectKey(clusterv1.GroupVersion.WithKind("ClusterClass").GroupKind(), clusterClass.Namespace, clusterClass.Name)) {
+			affectedClusterClasses.Insert(client.ObjectKeyFromObject(clusterClass))
+			continue
+		}
+		for _, ref := range clusterClassTemplateReferences(clusterClass) {
+			if inputObjs.Has(topologyPlanObjectKey(ref.GroupVersionKind().GroupKind(), ref.Namespace, ref.Name)) {
+				affectedClusterClasses.Insert(client.ObjectKeyFromObject(clusterClass))
+				break
+			}
+		}
+	}
+
+	clusterList := &clusterv1.ClusterList{}
+	if err := c.List(ctx, clusterList, client.InNamespace(in.TargetNamespace)); err != nil {
+		return nil, errors.Wrap(err, "failed to list Clusters")
+	}
+	clusters := []*clusterv1.Cluster{}
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		if cluster.Spec.Topology == nil {
+			continue
+		}
+		if in.TargetClusterName != "" && cluster.Name != in.TargetClusterName {
+			continue
+		}
+		if inputObjs.Has(topologyPlanObjectKey(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), cluster.Namespace, cluster.Name)) ||
+			affectedClusterClasses.Has(cluster.GetClassKey()) {
+			clusters = append(clusters, cluster)
+		}
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Namespace != clusters[j].Namespace {
+			return clusters[i].Namespace < clusters[j].Namespace
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+	return clusters, nil
+}
+
+// clusterClassTemplateReferences returns the references to all the templates used by a ClusterClass.
+func clusterClassTemplateReferences(clusterClass *clusterv1.ClusterClass) []*corev1.ObjectReference {
+	refs := []*corev1.ObjectReference{
+		clusterClass.Spec.Infrastructure.Ref.ToObjectReference(clusterClass.Namespace),
+		clusterClass.Spec.ControlPlane.Ref.ToObjectReference(clusterClass.Namespace),
+	}
+	if clusterClass.Spec.ControlPlane.MachineInfrastructure != nil {
+		refs = append(refs, clusterClass.Spec.ControlPlane.MachineInfrastructure.Ref.ToObjectReference(clusterClass.Namespace))
+	}
+	for _, mdClass := range clusterClass.Spec.Workers.MachineDeployments {
+		refs = append(refs,
+			mdClass.Template.Infrastructure.Ref.ToObjectReference(clusterClass.Namespace),
+			mdClass.Template.Bootstrap.Ref.ToObjectReference(clusterClass.Namespace),
+		)
+	}
+	for _, mpClass := range clusterClass.Spec.Workers.MachinePools {
+		refs = append(refs,
+			mpClass.Template.Infrastructure.Ref.ToObjectReference(clusterClass.Namespace),
+			mpClass.Template.Bootstrap.Ref.ToObjectReference(clusterClass.Namespace),
+		)
+	}
+
+	nonNilRefs := make([]*corev1.ObjectReference, 0, len(refs))
+	for _, ref := range refs {
+		if ref != nil {
+			nonNilRefs = append(nonNilRefs, ref)
+		}
+	}
+	return nonNilRefs
+}
+
+// topologyPlanObjectKey returns the key used to match the input objects with the objects in the cluster.
+// NOTE: The version is ignored, because references in ClusterClasses could use a different version of the
+// same object, e.g. after the provider served a new version.
+func topologyPlanObjectKey(gk schema.GroupKind, namespace, name string) string {
+	return fmt.Sprintf("%s %s/%s", gk.String(), namespace, name)
+}
+
+// topologyPlanRuntimeClient returns a client for calling the Runtime Extensions registered in the management cluster,
+// if any; this allows to compute the desired state of Clusters using ClusterClasses with external patches.
+// NOTE: Runtime Extensions must be reachable from the machine where clusterctl runs.
+// NOTE: External patches are called by the code computing the desired state whenever the runtime client is set,
+// without enabling the RuntimeSDK feature gate.
+func topologyPlanRuntimeClient(ctx context.Context, c client.Client) (runtimeclient.Client, error) {
+	extensionConfigList := &runtimev1.ExtensionConfigList{}
+	if err := c.List(ctx, extensionConfigList); err != nil {
+		// The ExtensionConfig CRD is not installed if the RuntimeSDK feature is not enabled in the management cluster.
+		if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to list ExtensionConfigs")
+	}
+	if len(extensionConfigList.Items) == 0 {
+		return nil, nil
+	}
+
+	registry := runtimeregistry.New()
+	if err := registry.WarmUp(extensionConfigList); err != nil {
+		return nil, errors.Wrap(err, "failed to register Runtime Extensions")
+	}
+	catalog := runtimecatalog.New()
+	if err := runtimehooksv1.AddToCatalog(catalog); err != nil {
+		return nil, errors.Wrap(err, "failed to create the Runtime SDK catalog")
+	}
+	return &topologyPlanExtensionsClient{
+		Client: internalruntimeclient.New(internalruntimeclient.Options{
+			Catalog:  catalog,
+			Registry: registry,
+			Client:   c,
+		}),
+	}, nil
+}
+
+// topologyPlanExtensionsClient is a runtime client only calling Runtime Extensions used for computing the
+// desired state, i.e. external patches; lifecycle hooks are not called while planning, and they are
+// considered as non-blocking.
+type topologyPlanExtensionsClient struct {
+	runtimeclient.Client
+}
+
+func (c *topologyPlanExtensionsClient) CallAllExtensions(_ context.Context, _ runtimecatalog.Hook, _ metav1.Object, _ runtimehooksv1.RequestObject, _ runtimehooksv1.ResponseObject) error {
+	return nil
+}
+
+// topologyPlanClusterCache provides access to workload clusters while planning, which is required
+// for checking if MachinePools are upgrading.
+type topologyPlanClusterCache struct {
+	clustercache.ClusterCache
+	client client.Client
+}
+
+func (c *topologyPlanClusterCache) GetClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error) {
+	return remote.NewClusterClient(ctx, "clusterctl", c.client, cluster)
+}
+
+// planClusterTopologyChanges compares the current and the desired state of a Cluster topology, and
+// returns the changes the topology controller would apply.
+func planClusterTopologyChanges(ctx context.Context, c client.Client, ssaCache ssa.Cache, s *scope.Scope) (*ClusterTopologyPlan, error) {
+	p := &topologyPlanner{
+		client:   c,
+		ssaCache: ssaCache,
+		plan: &ClusterTopologyPlan{
+			Cluster: client.ObjectKeyFromObject(s.Current.Cluster),
+		},
+	}
+
+	// The current Cluster could be an input object, so it is read from the management cluster.
+	var currentCluster client.Object
+	liveCluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, p.plan.Cluster, liveCluster); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to get Cluster %s", klog.KRef(p.plan.Cluster.Namespace, p.plan.Cluster.Name))
+		}
+	} else {
+		currentCluster = liveCluster
+	}
+	if _, err := p.diff(ctx, currentCluster, s.Desired.Cluster); err != nil {
+		return nil, err
+	}
+
+	ignorePaths, err := contract.InfrastructureCluster().IgnorePaths(s.Desired.InfrastructureCluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to calculate ignore paths")
+	}
+	if _, err := p.diff(ctx, s.Current.InfrastructureCluster, s.Desired.InfrastructureCluster, structuredmerge.IgnorePaths(ignorePaths)); err != nil {
+		return nil, err
+	}
+
+	// Control plane.
+	if err := p.diffMachineHealthCheck(ctx, s.Current.ControlPlane.MachineHealthCheck, s.Desired.ControlPlane.MachineHealthCheck); err != nil {
+		return nil, err
+	}
+	infrastructureMachineTemplateChanged, err := p.diff(ctx, s.Current.ControlPlane.InfrastructureMachineTemplate, s.Desired.ControlPlane.InfrastructureMachineTemplate)
+	if err != nil {
+		return nil, err
+	}
+	controlPlaneChanges, err := p.diff(ctx, s.Current.ControlPlane.Object, s.Desired.ControlPlane.Object)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: Changes to the replicas of the control plane do not roll out machines; whether or not other changes
+	// to the spec are actually rolling out machines depends on the control plane provider.
+	delete(controlPlaneChanges, "replicas")
+	p.plan.ControlPlaneRollout = infrastructureMachineTemplateChanged != nil || len(controlPlaneChanges) > 0
+
+	// MachineDeployments.
+	for _, name := range sets.List(sets.KeySet(s.Desired.MachineDeployments)) {
+		desired := s.Desired.MachineDeployments[name]
+		current, ok := s.Current.MachineDeployments[name]
+		if !ok {
+			current = &scope.MachineDeploymentState{}
+		}
+
+		infrastructureMachineTemplateChanges, err := p.diff(ctx, current.InfrastructureMachineTemplate, desired.InfrastructureMachineTemplate)
+		if err != nil {
+			return nil, err
+		}
+		bootstrapTemplateChanges, err := p.diff(ctx, current.BootstrapTemplate, desired.BootstrapTemplate)
+		if err != nil {
+			return nil, err
+		}
+		machineDeploymentChanges, err := p.diff(ctx, current.Object, desired.Object)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.diffMachineHealthCheck(ctx, current.MachineHealthCheck, desired.MachineHealthCheck); err != nil {
+			return nil, err
+		}
+
+		// NOTE: Changes to the spec of the machine template roll out machines, while changes to labels and
+		// annotations are propagated in place.
+		_, machineTemplateSpecChanged := nestedMap(machineDeploymentChanges, "template")["spec"]
+		if infrastructureMachineTemplateChanges != nil || bootstrapTemplateChanges != nil || machineTemplateSpecChanged {
+			p.plan.MachineDeploymentRollouts = append(p.plan.MachineDeploymentRollouts, name)
+		}
+	}
+	for _, name := range sets.List(sets.KeySet(s.Current.MachineDeployments)) {
+		if _, ok := s.Desired.MachineDeployments[name]; ok {
+			continue
+		}
+		current := s.Current.MachineDeployments[name]
+		if err := p.deleted(current.Object, current.MachineHealthCheck); err != nil {
+			return nil, err
+		}
+	}
+
+	// MachinePools.
+	for _, name := range sets.List(sets.KeySet(s.Desired.MachinePools)) {
+		desired := s.Desired.MachinePools[name]
+		current, ok := s.Current.MachinePools[name]
+		if !ok {
+			current = &scope.MachinePoolState{}
+		}
+
+		if _, err := p.diff(ctx, current.InfrastructureMachinePoolObject, desired.InfrastructureMachinePoolObject); err != nil {
+			return nil, err
+		}
+		if _, err := p.diff(ctx, current.BootstrapObject, desired.BootstrapObject); err != nil {
+			return nil, err
+		}
+		if _, err := p.diff(ctx, current.Object, desired.Object); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range sets.List(sets.KeySet(s.Current.MachinePools)) {
+		if _, ok := s.Desired.MachinePools[name]; ok {
+			continue
+		}
+		if err := p.deleted(s.Current.MachinePools[name].Object); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.plan, nil
+}
+
+// topologyPlanner collects the changes planned for a Cluster topology.
+type topologyPlanner struct {
+	client   client.Client
+	ssaCache ssa.Cache
+	plan     *ClusterTopologyPlan
+}
+
+// diff computes the changes the topology controller would apply to the current object to reach the desired object
+// using a server side apply dry run, and adds them to the plan.
+// It returns the changes to the spec of an existing object, or nil if there are no changes to the spec.
+func (p *topologyPlanner) diff(ctx context.Context, current, desired client.Object, opts ...structuredmerge.HelperOption) (map[string]interface{}, error) {
+	if util.IsNil(desired) {
+		return nil, nil
+	}
+	if util.IsNil(current) {
+		current = nil
+	}
+
+	patchHelper, err := structuredmerge.NewServerSidePatchHelper(ctx, current, desired, p.client, p.ssaCache, opts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to compute changes for %s %s", desired.GetObjectKind().GroupVersionKind().Kind, klog.KObj(desired))
+	}
+
+	if current == nil {
+		obj, err := p.toUnstructured(desired)
+		if err != nil {
+			return nil, err
+		}
+		p.plan.Created = append(p.plan.Created, obj)
+		return nil, nil
+	}
+
+	if !patchHelper.HasChanges() {
+		return nil, nil
+	}
+	obj, err := p.toUnstructured(current)
+	if err != nil {
+		return nil, err
+	}
+	p.plan.Modified = append(p.plan.Modified, &TopologyPlanModifiedObject{
+		Object: obj,
+		Diff:   patchHelper.Changes(),
+	})
+
+	if !patchHelper.HasSpecChanges() {
+		return nil, nil
+	}
+	changes := map[string]interface{}{}
+	if err := json.Unmarshal(patchHelper.Changes(), &changes); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal changes for %s %s", desired.GetObjectKind().GroupVersionKind().Kind, klog.KObj(desired))
+	}
+	specChanges := nestedMap(changes, "spec")
+	if specChanges == nil {
+		specChanges = map[string]interface{}{}
+	}
+	return specChanges, nil
+}
+
+// diffMachineHealthCheck computes the changes to a MachineHealthCheck, which is deleted if not desired anymore.
+func (p *topologyPlanner) diffMachineHealthCheck(ctx context.Context, current, desired *clusterv1.MachineHealthCheck) error {
+	if desired == nil {
+		if current != nil {
+			return p.deleted(current)
+		}
+		return nil
+	}
+	_, err := p.diff(ctx, current, desired)
+	return err
+}
+
+// deleted adds objects which would be deleted to the plan.
+func (p *topologyPlanner) deleted(objs ...client.Object) error {
+	for _, o := range objs {
+		if util.IsNil(o) {
+			continue
+		}
+		obj, err := p.toUnstructured(o)
+		if err != nil {
+			return err
+		}
+		p.plan.Deleted = append(p.plan.Deleted, obj)
+	}
+	return nil
+}
+
+func (p *topologyPlanner) toUnstructured(obj client.Object) (*unstructured.Unstructured, error) {
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return u.DeepCopy(), nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, p.client.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	u := &unstructured.Unstructured{}
+	if err := p.client.Scheme().Convert(obj, u, nil); err != nil {
+		return nil, errors.Wrapf(err, "failed to convert %s %s to Unstructured", gvk.Kind, klog.KObj(obj))
+	}
+	u.SetGroupVersionKind(gvk)
+	return u, nil
+}
+
+// nestedMap returns the map at the given field of a map, or nil if it does not exist.
+func nestedMap(m map[string]interface{}, field string) map[string]interface{} {
+	value, ok := m[field].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return value
+}
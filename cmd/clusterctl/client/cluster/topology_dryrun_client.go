@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// topologyPlanDryRunClient is a client reading objects from a set of in-memory objects, falling back to
+// the management cluster for objects not in the set; all the write operations are ignored.
+// NOTE: Field selectors are not applied to in-memory objects.
+type topologyPlanDryRunClient struct {
+	client.Client
+
+	objs []*unstructured.Unstructured
+}
+
+// newTopologyPlanDryRunClient returns a topologyPlanDryRunClient.
+func newTopologyPlanDryRunClient(c client.Client, objs []*unstructured.Unstructured) *topologyPlanDryRunClient {
+	return &topologyPlanDryRunClient{
+		Client: c,
+		objs:   objs,
+	}
+}
+
+func (c *topologyPlanDryRunClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return err
+	}
+	for _, o := range c.objs {
+		if o.GroupVersionKind() == gvk && o.GetNamespace() == key.Namespace && o.GetName() == key.Name {
+			return c.convert(o, obj)
+		}
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func (c *topologyPlanDryRunClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+
+	listGVK, err := apiutil.GVKForObject(list, c.Scheme())
+	if err != nil {
+		return err
+	}
+	gvk := listGVK.GroupVersion().WithKind(strings.TrimSuffix(listGVK.Kind, "List"))
+	listOpts := (&client.ListOptions{}).ApplyOptions(opts)
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return errors.Wrapf(err, "failed to extract items from %s", listGVK.Kind)
+	}
+	for _, o := range c.objs {
+		if o.GroupVersionKind() != gvk {
+			continue
+		}
+		if listOpts.Namespace != "" && o.GetNamespace() != listOpts.Namespace {
+			continue
+		}
+		if listOpts.LabelSelector != nil && !listOpts.LabelSelector.Matches(labels.Set(o.GetLabels())) {
+			continue
+		}
+
+		var item client.Object
+		if _, ok := list.(*unstructured.UnstructuredList); ok {
+			item = &unstructured.Unstructured{}
+		} else {
+			newObj, err := c.Scheme().New(gvk)
+			if err != nil {
+				return err
+			}
+			item = newObj.(client.Object)
+		}
+		if err := c.convert(o, item); err != nil {
+			return err
+		}
+
+		// Replace the object read from the management cluster, if any, or append the object to the list.
+		replaced := false
+		for i := range items {
+			existing := items[i].(client.Object)
+			if existing.GetNamespace() == o.GetNamespace() && existing.GetName() == o.GetName() {
+				items[i] = item
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			items = append(items, item)
+		}
+	}
+	return meta.SetList(list, items)
+}
+
+func (c *topologyPlanDryRunClient) convert(in *unstructured.Unstructured, out runtime.Object) error {
+	if u, ok := out.(*unstructured.Unstructured); ok {
+		u.SetUnstructuredContent(in.DeepCopy().UnstructuredContent())
+		return nil
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(in.DeepCopy().UnstructuredContent(), out); err != nil {
+		return errors.Wrapf(err, "failed to convert %s %s/%s", in.GetKind(), in.GetNamespace(), in.GetName())
+	}
+	out.GetObjectKind().SetGroupVersionKind(in.GroupVersionKind())
+	return nil
+}
+
+func (c *topologyPlanDryRunClient) Create(_ context.Context, _ client.Object, _ ...client.CreateOption) error {
+	return nil
+}
+
+func (c *topologyPlanDryRunClient) Update(_ context.Context, _ client.Object, _ ...client.UpdateOption) error {
+	return nil
+}
+
+func (c *topologyPlanDryRunClient) Patch(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	return nil
+}
+
+func (c *topologyPlanDryRunClient) Delete(_ context.Context, _ client.Object, _ ...client.DeleteOption) error {
+	return nil
+}
+
+func (c *topologyPlanDryRunClient) DeleteAllOf(_ context.Context, _ client.Object, _ ...client.DeleteAllOfOption) error {
+	return nil
+}
+
+func (c *topologyPlanDryRunClient) Status() client.SubResourceWriter {
+	return &topologyPlanDryRunSubResourceWriter{}
+}
+
+// topologyPlanDryRunSubResourceWriter is a SubResourceWriter ignoring all the write operations.
+type topologyPlanDryRunSubResourceWriter struct{}
+
+func (w *topologyPlanDryRunSubResourceWriter) Create(_ context.Context, _, _ client.Object, _ ...client.SubResourceCreateOption) error {
+	return nil
+}
+
+func (w *topologyPlanDryRunSubResourceWriter) Update(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+	return nil
+}
+
+func (w *topologyPlanDryRunSubResourceWriter) Patch(_ context.Context, _ client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+	return nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+	"sigs.k8s.io/cluster-api/util/test/builder"
+)
+
+func Test_prepareTopologyPlanInputObjects(t *testing.T) {
+	tests := []struct {
+		name          string
+		in            *TopologyPlanInput
+		wantNamespace string
+		wantErr       bool
+	}{
+		{
+			name: "objects without namespace are moved to the default namespace",
+			in: &TopologyPlanInput{
+				Objs: []*unstructured.Unstructured{builder.InfrastructureClusterTemplate("", "infra").Build()},
+			},
+			wantNamespace: "default",
+		},
+		{
+			name: "objects without namespace are moved to the target namespace",
+			in: &TopologyPlanInput{
+				Objs:            []*unstructured.Unstructured{builder.InfrastructureClusterTemplate("", "infra").Build()},
+				TargetNamespace: "ns1",
+			},
+			wantNamespace: "ns1",
+		},
+		{
+			name: "fails for objects in a namespace different from the target namespace",
+			in: &TopologyPlanInput{
+				Objs:            []*unstructured.Unstructured{builder.InfrastructureClusterTemplate("ns2", "infra").Build()},
+				TargetNamespace: "ns1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails for Cluster API objects not using the current API version",
+			in: &TopologyPlanInput{
+				Objs: []*unstructured.Unstructured{func() *unstructured.Unstructured {
+					u := &unstructured.Unstructured{}
+					u.SetAPIVersion("cluster.x-k8s.io/v1beta1")
+					u.SetKind("ClusterClass")
+					u.SetName("class1")
+					return u
+				}()},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs, err := prepareTopologyPlanInputObjects(tt.in)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(objs).To(HaveLen(1))
+			g.Expect(objs[0].GetNamespace()).To(Equal(tt.wantNamespace))
+			// Input objects must not be modified.
+			g.Expect(tt.in.Objs[0].GetNamespace()).To(BeEmpty())
+		})
+	}
+}
+
+func Test_topologyPlanDryRunClient(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	liveCluster := builder.Cluster("ns1", "cluster1").WithLabels(map[string]string{"foo": "bar"}).Build()
+	otherCluster := builder.Cluster("ns1", "cluster2").WithLabels(map[string]string{"foo": "bar"}).Build()
+	c, err := test.NewFakeProxy().WithObjs(liveCluster, otherCluster).NewClient(ctx)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	inputCluster := toTopologyTestUnstructured(g, builder.Cluster("ns1", "cluster1").
+		WithLabels(map[string]string{"foo": "bar"}).
+		WithTopology(builder.ClusterTopology().WithClass("class1").WithVersion("v1.33.0").Build()).
+		Build())
+	newCluster := toTopologyTestUnstructured(g, builder.Cluster("ns1", "cluster3").Build())
+	template := builder.InfrastructureClusterTemplate("ns1", "infra").Build()
+
+	dryRunClient := newTopologyPlanDryRunClient(c, []*unstructured.Unstructured{inputCluster, newCluster, template})
+
+	// Get returns input objects in place of objects in the management cluster.
+	cluster := &clusterv1.Cluster{}
+	g.Expect(dryRunClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "cluster1"}, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Topology).ToNot(BeNil())
+	g.Expect(cluster.Spec.Topology.Version).To(Equal("v1.33.0"))
+
+	g.Expect(dryRunClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "cluster2"}, cluster)).To(Succeed())
+	g.Expect(cluster.Spec.Topology).To(BeNil())
+
+	gotTemplate := &unstructured.Unstructured{}
+	gotTemplate.SetGroupVersionKind(template.GroupVersionKind())
+	g.Expect(dryRunClient.Get(ctx, client.ObjectKey{Namespace: "ns1", Name: "infra"}, gotTemplate)).To(Succeed())
+	g.Expect(gotTemplate.Object).To(Equal(template.Object))
+
+	// List merges input objects with objects in the management cluster.
+	clusterList := &clusterv1.ClusterList{}
+	g.Expect(dryRunClient.List(ctx, clusterList, client.InNamespace("ns1"))).To(Succeed())
+	g.Expect(clusterList.Items).To(HaveLen(3))
+	g.Expect(clusterList.Items[0].Spec.Topology).ToNot(BeNil())
+
+	g.Expect(dryRunClient.List(ctx, clusterList, client.MatchingLabels{"foo": "bar"})).To(Succeed())
+	g.Expect(clusterList.Items).To(HaveLen(2))
+
+	g.Expect(dryRunClient.List(ctx, clusterList, client.InNamespace("ns2"))).To(Succeed())
+	g.Expect(clusterList.Items).To(BeEmpty())
+
+	// Writes are ignored.
+	g.Expect(dryRunClient.Delete(ctx, liveCluster)).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(liveCluster), &clusterv1.Cluster{})).To(Succeed())
+}
+
+func Test_topologyPlanAffectedClusters(t *testing.T) {
+	infrastructureClusterTemplate := builder.InfrastructureClusterTemplate("ns1", "infra").Build()
+	controlPlaneTemplate := builder.ControlPlaneTemplate("ns1", "cp").Build()
+	otherControlPlaneTemplate := builder.ControlPlaneTemplate("ns1", "other-cp").Build()
+
+	class1 := builder.ClusterClass("ns1", "class1").
+		WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
+		WithControlPlaneTemplate(controlPlaneTemplate).
+		Build()
+	class2 := builder.ClusterClass("ns1", "class2").
+		WithInfrastructureClusterTemplate(infrastructureClusterTemplate).
+		WithControlPlaneTemplate(otherControlPlaneTemplate).
+		Build()
+
+	cluster1 := builder.Cluster("ns1", "cluster1").
+		WithTopology(builder.ClusterTopology().WithClass("class1").WithVersion("v1.33.0").Build()).
+		Build()
+	cluster2 := builder.Cluster("ns1", "cluster2").
+		WithTopology(builder.ClusterTopology().WithClass("class2").WithVersion("v1.33.0").Build()).
+		Build()
+	clusterWithoutTopology := builder.Cluster("ns1", "cluster3").Build()
+
+	tests := []struct {
+		name         string
+		objs         []*unstructured.Unstructured
+		in           *TopologyPlanInput
+		wantClusters []string
+	}{
+		{
+			name:         "Clusters using a modified ClusterClass",
+			objs:         []*unstructured.Unstructured{toTopologyTestUnstructured(NewWithT(t), class1)},
+			wantClusters: []string{"cluster1"},
+		},
+		{
+			name:         "Clusters using ClusterClasses referencing a modified template",
+			objs:         []*unstructured.Unstructured{infrastructureClusterTemplate},
+			wantClusters: []string{"cluster1", "cluster2"},
+		},
+		{
+			name:         "Clusters using ClusterClasses referencing a modified template, restricted to a target Cluster",
+			objs:         []*unstructured.Unstructured{infrastructureClusterTemplate},
+			in:           &TopologyPlanInput{TargetClusterName: "cluster2"},
+			wantClusters: []string{"cluster2"},
+		},
+		{
+			name:         "modified Cluster",
+			objs:         []*unstructured.Unstructured{toTopologyTestUnstructured(NewWithT(t), cluster2)},
+			wantClusters: []string{"cluster2"},
+		},
+		{
+			name:         "Clusters using ClusterClasses referencing a modified template with a different version",
+			objs:         []*unstructured.Unstructured{withTopologyTestAPIVersion(controlPlaneTemplate, builder.ControlPlaneGroupVersion.Group+"/v1alpha1")},
+			wantClusters: []string{"cluster1"},
+		},
+		{
+			name:         "no Clusters using a template with the same kind and name in another group",
+			objs:         []*unstructured.Unstructured{withTopologyTestAPIVersion(controlPlaneTemplate, "other.example.com/v1beta1")},
+			wantClusters: []string{},
+		},
+		{
+			name:         "no Clusters using a modified template",
+			objs:         []*unstructured.Unstructured{builder.ControlPlaneTemplate("ns1", "unused").Build()},
+			wantClusters: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			c, err := test.NewFakeProxy().WithObjs(class1, class2, cluster1, cluster2, clusterWithoutTopology).NewClient(ctx)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			in := tt.in
+			if in == nil {
+				in = &TopologyPlanInput{}
+			}
+			clusters, err := topologyPlanAffectedClusters(ctx, newTopologyPlanDryRunClient(c, tt.objs), tt.objs, in)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			names := []string{}
+			for _, cluster := range clusters {
+				names = append(names, cluster.Name)
+			}
+			g.Expect(names).To(Equal(tt.wantClusters))
+		})
+	}
+}
+
+func toTopologyTestUnstructured(g *WithT, obj client.Object) *unstructured.Unstructured {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	g.Expect(err).ToNot(HaveOccurred())
+	u := &unstructured.Unstructured{Object: content}
+	u.SetAPIVersion(clusterv1.GroupVersion.String())
+	u.SetKind(obj.GetObjectKind().GroupVersionKind().Kind)
+	return u
+}
+
+func withTopologyTestAPIVersion(obj *unstructured.Unstructured, apiVersion string) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	obj.SetAPIVersion(apiVersion)
+	return obj
+}
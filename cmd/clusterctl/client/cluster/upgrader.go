@@ -355,6 +355,9 @@ func (u *providerUpgrader) getUpgradeComponents(ctx context.Context, provider Up
 	}
 	components, err := providerRepository.Components().Get(ctx, options)
 	if err != nil {
+		if rejectedErr := repository.ProviderRejectedError(err); rejectedErr != nil {
+			return nil, rejectedErr
+		}
 		return nil, err
 	}
 	return components, nil
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/version"
 
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
 )
 
 // upgradeInfo holds all the information required for taking upgrade decisions for a provider.
@@ -84,6 +85,9 @@ func (u *providerUpgrader) getUpgradeInfo(ctx context.Context, provider clusterc
 
 	latestMetadata, err := providerRepository.Metadata(versionTag(latestVersion)).Get(ctx)
 	if err != nil {
+		if rejectedErr := repository.ProviderRejectedError(err); rejectedErr != nil {
+			return nil, rejectedErr
+		}
 		return nil, err
 	}
 
@@ -55,6 +55,9 @@ func (c *clusterctlClient) getComponentsByName(ctx context.Context, provider str
 
 	components, err := repositoryClientFactory.Components().Get(ctx, options)
 	if err != nil {
+		if rejectedErr := repository.ProviderRejectedError(err); rejectedErr != nil {
+			return nil, rejectedErr
+		}
 		return nil, err
 	}
 	return components, nil
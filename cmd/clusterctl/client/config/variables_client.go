@@ -21,8 +21,37 @@ const (
 	GitHubTokenVariable = "github-token"
 	// GitLabAccessTokenVariable defines a variable hosting the GitLab access token. This can be used with Personal and Project access tokens.
 	GitLabAccessTokenVariable = "gitlab-access-token"
+
+	// providerVerificationVariableSuffix is the suffix of the variable defining the verification policy for a provider.
+	providerVerificationVariableSuffix = "-verification"
+
+	// providerVerificationPublicKeyVariableSuffix is the suffix of the variable defining the public key used
+	// to verify signatures of provider artifacts.
+	providerVerificationPublicKeyVariableSuffix = "-verification-public-key"
+
+	// providerVerificationChecksumsVariableSuffix is the suffix of the variable defining the path of a checksums file
+	// obtained out of band, used to verify checksums of provider artifacts.
+	providerVerificationChecksumsVariableSuffix = "-verification-checksums"
 )
 
+// ProviderVerificationVariable returns the name of the variable defining the verification policy for the provider
+// artifacts, e.g. infrastructure-aws-verification (or INFRASTRUCTURE_AWS_VERIFICATION as an environment variable).
+func ProviderVerificationVariable(providerLabel string) string {
+	return providerLabel + providerVerificationVariableSuffix
+}
+
+// ProviderVerificationPublicKeyVariable returns the name of the variable defining the public key used to verify
+// signatures of the provider artifacts, e.g. infrastructure-aws-verification-public-key.
+func ProviderVerificationPublicKeyVariable(providerLabel string) string {
+	return providerLabel + providerVerificationPublicKeyVariableSuffix
+}
+
+// ProviderVerificationChecksumsVariable returns the name of the variable defining the path of a checksums file obtained
+// out of band, used to verify checksums of the provider artifacts, e.g. infrastructure-aws-verification-checksums.
+func ProviderVerificationChecksumsVariable(providerLabel string) string {
+	return providerLabel + providerVerificationChecksumsVariableSuffix
+}
+
 // VariablesClient has methods to work with environment variables and with variables defined in the clusterctl configuration file.
 type VariablesClient interface {
 	// Get returns a variable value. If the variable is not defined an error is returned.
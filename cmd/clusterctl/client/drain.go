@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/scheme"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+)
+
+// DrainSimulationOutput defines the Pods which would be evicted, waited for or ignored when draining the Nodes
+// of a set of Machines, and the Nodes considered for rescheduling the Pods to evict.
+type DrainSimulationOutput struct {
+	// PodsToEvict are the Pods that would be evicted (or deleted) by the drain.
+	PodsToEvict []DrainSimulationPod
+
+	// PodsToWaitCompleted are the Pods that would not be evicted, but the drain would wait for their completion.
+	PodsToWaitCompleted []DrainSimulationPod
+
+	// PodsTerminating are the Pods that are already terminating.
+	PodsTerminating []DrainSimulationPod
+
+	// PodsIgnored are the Pods that would be ignored by the drain.
+	PodsIgnored []DrainSimulationPod
+
+	// RemainingNodes are the names of the Nodes considered for rescheduling the Pods to evict.
+	RemainingNodes []string
+}
+
+// DrainSimulationPod defines a Pod running on a Node to drain.
+type DrainSimulationPod struct {
+	// Namespace of the Pod.
+	Namespace string
+
+	// Name of the Pod.
+	Name string
+
+	// NodeName is the name of the Node where the Pod is running.
+	NodeName string
+
+	// Reschedulable is true if the Pod fits on the remaining Nodes considering its resource requests.
+	// It is only set for Pods to evict.
+	Reschedulable bool
+}
+
+// PodsNotReschedulable returns the Pods to evict which do not fit on the remaining Nodes.
+func (o *DrainSimulationOutput) PodsNotReschedulable() []DrainSimulationPod {
+	pods := []DrainSimulationPod{}
+	for _, p := range o.PodsToEvict {
+		if !p.Reschedulable {
+			pods = append(pods, p)
+		}
+	}
+	return pods
+}
+
+// DrainSimulateOptions carries the options supported by DrainSimulate.
+type DrainSimulateOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Machines are the names of the Machines to simulate the drain for; all the Machines must
+	// belong to the same Cluster.
+	Machines []string
+
+	// Namespace where the Machines live. If unspecified, the namespace name will be inferred
+	// from the current configuration.
+	Namespace string
+}
+
+func (c *clusterctlClient) DrainSimulate(ctx context.Context, options DrainSimulateOptions) (*DrainSimulationOutput, error) {
+	if len(options.Machines) == 0 {
+		return nil, errors.New("at least one Machine must be specified")
+	}
+
+	// gets access to the management cluster
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure this command only runs against management clusters with the current Cluster API contract.
+	if err := clusterClient.ProviderInventory().CheckCAPIContract(ctx); err != nil {
+		return nil, err
+	}
+
+	if options.Namespace == "" {
+		currentNamespace, err := clusterClient.Proxy().CurrentNamespace()
+		if err != nil {
+			return nil, err
+		}
+		if currentNamespace == "" {
+			return nil, errors.New("failed to identify the current namespace. Please specify the namespace where the Machines exist")
+		}
+		options.Namespace = currentNamespace
+	}
+
+	c1, err := clusterClient.Proxy().NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	machines := []*clusterv1.Machine{}
+	for _, name := range options.Machines {
+		machine := &clusterv1.Machine{}
+		if err := c1.Get(ctx, ctrlclient.ObjectKey{Namespace: options.Namespace, Name: name}, machine); err != nil {
+			return nil, errors.Wrapf(err, "failed to get Machine %s", klog.KRef(options.Namespace, name))
+		}
+		if len(machines) > 0 && machines[0].Spec.ClusterName != machine.Spec.ClusterName {
+			return nil, errors.Errorf("Machines %s and %s belong to different Clusters", machines[0].Name, machine.Name)
+		}
+		machines = append(machines, machine)
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := c1.Get(ctx, ctrlclient.ObjectKey{Namespace: options.Namespace, Name: machines[0].Spec.ClusterName}, cluster); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Cluster %s", klog.KRef(options.Namespace, machines[0].Spec.ClusterName))
+	}
+
+	// gets access to the workload cluster
+	kubeconfig, err := clusterClient.WorkloadCluster().GetKubeconfig(ctx, cluster.Name, cluster.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create REST config for Cluster %s", klog.KObj(cluster))
+	}
+	remoteClient, err := ctrlclient.New(restConfig, ctrlclient.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create client for Cluster %s", klog.KObj(cluster))
+	}
+
+	drainer := &drain.Helper{
+		Client:       c1,
+		RemoteClient: remoteClient,
+	}
+	res, err := drainer.SimulateDrain(ctx, cluster, machines)
+	if err != nil {
+		return nil, err
+	}
+	return convertDrainSimulationResult(res), nil
+}
+
+// convertDrainSimulationResult converts the result of a drain simulation to a DrainSimulationOutput.
+func convertDrainSimulationResult(res *drain.SimulationResult) *DrainSimulationOutput {
+	notFitting := sets.Set[*corev1.Pod]{}
+	notFitting.Insert(res.PodsNotFitting...)
+
+	convertPods := func(pods []*corev1.Pod, checkReschedulable bool) []DrainSimulationPod {
+		out := make([]DrainSimulationPod, 0, len(pods))
+		for _, p := range pods {
+			out = append(out, DrainSimulationPod{
+				Namespace:     p.Namespace,
+				Name:          p.Name,
+				NodeName:      p.Spec.NodeName,
+				Reschedulable: checkReschedulable && !notFitting.Has(p),
+			})
+		}
+		return out
+	}
+
+	return &DrainSimulationOutput{
+		PodsToEvict:         convertPods(res.PodsToEvict, true),
+		PodsToWaitCompleted: convertPods(res.PodsToWaitCompleted, false),
+		PodsTerminating:     convertPods(res.PodsDeletionTimestampSet, false),
+		PodsIgnored:         convertPods(res.PodsIgnored, false),
+		RemainingNodes:      res.RemainingNodes,
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+)
+
+func Test_clusterctlClient_DrainSimulate(t *testing.T) {
+	ctx := context.Background()
+
+	machine := func(name, clusterName string) client.Object {
+		return &clusterv1.Machine{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1.GroupVersion.String(),
+				Kind:       "Machine",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns1",
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: clusterName,
+			},
+		}
+	}
+
+	configClient := newFakeConfig(ctx)
+	kubeconfig := cluster.Kubeconfig{Path: "kubeconfig", Context: "mgmt-context"}
+	clusterClient := newFakeCluster(cluster.Kubeconfig(kubeconfig), configClient).
+		WithObjs(fakeCAPISetupObjects()...).
+		WithObjs(machine("machine-1", "cluster-1"), machine("machine-2", "cluster-2"))
+	c := newFakeClient(ctx, configClient).WithCluster(clusterClient)
+
+	tests := []struct {
+		name    string
+		options DrainSimulateOptions
+		wantErr string
+	}{
+		{
+			name: "returns error if no Machines are specified",
+			options: DrainSimulateOptions{
+				Kubeconfig: Kubeconfig(kubeconfig),
+				Namespace:  "ns1",
+			},
+			wantErr: "at least one Machine must be specified",
+		},
+		{
+			name: "returns error if a Machine does not exist",
+			options: DrainSimulateOptions{
+				Kubeconfig: Kubeconfig(kubeconfig),
+				Namespace:  "ns1",
+				Machines:   []string{"machine-1", "machine-does-not-exist"},
+			},
+			wantErr: "failed to get Machine ns1/machine-does-not-exist",
+		},
+		{
+			name: "returns error if Machines belong to different Clusters",
+			options: DrainSimulateOptions{
+				Kubeconfig: Kubeconfig(kubeconfig),
+				Namespace:  "ns1",
+				Machines:   []string{"machine-1", "machine-2"},
+			},
+			wantErr: "Machines machine-1 and machine-2 belong to different Clusters",
+		},
+		{
+			name: "returns error if the Cluster does not exist",
+			options: DrainSimulateOptions{
+				Kubeconfig: Kubeconfig(kubeconfig),
+				Namespace:  "ns1",
+				Machines:   []string{"machine-1"},
+			},
+			wantErr: "failed to get Cluster ns1/cluster-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			_, err := c.DrainSimulate(ctx, tt.options)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+		})
+	}
+}
+
+func Test_convertDrainSimulationResult(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "ns1",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "node-1",
+			},
+		}
+	}
+	notFitting := pod("evict-2")
+	res := &drain.SimulationResult{
+		PodsToEvict:              []*corev1.Pod{pod("evict-1"), notFitting},
+		PodsToWaitCompleted:      []*corev1.Pod{pod("wait-completed")},
+		PodsDeletionTimestampSet: []*corev1.Pod{pod("terminating")},
+		PodsIgnored:              []*corev1.Pod{pod("ignored")},
+		PodsNotFitting:           []*corev1.Pod{notFitting},
+		RemainingNodes:           []string{"node-2"},
+	}
+
+	out := convertDrainSimulationResult(res)
+	g.Expect(out).To(BeComparableTo(&DrainSimulationOutput{
+		PodsToEvict: []DrainSimulationPod{
+			{Namespace: "ns1", Name: "evict-1", NodeName: "node-1", Reschedulable: true},
+			{Namespace: "ns1", Name: "evict-2", NodeName: "node-1", Reschedulable: false},
+		},
+		PodsToWaitCompleted: []DrainSimulationPod{{Namespace: "ns1", Name: "wait-completed", NodeName: "node-1"}},
+		PodsTerminating:     []DrainSimulationPod{{Namespace: "ns1", Name: "terminating", NodeName: "node-1"}},
+		PodsIgnored:         []DrainSimulationPod{{Namespace: "ns1", Name: "ignored", NodeName: "node-1"}},
+		RemainingNodes:      []string{"node-2"},
+	}))
+	g.Expect(out.PodsNotReschedulable()).To(BeComparableTo([]DrainSimulationPod{
+		{Namespace: "ns1", Name: "evict-2", NodeName: "node-1", Reschedulable: false},
+	}))
+}
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/version"
 
 	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/repository"
 )
 
 func (c *clusterctlClient) GenerateProvider(ctx context.Context, provider string, providerType clusterctlv1.ProviderType, options ComponentsOptions) (Components, error) {
@@ -48,6 +49,9 @@ func (c *clusterctlClient) GenerateProvider(ctx context.Context, provider string
 
 	latestMetadata, err := providerRepositoryClient.Metadata(providerVersion).Get(ctx)
 	if err != nil {
+		if rejectedErr := repository.ProviderRejectedError(err); rejectedErr != nil {
+			return nil, rejectedErr
+		}
 		return nil, err
 	}
 
@@ -96,6 +96,15 @@ func (f *componentsClient) getRawBytes(ctx context.Context, options *ComponentsO
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to read %q from provider's repository %q", path, f.provider.ManifestLabel())
 		}
+
+		// Verify the component YAML according to the verification policy defined for the provider.
+		verifier, err := newArtifactVerifier(f.provider, f.repository, f.configClient.Variables())
+		if err != nil {
+			return nil, err
+		}
+		if err := verifier.verify(ctx, options.Version, path, file); err != nil {
+			return nil, err
+		}
 	} else {
 		log.Info("Using", "override", path, "provider", f.provider.ManifestLabel(), "version", options.Version)
 	}
@@ -80,6 +80,15 @@ func (f *metadataClient) Get(ctx context.Context) (*clusterctlv1.Metadata, error
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to read %q from the repository for provider %q", metadataFile, f.provider.ManifestLabel())
 		}
+
+		// Verify the metadata according to the verification policy defined for the provider.
+		verifier, err := newArtifactVerifier(f.provider, f.repository, f.configVarClient)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifier.verify(ctx, version, metadataFile, file); err != nil {
+			return nil, err
+		}
 	} else {
 		log.V(1).Info("Using", "override", metadataFile, "provider", f.provider.ManifestLabel(), "version", version)
 	}
@@ -0,0 +1,551 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/version"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+)
+
+const (
+	ociScheme = "oci"
+
+	// ociTitleAnnotation is the layer annotation used to store the file name; this is the same
+	// convention used by tools like oras when pushing files as OCI artifacts.
+	ociTitleAnnotation = "org.opencontainers.image.title"
+
+	ociImageManifestMediaType  = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestV2MediaType  = "application/vnd.docker.distribution.manifest.v2+json"
+	ociRequestTimeout          = 30 * time.Second
+	ociListTagsPageSizeDefault = 1000
+)
+
+// ociRepository provides support for providers hosted in an OCI registry.
+//
+// Each version of a provider must be pushed as an OCI artifact tagged with the version, with one layer
+// for each file (e.g. components, metadata.yaml, cluster templates, ClusterClasses); the name of each file
+// must be stored in the org.opencontainers.image.title layer annotation. E.g.
+//
+//	oras push registry.example.com/capi/infrastructure-aws:v2.1.0 infrastructure-components.yaml metadata.yaml cluster-template.yaml
+//
+// The URL is expected to be in the form oci://{registry}/{repository}:{version}/{components.yaml}, where
+// version must obey the syntax and semantics of the "Semantic Versioning" specification; however, "latest" is also an
+// acceptable value and it is resolved by listing the repository tags.
+//
+// Credentials for accessing the registry are read from the docker config file ($DOCKER_CONFIG/config.json or
+// ~/.docker/config.json); plain HTTP is used only for registries on localhost.
+type ociRepository struct {
+	providerConfig config.Provider
+	httpClient     *http.Client
+	scheme         string
+	registry       string
+	repository     string
+	defaultVersion string
+	componentsPath string
+	credentials    *ociCredentials
+
+	// token is the bearer token obtained for accessing the repository, if required by the registry.
+	token string
+}
+
+var _ Repository = &ociRepository{}
+
+type ociRepositoryOption func(*ociRepository)
+
+func injectOCIHTTPClient(c *http.Client) ociRepositoryOption {
+	return func(r *ociRepository) {
+		r.httpClient = c
+	}
+}
+
+// ociCredentials are the credentials used for accessing an OCI registry.
+type ociCredentials struct {
+	username      string
+	password      string
+	identityToken string
+}
+
+// ociManifest is the subset of an OCI image manifest (or a docker v2 manifest) used by clusterctl.
+type ociManifest struct {
+	MediaType string          `json:"mediaType,omitempty"`
+	Layers    []ociDescriptor `json:"layers"`
+}
+
+// ociDescriptor is the subset of an OCI content descriptor used by clusterctl.
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// NewOCIRepository returns an ociRepository implementation.
+func NewOCIRepository(ctx context.Context, providerConfig config.Provider, opts ...ociRepositoryOption) (Repository, error) {
+	rURL, err := url.Parse(providerConfig.URL())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid url")
+	}
+
+	// Extracts repository, version, componentsPath from the url
+	// NB. format is oci://{registry}/{repository}:{version}/{components.yaml}
+	path := strings.TrimPrefix(rURL.Path, "/")
+	invalidURLErr := errors.Errorf("invalid url: an OCI repository url should be in the form oci://{registry}/{repository}:{version}/{components.yaml}, got %q", providerConfig.URL())
+	if rURL.Scheme != ociScheme || rURL.Host == "" {
+		return nil, invalidURLErr
+	}
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return nil, invalidURLErr
+	}
+	reference, componentsPath := path[:i], path[i+1:]
+	j := strings.LastIndex(reference, ":")
+	if j <= 0 || componentsPath == "" {
+		return nil, invalidURLErr
+	}
+	repository, defaultVersion := reference[:j], reference[j+1:]
+	if defaultVersion != latestVersionTag {
+		if _, err := version.ParseSemantic(defaultVersion); err != nil {
+			return nil, errors.Errorf("invalid version: %q. Version must obey the syntax and semantics of the \"Semantic Versioning\" specification (http://semver.org/)", defaultVersion)
+		}
+	}
+
+	credentials, err := ociCredentialsFromDockerConfig(rURL.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &ociRepository{
+		providerConfig: providerConfig,
+		httpClient:     http.DefaultClient,
+		scheme:         httpsScheme,
+		registry:       rURL.Host,
+		repository:     repository,
+		defaultVersion: defaultVersion,
+		componentsPath: componentsPath,
+		credentials:    credentials,
+	}
+	if isLocalhost(rURL.Hostname()) {
+		repo.scheme = "http"
+	}
+
+	// Process ociRepositoryOptions.
+	for _, o := range opts {
+		o(repo)
+	}
+
+	if defaultVersion == latestVersionTag {
+		repo.defaultVersion, err = latestContractRelease(ctx, repo, clusterv1.GroupVersion.Version)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest version")
+		}
+	}
+	return repo, nil
+}
+
+// DefaultVersion returns defaultVersion field of ociRepository struct.
+func (r *ociRepository) DefaultVersion() string {
+	return r.defaultVersion
+}
+
+// RootPath returns the empty string as it is not applicable to OCI repositories.
+func (r *ociRepository) RootPath() string {
+	return ""
+}
+
+// ComponentsPath returns componentsPath field of ociRepository struct.
+func (r *ociRepository) ComponentsPath() string {
+	return r.componentsPath
+}
+
+// GetVersions returns the list of versions that are available in a provider repository, by listing the repository tags.
+func (r *ociRepository) GetVersions(ctx context.Context) ([]string, error) {
+	cacheID := fmt.Sprintf("%s://%s/%s", ociScheme, r.registry, r.repository)
+	if versions, ok := cacheVersions[cacheID]; ok {
+		return versions, nil
+	}
+
+	versions := []string{}
+	next := fmt.Sprintf("/v2/%s/tags/list?n=%d", r.repository, ociListTagsPageSizeDefault)
+	for next != "" {
+		response, err := r.do(ctx, next, "")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list tags for %s", cacheID)
+		}
+
+		tags := &struct {
+			Tags []string `json:"tags"`
+		}{}
+		err = json.NewDecoder(response.Body).Decode(tags)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list tags for %s", cacheID)
+		}
+
+		for _, t := range tags.Tags {
+			if _, err := version.ParseSemantic(t); err != nil {
+				// discard tags that are not a valid semantic versions (the user can point explicitly to such tags)
+				continue
+			}
+			versions = append(versions, t)
+		}
+
+		next = nextLink(response.Header.Get("Link"))
+	}
+
+	cacheVersions[cacheID] = versions
+	return versions, nil
+}
+
+// GetFile returns a file for a given provider version.
+func (r *ociRepository) GetFile(ctx context.Context, version, path string) ([]byte, error) {
+	var err error
+
+	switch version {
+	case latestVersionTag:
+		version, err = latestRelease(ctx, r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the latest release")
+		}
+	case "":
+		version = r.defaultVersion
+	}
+
+	cacheID := fmt.Sprintf("%s://%s/%s:%s/%s", ociScheme, r.registry, r.repository, version, path)
+	if content, ok := cacheFiles[cacheID]; ok {
+		return content, nil
+	}
+
+	manifest, err := r.getManifest(ctx, version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get file %q from %s", path, cacheID)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.Annotations[ociTitleAnnotation] != path {
+			continue
+		}
+
+		content, err := r.getBlob(ctx, layer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get file %q from %s", path, cacheID)
+		}
+		cacheFiles[cacheID] = content
+		return content, nil
+	}
+
+	return nil, errors.Wrapf(errNotFound, "failed to get file %q from %s: no layer with %s annotation matching the file name", path, cacheID, ociTitleAnnotation)
+}
+
+// getManifest returns the manifest of the artifact with a given tag.
+func (r *ociRepository) getManifest(ctx context.Context, tag string) (*ociManifest, error) {
+	response, err := r.do(ctx, fmt.Sprintf("/v2/%s/manifests/%s", r.repository, tag), strings.Join([]string{ociImageManifestMediaType, dockerManifestV2MediaType}, ", "))
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	manifest := &ociManifest{}
+	if err := json.NewDecoder(response.Body).Decode(manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode manifest for tag %q", tag)
+	}
+	return manifest, nil
+}
+
+// getBlob returns the content of a blob, after checking its digest.
+func (r *ociRepository) getBlob(ctx context.Context, descriptor ociDescriptor) ([]byte, error) {
+	algorithm, expected, ok := strings.Cut(descriptor.Digest, ":")
+	if !ok || algorithm != "sha256" {
+		return nil, errors.Errorf("unsupported digest %q", descriptor.Digest)
+	}
+
+	response, err := r.do(ctx, fmt.Sprintf("/v2/%s/blobs/%s", r.repository, descriptor.Digest), "")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read blob %s", descriptor.Digest)
+	}
+
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != expected {
+		return nil, errors.Errorf("digest mismatch for blob %s", descriptor.Digest)
+	}
+	return content, nil
+}
+
+// do executes a GET request against the registry, taking care of authentication.
+func (r *ociRepository) do(ctx context.Context, path, accept string) (*http.Response, error) {
+	response, err := r.doWithAuth(ctx, path, accept)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the registry requires authentication, get a token using the challenge in the response and retry.
+	if response.StatusCode == http.StatusUnauthorized {
+		challenge := response.Header.Get("WWW-Authenticate")
+		_ = response.Body.Close()
+		if err := r.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if response, err = r.doWithAuth(ctx, path, accept); err != nil {
+			return nil, err
+		}
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return response, nil
+	case http.StatusNotFound:
+		_ = response.Body.Close()
+		return nil, errNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		_ = response.Body.Close()
+		return nil, errors.Errorf("failed to get %s from %s: unauthorized access, please check your credentials", path, r.registry)
+	default:
+		_ = response.Body.Close()
+		return nil, errors.Errorf("failed to get %s from %s, got %d", path, r.registry, response.StatusCode)
+	}
+}
+
+func (r *ociRepository) doWithAuth(ctx context.Context, path, accept string) (*http.Response, error) {
+	timeoutctx, cancel := context.WithTimeoutCause(ctx, ociRequestTimeout, errors.New("http request timeout expired"))
+	request, err := http.NewRequestWithContext(timeoutctx, http.MethodGet, fmt.Sprintf("%s://%s%s", r.scheme, r.registry, path), http.NoBody)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrap(err, "failed to create request")
+	}
+	if accept != "" {
+		request.Header.Set("Accept", accept)
+	}
+	switch {
+	case r.token != "":
+		request.Header.Set("Authorization", "Bearer "+r.token)
+	case r.credentials != nil && r.credentials.username != "":
+		request.SetBasicAuth(r.credentials.username, r.credentials.password)
+	}
+
+	response, err := r.httpClient.Do(request)
+	if err != nil {
+		cancel()
+		return nil, errors.Wrapf(err, "failed to get %s from %s", path, r.registry)
+	}
+	// Make sure the context is canceled only when the body is closed.
+	response.Body = &cancelOnCloseReader{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// authenticate gets a bearer token from the authorization service described by a WWW-Authenticate challenge.
+func (r *ociRepository) authenticate(ctx context.Context, challenge string) error {
+	scheme, params := parseAuthenticateChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		// Basic auth (if any) is already sent with the request, so there is nothing else to try.
+		return errors.Errorf("failed to access %s: unauthorized access, please check your credentials", r.registry)
+	}
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil {
+		return errors.Wrapf(err, "invalid authentication realm %q", params["realm"])
+	}
+	query := tokenURL.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", r.repository)
+	}
+	query.Set("scope", scope)
+	tokenURL.RawQuery = query.Encode()
+
+	timeoutctx, cancel := context.WithTimeoutCause(ctx, ociRequestTimeout, errors.New("http request timeout expired"))
+	defer cancel()
+	request, err := http.NewRequestWithContext(timeoutctx, http.MethodGet, tokenURL.String(), http.NoBody)
+	if err != nil {
+		return errors.Wrap(err, "failed to create token request")
+	}
+	if r.credentials != nil {
+		switch {
+		case r.credentials.identityToken != "":
+			request.Header.Set("Authorization", "Bearer "+r.credentials.identityToken)
+		case r.credentials.username != "":
+			request.SetBasicAuth(r.credentials.username, r.credentials.password)
+		}
+	}
+
+	response, err := r.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get a token for %s", r.registry)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to get a token for %s: unauthorized access, please check your credentials (got %d)", r.registry, response.StatusCode)
+	}
+
+	token := &struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(token); err != nil {
+		return errors.Wrapf(err, "failed to decode token for %s", r.registry)
+	}
+	r.token = token.Token
+	if r.token == "" {
+		r.token = token.AccessToken
+	}
+	if r.token == "" {
+		return errors.Errorf("failed to get a token for %s: empty token", r.registry)
+	}
+	return nil
+}
+
+// ociCredentialsFromDockerConfig reads the credentials for a registry from the docker config file.
+// NOTE: credential helpers are not supported.
+func ociCredentialsFromDockerConfig(registry string) (*ociCredentials, error) {
+	dir := os.Getenv("DOCKER_CONFIG")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, nil //nolint:nilerr // No home directory means no docker config.
+		}
+		dir = filepath.Join(home, ".docker")
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "config.json")) //nolint:gosec
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to read docker config")
+	}
+
+	dockerConfig := &struct {
+		Auths map[string]struct {
+			Auth          string `json:"auth"`
+			Username      string `json:"username"`
+			Password      string `json:"password"`
+			IdentityToken string `json:"identitytoken"`
+		} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(content, dockerConfig); err != nil {
+		return nil, errors.Wrap(err, "failed to parse docker config")
+	}
+
+	for key, auth := range dockerConfig.Auths {
+		// Keys in the docker config could be either a registry host or an URL.
+		host := key
+		if u, err := url.Parse(key); err == nil && u.Host != "" {
+			host = u.Host
+		}
+		if host != registry {
+			continue
+		}
+
+		credentials := &ociCredentials{
+			username:      auth.Username,
+			password:      auth.Password,
+			identityToken: auth.IdentityToken,
+		}
+		if auth.Auth != "" {
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to decode docker config auth for %s", registry)
+			}
+			username, password, ok := strings.Cut(string(decoded), ":")
+			if !ok {
+				return nil, errors.Errorf("invalid docker config auth for %s", registry)
+			}
+			credentials.username, credentials.password = username, password
+		}
+		return credentials, nil
+	}
+	return nil, nil
+}
+
+// parseAuthenticateChallenge parses a WWW-Authenticate header, e.g. Bearer realm="https://auth.example.com/token",service="registry.example.com".
+func parseAuthenticateChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, "\"") {
+			value, rest, _ = strings.Cut(rest[1:], "\"")
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		params[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	return scheme, params
+}
+
+// nextLink returns the path of the next page from a Link header, e.g. </v2/foo/tags/list?n=1000&last=v1.0.0>; rel="next".
+func nextLink(link string) string {
+	if link == "" || !strings.Contains(link, `rel="next"`) {
+		return ""
+	}
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	u, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return ""
+	}
+	return u.RequestURI()
+}
+
+func isLocalhost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// cancelOnCloseReader cancels a context when the reader is closed.
+type cancelOnCloseReader struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (r *cancelOnCloseReader) Close() error {
+	defer r.cancel()
+	return r.ReadCloser.Close()
+}
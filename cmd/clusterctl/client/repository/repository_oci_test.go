@@ -0,0 +1,340 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+)
+
+// fakeOCIRegistry is an in-process OCI registry serving a single repository.
+type fakeOCIRegistry struct {
+	repository string
+	// artifacts maps tags to files (file name -> content).
+	artifacts map[string]map[string]string
+	// username and password, if set, are required for getting a token.
+	username string
+	password string
+	// corruptBlobs makes the registry serve blobs not matching their digest.
+	corruptBlobs bool
+
+	server *httptest.Server
+}
+
+const fakeOCIRegistryToken = "fake-token"
+
+func newFakeOCIRegistry(t *testing.T, repository string, artifacts map[string]map[string]string) *fakeOCIRegistry {
+	t.Helper()
+
+	r := &fakeOCIRegistry{
+		repository: repository,
+		artifacts:  artifacts,
+	}
+	r.server = httptest.NewServer(http.HandlerFunc(r.serveHTTP))
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func (r *fakeOCIRegistry) host() string {
+	return strings.TrimPrefix(r.server.URL, "http://")
+}
+
+func (r *fakeOCIRegistry) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if r.username != "" {
+			if username, password, ok := req.BasicAuth(); !ok || username != r.username || password != r.password {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"token": fakeOCIRegistryToken})
+		return
+	}
+
+	if r.username != "" && req.Header.Get("Authorization") != "Bearer "+fakeOCIRegistryToken {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf("Bearer realm=%q,service=\"fake-registry\",scope=\"repository:%s:pull\"", r.server.URL+"/token", r.repository))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	prefix := "/v2/" + r.repository + "/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	path := strings.TrimPrefix(req.URL.Path, prefix)
+
+	switch {
+	case path == "tags/list":
+		// Serve tags one page at a time, to exercise pagination.
+		tags := []string{}
+		for tag := range r.artifacts {
+			tags = append(tags, tag)
+		}
+		last := req.URL.Query().Get("last")
+		page := []string{}
+		for _, tag := range tags {
+			if tag > last {
+				page = append(page, tag)
+			}
+		}
+		if len(page) > 1 {
+			first := page[0]
+			for _, tag := range page {
+				if tag < first {
+					first = tag
+				}
+			}
+			page = []string{first}
+			w.Header().Set("Link", fmt.Sprintf("<%s?n=1&last=%s>; rel=\"next\"", req.URL.Path, first))
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"name": r.repository, "tags": page})
+	case strings.HasPrefix(path, "manifests/"):
+		files, ok := r.artifacts[strings.TrimPrefix(path, "manifests/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		manifest := ociManifest{MediaType: ociImageManifestMediaType}
+		for name, content := range files {
+			manifest.Layers = append(manifest.Layers, ociDescriptor{
+				MediaType:   "application/vnd.oci.image.layer.v1.tar",
+				Digest:      fakeOCIDigest(content),
+				Size:        int64(len(content)),
+				Annotations: map[string]string{ociTitleAnnotation: name},
+			})
+		}
+		w.Header().Set("Content-Type", ociImageManifestMediaType)
+		_ = json.NewEncoder(w).Encode(manifest)
+	case strings.HasPrefix(path, "blobs/"):
+		digest := strings.TrimPrefix(path, "blobs/")
+		for _, files := range r.artifacts {
+			for _, content := range files {
+				if fakeOCIDigest(content) == digest {
+					if r.corruptBlobs {
+						content += "corrupted"
+					}
+					_, _ = w.Write([]byte(content))
+					return
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func fakeOCIDigest(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func Test_ociRepository_newOCIRepository(t *testing.T) {
+	t.Setenv("DOCKER_CONFIG", t.TempDir())
+
+	registry := newFakeOCIRegistry(t, "capi/infrastructure-foo", map[string]map[string]string{
+		"v1.0.0": {"metadata.yaml": "", "infrastructure-components.yaml": "v1.0.0"},
+		"v1.1.0": {"metadata.yaml": "", "infrastructure-components.yaml": "v1.1.0"},
+		"dev":    {"metadata.yaml": "", "infrastructure-components.yaml": "dev"},
+	})
+
+	tests := []struct {
+		name               string
+		url                string
+		wantRepository     string
+		wantDefaultVersion string
+		wantComponentsPath string
+		wantErr            bool
+	}{
+		{
+			name:               "can create a new OCI repository with a version",
+			url:                fmt.Sprintf("oci://%s/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml", registry.host()),
+			wantRepository:     "capi/infrastructure-foo",
+			wantDefaultVersion: "v1.0.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:               "can create a new OCI repository with latest",
+			url:                fmt.Sprintf("oci://%s/capi/infrastructure-foo:latest/infrastructure-components.yaml", registry.host()),
+			wantRepository:     "capi/infrastructure-foo",
+			wantDefaultVersion: "v1.1.0",
+			wantComponentsPath: "infrastructure-components.yaml",
+		},
+		{
+			name:    "fails if the version is missing",
+			url:     fmt.Sprintf("oci://%s/capi/infrastructure-foo/infrastructure-components.yaml", registry.host()),
+			wantErr: true,
+		},
+		{
+			name:    "fails if the components path is missing",
+			url:     fmt.Sprintf("oci://%s/capi/infrastructure-foo:v1.0.0", registry.host()),
+			wantErr: true,
+		},
+		{
+			name:    "fails if the version is not a semantic version",
+			url:     fmt.Sprintf("oci://%s/capi/infrastructure-foo:dev/infrastructure-components.yaml", registry.host()),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resetCaches()
+
+			repo, err := NewOCIRepository(context.Background(), config.NewProvider("foo", tt.url, clusterctlv1.InfrastructureProviderType))
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			ociRepo := repo.(*ociRepository)
+			g.Expect(ociRepo.scheme).To(Equal("http"))
+			g.Expect(ociRepo.repository).To(Equal(tt.wantRepository))
+			g.Expect(repo.DefaultVersion()).To(Equal(tt.wantDefaultVersion))
+			g.Expect(repo.ComponentsPath()).To(Equal(tt.wantComponentsPath))
+			g.Expect(repo.RootPath()).To(BeEmpty())
+		})
+	}
+}
+
+func Test_ociRepository_GetVersions(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("DOCKER_CONFIG", t.TempDir())
+	resetCaches()
+
+	registry := newFakeOCIRegistry(t, "capi/infrastructure-foo", map[string]map[string]string{
+		"v1.0.0": {},
+		"v1.1.0": {},
+		"v2.0.0": {},
+		"dev":    {},
+	})
+
+	repo, err := NewOCIRepository(context.Background(), config.NewProvider("foo", fmt.Sprintf("oci://%s/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml", registry.host()), clusterctlv1.InfrastructureProviderType))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	versions, err := repo.GetVersions(context.Background())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(versions).To(ConsistOf("v1.0.0", "v1.1.0", "v2.0.0"))
+}
+
+func Test_ociRepository_GetFile(t *testing.T) {
+	artifacts := map[string]map[string]string{
+		"v1.0.0": {
+			"metadata.yaml":                  "metadata",
+			"infrastructure-components.yaml": "components",
+			"cluster-template.yaml":          "template",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		username     string
+		password     string
+		dockerConfig string
+		corruptBlobs bool
+		file         string
+		want         string
+		wantErr      string
+	}{
+		{
+			name: "get a file",
+			file: "cluster-template.yaml",
+			want: "template",
+		},
+		{
+			name:    "fails if the file does not exist",
+			file:    "cluster-template-foo.yaml",
+			wantErr: "404 Not Found",
+		},
+		{
+			name:         "fails if the digest does not match",
+			corruptBlobs: true,
+			file:         "cluster-template.yaml",
+			wantErr:      "digest mismatch",
+		},
+		{
+			name:         "get a file from a registry requiring authentication, using auth from the docker config",
+			username:     "user",
+			password:     "pass",
+			dockerConfig: `{"auths":{"%s":{"auth":"` + base64.StdEncoding.EncodeToString([]byte("user:pass")) + `"}}}`,
+			file:         "infrastructure-components.yaml",
+			want:         "components",
+		},
+		{
+			name:         "get a file from a registry requiring authentication, using username and password from the docker config",
+			username:     "user",
+			password:     "pass",
+			dockerConfig: `{"auths":{"http://%s":{"username":"user","password":"pass"}}}`,
+			file:         "infrastructure-components.yaml",
+			want:         "components",
+		},
+		{
+			name:         "fails with wrong credentials",
+			username:     "user",
+			password:     "pass",
+			dockerConfig: `{"auths":{"%s":{"username":"user","password":"wrong"}}}`,
+			file:         "infrastructure-components.yaml",
+			wantErr:      "unauthorized access",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resetCaches()
+
+			registry := newFakeOCIRegistry(t, "capi/infrastructure-foo", artifacts)
+			registry.username = tt.username
+			registry.password = tt.password
+			registry.corruptBlobs = tt.corruptBlobs
+
+			dockerConfigDir := t.TempDir()
+			if tt.dockerConfig != "" {
+				g.Expect(os.WriteFile(filepath.Join(dockerConfigDir, "config.json"), []byte(fmt.Sprintf(tt.dockerConfig, registry.host())), 0600)).To(Succeed())
+			}
+			t.Setenv("DOCKER_CONFIG", dockerConfigDir)
+
+			repo, err := NewOCIRepository(context.Background(), config.NewProvider("foo", fmt.Sprintf("oci://%s/capi/infrastructure-foo:v1.0.0/infrastructure-components.yaml", registry.host()), clusterctlv1.InfrastructureProviderType))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := repo.GetFile(context.Background(), "v1.0.0", tt.file)
+			if tt.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(got)).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+)
+
+const (
+	// VerificationPolicyNone disables verification of provider artifacts; this is the default.
+	VerificationPolicyNone = "none"
+
+	// VerificationPolicyChecksum requires provider artifacts to match the SHA-256 checksums published
+	// in the checksums.txt file of the same release, or in a checksums file obtained out of band.
+	// NOTE: Checksums read from the provider repository only protect against corrupted downloads, because whoever can
+	// tamper with the artifacts can tamper with the checksums too; use a checksums file obtained out of band or
+	// VerificationPolicySignature to verify the authenticity of the artifacts.
+	VerificationPolicyChecksum = "checksum"
+
+	// VerificationPolicySignature requires provider artifacts to have a detached signature, published as
+	// {file}.sig in the same release, matching the public key configured for the provider.
+	VerificationPolicySignature = "signature"
+
+	// checksumsFile is the name of the file hosting the checksums of the release artifacts, in the sha256sum format.
+	checksumsFile = "checksums.txt"
+
+	// signatureFileSuffix is the suffix of the files hosting detached signatures of the release artifacts.
+	signatureFileSuffix = ".sig"
+)
+
+// VerificationError is returned when a provider artifact is rejected because it does not comply with
+// the verification policy defined for the provider.
+type VerificationError struct {
+	// Provider is the manifest label of the provider, e.g. infrastructure-aws.
+	Provider string
+
+	// Version is the provider version the artifact belongs to.
+	Version string
+
+	// File is the name of the rejected artifact.
+	File string
+
+	// Policy is the verification policy that has been applied.
+	Policy string
+
+	// Reason explains why the artifact has been rejected.
+	Reason string
+}
+
+func (e *VerificationError) Error() string {
+	return fmt.Sprintf("%s verification failed for %q of provider %s version %s: %s", e.Policy, e.File, e.Provider, e.Version, e.Reason)
+}
+
+// ProviderRejectedError returns an error explaining why a provider has been rejected in case
+// its artifacts failed verification, nil otherwise.
+func ProviderRejectedError(err error) error {
+	var verificationErr *VerificationError
+	if !errors.As(err, &verificationErr) {
+		return nil
+	}
+	return errors.Errorf("provider %s version %s has been rejected because %s does not comply with the %s verification policy: %s. "+
+		"The verification policy can be changed using the %q variable in the clusterctl configuration",
+		verificationErr.Provider, verificationErr.Version, verificationErr.File, verificationErr.Policy, verificationErr.Reason,
+		config.ProviderVerificationVariable(verificationErr.Provider))
+}
+
+// artifactVerifier verifies provider artifacts according to the verification policy defined for the provider.
+type artifactVerifier struct {
+	provider   config.Provider
+	repository Repository
+	policy     string
+	publicKey  crypto.PublicKey
+
+	// checksums is the content of the checksums file obtained out of band, if any;
+	// if not set, the checksums file is read from the provider repository.
+	checksums []byte
+}
+
+// newArtifactVerifier returns an artifactVerifier for a provider, reading the verification policy
+// from the clusterctl configuration.
+func newArtifactVerifier(provider config.Provider, repository Repository, configVariablesClient config.VariablesClient) (*artifactVerifier, error) {
+	v := &artifactVerifier{
+		provider:   provider,
+		repository: repository,
+		policy:     VerificationPolicyNone,
+	}
+
+	policyVariable := config.ProviderVerificationVariable(provider.ManifestLabel())
+	if policy, err := configVariablesClient.Get(policyVariable); err == nil && policy != "" {
+		v.policy = strings.ToLower(policy)
+	}
+
+	switch v.policy {
+	case VerificationPolicyNone:
+	case VerificationPolicyChecksum:
+		checksumsVariable := config.ProviderVerificationChecksumsVariable(provider.ManifestLabel())
+		if checksumsPath, err := configVariablesClient.Get(checksumsVariable); err == nil && checksumsPath != "" {
+			if v.checksums, err = os.ReadFile(checksumsPath); err != nil { //nolint:gosec
+				return nil, errors.Wrapf(err, "invalid %s variable for provider %s: failed to read checksums file", checksumsVariable, provider.ManifestLabel())
+			}
+		}
+	case VerificationPolicySignature:
+		publicKeyVariable := config.ProviderVerificationPublicKeyVariable(provider.ManifestLabel())
+		publicKey, err := configVariablesClient.Get(publicKeyVariable)
+		if err != nil || publicKey == "" {
+			return nil, errors.Errorf("the %s verification policy for provider %s requires the %s variable to be set", VerificationPolicySignature, provider.ManifestLabel(), publicKeyVariable)
+		}
+		if v.publicKey, err = parsePublicKey(publicKey); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s variable for provider %s", publicKeyVariable, provider.ManifestLabel())
+		}
+	default:
+		return nil, errors.Errorf("invalid %s variable for provider %s: unknown verification policy %q, valid values are %q, %q and %q", policyVariable, provider.ManifestLabel(), v.policy, VerificationPolicyNone, VerificationPolicyChecksum, VerificationPolicySignature)
+	}
+	return v, nil
+}
+
+// verify checks a file read from the provider repository according to the verification policy.
+func (v *artifactVerifier) verify(ctx context.Context, version, file string, content []byte) error {
+	log := logf.Log
+
+	var reason string
+	switch v.policy {
+	case VerificationPolicyChecksum:
+		reason = v.verifyChecksum(ctx, version, file, content)
+	case VerificationPolicySignature:
+		reason = v.verifySignature(ctx, version, file, content)
+	default:
+		return nil
+	}
+
+	if reason != "" {
+		return &VerificationError{
+			Provider: v.provider.ManifestLabel(),
+			Version:  version,
+			File:     file,
+			Policy:   v.policy,
+			Reason:   reason,
+		}
+	}
+
+	log.V(5).Info("Verified", "file", file, "provider", v.provider.ManifestLabel(), "version", version, "policy", v.policy)
+	return nil
+}
+
+// verifyChecksum checks the file against the checksums published in the release; it returns the reason why
+// the file has been rejected, if any.
+func (v *artifactVerifier) verifyChecksum(ctx context.Context, version, file string, content []byte) string {
+	checksums := v.checksums
+	if checksums == nil {
+		var err error
+		checksums, err = v.repository.GetFile(ctx, version, checksumsFile)
+		if err != nil {
+			return fmt.Sprintf("failed to read %s from the provider repository: %v", checksumsFile, err)
+		}
+	}
+
+	sum := sha256.Sum256(content)
+	actual := hex.EncodeToString(sum[:])
+
+	scanner := bufio.NewScanner(bytes.NewReader(checksums))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		// NB. sha256sum uses * as a prefix for files checked in binary mode.
+		name := strings.TrimPrefix(fields[1], "*")
+		if name != file && path.Base(name) != file {
+			continue
+		}
+		if !strings.EqualFold(fields[0], actual) {
+			return fmt.Sprintf("SHA-256 checksum %s does not match the checksum %s published in %s", actual, fields[0], checksumsFile)
+		}
+		return ""
+	}
+	return fmt.Sprintf("no checksum for the file is published in %s", checksumsFile)
+}
+
+// verifySignature checks the file against its detached signature; it returns the reason why
+// the file has been rejected, if any.
+func (v *artifactVerifier) verifySignature(ctx context.Context, version, file string, content []byte) string {
+	signatureFile := file + signatureFileSuffix
+	rawSignature, err := v.repository.GetFile(ctx, version, signatureFile)
+	if err != nil {
+		return fmt.Sprintf("failed to read %s from the provider repository: %v", signatureFile, err)
+	}
+
+	// Signatures are expected to be base64 encoded (e.g. as generated by cosign sign-blob), but raw signatures are accepted as well.
+	signature := rawSignature
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(rawSignature))); err == nil {
+		signature = decoded
+	}
+
+	digest := sha256.Sum256(content)
+	var valid bool
+	switch publicKey := v.publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(publicKey, digest[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(publicKey, content, signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature) == nil
+	}
+	if !valid {
+		return fmt.Sprintf("the signature in %s does not match the public key configured for the provider", signatureFile)
+	}
+	return ""
+}
+
+// parsePublicKey parses a PEM encoded public key; the value could be either the PEM content or the path of a file hosting it.
+func parsePublicKey(value string) (crypto.PublicKey, error) {
+	content := []byte(value)
+	if !strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		var err error
+		content, err = os.ReadFile(value) //nolint:gosec
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read public key")
+		}
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.New("failed to decode public key: no PEM data found")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse public key")
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return publicKey, nil
+	default:
+		return nil, errors.Errorf("unsupported public key type %T", publicKey)
+	}
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/config"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/internal/test"
+)
+
+func Test_artifactVerifier_verify(t *testing.T) {
+	g := NewWithT(t)
+
+	components := []byte("components")
+	sum := sha256.Sum256(components)
+	checksum := hex.EncodeToString(sum[:])
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	ecdsaSignature, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, sum[:])
+	g.Expect(err).ToNot(HaveOccurred())
+	ecdsaPublicKey := publicKeyPEM(t, &ecdsaKey.PublicKey)
+
+	ed25519PublicKey, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	g.Expect(err).ToNot(HaveOccurred())
+	ed25519Signature := ed25519.Sign(ed25519Key, components)
+
+	// Public keys can be set also as a path to a PEM file.
+	ecdsaPublicKeyFile := filepath.Join(t.TempDir(), "cosign.pub")
+	g.Expect(os.WriteFile(ecdsaPublicKeyFile, []byte(ecdsaPublicKey), 0600)).To(Succeed())
+
+	// Checksums can be obtained out of band.
+	checksumsFile := filepath.Join(t.TempDir(), "checksums.txt")
+	g.Expect(os.WriteFile(checksumsFile, []byte(fmt.Sprintf("%s  infrastructure-components.yaml\n", checksum)), 0600)).To(Succeed())
+	mismatchingChecksumsFile := filepath.Join(t.TempDir(), "checksums.txt")
+	g.Expect(os.WriteFile(mismatchingChecksumsFile, []byte("0000  infrastructure-components.yaml\n"), 0600)).To(Succeed())
+
+	provider := config.NewProvider("p1", "", clusterctlv1.InfrastructureProviderType)
+
+	tests := []struct {
+		name       string
+		vars       map[string]string
+		files      map[string][]byte
+		wantErr    bool
+		wantReason string
+	}{
+		{
+			name: "no verification by default",
+		},
+		{
+			name: "no verification with the none policy",
+			vars: map[string]string{"infrastructure-p1-verification": "none"},
+		},
+		{
+			name:    "fails with an invalid policy",
+			vars:    map[string]string{"infrastructure-p1-verification": "foo"},
+			wantErr: true,
+		},
+		{
+			name: "pass with matching checksum",
+			vars: map[string]string{"infrastructure-p1-verification": "checksum"},
+			files: map[string][]byte{
+				"checksums.txt": []byte(fmt.Sprintf("%s  metadata.yaml\n%s  infrastructure-components.yaml\n", checksum, checksum)),
+			},
+		},
+		{
+			name: "pass with matching checksum in binary mode",
+			vars: map[string]string{"infrastructure-p1-verification": "checksum"},
+			files: map[string][]byte{
+				"checksums.txt": []byte(fmt.Sprintf("%s *infrastructure-components.yaml\n", checksum)),
+			},
+		},
+		{
+			name: "fails with a checksum mismatch",
+			vars: map[string]string{"infrastructure-p1-verification": "checksum"},
+			files: map[string][]byte{
+				"checksums.txt": []byte("0000  infrastructure-components.yaml\n"),
+			},
+			wantErr:    true,
+			wantReason: "does not match the checksum",
+		},
+		{
+			name: "fails when the checksum is not published",
+			vars: map[string]string{"infrastructure-p1-verification": "checksum"},
+			files: map[string][]byte{
+				"checksums.txt": []byte(fmt.Sprintf("%s  metadata.yaml\n", checksum)),
+			},
+			wantErr:    true,
+			wantReason: "no checksum for the file",
+		},
+		{
+			name: "pass with matching checksum obtained out of band",
+			vars: map[string]string{
+				"infrastructure-p1-verification":           "checksum",
+				"infrastructure-p1-verification-checksums": checksumsFile,
+			},
+			files: map[string][]byte{
+				// Checksums published in the repository are ignored.
+				"checksums.txt": []byte("0000  infrastructure-components.yaml\n"),
+			},
+		},
+		{
+			name: "fails with a checksum mismatch with checksums obtained out of band",
+			vars: map[string]string{
+				"infrastructure-p1-verification":           "checksum",
+				"infrastructure-p1-verification-checksums": mismatchingChecksumsFile,
+			},
+			files: map[string][]byte{
+				// Checksums published in the repository are ignored.
+				"checksums.txt": []byte(fmt.Sprintf("%s  infrastructure-components.yaml\n", checksum)),
+			},
+			wantErr:    true,
+			wantReason: "does not match the checksum",
+		},
+		{
+			name: "fails when the checksums file obtained out of band is missing",
+			vars: map[string]string{
+				"infrastructure-p1-verification":           "checksum",
+				"infrastructure-p1-verification-checksums": filepath.Join(t.TempDir(), "missing.txt"),
+			},
+			wantErr: true,
+		},
+		{
+			name:       "fails when the checksums file is missing",
+			vars:       map[string]string{"infrastructure-p1-verification": "checksum"},
+			wantErr:    true,
+			wantReason: "failed to read checksums.txt",
+		},
+		{
+			name: "pass with a valid ECDSA signature",
+			vars: map[string]string{
+				"infrastructure-p1-verification":            "signature",
+				"infrastructure-p1-verification-public-key": ecdsaPublicKey,
+			},
+			files: map[string][]byte{
+				"infrastructure-components.yaml.sig": []byte(base64.StdEncoding.EncodeToString(ecdsaSignature)),
+			},
+		},
+		{
+			name: "pass with a valid ECDSA signature and a public key file",
+			vars: map[string]string{
+				"infrastructure-p1-verification":            "signature",
+				"infrastructure-p1-verification-public-key": ecdsaPublicKeyFile,
+			},
+			files: map[string][]byte{
+				"infrastructure-components.yaml.sig": []byte(base64.StdEncoding.EncodeToString(ecdsaSignature)),
+			},
+		},
+		{
+			name: "pass with a valid raw ED25519 signature",
+			vars: map[string]string{
+				"infrastructure-p1-verification":            "signature",
+				"infrastructure-p1-verification-public-key": publicKeyPEM(t, ed25519PublicKey),
+			},
+			files: map[string][]byte{
+				"infrastructure-components.yaml.sig": ed25519Signature,
+			},
+		},
+		{
+			name: "fails with a signature not matching the public key",
+			vars: map[string]string{
+				"infrastructure-p1-verification":            "signature",
+				"infrastructure-p1-verification-public-key": publicKeyPEM(t, ed25519PublicKey),
+			},
+			files: map[string][]byte{
+				"infrastructure-components.yaml.sig": []byte(base64.StdEncoding.EncodeToString(ecdsaSignature)),
+			},
+			wantErr:    true,
+			wantReason: "does not match the public key",
+		},
+		{
+			name: "fails when the signature is missing",
+			vars: map[string]string{
+				"infrastructure-p1-verification":            "signature",
+				"infrastructure-p1-verification-public-key": ecdsaPublicKey,
+			},
+			wantErr:    true,
+			wantReason: "failed to read infrastructure-components.yaml.sig",
+		},
+		{
+			name: "fails when the public key is not set",
+			vars: map[string]string{
+				"infrastructure-p1-verification": "signature",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			repository := NewMemoryRepository().
+				WithPaths("root", "infrastructure-components.yaml").
+				WithDefaultVersion("v1.0.0").
+				WithFile("v1.0.0", "infrastructure-components.yaml", components)
+			for name, content := range tt.files {
+				repository.WithFile("v1.0.0", name, content)
+			}
+			variables := test.NewFakeVariableClient()
+			for k, v := range tt.vars {
+				variables.WithVar(k, v)
+			}
+
+			verifier, err := newArtifactVerifier(provider, repository, variables)
+			if err == nil {
+				err = verifier.verify(context.Background(), "v1.0.0", "infrastructure-components.yaml", components)
+			}
+			if !tt.wantErr {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(HaveOccurred())
+			if tt.wantReason != "" {
+				var verificationErr *VerificationError
+				g.Expect(errors.As(err, &verificationErr)).To(BeTrue())
+				g.Expect(verificationErr.Provider).To(Equal("infrastructure-p1"))
+				g.Expect(verificationErr.File).To(Equal("infrastructure-components.yaml"))
+				g.Expect(verificationErr.Reason).To(ContainSubstring(tt.wantReason))
+			}
+		})
+	}
+}
+
+func Test_componentsClient_Get_Verification(t *testing.T) {
+	g := NewWithT(t)
+
+	repository := NewMemoryRepository().
+		WithPaths("root", "components.yaml").
+		WithDefaultVersion("v1.0.0").
+		WithFile("v1.0.0", "components.yaml", []byte("components")).
+		WithFile("v1.0.0", "checksums.txt", []byte("0000  components.yaml\n"))
+
+	configClient, err := config.New(context.Background(), "", config.InjectReader(test.NewFakeReader().
+		WithVar("infrastructure-p1-verification", "checksum")))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = newComponentsClient(config.NewProvider("p1", "", clusterctlv1.InfrastructureProviderType), repository, configClient).
+		Get(context.Background(), ComponentsOptions{Version: "v1.0.0", TargetNamespace: "ns1"})
+	g.Expect(err).To(HaveOccurred())
+
+	var verificationErr *VerificationError
+	g.Expect(errors.As(err, &verificationErr)).To(BeTrue())
+	g.Expect(verificationErr.Policy).To(Equal(VerificationPolicyChecksum))
+}
+
+func TestProviderRejectedError(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ProviderRejectedError(errors.New("not found"))).ToNot(HaveOccurred())
+
+	err := ProviderRejectedError(errors.Wrap(&VerificationError{
+		Provider: "infrastructure-p1",
+		Version:  "v1.0.0",
+		File:     "infrastructure-components.yaml",
+		Policy:   VerificationPolicyChecksum,
+		Reason:   "no checksum for the file is published in checksums.txt",
+	}, "failed to get components"))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(And(
+		ContainSubstring("provider infrastructure-p1 version v1.0.0 has been rejected"),
+		ContainSubstring(`"infrastructure-p1-verification"`),
+	))
+}
+
+func publicKeyPEM(t *testing.T, publicKey interface{}) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
 	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
@@ -69,6 +70,37 @@ type RolloutResumeOptions struct {
 	Namespace string
 }
 
+// RolloutUndoOptions carries the options supported by RolloutUndo.
+type RolloutUndoOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Resources for the rollout command
+	Resources []string
+
+	// Namespace where the resource(s) live. If unspecified, the namespace name will be inferred
+	// from the current configuration.
+	Namespace string
+
+	// ToRevision is the revision to roll back to. If 0, the resource(s) are rolled back to the previous revision.
+	ToRevision int64
+}
+
+// RolloutHistoryOptions carries the options supported by RolloutHistory.
+type RolloutHistoryOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Resource for the rollout command
+	Resource string
+
+	// Namespace where the resource lives. If unspecified, the namespace name will be inferred
+	// from the current configuration.
+	Namespace string
+}
+
 func (c *clusterctlClient) RolloutRestart(ctx context.Context, options RolloutRestartOptions) error {
 	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
 	if err != nil {
@@ -120,6 +152,46 @@ func (c *clusterctlClient) RolloutResume(ctx context.Context, options RolloutRes
 	return nil
 }
 
+func (c *clusterctlClient) RolloutUndo(ctx context.Context, options RolloutUndoOptions) error {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return err
+	}
+	objRefs, err := getObjectRefs(clusterClient, options.Namespace, options.Resources)
+	if err != nil {
+		return err
+	}
+	for _, ref := range objRefs {
+		if err := c.alphaClient.Rollout().ObjectRollbacker(ctx, clusterClient.Proxy(), ref, options.ToRevision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *clusterctlClient) RolloutHistory(ctx context.Context, options RolloutHistoryOptions) ([]RolloutRevision, error) {
+	clusterClient, err := c.clusterClientFactory(ClusterClientFactoryInput{Kubeconfig: options.Kubeconfig})
+	if err != nil {
+		return nil, err
+	}
+	objRefs, err := getObjectRefs(clusterClient, options.Namespace, []string{options.Resource})
+	if err != nil {
+		return nil, err
+	}
+	if len(objRefs) != 1 {
+		return nil, errors.New("only one resource can be specified")
+	}
+	revisions, err := c.alphaClient.Rollout().ObjectHistoryViewer(ctx, clusterClient.Proxy(), objRefs[0])
+	if err != nil {
+		return nil, err
+	}
+	history := make([]RolloutRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		history = append(history, RolloutRevision(revision))
+	}
+	return history, nil
+}
+
 func getObjectRefs(clusterClient cluster.Client, namespace string, resources []string) ([]corev1.ObjectReference, error) {
 	// If the option specifying the Namespace is empty, try to detect it.
 	if namespace == "" {
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+)
+
+// TopologyPlanOptions define options for TopologyPlan.
+type TopologyPlanOptions struct {
+	// Kubeconfig defines the kubeconfig to use for accessing the management cluster. If empty,
+	// default rules for kubeconfig discovery will be used.
+	Kubeconfig Kubeconfig
+
+	// Objs is the list of new or modified objects, e.g. ClusterClasses, templates or Clusters.
+	Objs []*unstructured.Unstructured
+
+	// Cluster restricts the plan to the Cluster with this name.
+	Cluster string
+
+	// Namespace restricts the plan to Clusters in this namespace; it is also used
+	// as a namespace for objects without a namespace.
+	Namespace string
+}
+
+func (c *clusterctlClient) TopologyPlan(ctx context.Context, options TopologyPlanOptions) (*TopologyPlanOutput, error) {
+	clusterClient, err := c.getClusterClient(ctx, options.Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := clusterClient.Topology().Plan(ctx, &cluster.TopologyPlanInput{
+		Objs:              options.Objs,
+		TargetClusterName: options.Cluster,
+		TargetNamespace:   options.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return (*TopologyPlanOutput)(out), nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type backupCreateOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	file              string
+}
+
+var bc = &backupCreateOptions{}
+
+var backupCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a backup of the Cluster API objects in a management cluster",
+	Long: templates.LongDesc(`
+		Create a backup of the Cluster API objects in a management cluster.
+
+		The backup includes all the Cluster API objects, the related secrets and the provider inventory,
+		and it is written to a versioned archive together with a manifest describing its content.
+
+		Differently from move, Clusters are not paused while taking the backup.`),
+
+	Example: templates.Examples(`
+		# Create a backup of all the Cluster API objects in the management cluster.
+		clusterctl backup create --file=backup.tar.gz
+
+		# Create a backup of the Cluster API objects in the foo namespace.
+		clusterctl backup create --namespace=foo --file=backup.tar.gz`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBackupCreate()
+	},
+}
+
+func init() {
+	backupCreateCmd.Flags().StringVar(&bc.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If empty, default discovery rules apply.")
+	backupCreateCmd.Flags().StringVar(&bc.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	backupCreateCmd.Flags().StringVarP(&bc.namespace, "namespace", "n", "",
+		"The namespace where the objects to back up exist. If unspecified, objects from all the namespaces are backed up.")
+	backupCreateCmd.Flags().StringVar(&bc.file, "file", "",
+		"Path of the backup archive to write.")
+	_ = backupCreateCmd.MarkFlagRequired("file")
+}
+
+func runBackupCreate() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	return c.BackupCreate(ctx, client.BackupCreateOptions{
+		Kubeconfig: client.Kubeconfig{Path: bc.kubeconfig, Context: bc.kubeconfigContext},
+		Namespace:  bc.namespace,
+		File:       bc.file,
+	})
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type backupRestoreOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	file              string
+}
+
+var br = &backupRestoreOptions{}
+
+var backupRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore a backup of the Cluster API objects into a management cluster",
+	Long: templates.LongDesc(`
+		Restore a backup of the Cluster API objects into a management cluster.
+
+		Before restoring, the Cluster API contract and the providers recorded in the backup are checked
+		against the provider inventory of the management cluster; all the providers in the backup
+		must be installed with the same or a newer version.
+
+		Objects are then recreated together with their status; objects already existing in the
+		management cluster are left untouched.`),
+
+	Example: templates.Examples(`
+		# Restore a backup into the management cluster.
+		clusterctl backup restore --file=backup.tar.gz`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runBackupRestore()
+	},
+}
+
+func init() {
+	backupRestoreCmd.Flags().StringVar(&br.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If empty, default discovery rules apply.")
+	backupRestoreCmd.Flags().StringVar(&br.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	backupRestoreCmd.Flags().StringVar(&br.file, "file", "",
+		"Path of the backup archive to restore.")
+	_ = backupRestoreCmd.MarkFlagRequired("file")
+}
+
+func runBackupRestore() error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	return c.BackupRestore(ctx, client.BackupRestoreOptions{
+		Kubeconfig: client.Kubeconfig{Path: br.kubeconfig, Context: br.kubeconfigContext},
+		File:       br.file,
+	})
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+type drainSimulateOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+}
+
+var ds = &drainSimulateOptions{}
+
+var drainSimulateCmd = &cobra.Command{
+	Use:   "simulate MACHINE...",
+	Short: "Simulate the drain of the Nodes of a set of Machines",
+	Long: templates.LongDesc(`
+		Simulate the drain of the Nodes of a set of Machines, without cordoning Nodes or evicting Pods.
+
+		The command prints the Pods which would be evicted, the Pods the drain would wait for,
+		and the Pods which would be ignored, taking into account MachineDrainRules.
+
+		It also checks if the Pods which would be evicted can be rescheduled on the remaining schedulable
+		and ready Nodes of the Cluster, by comparing CPU, memory and Pod count requested by the Pods with the
+		resources available on the Nodes; other scheduling constraints like affinity, taints or topology spread
+		constraints are not considered.`),
+
+	Example: templates.Examples(`
+		# Simulate the drain of the Node of a Machine.
+		clusterctl alpha drain simulate my-machine
+
+		# Simulate the drain of the Nodes of multiple Machines of a Cluster in the foo namespace.
+		clusterctl alpha drain simulate my-machine-1 my-machine-2 --namespace foo`),
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(_ *cobra.Command, args []string) error {
+		return runDrainSimulate(args)
+	},
+}
+
+func init() {
+	drainSimulateCmd.Flags().StringVar(&ds.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If empty, default discovery rules apply.")
+	drainSimulateCmd.Flags().StringVar(&ds.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	drainSimulateCmd.Flags().StringVarP(&ds.namespace, "namespace", "n", "",
+		"Namespace where the Machines reside. If unspecified, the current namespace will be used.")
+}
+
+func runDrainSimulate(machines []string) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.DrainSimulate(ctx, client.DrainSimulateOptions{
+		Kubeconfig: client.Kubeconfig{Path: ds.kubeconfig, Context: ds.kubeconfigContext},
+		Machines:   machines,
+		Namespace:  ds.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printDrainSimulation(os.Stdout, out)
+}
+
+func printDrainSimulation(w io.Writer, out *client.DrainSimulationOutput) error {
+	if len(out.PodsToEvict)+len(out.PodsToWaitCompleted)+len(out.PodsTerminating)+len(out.PodsIgnored) == 0 {
+		fmt.Fprintln(w, "No Pods are running on the Nodes to drain.")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "NAMESPACE\tNAME\tNODE\tDRAIN\tRESCHEDULABLE")
+	for _, p := range out.PodsToEvict {
+		reschedulable := "yes"
+		if !p.Reschedulable {
+			reschedulable = "no"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\tevict\t%s\n", p.Namespace, p.Name, p.NodeName, reschedulable)
+	}
+	for _, p := range out.PodsToWaitCompleted {
+		fmt.Fprintf(tw, "%s\t%s\t%s\twait completed\t-\n", p.Namespace, p.Name, p.NodeName)
+	}
+	for _, p := range out.PodsTerminating {
+		fmt.Fprintf(tw, "%s\t%s\t%s\twait terminating\t-\n", p.Namespace, p.Name, p.NodeName)
+	}
+	for _, p := range out.PodsIgnored {
+		fmt.Fprintf(tw, "%s\t%s\t%s\tskip\t-\n", p.Namespace, p.Name, p.NodeName)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+
+	if len(out.PodsToEvict) == 0 {
+		return nil
+	}
+	podsNotReschedulable := out.PodsNotReschedulable()
+	if len(podsNotReschedulable) == 0 {
+		fmt.Fprintf(w, "All the Pods to evict can be rescheduled on the remaining Nodes: %s.\n", strings.Join(out.RemainingNodes, ", "))
+		return nil
+	}
+	remainingNodes := "no schedulable and ready Nodes remaining"
+	if len(out.RemainingNodes) > 0 {
+		remainingNodes = fmt.Sprintf("remaining Nodes: %s", strings.Join(out.RemainingNodes, ", "))
+	}
+	fmt.Fprintf(w, "%d of %d Pods to evict cannot be rescheduled because of insufficient CPU, memory or Pod capacity (%s).\n",
+		len(podsNotReschedulable), len(out.PodsToEvict), remainingNodes)
+	return nil
+}
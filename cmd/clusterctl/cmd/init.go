@@ -64,6 +64,11 @@ var initCmd = &cobra.Command{
 		Refer to the provider documentation, or use 'clusterctl generate provider --infrastructure [name] --describe'
 		to get a list of required variables.
 
+		Provider artifacts can be verified before being installed by setting a verification policy for each
+		provider in the clusterctl configuration. Note that the checksum policy with the checksums published
+		in the provider repository verifies integrity only, not authenticity; use checksums obtained out of band
+		or the signature policy to verify authenticity.
+
 		See https://cluster-api.sigs.k8s.io for more details.`),
 
 	Example: templates.Examples(`
@@ -43,7 +43,15 @@ var (
 
 		# Resume an already paused machinedeployment or kubeadmcontrolplane
 		clusterctl alpha rollout resume machinedeployment/my-md-0
-		clusterctl alpha rollout resume kubeadmcontrolplane/my-kcp`)
+		clusterctl alpha rollout resume kubeadmcontrolplane/my-kcp
+
+		# View the rollout history of a machinedeployment or kubeadmcontrolplane
+		clusterctl alpha rollout history machinedeployment/my-md-0
+		clusterctl alpha rollout history kubeadmcontrolplane/my-kcp
+
+		# Roll back a machinedeployment or kubeadmcontrolplane to the previous revision
+		clusterctl alpha rollout undo machinedeployment/my-md-0
+		clusterctl alpha rollout undo kubeadmcontrolplane/my-kcp`)
 
 	rolloutCmd = &cobra.Command{
 		Use:     "rollout SUBCOMMAND",
@@ -58,4 +66,6 @@ func init() {
 	rolloutCmd.AddCommand(rollout.NewCmdRolloutRestart(cfgFile))
 	rolloutCmd.AddCommand(rollout.NewCmdRolloutPause(cfgFile))
 	rolloutCmd.AddCommand(rollout.NewCmdRolloutResume(cfgFile))
+	rolloutCmd.AddCommand(rollout.NewCmdRolloutUndo(cfgFile))
+	rolloutCmd.AddCommand(rollout.NewCmdRolloutHistory(cfgFile))
 }
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+)
+
+// historyOptions is the start of the data required to perform the operation.
+type historyOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	namespace         string
+	revision          int64
+}
+
+var historyOpt = &historyOptions{}
+
+var (
+	historyLong = templates.LongDesc(`
+		View the rollout history of a cluster-api resource.
+
+	        The revisions of MachineDeployments are computed from their MachineSets; the revisions of KubeadmControlPlanes are computed from the Kubernetes version, infrastructure machine template and ClusterConfiguration recorded on their existing Machines. Use --revision to show the changes of a revision compared to the previous one.`)
+
+	historyExample = templates.Examples(`
+		# View the rollout history of a machinedeployment
+		clusterctl alpha rollout history machinedeployment/my-md-0
+
+		# View the changes of revision 3 of a machinedeployment
+		clusterctl alpha rollout history machinedeployment/my-md-0 --revision=3
+
+		# View the rollout history of a kubeadmcontrolplane
+		clusterctl alpha rollout history kubeadmcontrolplane/my-kcp`)
+)
+
+// NewCmdRolloutHistory returns a Command instance for 'rollout history' sub command.
+func NewCmdRolloutHistory(cfgFile string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "history RESOURCE",
+		DisableFlagsInUseLine: true,
+		Short:                 "View the rollout history of a cluster-api resource",
+		Long:                  historyLong,
+		Example:               historyExample,
+		Args:                  cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runHistory(cfgFile, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&historyOpt.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If unspecified, default discovery rules apply.")
+	cmd.Flags().StringVar(&historyOpt.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	cmd.Flags().StringVarP(&historyOpt.namespace, "namespace", "n", "", "Namespace where the resource resides. If unspecified, the defult namespace will be used.")
+	cmd.Flags().Int64Var(&historyOpt.revision, "revision", historyOpt.revision, "Show the changes of this revision compared to the previous revision.")
+
+	return cmd
+}
+
+func runHistory(cfgFile, resource string) error {
+	ctx := context.Background()
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	history, err := c.RolloutHistory(ctx, client.RolloutHistoryOptions{
+		Kubeconfig: client.Kubeconfig{Path: historyOpt.kubeconfig, Context: historyOpt.kubeconfigContext},
+		Namespace:  historyOpt.namespace,
+		Resource:   resource,
+	})
+	if err != nil {
+		return err
+	}
+
+	if historyOpt.revision > 0 {
+		return printRolloutRevision(os.Stdout, history, historyOpt.revision)
+	}
+	return printRolloutHistory(os.Stdout, history)
+}
+
+func printRolloutHistory(w io.Writer, history []client.RolloutRevision) error {
+	if len(history) == 0 {
+		fmt.Fprintln(w, "No rollout history found.")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(tw, "REVISION\tNAME\tMACHINES\tAGE\tCURRENT")
+	for _, revision := range history {
+		current := ""
+		if revision.Current {
+			current = "*"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%s\t%s\n", revision.Revision, revision.Name, revision.Machines, duration.HumanDuration(time.Since(revision.CreationTimestamp.Time)), current)
+	}
+	return tw.Flush()
+}
+
+func printRolloutRevision(w io.Writer, history []client.RolloutRevision, revisionNumber int64) error {
+	for i, revision := range history {
+		if revision.Revision != revisionNumber {
+			continue
+		}
+		fmt.Fprintf(w, "Revision %d (%s)\n", revision.Revision, revision.Name)
+		switch {
+		case i == 0:
+			fmt.Fprintln(w, "No previous revision found.")
+		case revision.Diff == "":
+			fmt.Fprintf(w, "No changes compared to revision %d.\n", history[i-1].Revision)
+		default:
+			fmt.Fprintf(w, "Changes compared to revision %d:\n%s\n", history[i-1].Revision, revision.Diff)
+		}
+		return nil
+	}
+	return errors.Errorf("unable to find revision %d", revisionNumber)
+}
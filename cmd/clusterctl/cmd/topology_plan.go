@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/cluster"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/cmd/internal/templates"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+type topologyPlanOptions struct {
+	kubeconfig        string
+	kubeconfigContext string
+	files             []string
+	cluster           string
+	namespace         string
+}
+
+var tp = &topologyPlanOptions{}
+
+var topologyPlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Preview the changes to Clusters with a managed topology before applying new or modified ClusterClasses, templates or Clusters",
+	Long: templates.LongDesc(`
+		Preview the changes to Clusters with a managed topology before applying new or modified ClusterClasses,
+		templates or Clusters.
+
+		The desired state of all the Clusters affected by the input objects is computed using the input objects
+		in place of the corresponding objects in the management cluster, and it is compared with the current state
+		of the Clusters, without applying any change.
+
+		For each Cluster, the command prints the objects which would be created, modified or deleted, the diff
+		for each modified object, and the MachineDeployments and control plane which would roll out machines.
+
+		External patches are computed by calling the Runtime Extensions registered in the management cluster,
+		if any; in this case Runtime Extensions must be reachable from the machine where clusterctl runs.`),
+
+	Example: templates.Examples(`
+		# Preview the changes to the Clusters using a modified ClusterClass.
+		clusterctl topology plan -f clusterclass.yaml
+
+		# Preview the changes to a Cluster after changing its topology.
+		clusterctl topology plan -f cluster.yaml
+
+		# Preview the changes to the Cluster foo in the bar namespace after modifying a ClusterClass and its templates.
+		clusterctl topology plan -f clusterclass.yaml -f templates.yaml --cluster foo --namespace bar`),
+	Args: cobra.NoArgs,
+	RunE: func(*cobra.Command, []string) error {
+		return runTopologyPlan()
+	},
+}
+
+func init() {
+	topologyPlanCmd.Flags().StringVar(&tp.kubeconfig, "kubeconfig", "",
+		"Path to the kubeconfig file to use for accessing the management cluster. If empty, default discovery rules apply.")
+	topologyPlanCmd.Flags().StringVar(&tp.kubeconfigContext, "kubeconfig-context", "",
+		"Context to be used within the kubeconfig file. If empty, current context will be used.")
+	topologyPlanCmd.Flags().StringArrayVarP(&tp.files, "file", "f", nil,
+		"Path to a YAML file with new or modified ClusterClasses, templates or Clusters. Can be repeated.")
+	topologyPlanCmd.Flags().StringVar(&tp.cluster, "cluster", "",
+		"Name of the Cluster to preview changes for. If empty, changes are previewed for all the affected Clusters.")
+	topologyPlanCmd.Flags().StringVarP(&tp.namespace, "namespace", "n", "",
+		"Namespace of the Clusters to preview changes for, also used for input objects without a namespace. If empty, Clusters in all the namespaces are considered.")
+	_ = topologyPlanCmd.MarkFlagRequired("file")
+}
+
+func runTopologyPlan() error {
+	ctx := context.Background()
+
+	objs := []*unstructured.Unstructured{}
+	for _, f := range tp.files {
+		raw, err := os.ReadFile(f) //nolint:gosec
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", f)
+		}
+		fileObjs, err := utilyaml.ToUnstructured(raw)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse %s", f)
+		}
+		for i := range fileObjs {
+			objs = append(objs, &fileObjs[i])
+		}
+	}
+
+	c, err := client.New(ctx, cfgFile)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.TopologyPlan(ctx, client.TopologyPlanOptions{
+		Kubeconfig: client.Kubeconfig{Path: tp.kubeconfig, Context: tp.kubeconfigContext},
+		Objs:       objs,
+		Cluster:    tp.cluster,
+		Namespace:  tp.namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	return printTopologyPlan(os.Stdout, out)
+}
+
+func printTopologyPlan(w io.Writer, out *client.TopologyPlanOutput) error {
+	if len(out.Clusters) == 0 {
+		fmt.Fprintln(w, "No Clusters with a managed topology are affected by the input objects.")
+		return nil
+	}
+
+	for _, plan := range out.Clusters {
+		fmt.Fprintf(w, "Cluster %s/%s:\n", plan.Cluster.Namespace, plan.Cluster.Name)
+		if len(plan.Created) == 0 && len(plan.Modified) == 0 && len(plan.Deleted) == 0 {
+			fmt.Fprint(w, "  No changes.\n\n")
+			continue
+		}
+
+		if plan.ControlPlaneRollout {
+			fmt.Fprintln(w, "  The control plane would roll out machines.")
+		}
+		if len(plan.MachineDeploymentRollouts) > 0 {
+			fmt.Fprintf(w, "  The following MachineDeployments would roll out machines: %s.\n", strings.Join(plan.MachineDeploymentRollouts, ", "))
+		}
+		fmt.Fprintln(w)
+
+		tw := tabwriter.NewWriter(w, 10, 4, 3, ' ', 0)
+		fmt.Fprintln(tw, "  CHANGE\tKIND\tNAMESPACE\tNAME")
+		for _, o := range plan.Created {
+			fmt.Fprintf(tw, "  created\t%s\t%s\t%s\n", o.GetKind(), o.GetNamespace(), o.GetName())
+		}
+		for _, o := range plan.Modified {
+			fmt.Fprintf(tw, "  modified\t%s\t%s\t%s\n", o.Object.GetKind(), o.Object.GetNamespace(), o.Object.GetName())
+		}
+		for _, o := range plan.Deleted {
+			fmt.Fprintf(tw, "  deleted\t%s\t%s\t%s\n", o.GetKind(), o.GetNamespace(), o.GetName())
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+
+		if err := printTopologyPlanDiffs(w, plan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printTopologyPlanDiffs(w io.Writer, plan *cluster.ClusterTopologyPlan) error {
+	for _, o := range plan.Modified {
+		if len(o.Diff) == 0 {
+			continue
+		}
+		diff, err := yaml.JSONToYAML(o.Diff)
+		if err != nil {
+			return errors.Wrapf(err, "failed to convert the diff for %s %s/%s to YAML", o.Object.GetKind(), o.Object.GetNamespace(), o.Object.GetName())
+		}
+		fmt.Fprintf(w, "  Diff for %s %s/%s:\n", o.Object.GetKind(), o.Object.GetNamespace(), o.Object.GetName())
+		for _, line := range strings.Split(strings.TrimSuffix(string(diff), "\n"), "\n") {
+			fmt.Fprintf(w, "    %s\n", line)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
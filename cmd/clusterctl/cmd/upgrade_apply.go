@@ -54,6 +54,10 @@ var upgradeApplyCmd = &cobra.Command{
 		New version should be applied ensuring all the providers uses the same cluster API version
 		in order to guarantee the proper functioning of the management cluster.
 
+		Provider artifacts are verified according to the verification policy set for each provider in the clusterctl
+		configuration, if any. Note that the checksum policy with the checksums published in the provider repository
+		verifies integrity only, not authenticity; use checksums obtained out of band or the signature policy to verify authenticity.
+
  		Specifying the provider using namespace/name:version is deprecated and will be dropped in a future release.`),
 	Example: templates.Examples(`
 		# Upgrades all the providers in the management cluster to the latest version available which is compliant
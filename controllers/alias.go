@@ -74,6 +74,9 @@ type MachineReconciler struct {
 	APIReader    client.Reader
 	ClusterCache clustercache.ClusterCache
 
+	// RuntimeClient is a client for calling runtime extensions.
+	RuntimeClient runtimeclient.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
@@ -88,6 +91,7 @@ func (r *MachineReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manag
 		Client:                           r.Client,
 		APIReader:                        r.APIReader,
 		ClusterCache:                     r.ClusterCache,
+		RuntimeClient:                    r.RuntimeClient,
 		WatchFilterValue:                 r.WatchFilterValue,
 		RemoteConditionsGracePeriod:      r.RemoteConditionsGracePeriod,
 		AdditionalSyncMachineLabels:      r.AdditionalSyncMachineLabels,
@@ -103,6 +107,9 @@ type MachineSetReconciler struct {
 
 	PreflightChecks sets.Set[clusterv1.MachineSetPreflightCheck]
 
+	// RuntimeClient is a client for calling runtime extensions.
+	RuntimeClient runtimeclient.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -113,14 +120,16 @@ func (r *MachineSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Ma
 		APIReader:        r.APIReader,
 		ClusterCache:     r.ClusterCache,
 		PreflightChecks:  r.PreflightChecks,
+		RuntimeClient:    r.RuntimeClient,
 		WatchFilterValue: r.WatchFilterValue,
 	}).SetupWithManager(ctx, mgr, options)
 }
 
 // MachineDeploymentReconciler reconciles a MachineDeployment object.
 type MachineDeploymentReconciler struct {
-	Client    client.Client
-	APIReader client.Reader
+	Client       client.Client
+	APIReader    client.Reader
+	ClusterCache clustercache.ClusterCache
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
@@ -130,6 +139,7 @@ func (r *MachineDeploymentReconciler) SetupWithManager(ctx context.Context, mgr
 	return (&machinedeploymentcontroller.Reconciler{
 		Client:           r.Client,
 		APIReader:        r.APIReader,
+		ClusterCache:     r.ClusterCache,
 		WatchFilterValue: r.WatchFilterValue,
 	}).SetupWithManager(ctx, mgr, options)
 }
@@ -255,13 +265,23 @@ type ClusterResourceSetReconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// DriftCorrectionInterval is the interval at which the objects of "Reconcile" ClusterResourceSets
+	// are re-applied to correct out-of-band changes. Drift correction is disabled if it is 0.
+	DriftCorrectionInterval time.Duration
+
+	// Renderer renders the Helm charts and the kustomizations referenced by ClusterResourceSets.
+	// Resources of these kinds fail to be applied if it is nil.
+	Renderer clusterresourceset.Renderer
 }
 
 func (r *ClusterResourceSetReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options, partialSecretCache cache.Cache) error {
 	return (&clusterresourceset.Reconciler{
-		Client:           r.Client,
-		ClusterCache:     r.ClusterCache,
-		WatchFilterValue: r.WatchFilterValue,
+		Client:                  r.Client,
+		ClusterCache:            r.ClusterCache,
+		WatchFilterValue:        r.WatchFilterValue,
+		DriftCorrectionInterval: r.DriftCorrectionInterval,
+		Renderer:                r.Renderer,
 	}).SetupWithManager(ctx, mgr, options, partialSecretCache)
 }
 
@@ -26,12 +26,15 @@ import (
 
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	kubeadmcontrolplanecontrollers "sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/controllers"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd/snapshot"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 )
 
 // KubeadmControlPlaneReconciler reconciles a KubeadmControlPlane object.
 type KubeadmControlPlaneReconciler struct {
 	Client              client.Client
 	SecretCachingClient client.Client
+	RuntimeClient       runtimeclient.Client
 	ClusterCache        clustercache.ClusterCache
 
 	EtcdDialTimeout time.Duration
@@ -41,17 +44,34 @@ type KubeadmControlPlaneReconciler struct {
 	WatchFilterValue string
 
 	RemoteConditionsGracePeriod time.Duration
+
+	// EtcdSnapshotDir is the directory where etcd snapshots are stored; if not set, etcd snapshots and restores are disabled.
+	EtcdSnapshotDir string
+
+	// EtcdSnapshotDownloadURL is the base URL EtcdSnapshotDir is served at; if not set, etcd restores are disabled.
+	EtcdSnapshotDownloadURL string
 }
 
 // SetupWithManager sets up the reconciler with the Manager.
 func (r *KubeadmControlPlaneReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	var etcdSnapshotStore snapshot.Store
+	if r.EtcdSnapshotDir != "" {
+		var err error
+		etcdSnapshotStore, err = snapshot.NewFilesystemStore(r.EtcdSnapshotDir, r.EtcdSnapshotDownloadURL)
+		if err != nil {
+			return err
+		}
+	}
+
 	return (&kubeadmcontrolplanecontrollers.KubeadmControlPlaneReconciler{
 		Client:                      r.Client,
 		SecretCachingClient:         r.SecretCachingClient,
+		RuntimeClient:               r.RuntimeClient,
 		ClusterCache:                r.ClusterCache,
 		EtcdDialTimeout:             r.EtcdDialTimeout,
 		EtcdCallTimeout:             r.EtcdCallTimeout,
 		WatchFilterValue:            r.WatchFilterValue,
 		RemoteConditionsGracePeriod: r.RemoteConditionsGracePeriod,
+		EtcdSnapshotStore:           etcdSnapshotStore,
 	}).SetupWithManager(ctx, mgr, options)
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -54,6 +55,10 @@ type ControlPlane struct {
 	machinesNotUptoDateLogMessages       map[string][]string
 	machinesNotUptoDateConditionMessages map[string][]string
 
+	// rolloutMachinesCreatedBefore is set when all the machines created before a given time must be rolled out,
+	// e.g. to pick up a rotated CA.
+	rolloutMachinesCreatedBefore *time.Time
+
 	// reconciliationTime is the time of the current reconciliation, and should be used for all "now" calculations
 	reconciliationTime metav1.Time
 
@@ -265,6 +270,49 @@ func (c *ControlPlane) NotUpToDateMachines() (collections.Machines, map[string][
 	return c.machinesNotUptoDate, c.machinesNotUptoDateConditionMessages
 }
 
+// CanUpdateMachineInPlace returns true if all the changes required to bring a machine up-to-date with the control
+// plane's configuration can be performed in-place.
+func (c *ControlPlane) CanUpdateMachineInPlace(machine *clusterv1.Machine) (bool, error) {
+	// Machines which must be rolled out anyway are not eligible for an in-place update.
+	if c.rolloutMachinesCreatedBefore != nil && machine.CreationTimestamp.Time.Before(*c.rolloutMachinesCreatedBefore) {
+		return false, nil
+	}
+	return CanUpdateInPlace(machine, c.KCP, &c.reconciliationTime, c.InfraResources, c.KubeadmConfigs)
+}
+
+// CanRenewMachineCertificatesInPlace returns true if a machine must be rolled out only because its certificates
+// are about to expire, and thus its certificates can be renewed in-place instead.
+func (c *ControlPlane) CanRenewMachineCertificatesInPlace(machine *clusterv1.Machine) (bool, error) {
+	// Machines which must be rolled out anyway are not eligible for an in-place renewal.
+	if c.rolloutMachinesCreatedBefore != nil && machine.CreationTimestamp.Time.Before(*c.rolloutMachinesCreatedBefore) {
+		return false, nil
+	}
+	return CanRenewCertificatesInPlace(machine, c.KCP, &c.reconciliationTime, c.InfraResources, c.KubeadmConfigs)
+}
+
+// RolloutMachinesCreatedBefore marks all the machines created before the given time as not up-to-date,
+// so they are rolled out, e.g. to pick up a rotated CA.
+func (c *ControlPlane) RolloutMachinesCreatedBefore(t time.Time, logMessage, conditionMessage string) {
+	c.rolloutMachinesCreatedBefore = &t
+	if c.machinesNotUptoDate == nil {
+		c.machinesNotUptoDate = collections.Machines{}
+	}
+	if c.machinesNotUptoDateLogMessages == nil {
+		c.machinesNotUptoDateLogMessages = map[string][]string{}
+	}
+	if c.machinesNotUptoDateConditionMessages == nil {
+		c.machinesNotUptoDateConditionMessages = map[string][]string{}
+	}
+	for _, m := range c.Machines {
+		if !m.CreationTimestamp.Time.Before(t) {
+			continue
+		}
+		c.machinesNotUptoDate.Insert(m)
+		c.machinesNotUptoDateLogMessages[m.Name] = append(c.machinesNotUptoDateLogMessages[m.Name], logMessage)
+		c.machinesNotUptoDateConditionMessages[m.Name] = append(c.machinesNotUptoDateConditionMessages[m.Name], conditionMessage)
+	}
+}
+
 // UpToDateMachines returns the machines that are up to date with the control
 // plane's configuration.
 func (c *ControlPlane) UpToDateMachines() collections.Machines {
@@ -359,6 +407,8 @@ func (c *ControlPlane) PatchMachines(ctx context.Context) error {
 				controlplanev1.KubeadmControlPlaneMachineSchedulerPodHealthyCondition,
 				controlplanev1.KubeadmControlPlaneMachineEtcdPodHealthyCondition,
 				controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition,
+				controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+				controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
 			}}); err != nil {
 				errList = append(errList, err)
 			}
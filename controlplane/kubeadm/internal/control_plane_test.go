@@ -19,6 +19,7 @@ package internal
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
@@ -288,6 +289,35 @@ func TestHasHealthyMachineStillProvisioning(t *testing.T) {
 	})
 }
 
+func TestRolloutMachinesCreatedBefore(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	oldMachine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "old", CreationTimestamp: metav1.Time{Time: now.Add(-1 * time.Hour)}}}
+	newMachine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: metav1.Time{Time: now.Add(time.Hour)}}}
+
+	c := ControlPlane{
+		KCP:      &controlplanev1.KubeadmControlPlane{},
+		Machines: collections.FromMachines(oldMachine, newMachine),
+	}
+	c.RolloutMachinesCreatedBefore(now, "cluster CA rotation", "Cluster CA rotation in progress")
+
+	machinesNeedingRollout, logMessages := c.MachinesNeedingRollout()
+	g.Expect(machinesNeedingRollout.Names()).To(ConsistOf("old"))
+	g.Expect(logMessages).To(HaveKeyWithValue("old", []string{"cluster CA rotation"}))
+	_, conditionMessages := c.NotUpToDateMachines()
+	g.Expect(conditionMessages).To(HaveKeyWithValue("old", []string{"Cluster CA rotation in progress"}))
+	g.Expect(c.UpToDateMachines().Names()).To(ConsistOf("new"))
+
+	// Machines which must be rolled out anyway cannot be updated in-place.
+	canUpdateInPlace, err := c.CanUpdateMachineInPlace(oldMachine)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canUpdateInPlace).To(BeFalse())
+	canRenewCertificatesInPlace, err := c.CanRenewMachineCertificatesInPlace(oldMachine)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(canRenewCertificatesInPlace).To(BeFalse())
+}
+
 func TestStatusToLogKeyAndValues(t *testing.T) {
 	healthyMachine := &clusterv1.Machine{
 		ObjectMeta: metav1.ObjectMeta{Name: "healthy"},
@@ -0,0 +1,404 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/kubeconfig"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+// caRotationRequeueAfter is the interval at which the progress of a cluster CA rotation is checked.
+const caRotationRequeueAfter = 1 * time.Minute
+
+// reconcileCertificateAuthorityRotationPhase marks all the control plane machines created before the current phase
+// of a cluster CA rotation, if any, as not up-to-date, so they are rolled out and pick up the CA bundle for this phase.
+func (r *KubeadmControlPlaneReconciler) reconcileCertificateAuthorityRotationPhase(ctx context.Context, controlPlane *internal.ControlPlane) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	caSecret, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, util.ObjectKey(controlPlane.Cluster), secret.ClusterCA)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get cluster CA secret")
+	}
+
+	phase, phaseStarted, err := secret.GetCARotationPhase(caSecret)
+	if err != nil {
+		return err
+	}
+	if phase == "" {
+		return nil
+	}
+
+	controlPlane.RolloutMachinesCreatedBefore(phaseStarted,
+		fmt.Sprintf("cluster CA rotation in phase %s started at %s", phase, phaseStarted.Format(time.RFC3339)),
+		"Cluster CA rotation in progress")
+
+	// Make sure machines joining the cluster during this phase trust the CA bundle for this phase.
+	// NOTE: Failures are not blocking here, because the cluster-info ConfigMap is updated also
+	// before completing the phase, see reconcileCertificateAuthorityRotation.
+	if err := r.syncClusterInfoCertificateAuthority(ctx, controlPlane, caSecret); err != nil {
+		log.V(2).Info("Failed to update cluster-info ConfigMap with the cluster CA bundle, will retry", "cause", err)
+	}
+	return nil
+}
+
+// reconcileCertificateAuthorityRotation starts a cluster CA rotation if requested via the
+// controlplane.cluster.x-k8s.io/rotate-certificate-authority annotation, and advances it to the next phase
+// once all the control plane machines first and then all the worker machines have been rolled out.
+// NOTE: A CA rotation requires the cluster CA secret to be controlled by the KubeadmControlPlane, i.e. user provided
+// CAs are not rotated.
+func (r *KubeadmControlPlaneReconciler) reconcileCertificateAuthorityRotation(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	caSecret, err := secret.GetFromNamespacedName(ctx, r.Client, util.ObjectKey(controlPlane.Cluster), secret.ClusterCA)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to get cluster CA secret")
+	}
+
+	phase, phaseStarted, err := secret.GetCARotationPhase(caSecret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if phase == "" {
+		if _, ok := kcp.Annotations[controlplanev1.RotateCertificateAuthorityAnnotation]; !ok {
+			if conditions.Has(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition) {
+				conditions.Set(kcp, metav1.Condition{
+					Type:   controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition,
+					Status: metav1.ConditionFalse,
+					Reason: controlplanev1.KubeadmControlPlaneCertificateAuthorityNotRotatingReason,
+				})
+			}
+			return ctrl.Result{}, nil
+		}
+
+		if !util.IsControlledBy(caSecret, kcp) {
+			conditions.Set(kcp, metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  controlplanev1.KubeadmControlPlaneCertificateAuthorityRotationNotSupportedReason,
+				Message: "Cluster CA is not managed by KubeadmControlPlane",
+			})
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, "FailedCertificateAuthorityRotation", "Failed to rotate cluster CA: secret %s is not managed by KubeadmControlPlane", caSecret.Name)
+			return ctrl.Result{}, nil
+		}
+
+		log.Info("Starting cluster CA rotation")
+		if err := secret.StartCARotation(caSecret, time.Now()); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to start cluster CA rotation")
+		}
+		if err := r.Client.Update(ctx, caSecret); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to start cluster CA rotation")
+		}
+		// NOTE: The annotation is removed when patching the KubeadmControlPlane at the end of the reconcile.
+		delete(kcp.Annotations, controlplanev1.RotateCertificateAuthorityAnnotation)
+
+		conditions.Set(kcp, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingReason,
+			Message: fmt.Sprintf("Phase %s: waiting for control plane Machines to be rolled out", secret.CARotationPhaseTrust),
+		})
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, "CertificateAuthorityRotationStarted", "Started cluster CA rotation")
+
+		// Requeue to start rolling out machines.
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	// Make sure the cluster-info ConfigMap trusts the CA bundle for this phase.
+	// NOTE: The kubeconfig secret is updated by reconcileKubeconfig.
+	if err := r.syncClusterInfoCertificateAuthority(ctx, controlPlane, caSecret); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to update cluster-info ConfigMap with the cluster CA bundle")
+	}
+
+	// Control plane machines must be rolled out first, so the CA used for signing by the control plane components
+	// is the one for this phase before worker machines join the cluster.
+	// NOTE: The rollout of the control plane machines is performed by the rollout logic, see reconcileCertificateAuthorityRotationPhase.
+	outdatedControlPlaneMachines := controlPlane.Machines.Filter(collections.Not(createdAtOrAfter(phaseStarted)))
+	if int32(len(controlPlane.Machines)) != ptr.Deref(kcp.Spec.Replicas, 0) || controlPlane.HasDeletingMachine() || len(outdatedControlPlaneMachines) > 0 {
+		setCertificateAuthorityRotatingCondition(kcp, phase, "waiting for control plane Machines to be rolled out")
+		return ctrl.Result{RequeueAfter: caRotationRequeueAfter}, nil
+	}
+
+	workers, err := r.getWorkers(ctx, controlPlane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	workersRolloutAfter, ok, err := getCARotationWorkersRolloutAfter(caSecret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !ok {
+		workersRolloutAfter = time.Now().UTC().Truncate(time.Second)
+		caSecret.Annotations[secret.CARotationWorkersRolloutAfterAnnotation] = workersRolloutAfter.Format(time.RFC3339)
+		if err := r.Client.Update(ctx, caSecret); err != nil {
+			return ctrl.Result{}, errors.Wrap(err, "failed to start rollout of worker Machines for cluster CA rotation")
+		}
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, "CertificateAuthorityRotationWorkersRollout", "Rolling out worker Machines for cluster CA rotation phase %s", phase)
+		if message := workers.manualRolloutMessage(workersRolloutAfter); message != "" {
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, "CertificateAuthorityRotationManualRolloutRequired", "Cluster CA rotation phase %s: %s", phase, message)
+		}
+	}
+
+	if err := r.rolloutMachineDeploymentsAfter(ctx, workers.machineDeployments, workersRolloutAfter); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if outdatedWorkerMachines := workers.rolloutable.Filter(collections.Not(createdAtOrAfter(workersRolloutAfter))); len(outdatedWorkerMachines) > 0 {
+		message := fmt.Sprintf("waiting for %d worker Machines to be rolled out", len(outdatedWorkerMachines))
+		if manualRolloutMessage := workers.manualRolloutMessage(workersRolloutAfter); manualRolloutMessage != "" {
+			message = fmt.Sprintf("%s; %s", message, manualRolloutMessage)
+		}
+		setCertificateAuthorityRotatingCondition(kcp, phase, message)
+		return ctrl.Result{RequeueAfter: caRotationRequeueAfter}, nil
+	}
+
+	// Worker machines KCP cannot roll out must be rolled out by the user; wait for them to be replaced, or for the user
+	// to acknowledge the rollout, because KCP cannot tell when MachinePools have been rolled out.
+	if manualRolloutMessage := workers.manualRolloutMessage(workersRolloutAfter); manualRolloutMessage != "" {
+		if kcp.Annotations[controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation] != string(phase) {
+			setCertificateAuthorityRotatingCondition(kcp, phase, fmt.Sprintf("%s; set the %s annotation to %s once they are rolled out",
+				manualRolloutMessage, controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation, phase))
+			return ctrl.Result{RequeueAfter: caRotationRequeueAfter}, nil
+		}
+		log.Info(fmt.Sprintf("Rollout of worker Machines not owned by a MachineDeployment acknowledged: %s", manualRolloutMessage), "phase", phase)
+	}
+
+	// All the machines have been rolled out, move to the next phase.
+	completed, err := secret.AdvanceCARotation(caSecret, time.Now())
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to advance cluster CA rotation")
+	}
+	if err := r.Client.Update(ctx, caSecret); err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to advance cluster CA rotation")
+	}
+	// NOTE: The annotation is removed when patching the KubeadmControlPlane at the end of the reconcile.
+	delete(kcp.Annotations, controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation)
+
+	if completed {
+		log.Info("Cluster CA rotation completed")
+		conditions.Set(kcp, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition,
+			Status: metav1.ConditionFalse,
+			Reason: controlplanev1.KubeadmControlPlaneCertificateAuthorityNotRotatingReason,
+		})
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, "SuccessfulCertificateAuthorityRotation", "Cluster CA rotation completed")
+		return ctrl.Result{}, nil
+	}
+
+	nextPhase, _, err := secret.GetCARotationPhase(caSecret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	log.Info("Cluster CA rotation moved to the next phase", "phase", nextPhase)
+	setCertificateAuthorityRotatingCondition(kcp, nextPhase, "waiting for control plane Machines to be rolled out")
+	r.recorder.Eventf(kcp, corev1.EventTypeNormal, "CertificateAuthorityRotationPhase", "Cluster CA rotation moved to phase %s", nextPhase)
+
+	// Requeue to start rolling out machines.
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// kubeconfigNeedsCAUpdate returns true if the kubeconfig secret doesn't trust the CA bundle of an ongoing
+// cluster CA rotation yet.
+func (r *KubeadmControlPlaneReconciler) kubeconfigNeedsCAUpdate(ctx context.Context, controlPlane *internal.ControlPlane, configSecret *corev1.Secret) (bool, error) {
+	caSecret, err := secret.GetFromNamespacedName(ctx, r.SecretCachingClient, util.ObjectKey(controlPlane.Cluster), secret.ClusterCA)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to get cluster CA secret")
+	}
+
+	if _, ok := caSecret.Annotations[secret.CARotationPhaseAnnotation]; !ok {
+		return false, nil
+	}
+	return kubeconfig.NeedsCACertUpdate(configSecret, caSecret.Data[secret.TLSCrtDataName])
+}
+
+// syncClusterInfoCertificateAuthority makes sure the cluster-info ConfigMap in the workload cluster contains
+// the CA bundle of the cluster CA secret, so machines joining the cluster trust it.
+func (r *KubeadmControlPlaneReconciler) syncClusterInfoCertificateAuthority(ctx context.Context, controlPlane *internal.ControlPlane, caSecret *corev1.Secret) error {
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create client to workload cluster")
+	}
+	return workloadCluster.UpdateClusterInfoCertificateAuthority(ctx, caSecret.Data[secret.TLSCrtDataName])
+}
+
+// caRotationWorkers groups the worker machines of a cluster by how they are rolled out during a cluster CA rotation.
+type caRotationWorkers struct {
+	// machineDeployments are the MachineDeployments of the cluster which are not being deleted.
+	machineDeployments []*clusterv1.MachineDeployment
+
+	// rolloutable are the worker machines owned by machineDeployments, which are rolled out by KCP.
+	rolloutable collections.Machines
+
+	// notRolloutable are the worker machines KCP cannot roll out, e.g. stand-alone Machines, Machines of MachineSets
+	// not owned by a MachineDeployment or Machines of MachinePools.
+	notRolloutable collections.Machines
+
+	// machinePools are the MachinePools of the cluster; KCP cannot roll them out.
+	machinePools []*clusterv1.MachinePool
+}
+
+// manualRolloutMessage returns a message listing the worker machines created before the given time and the MachinePools
+// which must be rolled out by the user, if any.
+func (w *caRotationWorkers) manualRolloutMessage(rolloutAfter time.Time) string {
+	var messages []string
+	if outdated := w.notRolloutable.Filter(collections.Not(createdAtOrAfter(rolloutAfter))); len(outdated) > 0 {
+		messages = append(messages, fmt.Sprintf("Machines %s", strings.Join(outdated.Names(), ", ")))
+	}
+	if len(w.machinePools) > 0 {
+		names := make([]string, 0, len(w.machinePools))
+		for _, mp := range w.machinePools {
+			names = append(names, mp.Name)
+		}
+		sort.Strings(names)
+		messages = append(messages, fmt.Sprintf("MachinePools %s", strings.Join(names, ", ")))
+	}
+	if len(messages) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s are not owned by a MachineDeployment and must be rolled out manually", strings.Join(messages, " and "))
+}
+
+// getWorkers returns the worker machines of the cluster, grouped by how they are rolled out during a cluster CA rotation.
+func (r *KubeadmControlPlaneReconciler) getWorkers(ctx context.Context, controlPlane *internal.ControlPlane) (*caRotationWorkers, error) {
+	workers := &caRotationWorkers{}
+
+	machineDeploymentList := &clusterv1.MachineDeploymentList{}
+	if err := r.Client.List(ctx, machineDeploymentList,
+		client.InNamespace(controlPlane.Cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: controlPlane.Cluster.Name},
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to list MachineDeployments")
+	}
+	machineDeploymentNames := sets.Set[string]{}
+	for i := range machineDeploymentList.Items {
+		md := &machineDeploymentList.Items[i]
+		if !md.DeletionTimestamp.IsZero() {
+			continue
+		}
+		workers.machineDeployments = append(workers.machineDeployments, md)
+		machineDeploymentNames.Insert(md.Name)
+	}
+
+	machinePoolList := &clusterv1.MachinePoolList{}
+	if err := r.Client.List(ctx, machinePoolList,
+		client.InNamespace(controlPlane.Cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: controlPlane.Cluster.Name},
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to list MachinePools")
+	}
+	for i := range machinePoolList.Items {
+		if mp := &machinePoolList.Items[i]; mp.DeletionTimestamp.IsZero() {
+			workers.machinePools = append(workers.machinePools, mp)
+		}
+	}
+
+	machineList := &clusterv1.MachineList{}
+	if err := r.Client.List(ctx, machineList,
+		client.InNamespace(controlPlane.Cluster.Namespace),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: controlPlane.Cluster.Name},
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to list Machines")
+	}
+	machines := collections.FromMachineList(machineList).Filter(
+		collections.Not(collections.ControlPlaneMachines(controlPlane.Cluster.Name)),
+		collections.Not(collections.HasDeletionTimestamp),
+	)
+	isOwnedByMachineDeployment := func(machine *clusterv1.Machine) bool {
+		return machineDeploymentNames.Has(machine.Labels[clusterv1.MachineDeploymentNameLabel])
+	}
+	workers.rolloutable = machines.Filter(isOwnedByMachineDeployment)
+	workers.notRolloutable = machines.Filter(collections.Not(isOwnedByMachineDeployment))
+	return workers, nil
+}
+
+// rolloutMachineDeploymentsAfter makes sure all the worker machines of the given MachineDeployments created before
+// the given time are rolled out, by setting spec.rolloutAfter on the MachineDeployments.
+// NOTE: This applies also to MachineDeployments managed by a Cluster topology, because the topology controller
+// does not set spec.rolloutAfter and server side apply preserves the fields it does not manage.
+func (r *KubeadmControlPlaneReconciler) rolloutMachineDeploymentsAfter(ctx context.Context, machineDeployments []*clusterv1.MachineDeployment, rolloutAfter time.Time) error {
+	for _, md := range machineDeployments {
+		if md.Spec.RolloutAfter != nil && !md.Spec.RolloutAfter.Time.Before(rolloutAfter) {
+			continue
+		}
+
+		original := md.DeepCopy()
+		md.Spec.RolloutAfter = &metav1.Time{Time: rolloutAfter}
+		if err := r.Client.Patch(ctx, md, client.MergeFrom(original)); err != nil {
+			return errors.Wrapf(err, "failed to set rolloutAfter on MachineDeployment %s", md.Name)
+		}
+	}
+	return nil
+}
+
+// getCARotationWorkersRolloutAfter returns when the rollout of the worker machines for the current phase of a
+// cluster CA rotation started, if it already started.
+func getCARotationWorkersRolloutAfter(caSecret *corev1.Secret) (time.Time, bool, error) {
+	value, ok := caSecret.Annotations[secret.CARotationWorkersRolloutAfterAnnotation]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false, errors.Wrapf(err, "invalid value for annotation %s", secret.CARotationWorkersRolloutAfterAnnotation)
+	}
+	return t, true, nil
+}
+
+func setCertificateAuthorityRotatingCondition(kcp *controlplanev1.KubeadmControlPlane, phase secret.CARotationPhase, message string) {
+	conditions.Set(kcp, metav1.Condition{
+		Type:    controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingReason,
+		Message: fmt.Sprintf("Phase %s: %s", phase, message),
+	})
+}
+
+// createdAtOrAfter returns a filter to find all machines created at the given time or later.
+func createdAtOrAfter(t time.Time) collections.Func {
+	return func(machine *clusterv1.Machine) bool {
+		return !machine.CreationTimestamp.Time.Before(t)
+	}
+}
@@ -0,0 +1,365 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/secret"
+)
+
+func TestReconcileCertificateAuthorityRotation(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	newKCP := func() *controlplanev1.KubeadmControlPlane {
+		return &controlplanev1.KubeadmControlPlane{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: controlplanev1.GroupVersion.String(),
+				Kind:       kubeadmControlPlaneKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "kcp",
+			},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				Replicas: ptr.To[int32](1),
+			},
+		}
+	}
+	newCASecret := func(g *WithT, kcp *controlplanev1.KubeadmControlPlane, controlled bool) *corev1.Secret {
+		certificates := secret.NewCertificatesForInitialControlPlane(&bootstrapv1.ClusterConfiguration{})
+		g.Expect(certificates.Generate()).To(Succeed())
+		caSecret := certificates.GetByPurpose(secret.ClusterCA).AsSecret(util.ObjectKey(cluster), *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind)))
+		if !controlled {
+			caSecret.OwnerReferences = nil
+		}
+		return caSecret
+	}
+	newMachine := func(name string, created time.Time, controlPlane bool) *clusterv1.Machine {
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         metav1.NamespaceDefault,
+				Name:              name,
+				CreationTimestamp: metav1.Time{Time: created},
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: cluster.Name,
+				},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: cluster.Name,
+			},
+		}
+		if controlPlane {
+			m.Labels[clusterv1.MachineControlPlaneLabel] = ""
+		}
+		return m
+	}
+	newReconciler := func(kcp *controlplanev1.KubeadmControlPlane, workload *fakeWorkloadCluster, objs []client.Object, machines ...*clusterv1.Machine) (*KubeadmControlPlaneReconciler, *internal.ControlPlane, client.Client) {
+		fakeClient := newFakeClient(objs...)
+		managementCluster := &fakeManagementCluster{Workload: workload}
+		r := &KubeadmControlPlaneReconciler{
+			Client:              fakeClient,
+			SecretCachingClient: fakeClient,
+			recorder:            record.NewFakeRecorder(32),
+			managementCluster:   managementCluster,
+		}
+		controlPlane := &internal.ControlPlane{
+			KCP:      kcp,
+			Cluster:  cluster,
+			Machines: collections.FromMachines(machines...),
+		}
+		controlPlane.InjectTestManagementCluster(managementCluster)
+		return r, controlPlane, fakeClient
+	}
+	getCASecret := func(g *WithT, c client.Client) *corev1.Secret {
+		caSecret, err := secret.GetFromNamespacedName(ctx, c, util.ObjectKey(cluster), secret.ClusterCA)
+		g.Expect(err).ToNot(HaveOccurred())
+		return caSecret
+	}
+
+	t.Run("does nothing if the CA rotation is not requested", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{newCASecret(g, kcp, true)})
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(getCASecret(g, c).Annotations).ToNot(HaveKey(secret.CARotationPhaseAnnotation))
+		g.Expect(conditions.Has(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition)).To(BeFalse())
+	})
+
+	t.Run("does not rotate a CA not managed by KCP", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Annotations = map[string]string{controlplanev1.RotateCertificateAuthorityAnnotation: ""}
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{newCASecret(g, kcp, false)})
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(getCASecret(g, c).Annotations).ToNot(HaveKey(secret.CARotationPhaseAnnotation))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Reason).To(Equal(controlplanev1.KubeadmControlPlaneCertificateAuthorityRotationNotSupportedReason))
+	})
+
+	t.Run("starts the CA rotation if requested", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Annotations = map[string]string{controlplanev1.RotateCertificateAuthorityAnnotation: ""}
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{newCASecret(g, kcp, true)})
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Requeue).To(BeTrue())
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseTrust)))
+		g.Expect(kcp.Annotations).ToNot(HaveKey(controlplanev1.RotateCertificateAuthorityAnnotation))
+		g.Expect(conditions.IsTrue(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition)).To(BeTrue())
+	})
+
+	t.Run("waits for control plane machines to be rolled out", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		phaseStarted := time.Now().Add(-1 * time.Hour)
+		caSecret := newCASecret(g, kcp, true)
+		g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+		workload := &fakeWorkloadCluster{}
+		r, controlPlane, _ := newReconciler(kcp, workload, []client.Object{caSecret},
+			newMachine("cp-1", phaseStarted.Add(-1*time.Hour), true))
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(caRotationRequeueAfter))
+		g.Expect(workload.clusterInfoCertificateAuthority).To(Equal(caSecret.Data[secret.TLSCrtDataName]))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Message).To(ContainSubstring("waiting for control plane Machines"))
+	})
+
+	t.Run("rolls out worker machines after control plane machines", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		phaseStarted := time.Now().Add(-1 * time.Hour)
+		caSecret := newCASecret(g, kcp, true)
+		g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+		md := &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "md",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: cluster.Name,
+				},
+			},
+		}
+		worker := newMachine("worker-1", phaseStarted.Add(-1*time.Hour), false)
+		worker.Labels[clusterv1.MachineDeploymentNameLabel] = md.Name
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{caSecret, md, worker},
+			newMachine("cp-1", phaseStarted.Add(time.Minute), true))
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(caRotationRequeueAfter))
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKey(secret.CARotationWorkersRolloutAfterAnnotation))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Message).To(ContainSubstring("waiting for 1 worker Machines"))
+
+		gotMD := &clusterv1.MachineDeployment{}
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(md), gotMD)).To(Succeed())
+		g.Expect(gotMD.Spec.RolloutAfter).ToNot(BeNil())
+	})
+
+	t.Run("rolls out MachineDeployments owned by a Cluster topology", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		phaseStarted := time.Now().Add(-1 * time.Hour)
+		caSecret := newCASecret(g, kcp, true)
+		g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+		md := &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "md-abcde",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel:                          cluster.Name,
+					clusterv1.ClusterTopologyOwnedLabel:                 "",
+					clusterv1.ClusterTopologyMachineDeploymentNameLabel: "md-topology",
+				},
+			},
+		}
+		worker := newMachine("worker-1", phaseStarted.Add(-1*time.Hour), false)
+		worker.Labels[clusterv1.MachineDeploymentNameLabel] = md.Name
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{caSecret, md, worker},
+			newMachine("cp-1", phaseStarted.Add(time.Minute), true))
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(caRotationRequeueAfter))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Message).To(ContainSubstring("waiting for 1 worker Machines"))
+
+		gotMD := &clusterv1.MachineDeployment{}
+		g.Expect(c.Get(ctx, client.ObjectKeyFromObject(md), gotMD)).To(Succeed())
+		g.Expect(gotMD.Spec.RolloutAfter).ToNot(BeNil())
+	})
+
+	t.Run("waits for worker machines not owned by a MachineDeployment to be rolled out", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		phaseStarted := time.Now().Add(-1 * time.Hour)
+		caSecret := newCASecret(g, kcp, true)
+		g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+		caSecret.Annotations[secret.CARotationWorkersRolloutAfterAnnotation] = phaseStarted.Add(time.Minute).UTC().Format(time.RFC3339)
+		standalone := newMachine("standalone-1", phaseStarted.Add(-1*time.Hour), false)
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{caSecret, standalone},
+			newMachine("cp-1", phaseStarted.Add(time.Minute), true))
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(caRotationRequeueAfter))
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseTrust)))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Message).To(And(
+			ContainSubstring("Machines standalone-1"),
+			ContainSubstring(controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation),
+		))
+
+		// Once the Machine is replaced, the rotation moves to the next phase.
+		g.Expect(c.Delete(ctx, standalone)).To(Succeed())
+		g.Expect(c.Create(ctx, newMachine("standalone-2", time.Now(), false))).To(Succeed())
+
+		result, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Requeue).To(BeTrue())
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseSign)))
+	})
+
+	t.Run("waits for the rollout of MachinePools to be acknowledged", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		phaseStarted := time.Now().Add(-1 * time.Hour)
+		caSecret := newCASecret(g, kcp, true)
+		g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+		caSecret.Annotations[secret.CARotationWorkersRolloutAfterAnnotation] = phaseStarted.Add(time.Minute).UTC().Format(time.RFC3339)
+		mp := &clusterv1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "mp",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: cluster.Name,
+				},
+			},
+		}
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{caSecret, mp},
+			newMachine("cp-1", phaseStarted.Add(time.Minute), true))
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(caRotationRequeueAfter))
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseTrust)))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Message).To(ContainSubstring("MachinePools mp"))
+
+		// An acknowledgement for another phase is ignored.
+		kcp.Annotations = map[string]string{controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation: string(secret.CARotationPhaseSign)}
+		result, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(caRotationRequeueAfter))
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseTrust)))
+
+		kcp.Annotations[controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation] = string(secret.CARotationPhaseTrust)
+		result, err = r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Requeue).To(BeTrue())
+		g.Expect(getCASecret(g, c).Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseSign)))
+		g.Expect(kcp.Annotations).ToNot(HaveKey(controlplanev1.CertificateAuthorityRotationWorkersRolledOutAnnotation))
+	})
+
+	t.Run("moves to the next phase when all the machines are rolled out", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		phaseStarted := time.Now().Add(-1 * time.Hour)
+		caSecret := newCASecret(g, kcp, true)
+		g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+		caSecret.Annotations[secret.CARotationWorkersRolloutAfterAnnotation] = phaseStarted.Add(time.Minute).UTC().Format(time.RFC3339)
+		worker := newMachine("worker-1", phaseStarted.Add(time.Hour), false)
+		r, controlPlane, c := newReconciler(kcp, &fakeWorkloadCluster{}, []client.Object{caSecret, worker},
+			newMachine("cp-1", phaseStarted.Add(time.Minute), true))
+
+		result, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.Requeue).To(BeTrue())
+		gotCASecret := getCASecret(g, c)
+		g.Expect(gotCASecret.Annotations).To(HaveKeyWithValue(secret.CARotationPhaseAnnotation, string(secret.CARotationPhaseSign)))
+		g.Expect(gotCASecret.Annotations).ToNot(HaveKey(secret.CARotationWorkersRolloutAfterAnnotation))
+		g.Expect(conditions.Get(kcp, controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition).Message).To(HavePrefix("Phase Sign"))
+	})
+}
+
+func TestReconcileCertificateAuthorityRotationPhase(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "kcp",
+		},
+	}
+	certificates := secret.NewCertificatesForInitialControlPlane(&bootstrapv1.ClusterConfiguration{})
+	g.Expect(certificates.Generate()).To(Succeed())
+	caSecret := certificates.GetByPurpose(secret.ClusterCA).AsSecret(util.ObjectKey(cluster), *metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind)))
+	phaseStarted := time.Now().Add(-1 * time.Hour)
+	g.Expect(secret.StartCARotation(caSecret, phaseStarted)).To(Succeed())
+
+	oldMachine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "old", CreationTimestamp: metav1.Time{Time: phaseStarted.Add(-1 * time.Hour)}}}
+	newMachine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "new", CreationTimestamp: metav1.Time{Time: phaseStarted.Add(time.Hour)}}}
+
+	fakeClient := newFakeClient(caSecret)
+	workload := &fakeWorkloadCluster{}
+	r := &KubeadmControlPlaneReconciler{
+		Client:              fakeClient,
+		SecretCachingClient: fakeClient,
+		recorder:            record.NewFakeRecorder(32),
+	}
+	controlPlane := &internal.ControlPlane{
+		KCP:      kcp,
+		Cluster:  cluster,
+		Machines: collections.FromMachines(oldMachine, newMachine),
+	}
+	controlPlane.InjectTestManagementCluster(&fakeManagementCluster{Workload: workload})
+
+	g.Expect(r.reconcileCertificateAuthorityRotationPhase(ctx, controlPlane)).To(Succeed())
+	machinesNeedingRollout, _ := controlPlane.MachinesNeedingRollout()
+	g.Expect(machinesNeedingRollout.Names()).To(ConsistOf("old"))
+	g.Expect(workload.clusterInfoCertificateAuthority).To(Equal(caSecret.Data[secret.TLSCrtDataName]))
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// reconcileCertificatesRenewal renews in-place the certificates of machines which must be rolled out only because
+// their certificates are about to expire, by calling the RenewCertificates hook of a Runtime Extension.
+// If no Runtime Extension implements the RenewCertificates hook, or if the renewal fails, machines are rolled out.
+// NOTE: While a certificates renewal is in progress all the other rollout or scale operations are blocked.
+func (r *KubeadmControlPlaneReconciler) reconcileCertificatesRenewal(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	if !r.inPlaceUpdatesEnabled() {
+		return ctrl.Result{}, nil
+	}
+
+	machinesRenewingCertificates := controlPlane.Machines.Filter(
+		collections.Not(collections.HasDeletionTimestamp),
+		func(machine *clusterv1.Machine) bool {
+			return conditions.IsTrue(machine, controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition)
+		},
+	)
+	if len(machinesRenewingCertificates) > 0 {
+		return r.renewCertificates(ctx, controlPlane, machinesRenewingCertificates.Oldest())
+	}
+
+	if controlPlane.KCP.Spec.RolloutBefore == nil || controlPlane.KCP.Spec.RolloutBefore.CertificatesExpiryDays == nil {
+		return ctrl.Result{}, nil
+	}
+
+	// Only renew certificates in-place when the number of machines is equal to the desired number of replicas;
+	// otherwise, e.g. in the middle of a rollout, continue with the rollout.
+	if controlPlane.KCP.Spec.Replicas == nil || int32(controlPlane.Machines.Len()) != *controlPlane.KCP.Spec.Replicas {
+		return ctrl.Result{}, nil
+	}
+
+	machinesNeedingRollout, _ := controlPlane.MachinesNeedingRollout()
+	var machine *clusterv1.Machine
+	for _, m := range machinesNeedingRollout.SortedByCreationTimestamp() {
+		canRenewInPlace, err := controlPlane.CanRenewMachineCertificatesInPlace(m)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if canRenewInPlace {
+			machine = m
+			break
+		}
+	}
+	if machine == nil {
+		return ctrl.Result{}, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	// Note: the first Runtime Extension implementing the RenewCertificates hook is used.
+	extensionNames, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.RenewCertificates, machine)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(extensionNames) == 0 {
+		return ctrl.Result{}, nil
+	}
+	extensionName := extensionNames[0]
+
+	// Make sure the control plane is healthy before renewing certificates, because it requires restarting control plane components.
+	if result, err := r.preflightChecks(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	log.Info("Renewing Machine certificates in-place", "extension", extensionName)
+	if err := r.patchMachine(ctx, controlPlane, machine, func(m *clusterv1.Machine) {
+		if m.Annotations == nil {
+			m.Annotations = map[string]string{}
+		}
+		m.Annotations[controlplanev1.CertificatesRenewalExtensionAnnotation] = extensionName
+		conditions.Set(m, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalInProgressReason,
+			Message: inPlaceUpdateMessage(extensionName, ""),
+		})
+	}); err != nil {
+		return ctrl.Result{}, err
+	}
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "CertificatesRenewalStarted", "Renewing certificates of Machine %s in-place using extension %s", machine.Name, extensionName)
+
+	// Requeue to start calling the RenewCertificates hook.
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// renewCertificates calls the RenewCertificates hook of the Runtime Extension renewing the certificates of a machine,
+// until the renewal is either completed or failed.
+func (r *KubeadmControlPlaneReconciler) renewCertificates(ctx context.Context, controlPlane *internal.ControlPlane, machine *clusterv1.Machine) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	extensionName := machine.Annotations[controlplanev1.CertificatesRenewalExtensionAnnotation]
+	if extensionName == "" {
+		return ctrl.Result{}, r.completeCertificatesRenewal(ctx, controlPlane, machine, errors.Errorf("annotation %s is not set", controlplanev1.CertificatesRenewalExtensionAnnotation))
+	}
+
+	// Note: Runtime hooks are using v1beta1 core types.
+	v1beta1Machine := &clusterv1beta1.Machine{}
+	if err := clusterv1beta1.Convert_v1beta2_Machine_To_v1beta1_Machine(machine, v1beta1Machine, nil); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to convert Machine %s to v1beta1", machine.Name)
+	}
+	v1beta1Machine.SetGroupVersionKind(clusterv1beta1.GroupVersion.WithKind("Machine"))
+
+	request := &runtimehooksv1.RenewCertificatesRequest{
+		Machine: *v1beta1Machine,
+	}
+	response := &runtimehooksv1.RenewCertificatesResponse{}
+	if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.RenewCertificates, machine, extensionName, request, response); err != nil {
+		if response.GetStatus() == runtimehooksv1.ResponseStatusFailure {
+			return ctrl.Result{}, r.completeCertificatesRenewal(ctx, controlPlane, machine, errors.New(response.GetMessage()))
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "failed to call RenewCertificates hook of extension %s for Machine %s", extensionName, machine.Name)
+	}
+
+	if response.GetRetryAfterSeconds() > 0 {
+		log.V(4).Info("Certificates renewal in progress", "extension", extensionName, "message", response.GetMessage())
+		if err := r.patchMachine(ctx, controlPlane, machine, func(m *clusterv1.Machine) {
+			conditions.Set(m, metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalInProgressReason,
+				Message: inPlaceUpdateMessage(extensionName, response.GetMessage()),
+			})
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: time.Duration(response.GetRetryAfterSeconds()) * time.Second}, nil
+	}
+
+	if err := r.completeCertificatesRenewal(ctx, controlPlane, machine, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+	// Requeue so the certificates of other machines, if any, are renewed.
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// completeCertificatesRenewal marks the certificates renewal of a machine as completed, or as failed if an error is passed.
+// When the renewal is completed, the certificates expiry date is reset so it is re-discovered from the workload cluster.
+// NOTE: Machines with a failed certificates renewal are rolled out.
+func (r *KubeadmControlPlaneReconciler) completeCertificatesRenewal(ctx context.Context, controlPlane *internal.ControlPlane, machine *clusterv1.Machine, renewalErr error) error {
+	log := ctrl.LoggerFrom(ctx)
+	extensionName := machine.Annotations[controlplanev1.CertificatesRenewalExtensionAnnotation]
+
+	condition := metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
+		Status: metav1.ConditionFalse,
+		Reason: controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalCompletedReason,
+	}
+	if renewalErr != nil {
+		condition.Reason = controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalFailedReason
+		condition.Message = inPlaceUpdateMessage(extensionName, renewalErr.Error())
+	}
+
+	if renewalErr == nil && machine.Spec.Bootstrap.ConfigRef != nil {
+		// Remove the certificates expiry annotation from the KubeadmConfig, so the new expiry date is re-discovered.
+		// Note: The KubeadmConfig is read from the API server, because the KubeadmConfigs in the control plane scope are
+		// modified when checking if machines are up-to-date.
+		kubeadmConfig := &bootstrapv1.KubeadmConfig{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.Bootstrap.ConfigRef.Name}, kubeadmConfig); err != nil {
+			return errors.Wrapf(err, "failed to get KubeadmConfig for Machine %s", machine.Name)
+		}
+		if _, ok := kubeadmConfig.Annotations[clusterv1.MachineCertificatesExpiryDateAnnotation]; ok {
+			patchHelper, err := patch.NewHelper(kubeadmConfig, r.Client)
+			if err != nil {
+				return err
+			}
+			delete(kubeadmConfig.Annotations, clusterv1.MachineCertificatesExpiryDateAnnotation)
+			if err := patchHelper.Patch(ctx, kubeadmConfig); err != nil {
+				return errors.Wrapf(err, "failed to update KubeadmConfig %s", klog.KObj(kubeadmConfig))
+			}
+		}
+		if kc, ok := controlPlane.KubeadmConfigs[machine.Name]; ok {
+			delete(kc.Annotations, clusterv1.MachineCertificatesExpiryDateAnnotation)
+		}
+	}
+
+	if err := r.patchMachine(ctx, controlPlane, machine, func(m *clusterv1.Machine) {
+		delete(m.Annotations, controlplanev1.CertificatesRenewalExtensionAnnotation)
+		conditions.Set(m, condition)
+		if renewalErr == nil {
+			// Note: The Machine controller sets the new expiry date once it is re-discovered.
+			m.Status.CertificatesExpiryDate = nil
+		}
+	}); err != nil {
+		return err
+	}
+
+	if renewalErr != nil {
+		log.Info("Certificates renewal failed, Machine will be rolled out", "extension", extensionName, "reason", renewalErr.Error())
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "FailedCertificatesRenewal", "Failed to renew certificates of Machine %s in-place: %v", machine.Name, renewalErr)
+		return nil
+	}
+	log.Info("Certificates renewal completed", "extension", extensionName)
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "SuccessfulCertificatesRenewal", "Certificates of Machine %s renewed in-place", machine.Name)
+	return nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileCertificatesRenewal(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InPlaceUpdates, true)
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "kcp",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.2",
+		},
+	}
+	newMachine := func() (*clusterv1.Machine, *bootstrapv1.KubeadmConfig) {
+		expiry := metav1.NewTime(time.Now().Add(24 * time.Hour))
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "machine-1",
+				Annotations: map[string]string{
+					controlplanev1.CertificatesRenewalExtensionAnnotation: "renewal-extension",
+				},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: cluster.Name,
+				Version:     ptr.To("v1.31.2"),
+				Bootstrap: clusterv1.Bootstrap{
+					ConfigRef: &corev1.ObjectReference{
+						APIVersion: bootstrapv1.GroupVersion.String(),
+						Kind:       "KubeadmConfig",
+						Namespace:  metav1.NamespaceDefault,
+						Name:       "machine-1",
+					},
+				},
+			},
+			Status: clusterv1.MachineStatus{
+				CertificatesExpiryDate: &expiry,
+			},
+		}
+		conditions.Set(machine, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
+			Status: metav1.ConditionTrue,
+			Reason: controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalInProgressReason,
+		})
+		kubeadmConfig := &bootstrapv1.KubeadmConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "machine-1",
+				Annotations: map[string]string{
+					clusterv1.MachineCertificatesExpiryDateAnnotation: expiry.Format(time.RFC3339),
+				},
+			},
+		}
+		return machine, kubeadmConfig
+	}
+
+	tests := []struct {
+		name             string
+		response         *runtimehooksv1.RenewCertificatesResponse
+		wantResult       ctrl.Result
+		wantStatus       metav1.ConditionStatus
+		wantReason       string
+		wantAnnotation   bool
+		wantExpiryReset  bool
+		wantMessageMatch string
+	}{
+		{
+			name: "renewal in progress",
+			response: &runtimehooksv1.RenewCertificatesResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess, Message: "restarting kube-apiserver"},
+					RetryAfterSeconds: 10,
+				},
+			},
+			wantResult:       ctrl.Result{RequeueAfter: 10 * time.Second},
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalInProgressReason,
+			wantAnnotation:   true,
+			wantExpiryReset:  false,
+			wantMessageMatch: "restarting kube-apiserver",
+		},
+		{
+			name: "renewal completed",
+			response: &runtimehooksv1.RenewCertificatesResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+				},
+			},
+			wantResult:      ctrl.Result{Requeue: true},
+			wantStatus:      metav1.ConditionFalse,
+			wantReason:      controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalCompletedReason,
+			wantAnnotation:  false,
+			wantExpiryReset: true,
+		},
+		{
+			name: "renewal failed",
+			response: &runtimehooksv1.RenewCertificatesResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure, Message: "kubeadm certs renew failed"},
+				},
+			},
+			wantResult:       ctrl.Result{},
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalFailedReason,
+			wantAnnotation:   false,
+			wantExpiryReset:  false,
+			wantMessageMatch: "kubeadm certs renew failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine, kubeadmConfig := newMachine()
+			fakeClient := fake.NewClientBuilder().WithObjects(machine.DeepCopy(), kubeadmConfig.DeepCopy()).WithStatusSubresource(&clusterv1.Machine{}).Build()
+			r := &KubeadmControlPlaneReconciler{
+				Client: fakeClient,
+				RuntimeClient: fakeruntimeclient.NewRuntimeClientBuilder().
+					WithCatalog(catalog).
+					WithCallExtensionResponses(map[string]runtimehooksv1.ResponseObject{
+						"renewal-extension": tt.response,
+					}).
+					MarkReady(true).
+					Build(),
+				recorder: record.NewFakeRecorder(32),
+			}
+			controlPlane := &internal.ControlPlane{
+				KCP:            kcp,
+				Cluster:        cluster,
+				Machines:       collections.FromMachines(machine),
+				KubeadmConfigs: map[string]*bootstrapv1.KubeadmConfig{machine.Name: kubeadmConfig.DeepCopy()},
+			}
+
+			result, err := r.reconcileCertificatesRenewal(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(Equal(tt.wantResult))
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+			c := conditions.Get(gotMachine, controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition)
+			g.Expect(c).ToNot(BeNil())
+			g.Expect(c.Status).To(Equal(tt.wantStatus))
+			g.Expect(c.Reason).To(Equal(tt.wantReason))
+			g.Expect(c.Message).To(ContainSubstring(tt.wantMessageMatch))
+			if tt.wantAnnotation {
+				g.Expect(gotMachine.Annotations).To(HaveKeyWithValue(controlplanev1.CertificatesRenewalExtensionAnnotation, "renewal-extension"))
+			} else {
+				g.Expect(gotMachine.Annotations).ToNot(HaveKey(controlplanev1.CertificatesRenewalExtensionAnnotation))
+			}
+
+			gotKubeadmConfig := &bootstrapv1.KubeadmConfig{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kubeadmConfig), gotKubeadmConfig)).To(Succeed())
+			if tt.wantExpiryReset {
+				g.Expect(gotMachine.Status.CertificatesExpiryDate).To(BeNil())
+				g.Expect(gotKubeadmConfig.Annotations).ToNot(HaveKey(clusterv1.MachineCertificatesExpiryDateAnnotation))
+			} else {
+				g.Expect(gotMachine.Status.CertificatesExpiryDate).ToNot(BeNil())
+				g.Expect(gotKubeadmConfig.Annotations).To(HaveKey(clusterv1.MachineCertificatesExpiryDateAnnotation))
+			}
+		})
+	}
+}
@@ -45,6 +45,8 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd/snapshot"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
@@ -67,17 +69,19 @@ const (
 )
 
 // +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
-// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinepools,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machinedeployments,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 
 // KubeadmControlPlaneReconciler reconciles a KubeadmControlPlane object.
 type KubeadmControlPlaneReconciler struct {
 	Client              client.Client
 	SecretCachingClient client.Client
+	RuntimeClient       runtimeclient.Client
 	controller          controller.Controller
 	recorder            record.EventRecorder
 	ClusterCache        clustercache.ClusterCache
@@ -90,6 +94,9 @@ type KubeadmControlPlaneReconciler struct {
 
 	RemoteConditionsGracePeriod time.Duration
 
+	// EtcdSnapshotStore is used to persist etcd snapshots; if not set, etcd snapshots and restores are disabled.
+	EtcdSnapshotStore snapshot.Store
+
 	managementCluster         internal.ManagementCluster
 	managementClusterUncached internal.ManagementCluster
 	ssaCache                  ssa.Cache
@@ -353,6 +360,8 @@ func patchKubeadmControlPlane(ctx context.Context, patchHelper *patch.Helper, kc
 			controlplanev1.KubeadmControlPlaneScalingUpCondition,
 			controlplanev1.KubeadmControlPlaneScalingDownCondition,
 			controlplanev1.KubeadmControlPlaneRemediatingCondition,
+			controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition,
+			controlplanev1.KubeadmControlPlaneCertificateAuthorityRotatingCondition,
 			controlplanev1.KubeadmControlPlaneDeletingCondition,
 		}},
 	)
@@ -429,6 +438,17 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 		return ctrl.Result{}, errors.Wrap(err, "failed to sync Machines")
 	}
 
+	// Roll out machines created before the current phase of a cluster CA rotation, if any.
+	if err := r.reconcileCertificateAuthorityRotationPhase(ctx, controlPlane); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Restore etcd from a snapshot if requested; this takes precedence over all the other operations
+	// because it is usually required to recover from etcd quorum loss.
+	if result, err := r.reconcileEtcdRestore(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
 	// Aggregate the operational state of all the machines; while aggregating we are adding the
 	// source ref (reason@machine/name) so the problem can be easily tracked down to its source machine.
 	v1beta1conditions.SetAggregate(controlPlane.KCP, controlplanev1.MachinesReadyV1Beta1Condition, controlPlane.Machines.ConditionGetters(), v1beta1conditions.AddSourceRef())
@@ -457,6 +477,24 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 		return result, err
 	}
 
+	// Defragment etcd members if required, and disarm NOSPACE alarms once done.
+	// NOTE: This happens before rollout and scale operations, because a NOSPACE alarm makes etcd reject writes.
+	if result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// Complete in-place updates in progress, if any.
+	// NOTE: This happens before rollout and scale operations, which are blocked while a Machine is updating in-place.
+	if result, err := r.reconcileInPlaceUpdates(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// Renew in-place the certificates of machines that must be rolled out only because certificates are about to expire.
+	// NOTE: This happens before rollout and scale operations, which are blocked while certificates are being renewed.
+	if result, err := r.reconcileCertificatesRenewal(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, err
+	}
+
 	// Control plane machines rollout due to configuration changes (e.g. upgrades) takes precedence over other operations.
 	machinesNeedingRollout, machinesNeedingRolloutLogMessages := controlPlane.MachinesNeedingRollout()
 	switch {
@@ -525,7 +563,21 @@ func (r *KubeadmControlPlaneReconciler) reconcile(ctx context.Context, controlPl
 	if err := r.reconcileCertificateExpiries(ctx, controlPlane); err != nil {
 		return ctrl.Result{}, err
 	}
-	return ctrl.Result{}, nil
+
+	// Rotate the cluster CA if requested, or move a cluster CA rotation in progress to the next phase.
+	// Note: Same as for certificate expiries, this requires that all control plane machines are working.
+	caRotationResult, err := r.reconcileCertificateAuthorityRotation(ctx, controlPlane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Take etcd snapshots if configured.
+	// Note: Same as for certificate expiries, this requires that all control plane machines are working.
+	etcdSnapshotResult, err := r.reconcileEtcdSnapshot(ctx, controlPlane)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return util.LowestNonZeroResult(caRotationResult, etcdSnapshotResult), nil
 }
 
 // reconcileClusterCertificates ensures that all the cluster certificates exists and
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const (
+	// etcdDefragmentationMinReclaimableBytes is the minimum amount of space that must be reclaimable from an etcd member
+	// database before the member is defragmented; this prevents defragmenting small databases over and over.
+	etcdDefragmentationMinReclaimableBytes = int64(10 * 1024 * 1024)
+
+	// etcdDefragmentationRequeueAfter is how long to wait before checking if another etcd member must be defragmented.
+	etcdDefragmentationRequeueAfter = 10 * time.Second
+)
+
+// reconcileEtcdDefragmentation defragments etcd members one at a time when the fragmentation of the member database
+// exceeds the threshold defined in spec.etcdDefragmentation or when a NOSPACE alarm is raised; NOSPACE alarms
+// are disarmed once there are no more members to defragment.
+// NOTE: Non-leader members are always defragmented before the leader, so the leader is defragmented last.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdDefragmentation(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	if kcp.Spec.EtcdDefragmentation == nil || !controlPlane.IsEtcdManaged() {
+		if conditions.Has(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition) {
+			conditions.Delete(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Defragmentation temporarily blocks reads and writes on a member, so it is deferred while
+	// machines are provisioning or deleting, thus avoiding to further reduce etcd availability.
+	if len(controlPlane.Machines.Filter(collections.Or(collections.HasDeletionTimestamp, collections.Not(collections.HasNode())))) > 0 {
+		return ctrl.Result{}, nil
+	}
+
+	nodeNames := make([]string, 0, len(controlPlane.Machines))
+	for _, machine := range controlPlane.Machines.SortedByCreationTimestamp() {
+		nodeNames = append(nodeNames, machine.Status.NodeRef.Name)
+	}
+
+	var statuses []internal.EtcdMemberDBStatus
+	var alarms []etcd.MemberAlarm
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err == nil {
+		statuses, alarms, err = workloadCluster.EtcdMemberDBStatuses(ctx, nodeNames)
+	}
+	if err != nil {
+		// Defragmentation is not critical for the rest of the reconcile, so the error is surfaced
+		// in the condition without blocking other operations.
+		log.Error(err, "Failed to inspect etcd members for defragmentation")
+		conditions.Set(kcp, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  controlplanev1.KubeadmControlPlaneEtcdDefragmentingInspectionFailedReason,
+			Message: "Failed to inspect etcd members",
+		})
+		return ctrl.Result{}, nil
+	}
+
+	noSpaceAlarms := []etcd.MemberAlarm{}
+	for _, alarm := range alarms {
+		if alarm.Type == etcd.AlarmNoSpace {
+			noSpaceAlarms = append(noSpaceAlarms, alarm)
+		}
+	}
+
+	threshold := controlplanev1.DefaultEtcdDefragmentationThresholdPercent
+	if kcp.Spec.EtcdDefragmentation.ThresholdPercent != nil {
+		threshold = *kcp.Spec.EtcdDefragmentation.ThresholdPercent
+	}
+
+	if member := selectEtcdMemberToDefragment(statuses, threshold, len(noSpaceAlarms) > 0); member != nil {
+		message := fmt.Sprintf("Defragmenting etcd member on Node %s (fragmentation %d%%)", member.NodeName, etcdFragmentationPercent(*member))
+		if len(noSpaceAlarms) > 0 {
+			message += "; NOSPACE alarm raised"
+		}
+		conditions.Set(kcp, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  controlplanev1.KubeadmControlPlaneEtcdDefragmentingReason,
+			Message: message,
+		})
+
+		log.Info("Defragmenting etcd member", "Node", member.NodeName, "dbSize", member.DBSize, "dbSizeInUse", member.DBSizeInUse)
+		if err := workloadCluster.DefragmentEtcdMember(ctx, member.NodeName); err != nil {
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, "FailedEtcdDefragmentation", "Failed to defragment etcd member on Node %s: %v", member.NodeName, err)
+			return ctrl.Result{}, errors.Wrapf(err, "failed to defragment etcd member on Node %s", member.NodeName)
+		}
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, "SuccessfulEtcdDefragmentation", "Defragmented etcd member on Node %s", member.NodeName)
+		return ctrl.Result{RequeueAfter: etcdDefragmentationRequeueAfter}, nil
+	}
+
+	if len(noSpaceAlarms) > 0 {
+		log.Info("Disarming etcd NOSPACE alarms")
+		if err := workloadCluster.DisarmEtcdAlarms(ctx, nodeNames, noSpaceAlarms); err != nil {
+			conditions.Set(kcp, metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  controlplanev1.KubeadmControlPlaneEtcdDefragmentingReason,
+				Message: "Failed to disarm NOSPACE alarm",
+			})
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, "FailedEtcdAlarmDisarm", "Failed to disarm etcd NOSPACE alarms: %v", err)
+			return ctrl.Result{}, errors.Wrap(err, "failed to disarm etcd NOSPACE alarms")
+		}
+		r.recorder.Event(kcp, corev1.EventTypeNormal, "SuccessfulEtcdAlarmDisarm", "Disarmed etcd NOSPACE alarms")
+	}
+
+	conditions.Set(kcp, metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition,
+		Status: metav1.ConditionFalse,
+		Reason: controlplanev1.KubeadmControlPlaneEtcdNotDefragmentingReason,
+	})
+	return ctrl.Result{}, nil
+}
+
+// selectEtcdMemberToDefragment returns the etcd member to be defragmented next, if any.
+// A member must be defragmented when at least etcdDefragmentationMinReclaimableBytes can be reclaimed and
+// either the fragmentation of its database is equal or greater than the threshold or a NOSPACE alarm is raised.
+// Non-leader members are always selected before the leader.
+func selectEtcdMemberToDefragment(statuses []internal.EtcdMemberDBStatus, thresholdPercent int32, noSpace bool) *internal.EtcdMemberDBStatus {
+	var leader *internal.EtcdMemberDBStatus
+	for i := range statuses {
+		member := &statuses[i]
+		if member.DBSize-member.DBSizeInUse < etcdDefragmentationMinReclaimableBytes {
+			continue
+		}
+		if !noSpace && etcdFragmentationPercent(*member) < int64(thresholdPercent) {
+			continue
+		}
+		if member.IsLeader {
+			leader = member
+			continue
+		}
+		return member
+	}
+	return leader
+}
+
+// etcdFragmentationPercent returns the percentage of the etcd member database which is allocated but not in use.
+func etcdFragmentationPercent(member internal.EtcdMemberDBStatus) int64 {
+	if member.DBSize <= 0 {
+		return 0
+	}
+	return (member.DBSize - member.DBSizeInUse) * 100 / member.DBSize
+}
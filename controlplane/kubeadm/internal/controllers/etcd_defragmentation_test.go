@@ -0,0 +1,262 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+const mib = int64(1024 * 1024)
+
+func TestSelectEtcdMemberToDefragment(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []internal.EtcdMemberDBStatus
+		noSpace  bool
+		want     string
+	}{
+		{
+			name: "no member selected if fragmentation is below the threshold",
+			statuses: []internal.EtcdMemberDBStatus{
+				{NodeName: "node-1", DBSize: 100 * mib, DBSizeInUse: 80 * mib},
+				{NodeName: "node-2", DBSize: 100 * mib, DBSizeInUse: 90 * mib, IsLeader: true},
+			},
+			want: "",
+		},
+		{
+			name: "no member selected if reclaimable space is too small",
+			statuses: []internal.EtcdMemberDBStatus{
+				{NodeName: "node-1", DBSize: 4 * mib, DBSizeInUse: 1 * mib},
+			},
+			want: "",
+		},
+		{
+			name: "member above the threshold is selected",
+			statuses: []internal.EtcdMemberDBStatus{
+				{NodeName: "node-1", DBSize: 100 * mib, DBSizeInUse: 80 * mib},
+				{NodeName: "node-2", DBSize: 100 * mib, DBSizeInUse: 30 * mib},
+			},
+			want: "node-2",
+		},
+		{
+			name: "non-leader members are selected before the leader",
+			statuses: []internal.EtcdMemberDBStatus{
+				{NodeName: "node-1", DBSize: 100 * mib, DBSizeInUse: 10 * mib, IsLeader: true},
+				{NodeName: "node-2", DBSize: 100 * mib, DBSizeInUse: 40 * mib},
+			},
+			want: "node-2",
+		},
+		{
+			name: "leader is selected when no other member must be defragmented",
+			statuses: []internal.EtcdMemberDBStatus{
+				{NodeName: "node-1", DBSize: 100 * mib, DBSizeInUse: 10 * mib, IsLeader: true},
+				{NodeName: "node-2", DBSize: 100 * mib, DBSizeInUse: 95 * mib},
+			},
+			want: "node-1",
+		},
+		{
+			name: "member below the threshold is selected if a NOSPACE alarm is raised",
+			statuses: []internal.EtcdMemberDBStatus{
+				{NodeName: "node-1", DBSize: 100 * mib, DBSizeInUse: 80 * mib},
+			},
+			noSpace: true,
+			want:    "node-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got := selectEtcdMemberToDefragment(tt.statuses, controlplanev1.DefaultEtcdDefragmentationThresholdPercent, tt.noSpace)
+			if tt.want == "" {
+				g.Expect(got).To(BeNil())
+				return
+			}
+			g.Expect(got).ToNot(BeNil())
+			g.Expect(got.NodeName).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileEtcdDefragmentation(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	newKCP := func() *controlplanev1.KubeadmControlPlane {
+		return &controlplanev1.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "kcp",
+			},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				EtcdDefragmentation: &controlplanev1.EtcdDefragmentationPolicy{
+					ThresholdPercent: ptr.To[int32](40),
+				},
+			},
+		}
+	}
+	newMachine := func(name string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      name,
+			},
+			Spec: clusterv1.MachineSpec{
+				InfrastructureRef: corev1.ObjectReference{
+					Kind:       "GenericMachine",
+					APIVersion: "generic.io/v1",
+					Namespace:  metav1.NamespaceDefault,
+					Name:       name + "-infra",
+				},
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: &clusterv1.MachineNodeReference{Name: name + "-node"},
+			},
+		}
+	}
+	newReconciler := func(g *WithT, kcp *controlplanev1.KubeadmControlPlane, workload *fakeWorkloadCluster, machines ...*clusterv1.Machine) (*KubeadmControlPlaneReconciler, *internal.ControlPlane) {
+		fakeClient := newFakeClient()
+		managementCluster := &fakeManagementCluster{Workload: workload}
+		r := &KubeadmControlPlaneReconciler{
+			Client:              fakeClient,
+			SecretCachingClient: fakeClient,
+			recorder:            record.NewFakeRecorder(32),
+			managementCluster:   managementCluster,
+		}
+		controlPlane, err := internal.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp, collections.FromMachines(machines...))
+		g.Expect(err).ToNot(HaveOccurred())
+		return r, controlPlane
+	}
+
+	t.Run("does nothing if etcd defragmentation is not configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Spec.EtcdDefragmentation = nil
+		workload := &fakeWorkloadCluster{
+			EtcdMemberDBStatusesResult: []internal.EtcdMemberDBStatus{
+				{NodeName: "m1-node", DBSize: 100 * mib, DBSizeInUse: 10 * mib},
+			},
+		}
+		r, controlPlane := newReconciler(g, kcp, workload, newMachine("m1"))
+
+		result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(workload.defragmentedEtcdMembers).To(BeEmpty())
+		g.Expect(conditions.Has(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition)).To(BeFalse())
+	})
+
+	t.Run("defers defragmentation while machines are provisioning", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		workload := &fakeWorkloadCluster{
+			EtcdMemberDBStatusesResult: []internal.EtcdMemberDBStatus{
+				{NodeName: "m1-node", DBSize: 100 * mib, DBSizeInUse: 10 * mib},
+			},
+		}
+		provisioning := newMachine("m2")
+		provisioning.Status.NodeRef = nil
+		r, controlPlane := newReconciler(g, kcp, workload, newMachine("m1"), provisioning)
+
+		result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(workload.defragmentedEtcdMembers).To(BeEmpty())
+	})
+
+	t.Run("surfaces inspection failures without blocking", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		workload := &fakeWorkloadCluster{
+			EtcdMemberDBStatusesErr: errors.New("failed to connect"),
+		}
+		r, controlPlane := newReconciler(g, kcp, workload, newMachine("m1"))
+
+		result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		c := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition)
+		g.Expect(c).ToNot(BeNil())
+		g.Expect(c.Status).To(Equal(metav1.ConditionUnknown))
+		g.Expect(c.Reason).To(Equal(controlplanev1.KubeadmControlPlaneEtcdDefragmentingInspectionFailedReason))
+	})
+
+	t.Run("defragments one member at a time, leader last", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		workload := &fakeWorkloadCluster{
+			EtcdMemberDBStatusesResult: []internal.EtcdMemberDBStatus{
+				{NodeName: "m1-node", DBSize: 100 * mib, DBSizeInUse: 10 * mib, IsLeader: true},
+				{NodeName: "m2-node", DBSize: 100 * mib, DBSizeInUse: 50 * mib},
+			},
+		}
+		r, controlPlane := newReconciler(g, kcp, workload, newMachine("m1"), newMachine("m2"))
+
+		result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(etcdDefragmentationRequeueAfter))
+		g.Expect(workload.defragmentedEtcdMembers).To(Equal([]string{"m2-node"}))
+		c := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition)
+		g.Expect(c).ToNot(BeNil())
+		g.Expect(c.Status).To(Equal(metav1.ConditionTrue))
+		g.Expect(c.Reason).To(Equal(controlplanev1.KubeadmControlPlaneEtcdDefragmentingReason))
+	})
+
+	t.Run("disarms NOSPACE alarms once all members have been defragmented", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		workload := &fakeWorkloadCluster{
+			EtcdMemberDBStatusesResult: []internal.EtcdMemberDBStatus{
+				{NodeName: "m1-node", DBSize: 100 * mib, DBSizeInUse: 99 * mib, IsLeader: true},
+			},
+			EtcdAlarmsResult: []etcd.MemberAlarm{
+				{MemberID: 1, Type: etcd.AlarmNoSpace},
+				{MemberID: 1, Type: etcd.AlarmCorrupt},
+			},
+		}
+		r, controlPlane := newReconciler(g, kcp, workload, newMachine("m1"))
+
+		result, err := r.reconcileEtcdDefragmentation(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(workload.defragmentedEtcdMembers).To(BeEmpty())
+		g.Expect(workload.disarmedEtcdAlarms).To(Equal([]etcd.MemberAlarm{{MemberID: 1, Type: etcd.AlarmNoSpace}}))
+		c := conditions.Get(kcp, controlplanev1.KubeadmControlPlaneEtcdDefragmentingCondition)
+		g.Expect(c).ToNot(BeNil())
+		g.Expect(c.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(c.Reason).To(Equal(controlplanev1.KubeadmControlPlaneEtcdNotDefragmentingReason))
+	})
+}
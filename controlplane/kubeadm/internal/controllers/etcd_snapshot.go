@@ -0,0 +1,330 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+const (
+	// etcdSnapshotTimeFormat is the format of the timestamp used in etcd snapshot names.
+	etcdSnapshotTimeFormat = "20060102150405"
+
+	// etcdRestoreRequeueAfter is how long to wait before checking again the progress of an etcd snapshot restore.
+	etcdRestoreRequeueAfter = 20 * time.Second
+)
+
+// reconcileEtcdSnapshot takes an etcd snapshot if the interval defined in spec.etcdSnapshot expired since
+// the last snapshot, and deletes the snapshots exceeding the retention.
+// NOTE: This requires that the control plane is up and running; it is expected to be called at the end of the reconcile.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdSnapshot(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	if kcp.Spec.EtcdSnapshot == nil || !controlPlane.IsEtcdManaged() {
+		return ctrl.Result{}, nil
+	}
+	if r.EtcdSnapshotStore == nil {
+		log.Info("Skipping etcd snapshot: no etcd snapshot store configured for the KubeadmControlPlane controller")
+		return ctrl.Result{}, nil
+	}
+
+	interval := time.Duration(kcp.Spec.EtcdSnapshot.IntervalSeconds) * time.Second
+	if kcp.Status.EtcdSnapshot != nil && kcp.Status.EtcdSnapshot.LastSnapshotTime != nil {
+		if next := kcp.Status.EtcdSnapshot.LastSnapshotTime.Add(interval); time.Now().Before(next) {
+			return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+		}
+	}
+
+	workloadCluster, err := controlPlane.GetWorkloadCluster(ctx)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrap(err, "failed to take etcd snapshot: failed to create client to workload cluster")
+	}
+
+	now := time.Now().UTC()
+	name := fmt.Sprintf("%s-%s", kcp.Name, now.Format(etcdSnapshotTimeFormat))
+	log.Info("Taking etcd snapshot", "snapshot", name)
+
+	// Stream the snapshot into the store without buffering it in memory.
+	pr, pw := io.Pipe()
+	go func() {
+		_, err := workloadCluster.SnapshotEtcd(ctx, pw)
+		_ = pw.CloseWithError(err)
+	}()
+	err = r.EtcdSnapshotStore.Write(ctx, client.ObjectKeyFromObject(kcp), name, pr)
+	_ = pr.Close()
+	if err != nil {
+		r.recorder.Eventf(kcp, corev1.EventTypeWarning, "FailedEtcdSnapshot", "Failed to take etcd snapshot %s: %v", name, err)
+		return ctrl.Result{}, errors.Wrapf(err, "failed to take etcd snapshot %s", name)
+	}
+
+	if kcp.Status.EtcdSnapshot == nil {
+		kcp.Status.EtcdSnapshot = &controlplanev1.EtcdSnapshotStatus{}
+	}
+	kcp.Status.EtcdSnapshot.LastSnapshotName = name
+	kcp.Status.EtcdSnapshot.LastSnapshotTime = ptr.To(metav1.NewTime(now))
+	r.recorder.Eventf(kcp, corev1.EventTypeNormal, "SuccessfulEtcdSnapshot", "Took etcd snapshot %s", name)
+
+	if err := r.deleteExpiredEtcdSnapshots(ctx, kcp); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
+
+// deleteExpiredEtcdSnapshots deletes the oldest etcd snapshots exceeding the retention defined in spec.etcdSnapshot.
+func (r *KubeadmControlPlaneReconciler) deleteExpiredEtcdSnapshots(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	retention := controlplanev1.DefaultEtcdSnapshotRetention
+	if kcp.Spec.EtcdSnapshot.Retention != nil {
+		retention = *kcp.Spec.EtcdSnapshot.Retention
+	}
+
+	snapshots, err := r.EtcdSnapshotStore.List(ctx, client.ObjectKeyFromObject(kcp))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete expired etcd snapshots")
+	}
+	// Snapshots are sorted from the oldest to the newest.
+	for i := 0; i < len(snapshots)-int(retention); i++ {
+		log.Info("Deleting expired etcd snapshot", "snapshot", snapshots[i].Name)
+		if err := r.EtcdSnapshotStore.Delete(ctx, client.ObjectKeyFromObject(kcp), snapshots[i].Name); err != nil {
+			return errors.Wrapf(err, "failed to delete expired etcd snapshot %s", snapshots[i].Name)
+		}
+	}
+	return nil
+}
+
+// reconcileEtcdRestore restores etcd from the snapshot requested using the RestoreEtcdSnapshotAnnotation.
+// The restore is executed in the following steps:
+//   - All the existing control plane Machines are deleted; given that the restore is usually required after etcd lost quorum,
+//     the pre-terminate hook is removed and node drain is skipped, because they require a working control plane.
+//   - A single Machine is created; its bootstrap data downloads the snapshot from the snapshot store and restores the etcd
+//     data directory from it before running kubeadm init.
+//   - Once the Machine has a Node, the restore is completed and the control plane is scaled back out by the regular reconcile.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdRestore(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	kcp := controlPlane.KCP
+
+	snapshotName, restoreRequested := kcp.Annotations[controlplanev1.RestoreEtcdSnapshotAnnotation]
+	var restore *controlplanev1.EtcdRestoreStatus
+	if kcp.Status.EtcdSnapshot != nil {
+		restore = kcp.Status.EtcdSnapshot.Restore
+	}
+
+	if !restoreRequested {
+		if restore == nil {
+			return ctrl.Result{}, nil
+		}
+		// The annotation has been removed while the restore was in progress; stop tracking the restore.
+		log.Info("Etcd snapshot restore has been aborted", "snapshot", restore.SnapshotName)
+		kcp.Status.EtcdSnapshot.Restore = nil
+		return ctrl.Result{}, r.deleteEtcdRestoreSecret(ctx, kcp)
+	}
+
+	if !controlPlane.IsEtcdManaged() {
+		return ctrl.Result{}, errors.Errorf("failed to restore etcd snapshot %s: etcd is not managed by KubeadmControlPlane", snapshotName)
+	}
+	if r.EtcdSnapshotStore == nil {
+		return ctrl.Result{}, errors.Errorf("failed to restore etcd snapshot %s: no etcd snapshot store configured for the KubeadmControlPlane controller", snapshotName)
+	}
+
+	if restore == nil || restore.SnapshotName != snapshotName {
+		// Check the snapshot can be downloaded before deleting any Machine.
+		if _, err := r.EtcdSnapshotStore.DownloadURL(ctx, client.ObjectKeyFromObject(kcp), snapshotName); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to restore etcd snapshot %s", snapshotName)
+		}
+
+		log.Info("Starting etcd snapshot restore", "snapshot", snapshotName)
+		if kcp.Status.EtcdSnapshot == nil {
+			kcp.Status.EtcdSnapshot = &controlplanev1.EtcdSnapshotStatus{}
+		}
+		kcp.Status.EtcdSnapshot.Restore = &controlplanev1.EtcdRestoreStatus{
+			SnapshotName: snapshotName,
+			StartTime:    metav1.Now(),
+		}
+		r.recorder.Eventf(kcp, corev1.EventTypeNormal, "EtcdSnapshotRestoreStarted", "Restoring etcd from snapshot %s", snapshotName)
+	}
+
+	// Delete all the Machines which have not been created to restore the snapshot.
+	restoreMachines := controlPlane.Machines.Filter(isEtcdRestoreMachine(kcp))
+	if machinesToDelete := controlPlane.Machines.Difference(restoreMachines); len(machinesToDelete) > 0 {
+		for _, machine := range machinesToDelete {
+			if err := r.deleteMachineForEtcdRestore(ctx, machine); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		log.Info("Waiting for control plane Machines to be deleted before restoring etcd snapshot", "snapshot", snapshotName, "Machines", machinesToDelete.Names())
+		return ctrl.Result{RequeueAfter: deleteRequeueAfter}, nil
+	}
+
+	// Create the Machine restoring the snapshot.
+	if len(restoreMachines) == 0 {
+		if err := r.reconcileEtcdRestoreSecret(ctx, controlPlane, snapshotName); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		fd, err := controlPlane.NextFailureDomainForScaleUp(ctx)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		newMachine, err := r.cloneConfigsAndGenerateMachine(ctx, controlPlane.Cluster, kcp, controlPlane.InitialControlPlaneConfig(), fd)
+		if err != nil {
+			r.recorder.Eventf(kcp, corev1.EventTypeWarning, "FailedEtcdSnapshotRestore", "Failed to create control plane Machine restoring etcd snapshot %s: %v", snapshotName, err)
+			return ctrl.Result{}, errors.Wrapf(err, "failed to create control plane Machine restoring etcd snapshot %s", snapshotName)
+		}
+		log.Info("Created control plane Machine restoring etcd snapshot", "snapshot", snapshotName, "Machine", klog.KObj(newMachine))
+		return ctrl.Result{RequeueAfter: etcdRestoreRequeueAfter}, nil
+	}
+
+	// Wait for the Machine restoring the snapshot to have a Node.
+	restoreMachine := restoreMachines.Oldest()
+	if restoreMachine.Status.NodeRef == nil {
+		log.Info("Waiting for control plane Machine restoring etcd snapshot to have a Node", "snapshot", snapshotName, "Machine", klog.KObj(restoreMachine))
+		return ctrl.Result{RequeueAfter: etcdRestoreRequeueAfter}, nil
+	}
+
+	// The restore is completed, the control plane can be scaled out.
+	if err := r.deleteEtcdRestoreSecret(ctx, kcp); err != nil {
+		return ctrl.Result{}, err
+	}
+	delete(kcp.Annotations, controlplanev1.RestoreEtcdSnapshotAnnotation)
+	kcp.Status.EtcdSnapshot.Restore = nil
+	log.Info("Completed etcd snapshot restore", "snapshot", snapshotName)
+	r.recorder.Eventf(kcp, corev1.EventTypeNormal, "EtcdSnapshotRestored", "Restored etcd from snapshot %s", snapshotName)
+	return ctrl.Result{}, nil
+}
+
+// deleteMachineForEtcdRestore deletes a control plane Machine without waiting for operations requiring a working control plane.
+func (r *KubeadmControlPlaneReconciler) deleteMachineForEtcdRestore(ctx context.Context, machine *clusterv1.Machine) error {
+	machineOriginal := machine.DeepCopy()
+	if machine.Annotations == nil {
+		machine.Annotations = map[string]string{}
+	}
+	delete(machine.Annotations, controlplanev1.PreTerminateHookCleanupAnnotation)
+	machine.Annotations[clusterv1.ExcludeNodeDrainingAnnotation] = ""
+	machine.Annotations[clusterv1.ExcludeWaitForNodeVolumeDetachAnnotation] = ""
+	if err := r.Client.Patch(ctx, machine, client.MergeFrom(machineOriginal)); err != nil {
+		return errors.Wrapf(err, "failed to prepare control plane Machine %s for deletion", klog.KObj(machine))
+	}
+
+	if !machine.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if err := r.Client.Delete(ctx, machine); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete control plane Machine %s", klog.KObj(machine))
+	}
+	return nil
+}
+
+// etcdRestoreSecretName returns the name of the Secret used to pass the location of the etcd snapshot to the Machine restoring it.
+func etcdRestoreSecretName(kcp *controlplanev1.KubeadmControlPlane) string {
+	return fmt.Sprintf("%s-etcd-restore", kcp.Name)
+}
+
+// reconcileEtcdRestoreSecret creates the Secret used to pass the location of the etcd snapshot to the Machine restoring it.
+// NOTE: The snapshot is not embedded in the bootstrap data, because its size usually exceeds both the maximum size of a Secret
+// and the user data limits of infrastructure providers; instead the Machine downloads it from the snapshot store and
+// verifies it using the checksum computed here.
+func (r *KubeadmControlPlaneReconciler) reconcileEtcdRestoreSecret(ctx context.Context, controlPlane *internal.ControlPlane, snapshotName string) error {
+	kcp := controlPlane.KCP
+
+	downloadURL, err := r.EtcdSnapshotStore.DownloadURL(ctx, client.ObjectKeyFromObject(kcp), snapshotName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to restore etcd snapshot %s", snapshotName)
+	}
+
+	rc, err := r.EtcdSnapshotStore.Open(ctx, client.ObjectKeyFromObject(kcp), snapshotName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to restore etcd snapshot %s", snapshotName)
+	}
+	defer rc.Close()
+
+	// Compute the checksum streaming the snapshot, without buffering it in memory.
+	hash := sha256.New()
+	if _, err := io.Copy(hash, rc); err != nil {
+		return errors.Wrapf(err, "failed to restore etcd snapshot %s: failed to read snapshot", snapshotName)
+	}
+
+	restoreSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kcp.Namespace,
+			Name:      etcdRestoreSecretName(kcp),
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel: controlPlane.Cluster.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(kcp, controlplanev1.GroupVersion.WithKind(kubeadmControlPlaneKind)),
+			},
+		},
+		Data: map[string][]byte{
+			bootstrapv1.EtcdSnapshotURLSecretKey:    []byte(downloadURL),
+			bootstrapv1.EtcdSnapshotSHA256SecretKey: []byte(hex.EncodeToString(hash.Sum(nil))),
+		},
+		Type: clusterv1.ClusterSecretType,
+	}
+	// Recreate the Secret so it always points to the requested snapshot.
+	if err := r.deleteEtcdRestoreSecret(ctx, kcp); err != nil {
+		return err
+	}
+	if err := r.Client.Create(ctx, restoreSecret); err != nil {
+		return errors.Wrapf(err, "failed to create Secret %s", klog.KObj(restoreSecret))
+	}
+	return nil
+}
+
+// deleteEtcdRestoreSecret deletes the Secret used to pass the location of the etcd snapshot to the Machine restoring it.
+func (r *KubeadmControlPlaneReconciler) deleteEtcdRestoreSecret(ctx context.Context, kcp *controlplanev1.KubeadmControlPlane) error {
+	restoreSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: kcp.Namespace,
+			Name:      etcdRestoreSecretName(kcp),
+		},
+	}
+	if err := r.Client.Delete(ctx, restoreSecret); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete Secret %s", klog.KObj(restoreSecret))
+	}
+	return nil
+}
+
+// isEtcdRestoreMachine returns true if the Machine has been created to restore the etcd snapshot requested on the KubeadmControlPlane.
+func isEtcdRestoreMachine(kcp *controlplanev1.KubeadmControlPlane) collections.Func {
+	return func(machine *clusterv1.Machine) bool {
+		snapshotName, ok := kcp.Annotations[controlplanev1.RestoreEtcdSnapshotAnnotation]
+		return ok && machine != nil && machine.Annotations[controlplanev1.RestoreEtcdSnapshotAnnotation] == snapshotName
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd/snapshot"
+	"sigs.k8s.io/cluster-api/util/collections"
+)
+
+func TestReconcileEtcdSnapshot(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	newKCP := func() *controlplanev1.KubeadmControlPlane {
+		return &controlplanev1.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "kcp",
+			},
+			Spec: controlplanev1.KubeadmControlPlaneSpec{
+				EtcdSnapshot: &controlplanev1.EtcdSnapshotPolicy{
+					IntervalSeconds: 3600,
+					Retention:       ptr.To[int32](2),
+				},
+			},
+		}
+	}
+
+	t.Run("does nothing if etcd snapshots are not configured", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Spec.EtcdSnapshot = nil
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.Machines{})
+
+		result, err := r.reconcileEtcdSnapshot(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(kcp.Status.EtcdSnapshot).To(BeNil())
+	})
+
+	t.Run("requeues if the interval has not expired yet", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Status.EtcdSnapshot = &controlplanev1.EtcdSnapshotStatus{
+			LastSnapshotName: "kcp-1",
+			LastSnapshotTime: ptr.To(metav1.NewTime(time.Now().Add(-30 * time.Minute))),
+		}
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.Machines{})
+
+		result, err := r.reconcileEtcdSnapshot(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(BeNumerically("~", 30*time.Minute, time.Minute))
+		g.Expect(kcp.Status.EtcdSnapshot.LastSnapshotName).To(Equal("kcp-1"))
+	})
+
+	t.Run("takes a snapshot and deletes snapshots exceeding the retention", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Status.EtcdSnapshot = &controlplanev1.EtcdSnapshotStatus{
+			LastSnapshotName: "kcp-2",
+			LastSnapshotTime: ptr.To(metav1.NewTime(time.Now().Add(-2 * time.Hour))),
+		}
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(store.Write(ctx, client.ObjectKeyFromObject(kcp), "kcp-1", bytes.NewBufferString("old"))).To(Succeed())
+		g.Expect(store.Write(ctx, client.ObjectKeyFromObject(kcp), "kcp-2", bytes.NewBufferString("old"))).To(Succeed())
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.Machines{})
+
+		result, err := r.reconcileEtcdSnapshot(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(time.Hour))
+		g.Expect(kcp.Status.EtcdSnapshot.LastSnapshotName).ToNot(Equal("kcp-2"))
+		g.Expect(kcp.Status.EtcdSnapshot.LastSnapshotTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+
+		rc, err := store.Open(ctx, client.ObjectKeyFromObject(kcp), kcp.Status.EtcdSnapshot.LastSnapshotName)
+		g.Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(rc)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rc.Close()).To(Succeed())
+		g.Expect(string(data)).To(Equal("snapshot"))
+
+		snapshots, err := store.List(ctx, client.ObjectKeyFromObject(kcp))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshots).To(HaveLen(2))
+		g.Expect(snapshots[0].Name).To(Equal("kcp-2"))
+		g.Expect(snapshots[1].Name).To(Equal(kcp.Status.EtcdSnapshot.LastSnapshotName))
+	})
+}
+
+func TestReconcileEtcdRestore(t *testing.T) {
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	newKCP := func() *controlplanev1.KubeadmControlPlane {
+		return &controlplanev1.KubeadmControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "kcp",
+				UID:       "kcp-uid",
+				Annotations: map[string]string{
+					controlplanev1.RestoreEtcdSnapshotAnnotation: "kcp-1",
+				},
+			},
+		}
+	}
+	newMachine := func(name string, annotations map[string]string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   metav1.NamespaceDefault,
+				Name:        name,
+				Annotations: annotations,
+			},
+			Spec: clusterv1.MachineSpec{
+				InfrastructureRef: corev1.ObjectReference{
+					Kind:       "GenericMachine",
+					APIVersion: "generic.io/v1",
+					Namespace:  metav1.NamespaceDefault,
+					Name:       name + "-infra",
+				},
+			},
+		}
+	}
+
+	t.Run("does nothing if no restore has been requested", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Annotations = nil
+		machine := newMachine("m1", nil)
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, nil, collections.FromMachines(machine), machine)
+
+		result, err := r.reconcileEtcdRestore(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(machine), &clusterv1.Machine{})).To(Succeed())
+	})
+
+	t.Run("fails if the snapshot does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.Machines{})
+
+		_, err = r.reconcileEtcdRestore(ctx, controlPlane)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(kcp.Status.EtcdSnapshot).To(BeNil())
+	})
+
+	t.Run("starts the restore and deletes existing Machines", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(store.Write(ctx, client.ObjectKeyFromObject(kcp), "kcp-1", bytes.NewBufferString("snapshot"))).To(Succeed())
+		machine := newMachine("m1", map[string]string{
+			controlplanev1.PreTerminateHookCleanupAnnotation: "",
+		})
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.FromMachines(machine), machine)
+
+		result, err := r.reconcileEtcdRestore(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(deleteRequeueAfter))
+		g.Expect(kcp.Status.EtcdSnapshot.Restore).ToNot(BeNil())
+		g.Expect(kcp.Status.EtcdSnapshot.Restore.SnapshotName).To(Equal("kcp-1"))
+
+		err = r.Client.Get(ctx, client.ObjectKeyFromObject(machine), &clusterv1.Machine{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	t.Run("fails if the snapshot cannot be downloaded", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(store.Write(ctx, client.ObjectKeyFromObject(kcp), "kcp-1", bytes.NewBufferString("snapshot"))).To(Succeed())
+		machine := newMachine("m1", nil)
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.FromMachines(machine), machine)
+
+		_, err = r.reconcileEtcdRestore(ctx, controlPlane)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(kcp.Status.EtcdSnapshot).To(BeNil())
+		// Machines must not be deleted if the snapshot cannot be restored.
+		g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(machine), &clusterv1.Machine{})).To(Succeed())
+	})
+
+	t.Run("creates the Secret with the location of the snapshot", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(store.Write(ctx, client.ObjectKeyFromObject(kcp), "kcp-1", bytes.NewBufferString("snapshot"))).To(Succeed())
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.Machines{})
+
+		g.Expect(r.reconcileEtcdRestoreSecret(ctx, controlPlane, "kcp-1")).To(Succeed())
+
+		restoreSecret := &corev1.Secret{}
+		g.Expect(r.Client.Get(ctx, client.ObjectKey{Namespace: kcp.Namespace, Name: etcdRestoreSecretName(kcp)}, restoreSecret)).To(Succeed())
+		g.Expect(restoreSecret.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, cluster.Name))
+		g.Expect(restoreSecret.Data).To(HaveKeyWithValue(bootstrapv1.EtcdSnapshotURLSecretKey, []byte("https://snapshots.example.com/default/kcp/kcp-1.db")))
+		// sha256sum of "snapshot".
+		g.Expect(restoreSecret.Data).To(HaveKeyWithValue(bootstrapv1.EtcdSnapshotSHA256SecretKey, []byte("16a0eeb0791b6c92451fd284dd9f599e0a7dbe7f6ebea6e2d2d06c7f74aec112")))
+	})
+
+	t.Run("waits for the Machine restoring the snapshot to have a Node", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Status.EtcdSnapshot = &controlplanev1.EtcdSnapshotStatus{
+			Restore: &controlplanev1.EtcdRestoreStatus{
+				SnapshotName: "kcp-1",
+				StartTime:    metav1.Now(),
+			},
+		}
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+		machine := newMachine("m1", map[string]string{
+			controlplanev1.RestoreEtcdSnapshotAnnotation: "kcp-1",
+		})
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.FromMachines(machine), machine)
+
+		result, err := r.reconcileEtcdRestore(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(etcdRestoreRequeueAfter))
+		g.Expect(kcp.Status.EtcdSnapshot.Restore).ToNot(BeNil())
+		g.Expect(kcp.Annotations).To(HaveKey(controlplanev1.RestoreEtcdSnapshotAnnotation))
+	})
+
+	t.Run("completes the restore when the Machine restoring the snapshot has a Node", func(t *testing.T) {
+		g := NewWithT(t)
+
+		kcp := newKCP()
+		kcp.Status.EtcdSnapshot = &controlplanev1.EtcdSnapshotStatus{
+			Restore: &controlplanev1.EtcdRestoreStatus{
+				SnapshotName: "kcp-1",
+				StartTime:    metav1.Now(),
+			},
+		}
+		store, err := snapshot.NewFilesystemStore(t.TempDir(), "https://snapshots.example.com")
+		g.Expect(err).ToNot(HaveOccurred())
+		machine := newMachine("m1", map[string]string{
+			controlplanev1.RestoreEtcdSnapshotAnnotation: "kcp-1",
+		})
+		machine.Status.NodeRef = &clusterv1.MachineNodeReference{Name: "node-1"}
+		restoreSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: kcp.Namespace,
+				Name:      etcdRestoreSecretName(kcp),
+			},
+		}
+
+		r, controlPlane := newEtcdSnapshotTestReconciler(g, cluster, kcp, store, collections.FromMachines(machine), machine, restoreSecret)
+
+		result, err := r.reconcileEtcdRestore(ctx, controlPlane)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.IsZero()).To(BeTrue())
+		g.Expect(kcp.Status.EtcdSnapshot.Restore).To(BeNil())
+		g.Expect(kcp.Annotations).ToNot(HaveKey(controlplanev1.RestoreEtcdSnapshotAnnotation))
+
+		err = r.Client.Get(ctx, client.ObjectKeyFromObject(restoreSecret), &corev1.Secret{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+}
+
+func newEtcdSnapshotTestReconciler(g *WithT, cluster *clusterv1.Cluster, kcp *controlplanev1.KubeadmControlPlane, store snapshot.Store, machines collections.Machines, objs ...client.Object) (*KubeadmControlPlaneReconciler, *internal.ControlPlane) {
+	fakeClient := newFakeClient(objs...)
+	managementCluster := &fakeManagementCluster{
+		Workload: &fakeWorkloadCluster{
+			EtcdSnapshotData: []byte("snapshot"),
+		},
+	}
+
+	r := &KubeadmControlPlaneReconciler{
+		Client:              fakeClient,
+		SecretCachingClient: fakeClient,
+		recorder:            record.NewFakeRecorder(32),
+		managementCluster:   managementCluster,
+		EtcdSnapshotStore:   store,
+	}
+
+	controlPlane, err := internal.NewControlPlane(ctx, managementCluster, fakeClient, cluster, kcp, machines)
+	g.Expect(err).ToNot(HaveOccurred())
+	return r, controlPlane
+}
@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -71,10 +72,17 @@ type fakeWorkloadCluster struct {
 	*internal.Workload
 	Status                     internal.ClusterStatus
 	EtcdMembersResult          []string
+	EtcdSnapshotData           []byte
+	EtcdMemberDBStatusesResult []internal.EtcdMemberDBStatus
+	EtcdAlarmsResult           []etcd.MemberAlarm
+	EtcdMemberDBStatusesErr    error
 	APIServerCertificateExpiry *time.Time
 
 	forwardEtcdLeadershipCalled      int
 	removeEtcdMemberForMachineCalled int
+	defragmentedEtcdMembers          []string
+	disarmedEtcdAlarms               []etcd.MemberAlarm
+	clusterInfoCertificateAuthority  []byte
 }
 
 func (f *fakeWorkloadCluster) ForwardEtcdLeadership(_ context.Context, _ *clusterv1.Machine, leaderCandidate *clusterv1.Machine) error {
@@ -114,6 +122,30 @@ func (f *fakeWorkloadCluster) EtcdMembers(_ context.Context) ([]string, error) {
 	return f.EtcdMembersResult, nil
 }
 
+func (f *fakeWorkloadCluster) SnapshotEtcd(_ context.Context, w io.Writer) (int64, error) {
+	n, err := w.Write(f.EtcdSnapshotData)
+	return int64(n), err
+}
+
+func (f *fakeWorkloadCluster) EtcdMemberDBStatuses(_ context.Context, _ []string) ([]internal.EtcdMemberDBStatus, []etcd.MemberAlarm, error) {
+	return f.EtcdMemberDBStatusesResult, f.EtcdAlarmsResult, f.EtcdMemberDBStatusesErr
+}
+
+func (f *fakeWorkloadCluster) DefragmentEtcdMember(_ context.Context, nodeName string) error {
+	f.defragmentedEtcdMembers = append(f.defragmentedEtcdMembers, nodeName)
+	return nil
+}
+
+func (f *fakeWorkloadCluster) DisarmEtcdAlarms(_ context.Context, _ []string, alarms []etcd.MemberAlarm) error {
+	f.disarmedEtcdAlarms = append(f.disarmedEtcdAlarms, alarms...)
+	return nil
+}
+
+func (f *fakeWorkloadCluster) UpdateClusterInfoCertificateAuthority(_ context.Context, caData []byte) error {
+	f.clusterInfoCertificateAuthority = caData
+	return nil
+}
+
 func (f *fakeWorkloadCluster) UpdateClusterConfiguration(context.Context, semver.Version, ...func(*bootstrapv1.ClusterConfiguration)) error {
 	return nil
 }
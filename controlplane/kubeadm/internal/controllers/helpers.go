@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"maps"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -103,7 +104,13 @@ func (r *KubeadmControlPlaneReconciler) reconcileKubeconfig(ctx context.Context,
 		return ctrl.Result{}, err
 	}
 
-	if needsRotation {
+	// During a cluster CA rotation, the kubeconfig must trust the CA bundle for the current phase.
+	needsCAUpdate, err := r.kubeconfigNeedsCAUpdate(ctx, controlPlane, configSecret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if needsRotation || needsCAUpdate {
 		log.Info("Rotating kubeconfig secret")
 		if err := kubeconfig.RegenerateSecret(ctx, r.Client, configSecret); err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "failed to regenerate kubeconfig")
@@ -282,12 +289,21 @@ func (r *KubeadmControlPlaneReconciler) generateKubeadmConfig(ctx context.Contex
 		UID:        kcp.UID,
 	}
 
+	annotations := kcp.Spec.MachineTemplate.ObjectMeta.Annotations
+	// In case the KubeadmConfig is being created to restore an etcd snapshot, tell the bootstrap provider
+	// where to find the snapshot.
+	if _, ok := kcp.Annotations[controlplanev1.RestoreEtcdSnapshotAnnotation]; ok {
+		annotations = map[string]string{}
+		maps.Copy(annotations, kcp.Spec.MachineTemplate.ObjectMeta.Annotations)
+		annotations[bootstrapv1.RestoreEtcdSnapshotSecretAnnotation] = etcdRestoreSecretName(kcp)
+	}
+
 	bootstrapConfig := &bootstrapv1.KubeadmConfig{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            name,
 			Namespace:       kcp.Namespace,
 			Labels:          internal.ControlPlaneMachineLabelsForCluster(kcp, cluster.Name),
-			Annotations:     kcp.Spec.MachineTemplate.ObjectMeta.Annotations,
+			Annotations:     annotations,
 			OwnerReferences: []metav1.OwnerReference{owner},
 		},
 		Spec: *spec,
@@ -396,6 +412,12 @@ func (r *KubeadmControlPlaneReconciler) computeDesiredMachine(kcp *controlplanev
 		if remediationData, ok := kcp.Annotations[controlplanev1.RemediationInProgressAnnotation]; ok {
 			annotations[controlplanev1.RemediationForAnnotation] = remediationData
 		}
+
+		// In case this machine is being created to restore an etcd snapshot, then add an annotation
+		// tracking the snapshot being restored.
+		if snapshotName, ok := kcp.Annotations[controlplanev1.RestoreEtcdSnapshotAnnotation]; ok {
+			annotations[controlplanev1.RestoreEtcdSnapshotAnnotation] = snapshotName
+		}
 	} else {
 		// Updating an existing machine
 		machineName = existingMachine.Name
@@ -426,6 +448,21 @@ func (r *KubeadmControlPlaneReconciler) computeDesiredMachine(kcp *controlplanev
 		if remediationData, ok := existingMachine.Annotations[controlplanev1.RemediationForAnnotation]; ok {
 			annotations[controlplanev1.RemediationForAnnotation] = remediationData
 		}
+
+		// If the machine has been created to restore an etcd snapshot then preserve it.
+		if snapshotName, ok := existingMachine.Annotations[controlplanev1.RestoreEtcdSnapshotAnnotation]; ok {
+			annotations[controlplanev1.RestoreEtcdSnapshotAnnotation] = snapshotName
+		}
+
+		// If the machine is being updated in-place then preserve the Runtime Extension performing the update.
+		if extensionName, ok := existingMachine.Annotations[controlplanev1.InPlaceUpdateExtensionAnnotation]; ok {
+			annotations[controlplanev1.InPlaceUpdateExtensionAnnotation] = extensionName
+		}
+
+		// If the machine certificates are being renewed in-place then preserve the Runtime Extension performing the renewal.
+		if extensionName, ok := existingMachine.Annotations[controlplanev1.CertificatesRenewalExtensionAnnotation]; ok {
+			annotations[controlplanev1.CertificatesRenewalExtensionAnnotation] = extensionName
+		}
 	}
 	// Setting pre-terminate hook so we can later remove the etcd member right before Machine termination
 	// (i.e. before InfraMachine deletion).
@@ -0,0 +1,350 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// inPlaceUpdatesEnabled returns true if control plane machines can be updated in-place.
+func (r *KubeadmControlPlaneReconciler) inPlaceUpdatesEnabled() bool {
+	return r.RuntimeClient != nil && feature.Gates.Enabled(feature.RuntimeSDK) && feature.Gates.Enabled(feature.InPlaceUpdates)
+}
+
+// reconcileInPlaceUpdates drives in-place updates in progress to completion by calling the UpdateMachine hook of the
+// Runtime Extension performing the update, until the update is either completed or failed.
+// NOTE: While an in-place update is in progress all the other rollout or scale operations are blocked.
+func (r *KubeadmControlPlaneReconciler) reconcileInPlaceUpdates(ctx context.Context, controlPlane *internal.ControlPlane) (ctrl.Result, error) {
+	if !r.inPlaceUpdatesEnabled() {
+		return ctrl.Result{}, nil
+	}
+
+	machinesUpdatingInPlace := controlPlane.Machines.Filter(
+		collections.Not(collections.HasDeletionTimestamp),
+		func(machine *clusterv1.Machine) bool {
+			return conditions.IsTrue(machine, controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition)
+		},
+	)
+	if len(machinesUpdatingInPlace) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	// Note: KCP updates one machine at a time, so usually there is only one machine updating in-place.
+	machine := machinesUpdatingInPlace.Oldest()
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	extensionName := machine.Annotations[controlplanev1.InPlaceUpdateExtensionAnnotation]
+	if extensionName == "" {
+		return ctrl.Result{}, r.completeInPlaceUpdate(ctx, controlPlane, machine, errors.Errorf("annotation %s is not set", controlplanev1.InPlaceUpdateExtensionAnnotation))
+	}
+
+	desired, err := r.machineUpdateState(ctx, machine)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	request := &runtimehooksv1.UpdateMachineRequest{
+		Desired: *desired,
+	}
+	response := &runtimehooksv1.UpdateMachineResponse{}
+	if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.UpdateMachine, machine, extensionName, request, response); err != nil {
+		if response.GetStatus() == runtimehooksv1.ResponseStatusFailure {
+			return ctrl.Result{}, r.completeInPlaceUpdate(ctx, controlPlane, machine, errors.New(response.GetMessage()))
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "failed to call UpdateMachine hook of extension %s for Machine %s", extensionName, machine.Name)
+	}
+
+	if response.GetRetryAfterSeconds() > 0 {
+		log.V(4).Info("In-place update in progress", "extension", extensionName, "message", response.GetMessage())
+		if err := r.patchMachine(ctx, controlPlane, machine, func(m *clusterv1.Machine) {
+			conditions.Set(m, metav1.Condition{
+				Type:    controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateInProgressReason,
+				Message: inPlaceUpdateMessage(extensionName, response.GetMessage()),
+			})
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: time.Duration(response.GetRetryAfterSeconds()) * time.Second}, nil
+	}
+
+	if err := r.completeInPlaceUpdate(ctx, controlPlane, machine, nil); err != nil {
+		return ctrl.Result{}, err
+	}
+	// Requeue so other outdated machines, if any, are updated.
+	return ctrl.Result{Requeue: true}, nil
+}
+
+// completeInPlaceUpdate marks the in-place update of a machine as completed, or as failed if an error is passed.
+// NOTE: Machines with a failed in-place update are considered not up-to-date, and thus rolled out.
+func (r *KubeadmControlPlaneReconciler) completeInPlaceUpdate(ctx context.Context, controlPlane *internal.ControlPlane, machine *clusterv1.Machine, updateErr error) error {
+	log := ctrl.LoggerFrom(ctx)
+	extensionName := machine.Annotations[controlplanev1.InPlaceUpdateExtensionAnnotation]
+
+	condition := metav1.Condition{
+		Type:   controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+		Status: metav1.ConditionFalse,
+		Reason: controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateCompletedReason,
+	}
+	if updateErr != nil {
+		condition.Reason = controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateFailedReason
+		condition.Message = inPlaceUpdateMessage(extensionName, updateErr.Error())
+	}
+
+	if err := r.patchMachine(ctx, controlPlane, machine, func(m *clusterv1.Machine) {
+		delete(m.Annotations, controlplanev1.InPlaceUpdateExtensionAnnotation)
+		conditions.Set(m, condition)
+	}); err != nil {
+		return err
+	}
+
+	if updateErr != nil {
+		log.Info("In-place update failed, Machine will be rolled out", "extension", extensionName, "reason", updateErr.Error())
+		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "FailedInPlaceUpdate", "Failed to update Machine %s in-place: %v", machine.Name, updateErr)
+		return nil
+	}
+	log.Info("In-place update completed", "extension", extensionName)
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "SuccessfulInPlaceUpdate", "Machine %s updated in-place", machine.Name)
+	return nil
+}
+
+// tryUpdateInPlace starts the in-place update of one of the machines requiring a rollout, if all the changes required to bring
+// the machine up-to-date can be performed in-place and a Runtime Extension accepts to perform them.
+// The func returns true if an in-place update has been started; otherwise, the machines are rolled out.
+func (r *KubeadmControlPlaneReconciler) tryUpdateInPlace(ctx context.Context, controlPlane *internal.ControlPlane, machinesRequireUpgrade collections.Machines) (ctrl.Result, bool, error) {
+	if !r.inPlaceUpdatesEnabled() {
+		return ctrl.Result{}, false, nil
+	}
+
+	// Only update machines in-place when the number of machines is equal to the desired number of replicas;
+	// otherwise, e.g. in the middle of a rollout, continue with the rollout.
+	if controlPlane.KCP.Spec.Replicas == nil || int32(controlPlane.Machines.Len()) != *controlPlane.KCP.Spec.Replicas {
+		return ctrl.Result{}, false, nil
+	}
+
+	var machine *clusterv1.Machine
+	for _, m := range machinesRequireUpgrade.SortedByCreationTimestamp() {
+		canUpdateInPlace, err := controlPlane.CanUpdateMachineInPlace(m)
+		if err != nil {
+			return ctrl.Result{}, false, err
+		}
+		if canUpdateInPlace {
+			machine = m
+			break
+		}
+	}
+	if machine == nil {
+		return ctrl.Result{}, false, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine))
+	ctx = ctrl.LoggerInto(ctx, log)
+
+	extensionNames, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.CanUpdateMachine, machine)
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+	if len(extensionNames) == 0 {
+		return ctrl.Result{}, false, nil
+	}
+
+	current, err := r.machineUpdateState(ctx, machine)
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+	desiredMachine, desiredKubeadmConfig, err := computeDesiredInPlaceUpdate(controlPlane.KCP, machine, current.BootstrapConfig.Object.(*bootstrapv1.KubeadmConfig))
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+	desired, err := newMachineUpdateState(desiredMachine, desiredKubeadmConfig)
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+
+	extensionName := ""
+	for _, name := range extensionNames {
+		request := &runtimehooksv1.CanUpdateMachineRequest{
+			Current: *current,
+			Desired: *desired,
+		}
+		response := &runtimehooksv1.CanUpdateMachineResponse{}
+		if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.CanUpdateMachine, machine, name, request, response); err != nil {
+			// Do not block the rollout if an extension is not available; the extension is considered as declining
+			// to update the Machine in-place, so the Machine is eventually rolled out.
+			log.Error(err, "Failed to call CanUpdateMachine hook, considering the extension as declining to update Machine in-place", "extension", name)
+			continue
+		}
+		if response.CanUpdate {
+			extensionName = name
+			break
+		}
+		log.V(4).Info("Extension declined to update Machine in-place", "extension", name, "message", response.GetMessage())
+	}
+	if extensionName == "" {
+		log.Info("No extension accepted to update Machine in-place, Machine will be rolled out")
+		return ctrl.Result{}, false, nil
+	}
+
+	// Make sure the control plane is healthy before updating a machine.
+	if result, err := r.preflightChecks(ctx, controlPlane); err != nil || !result.IsZero() {
+		return result, true, err
+	}
+
+	log.Info("Updating Machine in-place", "extension", extensionName)
+
+	// Update the KubeadmConfig first, then the Machine; this ensures that, once the Machine is marked as updating in-place,
+	// both the objects reflect the desired state, and thus the Machine is not selected again for a rollout.
+	kubeadmConfig := current.BootstrapConfig.Object.(*bootstrapv1.KubeadmConfig)
+	kubeadmConfigPatchHelper, err := patch.NewHelper(kubeadmConfig, r.Client)
+	if err != nil {
+		return ctrl.Result{}, false, err
+	}
+	if err := kubeadmConfigPatchHelper.Patch(ctx, desiredKubeadmConfig); err != nil {
+		return ctrl.Result{}, false, errors.Wrapf(err, "failed to update KubeadmConfig %s", klog.KObj(desiredKubeadmConfig))
+	}
+
+	if err := r.patchMachine(ctx, controlPlane, machine, func(m *clusterv1.Machine) {
+		m.Spec.Version = desiredMachine.Spec.Version
+		if m.Annotations == nil {
+			m.Annotations = map[string]string{}
+		}
+		m.Annotations[controlplanev1.KubeadmClusterConfigurationAnnotation] = desiredMachine.Annotations[controlplanev1.KubeadmClusterConfigurationAnnotation]
+		m.Annotations[controlplanev1.InPlaceUpdateExtensionAnnotation] = extensionName
+		conditions.Set(m, metav1.Condition{
+			Type:    controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateInProgressReason,
+			Message: inPlaceUpdateMessage(extensionName, ""),
+		})
+	}); err != nil {
+		return ctrl.Result{}, false, err
+	}
+	r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeNormal, "InPlaceUpdateStarted", "Updating Machine %s in-place using extension %s", machine.Name, extensionName)
+
+	// Requeue to start calling the UpdateMachine hook.
+	return ctrl.Result{Requeue: true}, true, nil
+}
+
+// computeDesiredInPlaceUpdate computes the desired state of the Machine and of the KubeadmConfig after an in-place update.
+// NOTE: Only fields which can be updated in-place are changed; see internal.CanUpdateInPlace.
+func computeDesiredInPlaceUpdate(kcp *controlplanev1.KubeadmControlPlane, machine *clusterv1.Machine, kubeadmConfig *bootstrapv1.KubeadmConfig) (*clusterv1.Machine, *bootstrapv1.KubeadmConfig, error) {
+	desiredMachine := machine.DeepCopy()
+	desiredMachine.Spec.Version = &kcp.Spec.Version
+	clusterConfigurationAnnotation, err := internal.ClusterConfigurationToMachineAnnotationValue(kcp.Spec.KubeadmConfigSpec.ClusterConfiguration)
+	if err != nil {
+		return nil, nil, err
+	}
+	if desiredMachine.Annotations == nil {
+		desiredMachine.Annotations = map[string]string{}
+	}
+	desiredMachine.Annotations[controlplanev1.KubeadmClusterConfigurationAnnotation] = clusterConfigurationAnnotation
+
+	desiredKubeadmConfig := kubeadmConfig.DeepCopy()
+	if desiredKubeadmConfig.Spec.InitConfiguration != nil && kcp.Spec.KubeadmConfigSpec.InitConfiguration != nil {
+		desiredKubeadmConfig.Spec.InitConfiguration.NodeRegistration.KubeletExtraArgs = kcp.Spec.KubeadmConfigSpec.InitConfiguration.NodeRegistration.KubeletExtraArgs
+	}
+	if desiredKubeadmConfig.Spec.JoinConfiguration != nil && kcp.Spec.KubeadmConfigSpec.JoinConfiguration != nil {
+		desiredKubeadmConfig.Spec.JoinConfiguration.NodeRegistration.KubeletExtraArgs = kcp.Spec.KubeadmConfigSpec.JoinConfiguration.NodeRegistration.KubeletExtraArgs
+	}
+	return desiredMachine, desiredKubeadmConfig, nil
+}
+
+// machineUpdateState returns the current state of a Machine and of its KubeadmConfig to be used in in-place update hooks.
+// NOTE: The KubeadmConfig is read from the API server, because the KubeadmConfigs in the control plane scope are
+// modified when checking if machines are up-to-date.
+func (r *KubeadmControlPlaneReconciler) machineUpdateState(ctx context.Context, machine *clusterv1.Machine) (*runtimehooksv1.MachineUpdateState, error) {
+	if machine.Spec.Bootstrap.ConfigRef == nil {
+		return nil, errors.Errorf("failed to get KubeadmConfig for Machine %s: spec.bootstrap.configRef is not set", machine.Name)
+	}
+	kubeadmConfig := &bootstrapv1.KubeadmConfig{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: machine.Namespace, Name: machine.Spec.Bootstrap.ConfigRef.Name}, kubeadmConfig); err != nil {
+		return nil, errors.Wrapf(err, "failed to get KubeadmConfig for Machine %s", machine.Name)
+	}
+	return newMachineUpdateState(machine, kubeadmConfig)
+}
+
+// newMachineUpdateState returns a MachineUpdateState for the given Machine and KubeadmConfig.
+func newMachineUpdateState(machine *clusterv1.Machine, kubeadmConfig *bootstrapv1.KubeadmConfig) (*runtimehooksv1.MachineUpdateState, error) {
+	// Note: Runtime hooks are using v1beta1 core types.
+	v1beta1Machine := &clusterv1beta1.Machine{}
+	if err := clusterv1beta1.Convert_v1beta2_Machine_To_v1beta1_Machine(machine, v1beta1Machine, nil); err != nil {
+		return nil, errors.Wrapf(err, "failed to convert Machine %s to v1beta1", machine.Name)
+	}
+	v1beta1Machine.SetGroupVersionKind(clusterv1beta1.GroupVersion.WithKind("Machine"))
+
+	kubeadmConfig = kubeadmConfig.DeepCopy()
+	kubeadmConfig.SetGroupVersionKind(bootstrapv1.GroupVersion.WithKind("KubeadmConfig"))
+
+	return &runtimehooksv1.MachineUpdateState{
+		Machine:         *v1beta1Machine,
+		BootstrapConfig: runtime.RawExtension{Object: kubeadmConfig},
+	}, nil
+}
+
+// patchMachine patches a machine in the control plane scope, updating the corresponding patch helper.
+func (r *KubeadmControlPlaneReconciler) patchMachine(ctx context.Context, controlPlane *internal.ControlPlane, machine *clusterv1.Machine, mutate func(*clusterv1.Machine)) error {
+	patchHelper, err := patch.NewHelper(machine, r.Client)
+	if err != nil {
+		return err
+	}
+	updatedMachine := machine.DeepCopy()
+	mutate(updatedMachine)
+	if err := patchHelper.Patch(ctx, updatedMachine, patch.WithOwnedConditions{Conditions: []string{
+		controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+		controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
+	}}); err != nil {
+		return errors.Wrapf(err, "failed to patch Machine %s", klog.KObj(machine))
+	}
+
+	controlPlane.Machines[updatedMachine.Name] = updatedMachine
+	patchHelper, err = patch.NewHelper(updatedMachine, r.Client)
+	if err != nil {
+		return err
+	}
+	controlPlane.SetPatchHelpers(map[string]*patch.Helper{updatedMachine.Name: patchHelper})
+	return nil
+}
+
+// inPlaceUpdateMessage returns the message for the UpdatingInPlace condition.
+func inPlaceUpdateMessage(extensionName, message string) string {
+	if message == "" {
+		return fmt.Sprintf("Extension %s", extensionName)
+	}
+	return fmt.Sprintf("Extension %s: %s", extensionName, message)
+}
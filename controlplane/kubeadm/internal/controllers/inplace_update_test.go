@@ -0,0 +1,375 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestReconcileInPlaceUpdates(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InPlaceUpdates, true)
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "kcp",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.2",
+		},
+	}
+	newMachine := func() (*clusterv1.Machine, *bootstrapv1.KubeadmConfig) {
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "machine-1",
+				Annotations: map[string]string{
+					controlplanev1.InPlaceUpdateExtensionAnnotation: "update-extension",
+				},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: cluster.Name,
+				Version:     ptr.To("v1.31.2"),
+				Bootstrap: clusterv1.Bootstrap{
+					ConfigRef: &corev1.ObjectReference{
+						APIVersion: bootstrapv1.GroupVersion.String(),
+						Kind:       "KubeadmConfig",
+						Namespace:  metav1.NamespaceDefault,
+						Name:       "machine-1",
+					},
+				},
+			},
+		}
+		conditions.Set(machine, metav1.Condition{
+			Type:   controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+			Status: metav1.ConditionTrue,
+			Reason: controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateInProgressReason,
+		})
+		kubeadmConfig := &bootstrapv1.KubeadmConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "machine-1",
+			},
+		}
+		return machine, kubeadmConfig
+	}
+
+	tests := []struct {
+		name             string
+		response         *runtimehooksv1.UpdateMachineResponse
+		wantResult       ctrl.Result
+		wantStatus       metav1.ConditionStatus
+		wantReason       string
+		wantAnnotation   bool
+		wantMessageMatch string
+	}{
+		{
+			name: "update in progress",
+			response: &runtimehooksv1.UpdateMachineResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess, Message: "upgrading kubelet"},
+					RetryAfterSeconds: 10,
+				},
+			},
+			wantResult:       ctrl.Result{RequeueAfter: 10 * time.Second},
+			wantStatus:       metav1.ConditionTrue,
+			wantReason:       controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateInProgressReason,
+			wantAnnotation:   true,
+			wantMessageMatch: "upgrading kubelet",
+		},
+		{
+			name: "update completed",
+			response: &runtimehooksv1.UpdateMachineResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+				},
+			},
+			wantResult:     ctrl.Result{Requeue: true},
+			wantStatus:     metav1.ConditionFalse,
+			wantReason:     controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateCompletedReason,
+			wantAnnotation: false,
+		},
+		{
+			name: "update failed",
+			response: &runtimehooksv1.UpdateMachineResponse{
+				CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure, Message: "kubelet did not start"},
+				},
+			},
+			wantResult:       ctrl.Result{},
+			wantStatus:       metav1.ConditionFalse,
+			wantReason:       controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateFailedReason,
+			wantAnnotation:   false,
+			wantMessageMatch: "kubelet did not start",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine, kubeadmConfig := newMachine()
+			fakeClient := fake.NewClientBuilder().WithObjects(machine.DeepCopy(), kubeadmConfig.DeepCopy()).WithStatusSubresource(&clusterv1.Machine{}).Build()
+			r := &KubeadmControlPlaneReconciler{
+				Client: fakeClient,
+				RuntimeClient: fakeruntimeclient.NewRuntimeClientBuilder().
+					WithCatalog(catalog).
+					WithCallExtensionResponses(map[string]runtimehooksv1.ResponseObject{
+						"update-extension": tt.response,
+					}).
+					MarkReady(true).
+					Build(),
+				recorder: record.NewFakeRecorder(32),
+			}
+			controlPlane := &internal.ControlPlane{
+				KCP:      kcp,
+				Cluster:  cluster,
+				Machines: collections.FromMachines(machine),
+			}
+
+			result, err := r.reconcileInPlaceUpdates(ctx, controlPlane)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(result).To(Equal(tt.wantResult))
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+			c := conditions.Get(gotMachine, controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition)
+			g.Expect(c).ToNot(BeNil())
+			g.Expect(c.Status).To(Equal(tt.wantStatus))
+			g.Expect(c.Reason).To(Equal(tt.wantReason))
+			g.Expect(c.Message).To(ContainSubstring(tt.wantMessageMatch))
+			if tt.wantAnnotation {
+				g.Expect(gotMachine.Annotations).To(HaveKeyWithValue(controlplanev1.InPlaceUpdateExtensionAnnotation, "update-extension"))
+			} else {
+				g.Expect(gotMachine.Annotations).ToNot(HaveKey(controlplanev1.InPlaceUpdateExtensionAnnotation))
+			}
+
+			// Machines are updated in the control plane scope as well.
+			g.Expect(conditions.Get(controlPlane.Machines[machine.Name], controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition).Reason).To(Equal(tt.wantReason))
+		})
+	}
+}
+
+func TestTryUpdateInPlace(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.InPlaceUpdates, true)
+
+	catalog := runtimecatalog.New()
+	_ = runtimehooksv1.AddToCatalog(catalog)
+	canUpdateMachineGVH, err := catalog.GroupVersionHook(runtimehooksv1.CanUpdateMachine)
+	if err != nil {
+		panic("unable to compute GVH")
+	}
+
+	cluster := newCluster(&types.NamespacedName{Name: "foo", Namespace: metav1.NamespaceDefault})
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "kcp",
+		},
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Replicas: ptr.To[int32](1),
+			Version:  "v1.31.2",
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				InfrastructureRef: corev1.ObjectReference{APIVersion: clusterv1.GroupVersionInfrastructure.String(), Kind: "GenericMachineTemplate", Name: "template1"},
+			},
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: &bootstrapv1.ClusterConfiguration{
+					CertificatesDir: "foo",
+				},
+				InitConfiguration: &bootstrapv1.InitConfiguration{},
+			},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "machine-1",
+			Annotations: map[string]string{
+				controlplanev1.KubeadmClusterConfigurationAnnotation: "{\n  \"certificatesDir\": \"foo\"\n}",
+			},
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName:       cluster.Name,
+			Version:           ptr.To("v1.31.0"),
+			InfrastructureRef: corev1.ObjectReference{APIVersion: clusterv1.GroupVersionInfrastructure.String(), Kind: "GenericMachine", Name: "machine-1"},
+			Bootstrap: clusterv1.Bootstrap{
+				ConfigRef: &corev1.ObjectReference{
+					APIVersion: bootstrapv1.GroupVersion.String(),
+					Kind:       "KubeadmConfig",
+					Namespace:  metav1.NamespaceDefault,
+					Name:       "machine-1",
+				},
+			},
+		},
+	}
+	kubeadmConfig := &bootstrapv1.KubeadmConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "machine-1",
+		},
+		Spec: bootstrapv1.KubeadmConfigSpec{
+			InitConfiguration: &bootstrapv1.InitConfiguration{},
+		},
+	}
+	infraMachine := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"kind":       "GenericMachine",
+			"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+			"metadata": map[string]interface{}{
+				"name":      "machine-1",
+				"namespace": metav1.NamespaceDefault,
+				"annotations": map[string]interface{}{
+					clusterv1.TemplateClonedFromNameAnnotation:      "template1",
+					clusterv1.TemplateClonedFromGroupKindAnnotation: "GenericMachineTemplate.infrastructure.cluster.x-k8s.io",
+				},
+			},
+		},
+	}
+
+	t.Run("falls back to a rollout if the CanUpdateMachine hook fails", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeClient := fake.NewClientBuilder().WithObjects(machine.DeepCopy(), kubeadmConfig.DeepCopy()).WithStatusSubresource(&clusterv1.Machine{}).Build()
+		runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+			WithCatalog(catalog).
+			WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{
+				canUpdateMachineGVH: {"unavailable-extension", "update-extension"},
+			}).
+			WithCallExtensionResponses(map[string]runtimehooksv1.ResponseObject{
+				"unavailable-extension": &runtimehooksv1.CanUpdateMachineResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure, Message: "connection refused"},
+				},
+				"update-extension": &runtimehooksv1.CanUpdateMachineResponse{
+					CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+					CanUpdate:      false,
+				},
+			}).
+			MarkReady(true).
+			Build()
+		r := &KubeadmControlPlaneReconciler{
+			Client:        fakeClient,
+			RuntimeClient: runtimeClient,
+			recorder:      record.NewFakeRecorder(32),
+		}
+		controlPlane := &internal.ControlPlane{
+			KCP:            kcp,
+			Cluster:        cluster,
+			Machines:       collections.FromMachines(machine),
+			KubeadmConfigs: map[string]*bootstrapv1.KubeadmConfig{machine.Name: kubeadmConfig},
+			InfraResources: map[string]*unstructured.Unstructured{machine.Name: infraMachine},
+		}
+
+		result, updatingInPlace, err := r.tryUpdateInPlace(ctx, controlPlane, collections.FromMachines(machine))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatingInPlace).To(BeFalse())
+		g.Expect(result.IsZero()).To(BeTrue())
+		// The extensions following the failing one have been called.
+		g.Expect(runtimeClient.CallCount("unavailable-extension")).To(Equal(1))
+		g.Expect(runtimeClient.CallCount("update-extension")).To(Equal(1))
+
+		gotMachine := &clusterv1.Machine{}
+		g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+		g.Expect(gotMachine.Annotations).ToNot(HaveKey(controlplanev1.InPlaceUpdateExtensionAnnotation))
+	})
+}
+
+func TestComputeDesiredInPlaceUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	kcp := &controlplanev1.KubeadmControlPlane{
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.2",
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: &bootstrapv1.ClusterConfiguration{
+					APIServer: bootstrapv1.APIServer{
+						ControlPlaneComponent: bootstrapv1.ControlPlaneComponent{
+							ExtraArgs: []bootstrapv1.Arg{{Name: "v", Value: "4"}},
+						},
+					},
+				},
+				JoinConfiguration: &bootstrapv1.JoinConfiguration{
+					NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+						KubeletExtraArgs: []bootstrapv1.Arg{{Name: "max-pods", Value: "200"}},
+					},
+				},
+			},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "machine-1",
+		},
+		Spec: clusterv1.MachineSpec{
+			Version: ptr.To("v1.31.1"),
+		},
+	}
+	kubeadmConfig := &bootstrapv1.KubeadmConfig{
+		Spec: bootstrapv1.KubeadmConfigSpec{
+			JoinConfiguration: &bootstrapv1.JoinConfiguration{
+				NodeRegistration: bootstrapv1.NodeRegistrationOptions{
+					Name:             "node-1",
+					KubeletExtraArgs: []bootstrapv1.Arg{{Name: "max-pods", Value: "110"}},
+				},
+			},
+		},
+	}
+
+	desiredMachine, desiredKubeadmConfig, err := computeDesiredInPlaceUpdate(kcp, machine, kubeadmConfig)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(*desiredMachine.Spec.Version).To(Equal("v1.31.2"))
+	clusterConfiguration, err := internal.ClusterConfigurationFromMachine(desiredMachine)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(clusterConfiguration.APIServer.ExtraArgs).To(Equal(kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer.ExtraArgs))
+
+	g.Expect(desiredKubeadmConfig.Spec.JoinConfiguration.NodeRegistration.Name).To(Equal("node-1"))
+	g.Expect(desiredKubeadmConfig.Spec.JoinConfiguration.NodeRegistration.KubeletExtraArgs).To(Equal(kcp.Spec.KubeadmConfigSpec.JoinConfiguration.NodeRegistration.KubeletExtraArgs))
+
+	// The original objects are not modified.
+	g.Expect(*machine.Spec.Version).To(Equal("v1.31.1"))
+	g.Expect(kubeadmConfig.Spec.JoinConfiguration.NodeRegistration.KubeletExtraArgs[0].Value).To(Equal("110"))
+}
@@ -27,12 +27,14 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	etcdutil "sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd/util"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util/collections"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -247,6 +249,16 @@ loopmachines:
 			}
 		}
 	}
+	// If scaling down below the desired number of replicas, e.g. when an outdated machine is deleted before its replacement
+	// is created (scale-in-first rollout), make sure that the etcd cluster preserves quorum both after the etcd member
+	// hosted on the machine to be deleted is removed and while the etcd member for the replacement machine joins.
+	if controlPlane.IsEtcdManaged() && len(excludeFor) > 0 && controlPlane.KCP.Spec.Replicas != nil && int32(controlPlane.Machines.Len()) <= *controlPlane.KCP.Spec.Replicas {
+		if err := preflightCheckEtcdQuorumOnScaleIn(controlPlane, excludeFor...); err != nil {
+			controlPlane.PreflightCheckResults.EtcdClusterNotHealthy = true
+			machineErrors = append(machineErrors, err)
+		}
+	}
+
 	if len(machineErrors) > 0 {
 		aggregatedError := kerrors.NewAggregate(machineErrors)
 		r.recorder.Eventf(controlPlane.KCP, corev1.EventTypeWarning, "ControlPlaneUnhealthy",
@@ -259,6 +271,44 @@ loopmachines:
 	return ctrl.Result{}, nil
 }
 
+// preflightCheckEtcdQuorumOnScaleIn checks that the etcd cluster preserves quorum when the etcd members hosted on the
+// given machines are removed before the replacement machines are created.
+// More specifically, the healthy etcd members left after the removal must be a quorum of the etcd cluster
+// including the member which will be added by the replacement machine.
+func preflightCheckEtcdQuorumOnScaleIn(controlPlane *internal.ControlPlane, machinesToDelete ...*clusterv1.Machine) error {
+	if !controlPlane.EtcdMembersAndMachinesAreMatching {
+		return errors.New("etcd members do not match control plane machines")
+	}
+
+	toDelete := sets.Set[string]{}
+	for _, machine := range machinesToDelete {
+		toDelete.Insert(machine.Name)
+	}
+
+	membersAfterScaleIn := 0
+	healthyMembersAfterScaleIn := 0
+	for _, machine := range controlPlane.Machines {
+		if toDelete.Has(machine.Name) || machine.Status.NodeRef == nil {
+			continue
+		}
+		if etcdutil.MemberForName(controlPlane.EtcdMembers, machine.Status.NodeRef.Name) == nil {
+			continue
+		}
+		membersAfterScaleIn++
+		if conditions.IsTrue(machine, controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition) {
+			healthyMembersAfterScaleIn++
+		}
+	}
+
+	// Note: the quorum is computed including the etcd member for the replacement machine, because while this member
+	// is joining the etcd cluster it counts towards the cluster size without being able to vote yet.
+	if quorum := (membersAfterScaleIn+1)/2 + 1; healthyMembersAfterScaleIn < quorum {
+		return errors.Errorf("deleting Machine %s would leave the etcd cluster with %d healthy members, while at least %d are required to preserve quorum",
+			strings.Join(sets.List(toDelete), ", "), healthyMembersAfterScaleIn, quorum)
+	}
+	return nil
+}
+
 func preflightCheckCondition(kind string, obj *clusterv1.Machine, conditionType string) error {
 	c := conditions.Get(obj, conditionType)
 	if c == nil {
@@ -35,9 +35,11 @@ import (
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestKubeadmControlPlaneReconciler_initializeControlPlane(t *testing.T) {
@@ -714,6 +716,70 @@ func TestPreflightChecks(t *testing.T) {
 	}
 }
 
+func TestPreflightCheckEtcdQuorumOnScaleIn(t *testing.T) {
+	newControlPlane := func(replicas int, unhealthy ...string) (*internal.ControlPlane, *clusterv1.Machine) {
+		machines := collections.Machines{}
+		members := []*etcd.Member{}
+		for i := range replicas {
+			name := fmt.Sprintf("m%d", i)
+			m := machine(name)
+			setMachineHealthy(m)
+			m.Status.NodeRef.Name = name
+			for _, u := range unhealthy {
+				if u == name {
+					conditions.Set(m, metav1.Condition{Type: controlplanev1.KubeadmControlPlaneMachineEtcdMemberHealthyCondition, Status: metav1.ConditionFalse})
+				}
+			}
+			machines.Insert(m)
+			members = append(members, &etcd.Member{Name: name})
+		}
+		return &internal.ControlPlane{
+			Machines:                          machines,
+			EtcdMembers:                       members,
+			EtcdMembersAndMachinesAreMatching: true,
+		}, machines["m0"]
+	}
+
+	t.Run("3 replicas, all the other members are healthy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		controlPlane, machineToDelete := newControlPlane(3)
+		g.Expect(preflightCheckEtcdQuorumOnScaleIn(controlPlane, machineToDelete)).To(Succeed())
+	})
+	t.Run("3 replicas, one of the other members is not healthy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		controlPlane, machineToDelete := newControlPlane(3, "m1")
+		g.Expect(preflightCheckEtcdQuorumOnScaleIn(controlPlane, machineToDelete)).ToNot(Succeed())
+	})
+	t.Run("5 replicas, one of the other members is not healthy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		controlPlane, machineToDelete := newControlPlane(5, "m1")
+		g.Expect(preflightCheckEtcdQuorumOnScaleIn(controlPlane, machineToDelete)).To(Succeed())
+	})
+	t.Run("5 replicas, two of the other members are not healthy", func(t *testing.T) {
+		g := NewWithT(t)
+
+		controlPlane, machineToDelete := newControlPlane(5, "m1", "m2")
+		g.Expect(preflightCheckEtcdQuorumOnScaleIn(controlPlane, machineToDelete)).ToNot(Succeed())
+	})
+	t.Run("etcd members do not match machines", func(t *testing.T) {
+		g := NewWithT(t)
+
+		controlPlane, machineToDelete := newControlPlane(3)
+		controlPlane.EtcdMembersAndMachinesAreMatching = false
+		g.Expect(preflightCheckEtcdQuorumOnScaleIn(controlPlane, machineToDelete)).ToNot(Succeed())
+	})
+	t.Run("a machine does not host an etcd member", func(t *testing.T) {
+		g := NewWithT(t)
+
+		controlPlane, machineToDelete := newControlPlane(3)
+		controlPlane.EtcdMembers = controlPlane.EtcdMembers[:2]
+		g.Expect(preflightCheckEtcdQuorumOnScaleIn(controlPlane, machineToDelete)).ToNot(Succeed())
+	})
+}
+
 func TestPreflightCheckCondition(t *testing.T) {
 	condition := "fooCondition"
 	testCases := []struct {
@@ -87,10 +87,18 @@ func (r *KubeadmControlPlaneReconciler) upgradeControlPlane(
 		return ctrl.Result{}, err
 	}
 
+	// If possible, update machines in-place instead of rolling them out.
+	if result, updatingInPlace, err := r.tryUpdateInPlace(ctx, controlPlane, machinesRequireUpgrade); err != nil || updatingInPlace {
+		return result, err
+	}
+
 	switch controlPlane.KCP.Spec.RolloutStrategy.Type {
 	case controlplanev1.RollingUpdateStrategyType:
-		// RolloutStrategy is currently defaulted and validated to be RollingUpdate
-		// We can ignore MaxUnavailable because we are enforcing health checks before we get here.
+		// RolloutStrategy is currently defaulted and validated to be RollingUpdate, with either MaxSurge or MaxUnavailable set to 1.
+		// When MaxSurge is 1, a new machine is created before an outdated machine is deleted (scale-out-first);
+		// when MaxUnavailable is 1 (and thus MaxSurge is 0), an outdated machine is deleted before its replacement
+		// is created (scale-in-first). In both cases health checks are enforced before creating or deleting machines,
+		// and preflight checks for scale down also ensure etcd quorum is preserved while the replacement is created.
 		maxNodes := *controlPlane.KCP.Spec.Replicas + int32(controlPlane.KCP.Spec.RolloutStrategy.RollingUpdate.MaxSurge.IntValue())
 		if int32(controlPlane.Machines.Len()) < maxNodes {
 			// scaleUp ensures that we don't continue scaling up while waiting for Machines to have NodeRefs
@@ -34,6 +34,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal"
+	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/collections"
@@ -250,8 +251,11 @@ func TestKubeadmControlPlaneReconciler_RolloutStrategy_ScaleDown(t *testing.T) {
 	machineList := &clusterv1.MachineList{}
 	g.Expect(fakeClient.List(ctx, machineList, client.InNamespace(cluster.Namespace))).To(Succeed())
 	g.Expect(machineList.Items).To(HaveLen(3))
+	etcdMembers := []*etcd.Member{}
 	for i := range machineList.Items {
 		setMachineHealthy(&machineList.Items[i])
+		machineList.Items[i].Status.NodeRef.Name = machineList.Items[i].Name
+		etcdMembers = append(etcdMembers, &etcd.Member{Name: machineList.Items[i].Name})
 	}
 
 	// change the KCP spec so the machine becomes outdated
@@ -260,6 +264,8 @@ func TestKubeadmControlPlaneReconciler_RolloutStrategy_ScaleDown(t *testing.T) {
 	// run upgrade, expect we scale down
 	needingUpgrade := collections.FromMachineList(machineList)
 	controlPlane.Machines = needingUpgrade
+	controlPlane.EtcdMembers = etcdMembers
+	controlPlane.EtcdMembersAndMachinesAreMatching = true
 
 	result, err = r.upgradeControlPlane(ctx, controlPlane, needingUpgrade)
 	g.Expect(result).To(BeComparableTo(ctrl.Result{Requeue: true}))
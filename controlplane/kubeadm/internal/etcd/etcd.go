@@ -19,6 +19,7 @@ package etcd
 import (
 	"context"
 	"crypto/tls"
+	"io"
 	"net"
 	"time"
 
@@ -39,12 +40,15 @@ type GRPCDial func(ctx context.Context, addr string) (net.Conn, error)
 // etcd wraps the etcd client from etcd's clientv3 package.
 // This interface is implemented by both the clientv3 package and the backoff adapter that adds retries to the client.
 type etcd interface {
+	AlarmDisarm(ctx context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error)
 	AlarmList(ctx context.Context) (*clientv3.AlarmResponse, error)
 	Close() error
+	Defragment(ctx context.Context, endpoint string) (*clientv3.DefragmentResponse, error)
 	Endpoints() []string
 	MemberList(ctx context.Context) (*clientv3.MemberListResponse, error)
 	MemberRemove(ctx context.Context, id uint64) (*clientv3.MemberRemoveResponse, error)
 	MoveLeader(ctx context.Context, id uint64) (*clientv3.MoveLeaderResponse, error)
+	Snapshot(ctx context.Context) (io.ReadCloser, error)
 	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
 }
 
@@ -52,7 +56,10 @@ type etcd interface {
 type Client struct {
 	EtcdClient  etcd
 	Endpoint    string
+	MemberID    uint64
 	LeaderID    uint64
+	DBSize      int64
+	DBSizeInUse int64
 	Errors      []string
 	CallTimeout time.Duration
 }
@@ -84,6 +91,16 @@ const (
 // for read and write operations to etcd.
 const DefaultCallTimeout = 15 * time.Second
 
+// DefaultSnapshotTimeout represents the duration that the etcd client waits at most
+// for streaming a snapshot from etcd.
+// NOTE: This is longer than DefaultCallTimeout because snapshot size grows with the etcd db size.
+const DefaultSnapshotTimeout = 5 * time.Minute
+
+// DefaultDefragmentTimeout represents the duration that the etcd client waits at most
+// for an etcd member to be defragmented.
+// NOTE: This is longer than DefaultCallTimeout because defragmentation time grows with the etcd db size.
+const DefaultDefragmentTimeout = 5 * time.Minute
+
 // AlarmTypeName provides a text translation for AlarmType codes.
 var AlarmTypeName = map[AlarmType]string{
 	AlarmOK:      "NONE",
@@ -191,7 +208,10 @@ func newEtcdClient(ctx context.Context, etcdClient etcd, callTimeout time.Durati
 	return &Client{
 		Endpoint:    endpoints[0],
 		EtcdClient:  etcdClient,
+		MemberID:    status.Header.GetMemberId(),
 		LeaderID:    status.Leader,
+		DBSize:      status.DbSize,
+		DBSizeInUse: status.DbSizeInUse,
 		Errors:      status.Errors,
 		CallTimeout: callTimeout,
 	}, nil
@@ -261,3 +281,44 @@ func (c *Client) Alarms(ctx context.Context) ([]MemberAlarm, error) {
 
 	return memberAlarms, nil
 }
+
+// DisarmAlarm disarms the given alarm.
+func (c *Client) DisarmAlarm(ctx context.Context, alarm MemberAlarm) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, c.CallTimeout, errors.New("call timeout expired"))
+	defer cancel()
+
+	_, err := c.EtcdClient.AlarmDisarm(ctx, &clientv3.AlarmMember{
+		MemberID: alarm.MemberID,
+		Alarm:    etcdserverpb.AlarmType(alarm.Type),
+	})
+	return errors.Wrapf(err, "failed to disarm etcd alarm %s for member: %v", AlarmTypeName[alarm.Type], alarm.MemberID)
+}
+
+// Defragment defragments the database of the etcd member the client is connected to.
+// NOTE: Defragmentation blocks reads and writes on the member until it completes.
+func (c *Client) Defragment(ctx context.Context) error {
+	ctx, cancel := context.WithTimeoutCause(ctx, DefaultDefragmentTimeout, errors.New("defragment timeout expired"))
+	defer cancel()
+
+	_, err := c.EtcdClient.Defragment(ctx, c.Endpoint)
+	return errors.Wrapf(err, "failed to defragment etcd member: %s", c.Endpoint)
+}
+
+// Snapshot streams a point-in-time snapshot of the etcd member the client is connected to into w.
+// It returns the number of bytes written.
+func (c *Client) Snapshot(ctx context.Context, w io.Writer) (int64, error) {
+	ctx, cancel := context.WithTimeoutCause(ctx, DefaultSnapshotTimeout, errors.New("snapshot timeout expired"))
+	defer cancel()
+
+	rc, err := c.EtcdClient.Snapshot(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get etcd snapshot")
+	}
+	defer rc.Close()
+
+	size, err := io.Copy(w, rc)
+	if err != nil {
+		return size, errors.Wrap(err, "failed to read etcd snapshot")
+	}
+	return size, nil
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package etcd
 
 import (
+	"bytes"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -93,3 +94,96 @@ func TestEtcdMembers_WithSuccess(t *testing.T) {
 	err = client.RemoveMember(ctx, 1234)
 	g.Expect(err).ToNot(HaveOccurred())
 }
+
+func TestEtcdSnapshot(t *testing.T) {
+	t.Run("streams the snapshot into the writer", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints:  []string{"https://etcd-instance:2379"},
+			StatusResponse: &clientv3.StatusResponse{},
+			SnapshotData:   []byte("snapshot-data"),
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		buf := &bytes.Buffer{}
+		size, err := client.Snapshot(ctx, buf)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(size).To(Equal(int64(len("snapshot-data"))))
+		g.Expect(buf.String()).To(Equal("snapshot-data"))
+	})
+	t.Run("returns an error if the snapshot cannot be taken", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints:  []string{"https://etcd-instance:2379"},
+			StatusResponse: &clientv3.StatusResponse{},
+			ErrorResponse:  errors.New("something went wrong"),
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = client.Snapshot(ctx, &bytes.Buffer{})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestEtcdDefragment(t *testing.T) {
+	t.Run("defragments the member the client is connected to", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints: []string{"https://etcd-instance:2379"},
+			StatusResponse: &clientv3.StatusResponse{
+				Header:      &etcdserverpb.ResponseHeader{MemberId: 1234},
+				DbSize:      100,
+				DbSizeInUse: 40,
+			},
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(client.MemberID).To(Equal(uint64(1234)))
+		g.Expect(client.DBSize).To(Equal(int64(100)))
+		g.Expect(client.DBSizeInUse).To(Equal(int64(40)))
+
+		err = client.Defragment(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(fakeEtcdClient.DefragmentedEndpoint).To(Equal("https://etcd-instance:2379"))
+	})
+	t.Run("returns an error if the member cannot be defragmented", func(t *testing.T) {
+		g := NewWithT(t)
+
+		fakeEtcdClient := &etcdfake.FakeEtcdClient{
+			EtcdEndpoints:  []string{"https://etcd-instance:2379"},
+			StatusResponse: &clientv3.StatusResponse{},
+			ErrorResponse:  errors.New("something went wrong"),
+		}
+
+		client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		err = client.Defragment(ctx)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestEtcdDisarmAlarm(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeEtcdClient := &etcdfake.FakeEtcdClient{
+		EtcdEndpoints:  []string{"https://etcd-instance:2379"},
+		StatusResponse: &clientv3.StatusResponse{},
+		AlarmResponse:  &clientv3.AlarmResponse{},
+	}
+
+	client, err := newEtcdClient(ctx, fakeEtcdClient, DefaultCallTimeout)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = client.DisarmAlarm(ctx, MemberAlarm{MemberID: 1234, Type: AlarmNoSpace})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(fakeEtcdClient.DisarmedAlarm).To(Equal(&clientv3.AlarmMember{MemberID: 1234, Alarm: etcdserverpb.AlarmType_NOSPACE}))
+}
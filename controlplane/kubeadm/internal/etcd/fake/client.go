@@ -18,21 +18,27 @@ limitations under the License.
 package fake
 
 import (
+	"bytes"
 	"context"
+	"io"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 type FakeEtcdClient struct { //nolint:revive
 	AlarmResponse        *clientv3.AlarmResponse
+	DefragmentResponse   *clientv3.DefragmentResponse
 	EtcdEndpoints        []string
 	MemberListResponse   *clientv3.MemberListResponse
 	MemberRemoveResponse *clientv3.MemberRemoveResponse
 	MoveLeaderResponse   *clientv3.MoveLeaderResponse
+	SnapshotData         []byte
 	StatusResponse       *clientv3.StatusResponse
 	ErrorResponse        error
 	MovedLeader          uint64
 	RemovedMember        uint64
+	DisarmedAlarm        *clientv3.AlarmMember
+	DefragmentedEndpoint string
 }
 
 func (c *FakeEtcdClient) Endpoints() []string {
@@ -48,6 +54,16 @@ func (c *FakeEtcdClient) Close() error {
 	return nil
 }
 
+func (c *FakeEtcdClient) AlarmDisarm(_ context.Context, m *clientv3.AlarmMember) (*clientv3.AlarmResponse, error) {
+	c.DisarmedAlarm = m
+	return c.AlarmResponse, c.ErrorResponse
+}
+
+func (c *FakeEtcdClient) Defragment(_ context.Context, endpoint string) (*clientv3.DefragmentResponse, error) {
+	c.DefragmentedEndpoint = endpoint
+	return c.DefragmentResponse, c.ErrorResponse
+}
+
 func (c *FakeEtcdClient) AlarmList(_ context.Context) (*clientv3.AlarmResponse, error) {
 	return c.AlarmResponse, c.ErrorResponse
 }
@@ -59,6 +75,9 @@ func (c *FakeEtcdClient) MemberRemove(_ context.Context, i uint64) (*clientv3.Me
 	c.RemovedMember = i
 	return c.MemberRemoveResponse, c.ErrorResponse
 }
+func (c *FakeEtcdClient) Snapshot(_ context.Context) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(c.SnapshotData)), c.ErrorResponse
+}
 func (c *FakeEtcdClient) Status(_ context.Context, _ string) (*clientv3.StatusResponse, error) {
 	return c.StatusResponse, nil
 }
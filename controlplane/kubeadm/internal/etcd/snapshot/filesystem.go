@@ -0,0 +1,224 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	snapshotFileExtension = ".db"
+	tmpFilePattern        = ".tmp-*"
+)
+
+// filesystemStore is a Store persisting snapshots in a local directory.
+// Snapshots are stored as <root>/<namespace>/<name>/<snapshot>.db.
+type filesystemStore struct {
+	root string
+
+	// downloadURL is the base URL the directory is served at, e.g. by an HTTP server or an object
+	// storage gateway sharing the same volume; snapshots can be downloaded only if it is set.
+	downloadURL *url.URL
+}
+
+var _ Store = &filesystemStore{}
+
+// NewFilesystemStore returns a Store persisting snapshots below the given directory.
+// The directory is created if it does not exist.
+// If downloadURL is set, snapshots can be downloaded from <downloadURL>/<namespace>/<name>/<snapshot>.db.
+func NewFilesystemStore(root, downloadURL string) (Store, error) {
+	if root == "" {
+		return nil, errors.New("failed to create etcd snapshot store: directory must be set")
+	}
+
+	s := &filesystemStore{root: root}
+	if downloadURL != "" {
+		u, err := url.Parse(downloadURL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create etcd snapshot store: invalid download URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, errors.Errorf("failed to create etcd snapshot store: invalid download URL: scheme must be http or https, got %q", u.Scheme)
+		}
+		s.downloadURL = u
+	}
+
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create etcd snapshot store: failed to create directory %q", root)
+	}
+	return s, nil
+}
+
+func (s *filesystemStore) Write(_ context.Context, owner client.ObjectKey, name string, r io.Reader) error {
+	dir, err := s.dir(owner)
+	if err != nil {
+		return err
+	}
+	path, err := s.path(owner, name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return errors.Wrapf(err, "failed to write etcd snapshot %q: failed to create directory %q", name, dir)
+	}
+
+	// Write to a temporary file first and rename it afterwards, so partial snapshots
+	// are never returned by Open or List.
+	tmp, err := os.CreateTemp(dir, tmpFilePattern)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write etcd snapshot %q", name)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		return errors.Wrapf(err, "failed to write etcd snapshot %q", name)
+	}
+	if err := tmp.Sync(); err != nil {
+		return errors.Wrapf(err, "failed to write etcd snapshot %q", name)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write etcd snapshot %q", name)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrapf(err, "failed to write etcd snapshot %q", name)
+	}
+	return nil
+}
+
+func (s *filesystemStore) Open(_ context.Context, owner client.ObjectKey, name string) (io.ReadCloser, error) {
+	path, err := s.path(owner, name)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path) //nolint:gosec // path is validated by s.path.
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(ErrNotFound, "failed to open etcd snapshot %q", name)
+		}
+		return nil, errors.Wrapf(err, "failed to open etcd snapshot %q", name)
+	}
+	return f, nil
+}
+
+func (s *filesystemStore) List(_ context.Context, owner client.ObjectKey) ([]Info, error) {
+	dir, err := s.dir(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to list etcd snapshots in %q", dir)
+	}
+
+	snapshots := []Info{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), snapshotFileExtension) {
+			continue
+		}
+		fileInfo, err := entry.Info()
+		if err != nil {
+			// The snapshot has been deleted in the meantime.
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to list etcd snapshots in %q", dir)
+		}
+		snapshots = append(snapshots, Info{
+			Name:         strings.TrimSuffix(entry.Name(), snapshotFileExtension),
+			Size:         fileInfo.Size(),
+			CreationTime: fileInfo.ModTime(),
+		})
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].CreationTime.Equal(snapshots[j].CreationTime) {
+			return snapshots[i].Name < snapshots[j].Name
+		}
+		return snapshots[i].CreationTime.Before(snapshots[j].CreationTime)
+	})
+	return snapshots, nil
+}
+
+func (s *filesystemStore) Delete(_ context.Context, owner client.ObjectKey, name string) error {
+	path, err := s.path(owner, name)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete etcd snapshot %q", name)
+	}
+	return nil
+}
+
+func (s *filesystemStore) DownloadURL(_ context.Context, owner client.ObjectKey, name string) (string, error) {
+	if s.downloadURL == nil {
+		return "", errors.Errorf("failed to get download URL for etcd snapshot %q: no download URL configured for the etcd snapshot directory", name)
+	}
+	path, err := s.path(owner, name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return "", errors.Wrapf(ErrNotFound, "failed to get download URL for etcd snapshot %q", name)
+		}
+		return "", errors.Wrapf(err, "failed to get download URL for etcd snapshot %q", name)
+	}
+	return s.downloadURL.JoinPath(owner.Namespace, owner.Name, name+snapshotFileExtension).String(), nil
+}
+
+func (s *filesystemStore) dir(owner client.ObjectKey) (string, error) {
+	// Namespace and name are validated to prevent escaping the root directory.
+	if errs := validation.IsDNS1123Label(owner.Namespace); len(errs) > 0 {
+		return "", errors.Errorf("invalid namespace %q: %s", owner.Namespace, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Subdomain(owner.Name); len(errs) > 0 {
+		return "", errors.Errorf("invalid name %q: %s", owner.Name, strings.Join(errs, ", "))
+	}
+	return filepath.Join(s.root, owner.Namespace, owner.Name), nil
+}
+
+func (s *filesystemStore) path(owner client.ObjectKey, name string) (string, error) {
+	dir, err := s.dir(owner)
+	if err != nil {
+		return "", err
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", errors.Errorf("invalid etcd snapshot name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return filepath.Join(dir, name+snapshotFileExtension), nil
+}
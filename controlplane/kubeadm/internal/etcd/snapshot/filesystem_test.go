@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestFilesystemStore(t *testing.T) {
+	ctx := context.Background()
+	owner := client.ObjectKey{Namespace: "default", Name: "kcp"}
+
+	t.Run("write, open, list and delete snapshots", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store, err := NewFilesystemStore(t.TempDir(), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(store.Write(ctx, owner, "snapshot-1", bytes.NewBufferString("data-1"))).To(Succeed())
+		g.Expect(store.Write(ctx, owner, "snapshot-2", bytes.NewBufferString("data-22"))).To(Succeed())
+		// Snapshots of other KubeadmControlPlanes must not be listed.
+		g.Expect(store.Write(ctx, client.ObjectKey{Namespace: "other", Name: "kcp"}, "snapshot-3", bytes.NewBufferString("data"))).To(Succeed())
+
+		rc, err := store.Open(ctx, owner, "snapshot-2")
+		g.Expect(err).ToNot(HaveOccurred())
+		data, err := io.ReadAll(rc)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(rc.Close()).To(Succeed())
+		g.Expect(string(data)).To(Equal("data-22"))
+
+		snapshots, err := store.List(ctx, owner)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshots).To(HaveLen(2))
+		g.Expect(snapshots[0].Name).To(Equal("snapshot-1"))
+		g.Expect(snapshots[0].Size).To(Equal(int64(6)))
+		g.Expect(snapshots[1].Name).To(Equal("snapshot-2"))
+		g.Expect(snapshots[1].Size).To(Equal(int64(7)))
+
+		g.Expect(store.Delete(ctx, owner, "snapshot-1")).To(Succeed())
+		// Deleting a snapshot which does not exist is a no-op.
+		g.Expect(store.Delete(ctx, owner, "snapshot-1")).To(Succeed())
+
+		_, err = store.Open(ctx, owner, "snapshot-1")
+		g.Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+
+		snapshots, err = store.List(ctx, owner)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshots).To(HaveLen(1))
+		g.Expect(snapshots[0].Name).To(Equal("snapshot-2"))
+	})
+
+	t.Run("list sorts snapshots by creation time", func(t *testing.T) {
+		g := NewWithT(t)
+
+		root := t.TempDir()
+		store, err := NewFilesystemStore(root, "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(store.Write(ctx, owner, "b", bytes.NewBufferString("data"))).To(Succeed())
+		g.Expect(store.Write(ctx, owner, "a", bytes.NewBufferString("data"))).To(Succeed())
+		older := time.Now().Add(-time.Hour)
+		g.Expect(os.Chtimes(filepath.Join(root, owner.Namespace, owner.Name, "b.db"), older, older)).To(Succeed())
+
+		snapshots, err := store.List(ctx, owner)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshots).To(HaveLen(2))
+		g.Expect(snapshots[0].Name).To(Equal("b"))
+		g.Expect(snapshots[1].Name).To(Equal("a"))
+	})
+
+	t.Run("list returns no snapshots if nothing has been written yet", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store, err := NewFilesystemStore(t.TempDir(), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		snapshots, err := store.List(ctx, owner)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshots).To(BeEmpty())
+	})
+
+	t.Run("failed writes do not leave partial snapshots", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store, err := NewFilesystemStore(t.TempDir(), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(store.Write(ctx, owner, "snapshot", &failingReader{})).ToNot(Succeed())
+
+		snapshots, err := store.List(ctx, owner)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(snapshots).To(BeEmpty())
+	})
+
+	t.Run("download URL", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store, err := NewFilesystemStore(t.TempDir(), "https://snapshots.example.com/etcd/")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(store.Write(ctx, owner, "snapshot", bytes.NewBufferString("data"))).To(Succeed())
+
+		downloadURL, err := store.DownloadURL(ctx, owner, "snapshot")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(downloadURL).To(Equal("https://snapshots.example.com/etcd/default/kcp/snapshot.db"))
+
+		_, err = store.DownloadURL(ctx, owner, "does-not-exist")
+		g.Expect(errors.Is(err, ErrNotFound)).To(BeTrue())
+
+		// Snapshots cannot be downloaded if no download URL is configured.
+		store, err = NewFilesystemStore(t.TempDir(), "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(store.Write(ctx, owner, "snapshot", bytes.NewBufferString("data"))).To(Succeed())
+		_, err = store.DownloadURL(ctx, owner, "snapshot")
+		g.Expect(err).To(HaveOccurred())
+
+		_, err = NewFilesystemStore(t.TempDir(), "file:///snapshots")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("invalid names are rejected", func(t *testing.T) {
+		g := NewWithT(t)
+
+		store, err := NewFilesystemStore(t.TempDir(), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(store.Write(ctx, owner, "../snapshot", bytes.NewBufferString("data"))).ToNot(Succeed())
+		g.Expect(store.Write(ctx, client.ObjectKey{Namespace: "..", Name: "kcp"}, "snapshot", bytes.NewBufferString("data"))).ToNot(Succeed())
+		g.Expect(store.Write(ctx, client.ObjectKey{Namespace: "default", Name: "a/b"}, "snapshot", bytes.NewBufferString("data"))).ToNot(Succeed())
+	})
+}
+
+type failingReader struct{}
+
+func (r *failingReader) Read(_ []byte) (int, error) {
+	return 0, errors.New("read failed")
+}
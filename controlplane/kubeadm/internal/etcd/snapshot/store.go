@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrNotFound is returned when a snapshot does not exist in a Store.
+var ErrNotFound = errors.New("etcd snapshot not found")
+
+// Info describes a snapshot persisted in a Store.
+type Info struct {
+	// Name is the name of the snapshot, unique for a KubeadmControlPlane.
+	Name string
+
+	// Size is the size of the snapshot in bytes.
+	Size int64
+
+	// CreationTime is the time the snapshot has been written to the Store.
+	CreationTime time.Time
+}
+
+// Store persists etcd snapshots.
+// Snapshots are scoped by the KubeadmControlPlane they have been taken for, so the same
+// snapshot name can be used by different KubeadmControlPlanes.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Write persists the snapshot read from r with the given name.
+	// The snapshot must not be visible to Open or List until it has been fully written.
+	Write(ctx context.Context, owner client.ObjectKey, name string, r io.Reader) error
+
+	// Open returns a reader for the snapshot with the given name.
+	// It returns ErrNotFound if the snapshot does not exist.
+	Open(ctx context.Context, owner client.ObjectKey, name string) (io.ReadCloser, error)
+
+	// List returns all the snapshots for the given KubeadmControlPlane, sorted from the oldest to the newest.
+	List(ctx context.Context, owner client.ObjectKey) ([]Info, error)
+
+	// Delete deletes the snapshot with the given name.
+	// It does not return an error if the snapshot does not exist.
+	Delete(ctx context.Context, owner client.ObjectKey, name string) error
+
+	// DownloadURL returns the URL from which machines can download the snapshot with the given name,
+	// e.g. the Machine restoring etcd from the snapshot. The URL may embed the credentials required to access it.
+	// It returns ErrNotFound if the snapshot does not exist.
+	DownloadURL(ctx context.Context, owner client.ObjectKey, name string) (string, error)
+}
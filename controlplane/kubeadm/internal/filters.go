@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/blang/semver/v4"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -33,6 +34,7 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/internal/util/compare"
 	"sigs.k8s.io/cluster-api/util/collections"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 // matchesMachineSpec checks if a Machine matches any of a set of KubeadmConfigs and a set of infra machine configs.
@@ -97,6 +99,18 @@ func UpToDate(machine *clusterv1.Machine, kcp *controlplanev1.KubeadmControlPlan
 		conditionMessages = append(conditionMessages, "KubeadmControlPlane spec.rolloutAfter expired")
 	}
 
+	// Machines whose in-place update is in progress or failed.
+	if c := conditions.Get(machine, controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition); c != nil {
+		switch {
+		case c.Status == metav1.ConditionTrue:
+			logMessages = append(logMessages, "in-place update in progress")
+			conditionMessages = append(conditionMessages, "In-place update in progress")
+		case c.Reason == controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateFailedReason:
+			logMessages = append(logMessages, "in-place update failed")
+			conditionMessages = append(conditionMessages, "In-place update failed")
+		}
+	}
+
 	// Machines that do not match with KCP config.
 	matches, specLogMessages, specConditionMessages, err := matchesMachineSpec(infraConfigs, machineConfigs, kcp, machine)
 	if err != nil {
@@ -114,6 +128,95 @@ func UpToDate(machine *clusterv1.Machine, kcp *controlplanev1.KubeadmControlPlan
 	return true, nil, nil, nil
 }
 
+// CanUpdateInPlace checks if all the changes required to bring a Machine up-to-date with the control plane's configuration
+// can be performed in-place, i.e. if the Machine differs from the control plane's configuration only by:
+// - a Kubernetes patch version upgrade
+// - the API server extra args
+// - the kubelet extra args.
+// NOTE: Machines that must be rolled out for other reasons, e.g. because rolloutAfter expired, or for which there
+// is not enough information to determine the required changes, are never eligible for an in-place update.
+func CanUpdateInPlace(machine *clusterv1.Machine, kcp *controlplanev1.KubeadmControlPlane, reconciliationTime *metav1.Time, infraConfigs map[string]*unstructured.Unstructured, machineConfigs map[string]*bootstrapv1.KubeadmConfig) (bool, error) {
+	if machine.Spec.Version == nil {
+		return false, nil
+	}
+	machineVersion, err := semver.ParseTolerant(*machine.Spec.Version)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse version of Machine %s", machine.Name)
+	}
+	kcpVersion, err := semver.ParseTolerant(kcp.Spec.Version)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse version of KubeadmControlPlane %s", kcp.Name)
+	}
+	if machineVersion.Major != kcpVersion.Major || machineVersion.Minor != kcpVersion.Minor || kcpVersion.LT(machineVersion) {
+		return false, nil
+	}
+
+	machineClusterConfig, err := ClusterConfigurationFromMachine(machine)
+	if err != nil || machineClusterConfig == nil {
+		// We don't have enough information to determine the required changes.
+		return false, nil //nolint:nilerr // Intentionally not returning the error here
+	}
+	machineConfig, found := machineConfigs[machine.Name]
+	if !found {
+		// We don't have enough information to determine the required changes.
+		return false, nil
+	}
+
+	// Align the KCP configuration to the Machine for all the fields that can be updated in-place; if the Machine is
+	// up-to-date with the resulting configuration, all the required changes can be performed in-place.
+	kcpWithoutInPlaceChanges := kcp.DeepCopy()
+	kcpWithoutInPlaceChanges.Spec.Version = *machine.Spec.Version
+	kubeadmConfigSpec := &kcpWithoutInPlaceChanges.Spec.KubeadmConfigSpec
+	if kubeadmConfigSpec.ClusterConfiguration == nil {
+		kubeadmConfigSpec.ClusterConfiguration = &bootstrapv1.ClusterConfiguration{}
+	}
+	kubeadmConfigSpec.ClusterConfiguration.APIServer.ExtraArgs = machineClusterConfig.APIServer.ExtraArgs
+	if kubeadmConfigSpec.InitConfiguration != nil && machineConfig.Spec.InitConfiguration != nil {
+		kubeadmConfigSpec.InitConfiguration.NodeRegistration.KubeletExtraArgs = machineConfig.Spec.InitConfiguration.NodeRegistration.KubeletExtraArgs
+	}
+	if kubeadmConfigSpec.JoinConfiguration != nil && machineConfig.Spec.JoinConfiguration != nil {
+		kubeadmConfigSpec.JoinConfiguration.NodeRegistration.KubeletExtraArgs = machineConfig.Spec.JoinConfiguration.NodeRegistration.KubeletExtraArgs
+	}
+
+	// Note: UpToDate modifies the KubeadmConfig, so we are passing a copy.
+	upToDate, _, _, err := UpToDate(machine, kcpWithoutInPlaceChanges, reconciliationTime, infraConfigs, map[string]*bootstrapv1.KubeadmConfig{
+		machine.Name: machineConfig.DeepCopy(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return upToDate, nil
+}
+
+// CanRenewCertificatesInPlace checks if a Machine must be rolled out only because its certificates are about to expire,
+// and thus if its certificates can be renewed in-place instead.
+// NOTE: Machines for which a previous in-place renewal failed are never eligible for an in-place renewal.
+func CanRenewCertificatesInPlace(machine *clusterv1.Machine, kcp *controlplanev1.KubeadmControlPlane, reconciliationTime *metav1.Time, infraConfigs map[string]*unstructured.Unstructured, machineConfigs map[string]*bootstrapv1.KubeadmConfig) (bool, error) {
+	if !collections.ShouldRolloutBefore(reconciliationTime, kcp.Spec.RolloutBefore)(machine) {
+		return false, nil
+	}
+	if c := conditions.Get(machine, controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition); c != nil && c.Reason == controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalFailedReason {
+		return false, nil
+	}
+
+	machineConfig, found := machineConfigs[machine.Name]
+	if !found {
+		return false, nil
+	}
+
+	kcpWithoutRolloutBefore := kcp.DeepCopy()
+	kcpWithoutRolloutBefore.Spec.RolloutBefore = nil
+
+	// Note: UpToDate modifies the KubeadmConfig, so we are passing a copy.
+	upToDate, _, _, err := UpToDate(machine, kcpWithoutRolloutBefore, reconciliationTime, infraConfigs, map[string]*bootstrapv1.KubeadmConfig{
+		machine.Name: machineConfig.DeepCopy(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return upToDate, nil
+}
+
 // matchesTemplateClonedFrom checks if a Machine has a corresponding infrastructure machine that
 // matches a given KCP infra template and if it doesn't match returns the reason why.
 // Note: Differences to the labels and annotations on the infrastructure machine are not considered for matching
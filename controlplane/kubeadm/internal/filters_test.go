@@ -30,6 +30,7 @@ import (
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 func TestClusterConfigurationAnnotation(t *testing.T) {
@@ -1698,6 +1699,60 @@ func TestUpToDate(t *testing.T) {
 			expectLogMessages:       []string{"Infrastructure template on KCP rotated from AWSMachineTemplate.infrastructure.cluster.x-k8s.io template1 to AWSMachineTemplate.infrastructure.cluster.x-k8s.io template2"},
 			expectConditionMessages: []string{"AWSMachine is not up-to-date"},
 		},
+		{
+			name: "in-place update in progress",
+			kcp:  defaultKcp,
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				conditions.Set(machine, metav1.Condition{
+					Type:   controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+					Status: metav1.ConditionTrue,
+					Reason: controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateInProgressReason,
+				})
+				return machine
+			}(),
+			infraConfigs:            defaultInfraConfigs,
+			machineConfigs:          defaultMachineConfigs,
+			expectUptoDate:          false,
+			expectLogMessages:       []string{"in-place update in progress"},
+			expectConditionMessages: []string{"In-place update in progress"},
+		},
+		{
+			name: "in-place update failed",
+			kcp:  defaultKcp,
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				conditions.Set(machine, metav1.Condition{
+					Type:   controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+					Status: metav1.ConditionFalse,
+					Reason: controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateFailedReason,
+				})
+				return machine
+			}(),
+			infraConfigs:            defaultInfraConfigs,
+			machineConfigs:          defaultMachineConfigs,
+			expectUptoDate:          false,
+			expectLogMessages:       []string{"in-place update failed"},
+			expectConditionMessages: []string{"In-place update failed"},
+		},
+		{
+			name: "in-place update completed",
+			kcp:  defaultKcp,
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				conditions.Set(machine, metav1.Condition{
+					Type:   controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+					Status: metav1.ConditionFalse,
+					Reason: controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateCompletedReason,
+				})
+				return machine
+			}(),
+			infraConfigs:            defaultInfraConfigs,
+			machineConfigs:          defaultMachineConfigs,
+			expectUptoDate:          true,
+			expectLogMessages:       nil,
+			expectConditionMessages: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1713,3 +1768,293 @@ func TestUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestCanUpdateInPlace(t *testing.T) {
+	reconciliationTime := metav1.Now()
+
+	defaultKcp := &controlplanev1.KubeadmControlPlane{
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.0",
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				InfrastructureRef: corev1.ObjectReference{APIVersion: clusterv1.GroupVersionInfrastructure.String(), Kind: "AWSMachineTemplate", Name: "template1"},
+			},
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: &bootstrapv1.ClusterConfiguration{
+					CertificatesDir: "foo",
+				},
+				InitConfiguration: &bootstrapv1.InitConfiguration{},
+			},
+		},
+	}
+	defaultMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "machine1",
+			Annotations: map[string]string{
+				controlplanev1.KubeadmClusterConfigurationAnnotation: "{\n  \"certificatesDir\": \"foo\"\n}",
+			},
+		},
+		Spec: clusterv1.MachineSpec{
+			Version:           ptr.To("v1.31.0"),
+			InfrastructureRef: corev1.ObjectReference{APIVersion: clusterv1.GroupVersionInfrastructure.String(), Kind: "AWSMachine", Name: "infra-machine1"},
+		},
+	}
+	defaultInfraConfigs := map[string]*unstructured.Unstructured{
+		defaultMachine.Name: {
+			Object: map[string]interface{}{
+				"kind":       "AWSMachine",
+				"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+				"metadata": map[string]interface{}{
+					"name":      "infra-config1",
+					"namespace": "default",
+					"annotations": map[string]interface{}{
+						"cluster.x-k8s.io/cloned-from-name":      "template1",
+						"cluster.x-k8s.io/cloned-from-groupkind": "AWSMachineTemplate.infrastructure.cluster.x-k8s.io",
+					},
+				},
+			},
+		},
+	}
+	defaultMachineConfigs := map[string]*bootstrapv1.KubeadmConfig{
+		defaultMachine.Name: {
+			Spec: bootstrapv1.KubeadmConfigSpec{
+				InitConfiguration: &bootstrapv1.InitConfiguration{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		kcp                    *controlplanev1.KubeadmControlPlane
+		machine                *clusterv1.Machine
+		machineConfigs         map[string]*bootstrapv1.KubeadmConfig
+		expectCanUpdateInPlace bool
+	}{
+		{
+			name: "patch version upgrade can be performed in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.31.2"
+				return kcp
+			}(),
+			machine:                defaultMachine,
+			machineConfigs:         defaultMachineConfigs,
+			expectCanUpdateInPlace: true,
+		},
+		{
+			name: "minor version upgrade cannot be performed in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.32.0"
+				return kcp
+			}(),
+			machine:                defaultMachine,
+			machineConfigs:         defaultMachineConfigs,
+			expectCanUpdateInPlace: false,
+		},
+		{
+			name: "version downgrade cannot be performed in-place",
+			kcp:  defaultKcp,
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				machine.Spec.Version = ptr.To("v1.31.1")
+				return machine
+			}(),
+			machineConfigs:         defaultMachineConfigs,
+			expectCanUpdateInPlace: false,
+		},
+		{
+			name: "apiserver and kubelet extra args changes can be performed in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.APIServer.ExtraArgs = []bootstrapv1.Arg{{Name: "v", Value: "4"}}
+				kcp.Spec.KubeadmConfigSpec.InitConfiguration.NodeRegistration.KubeletExtraArgs = []bootstrapv1.Arg{{Name: "max-pods", Value: "200"}}
+				return kcp
+			}(),
+			machine:                defaultMachine,
+			machineConfigs:         defaultMachineConfigs,
+			expectCanUpdateInPlace: true,
+		},
+		{
+			name: "other ClusterConfiguration changes cannot be performed in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.31.2"
+				kcp.Spec.KubeadmConfigSpec.ClusterConfiguration.CertificatesDir = "bar"
+				return kcp
+			}(),
+			machine:                defaultMachine,
+			machineConfigs:         defaultMachineConfigs,
+			expectCanUpdateInPlace: false,
+		},
+		{
+			name: "machines with a failed in-place update cannot be updated in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.31.2"
+				return kcp
+			}(),
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				conditions.Set(machine, metav1.Condition{
+					Type:   controlplanev1.KubeadmControlPlaneMachineUpdatingInPlaceCondition,
+					Status: metav1.ConditionFalse,
+					Reason: controlplanev1.KubeadmControlPlaneMachineInPlaceUpdateFailedReason,
+				})
+				return machine
+			}(),
+			machineConfigs:         defaultMachineConfigs,
+			expectCanUpdateInPlace: false,
+		},
+		{
+			name: "machines without KubeadmConfig cannot be updated in-place",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.31.2"
+				return kcp
+			}(),
+			machine:                defaultMachine,
+			machineConfigs:         map[string]*bootstrapv1.KubeadmConfig{},
+			expectCanUpdateInPlace: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			canUpdateInPlace, err := CanUpdateInPlace(tt.machine, tt.kcp, &reconciliationTime, defaultInfraConfigs, tt.machineConfigs)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canUpdateInPlace).To(Equal(tt.expectCanUpdateInPlace))
+		})
+	}
+}
+
+func TestCanRenewCertificatesInPlace(t *testing.T) {
+	reconciliationTime := metav1.Now()
+
+	defaultKcp := &controlplanev1.KubeadmControlPlane{
+		Spec: controlplanev1.KubeadmControlPlaneSpec{
+			Version: "v1.31.0",
+			MachineTemplate: controlplanev1.KubeadmControlPlaneMachineTemplate{
+				InfrastructureRef: corev1.ObjectReference{APIVersion: clusterv1.GroupVersionInfrastructure.String(), Kind: "AWSMachineTemplate", Name: "template1"},
+			},
+			KubeadmConfigSpec: bootstrapv1.KubeadmConfigSpec{
+				ClusterConfiguration: &bootstrapv1.ClusterConfiguration{
+					CertificatesDir: "foo",
+				},
+				InitConfiguration: &bootstrapv1.InitConfiguration{},
+			},
+			RolloutBefore: &controlplanev1.RolloutBefore{
+				CertificatesExpiryDays: ptr.To[int32](60),
+			},
+		},
+	}
+	defaultMachine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "machine1",
+			Annotations: map[string]string{
+				controlplanev1.KubeadmClusterConfigurationAnnotation: "{\n  \"certificatesDir\": \"foo\"\n}",
+			},
+		},
+		Spec: clusterv1.MachineSpec{
+			Version:           ptr.To("v1.31.0"),
+			InfrastructureRef: corev1.ObjectReference{APIVersion: clusterv1.GroupVersionInfrastructure.String(), Kind: "AWSMachine", Name: "infra-machine1"},
+		},
+		Status: clusterv1.MachineStatus{
+			CertificatesExpiryDate: &metav1.Time{Time: reconciliationTime.Add(10 * 24 * time.Hour)},
+		},
+	}
+	defaultInfraConfigs := map[string]*unstructured.Unstructured{
+		defaultMachine.Name: {
+			Object: map[string]interface{}{
+				"kind":       "AWSMachine",
+				"apiVersion": clusterv1.GroupVersionInfrastructure.String(),
+				"metadata": map[string]interface{}{
+					"name":      "infra-config1",
+					"namespace": "default",
+					"annotations": map[string]interface{}{
+						"cluster.x-k8s.io/cloned-from-name":      "template1",
+						"cluster.x-k8s.io/cloned-from-groupkind": "AWSMachineTemplate.infrastructure.cluster.x-k8s.io",
+					},
+				},
+			},
+		},
+	}
+	defaultMachineConfigs := map[string]*bootstrapv1.KubeadmConfig{
+		defaultMachine.Name: {
+			Spec: bootstrapv1.KubeadmConfigSpec{
+				InitConfiguration: &bootstrapv1.InitConfiguration{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                       string
+		kcp                        *controlplanev1.KubeadmControlPlane
+		machine                    *clusterv1.Machine
+		machineConfigs             map[string]*bootstrapv1.KubeadmConfig
+		expectCanRenewCertificates bool
+	}{
+		{
+			name:                       "certificates about to expire can be renewed in-place",
+			kcp:                        defaultKcp,
+			machine:                    defaultMachine,
+			machineConfigs:             defaultMachineConfigs,
+			expectCanRenewCertificates: true,
+		},
+		{
+			name: "certificates not about to expire are not renewed",
+			kcp:  defaultKcp,
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				machine.Status.CertificatesExpiryDate = &metav1.Time{Time: reconciliationTime.Add(100 * 24 * time.Hour)}
+				return machine
+			}(),
+			machineConfigs:             defaultMachineConfigs,
+			expectCanRenewCertificates: false,
+		},
+		{
+			name: "certificates cannot be renewed in-place if the machine must be rolled out for other reasons",
+			kcp: func() *controlplanev1.KubeadmControlPlane {
+				kcp := defaultKcp.DeepCopy()
+				kcp.Spec.Version = "v1.31.2"
+				return kcp
+			}(),
+			machine:                    defaultMachine,
+			machineConfigs:             defaultMachineConfigs,
+			expectCanRenewCertificates: false,
+		},
+		{
+			name: "machines with a failed certificates renewal cannot be renewed in-place",
+			kcp:  defaultKcp,
+			machine: func() *clusterv1.Machine {
+				machine := defaultMachine.DeepCopy()
+				conditions.Set(machine, metav1.Condition{
+					Type:   controlplanev1.KubeadmControlPlaneMachineRenewingCertificatesCondition,
+					Status: metav1.ConditionFalse,
+					Reason: controlplanev1.KubeadmControlPlaneMachineCertificatesRenewalFailedReason,
+				})
+				return machine
+			}(),
+			machineConfigs:             defaultMachineConfigs,
+			expectCanRenewCertificates: false,
+		},
+		{
+			name:                       "machines without KubeadmConfig cannot be renewed in-place",
+			kcp:                        defaultKcp,
+			machine:                    defaultMachine,
+			machineConfigs:             map[string]*bootstrapv1.KubeadmConfig{},
+			expectCanRenewCertificates: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			canRenewCertificates, err := CanRenewCertificatesInPlace(tt.machine, tt.kcp, &reconciliationTime, defaultInfraConfigs, tt.machineConfigs)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(canRenewCertificates).To(Equal(tt.expectCanRenewCertificates))
+		})
+	}
+}
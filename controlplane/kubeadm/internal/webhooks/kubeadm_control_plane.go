@@ -93,12 +93,15 @@ func defaultKubeadmControlPlaneSpec(s *controlplanev1.KubeadmControlPlaneSpec, n
 
 func defaultRolloutStrategy(rolloutStrategy *controlplanev1.RolloutStrategy) *controlplanev1.RolloutStrategy {
 	ios1 := intstr.FromInt(1)
+	ios0 := intstr.FromInt(0)
 
 	if rolloutStrategy == nil {
 		rolloutStrategy = &controlplanev1.RolloutStrategy{}
 	}
 
-	// Enforce RollingUpdate strategy and default MaxSurge if not set.
+	// Enforce RollingUpdate strategy and default MaxSurge and MaxUnavailable if not set.
+	// NOTE: MaxSurge and MaxUnavailable are defaulted so exactly one of them is 1; MaxSurge has precedence
+	// when none of them is set, while MaxUnavailable is defaulted to 1 when MaxSurge is set to 0 (scale-in-first).
 	if rolloutStrategy != nil {
 		if len(rolloutStrategy.Type) == 0 {
 			rolloutStrategy.Type = controlplanev1.RollingUpdateStrategyType
@@ -107,7 +110,17 @@ func defaultRolloutStrategy(rolloutStrategy *controlplanev1.RolloutStrategy) *co
 			if rolloutStrategy.RollingUpdate == nil {
 				rolloutStrategy.RollingUpdate = &controlplanev1.RollingUpdate{}
 			}
-			rolloutStrategy.RollingUpdate.MaxSurge = intstr.ValueOrDefault(rolloutStrategy.RollingUpdate.MaxSurge, ios1)
+			defaultMaxSurge := ios1
+			if rolloutStrategy.RollingUpdate.MaxUnavailable != nil && rolloutStrategy.RollingUpdate.MaxUnavailable.IntValue() == ios1.IntValue() {
+				defaultMaxSurge = ios0
+			}
+			rolloutStrategy.RollingUpdate.MaxSurge = intstr.ValueOrDefault(rolloutStrategy.RollingUpdate.MaxSurge, defaultMaxSurge)
+
+			defaultMaxUnavailable := ios0
+			if rolloutStrategy.RollingUpdate.MaxSurge.IntValue() == ios0.IntValue() {
+				defaultMaxUnavailable = ios1
+			}
+			rolloutStrategy.RollingUpdate.MaxUnavailable = intstr.ValueOrDefault(rolloutStrategy.RollingUpdate.MaxUnavailable, defaultMaxUnavailable)
 		}
 	}
 
@@ -241,6 +254,10 @@ func (webhook *KubeadmControlPlane) ValidateUpdate(_ context.Context, oldObj, ne
 		{spec, "rolloutBefore", "*"},
 		{spec, "rolloutStrategy"},
 		{spec, "rolloutStrategy", "*"},
+		{spec, "etcdSnapshot"},
+		{spec, "etcdSnapshot", "*"},
+		{spec, "etcdDefragmentation"},
+		{spec, "etcdDefragmentation", "*"},
 	}
 
 	oldK, ok := oldObj.(*controlplanev1.KubeadmControlPlane)
@@ -344,6 +361,26 @@ func validateKubeadmControlPlaneSpec(s controlplanev1.KubeadmControlPlaneSpec, n
 		}
 	}
 
+	if externalEtcd && s.EtcdSnapshot != nil {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				pathPrefix.Child("etcdSnapshot"),
+				"cannot be set when using external etcd",
+			),
+		)
+	}
+
+	if externalEtcd && s.EtcdDefragmentation != nil {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(
+				pathPrefix.Child("etcdDefragmentation"),
+				"cannot be set when using external etcd",
+			),
+		)
+	}
+
 	if s.MachineTemplate.InfrastructureRef.APIVersion == "" {
 		allErrs = append(
 			allErrs,
@@ -447,6 +484,29 @@ func validateRolloutStrategy(rolloutStrategy *controlplanev1.RolloutStrategy, re
 		)
 	}
 
+	if rolloutStrategy.RollingUpdate.MaxUnavailable != nil {
+		maxUnavailable := rolloutStrategy.RollingUpdate.MaxUnavailable.IntValue()
+		switch {
+		case maxUnavailable != ios1.IntValue() && maxUnavailable != ios0.IntValue():
+			allErrs = append(
+				allErrs,
+				field.Required(
+					pathPrefix.Child("rollingUpdate", "maxUnavailable"),
+					"value must be 1 or 0",
+				),
+			)
+		case maxUnavailable+rolloutStrategy.RollingUpdate.MaxSurge.IntValue() != ios1.IntValue():
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					pathPrefix.Child("rollingUpdate", "maxUnavailable"),
+					rolloutStrategy.RollingUpdate.MaxUnavailable.String(),
+					"exactly one of maxSurge and maxUnavailable must be 1",
+				),
+			)
+		}
+	}
+
 	if rolloutStrategy.RollingUpdate.MaxSurge.IntValue() != ios1.IntValue() && rolloutStrategy.RollingUpdate.MaxSurge.IntValue() != ios0.IntValue() {
 		allErrs = append(
 			allErrs,
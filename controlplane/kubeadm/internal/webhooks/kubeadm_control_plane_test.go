@@ -76,6 +76,53 @@ func TestKubeadmControlPlaneDefault(t *testing.T) {
 	g.Expect(kcp.Spec.Version).To(Equal("v1.18.3"))
 	g.Expect(kcp.Spec.RolloutStrategy.Type).To(Equal(controlplanev1.RollingUpdateStrategyType))
 	g.Expect(kcp.Spec.RolloutStrategy.RollingUpdate.MaxSurge.IntVal).To(Equal(int32(1)))
+	g.Expect(kcp.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable.IntVal).To(Equal(int32(0)))
+}
+
+func TestKubeadmControlPlaneDefaultRollingUpdate(t *testing.T) {
+	ios0 := intstr.FromInt32(0)
+	ios1 := intstr.FromInt32(1)
+
+	tests := []struct {
+		name                   string
+		rollingUpdate          *controlplanev1.RollingUpdate
+		expectedMaxSurge       int32
+		expectedMaxUnavailable int32
+	}{
+		{
+			name:                   "defaults to maxSurge 1 and maxUnavailable 0",
+			rollingUpdate:          nil,
+			expectedMaxSurge:       1,
+			expectedMaxUnavailable: 0,
+		},
+		{
+			name:                   "defaults maxUnavailable to 1 when maxSurge is 0",
+			rollingUpdate:          &controlplanev1.RollingUpdate{MaxSurge: &ios0},
+			expectedMaxSurge:       0,
+			expectedMaxUnavailable: 1,
+		},
+		{
+			name:                   "defaults maxSurge to 0 when maxUnavailable is 1",
+			rollingUpdate:          &controlplanev1.RollingUpdate{MaxUnavailable: &ios1},
+			expectedMaxSurge:       0,
+			expectedMaxUnavailable: 1,
+		},
+		{
+			name:                   "defaults maxSurge to 1 when maxUnavailable is 0",
+			rollingUpdate:          &controlplanev1.RollingUpdate{MaxUnavailable: &ios0},
+			expectedMaxSurge:       1,
+			expectedMaxUnavailable: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rolloutStrategy := defaultRolloutStrategy(&controlplanev1.RolloutStrategy{RollingUpdate: tt.rollingUpdate})
+			g.Expect(rolloutStrategy.RollingUpdate.MaxSurge.IntValue()).To(Equal(int(tt.expectedMaxSurge)))
+			g.Expect(rolloutStrategy.RollingUpdate.MaxUnavailable.IntValue()).To(Equal(int(tt.expectedMaxUnavailable)))
+		})
+	}
 }
 
 func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
@@ -116,6 +163,20 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 	val := intstr.FromString("1")
 	stringMaxSurge.Spec.RolloutStrategy.RollingUpdate.MaxSurge = &val
 
+	invalidMaxUnavailable := valid.DeepCopy()
+	invalidMaxUnavailable.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(3))
+
+	conflictingMaxSurgeAndMaxUnavailable := valid.DeepCopy()
+	conflictingMaxSurgeAndMaxUnavailable.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+
+	scaleInFirst := valid.DeepCopy()
+	scaleInFirst.Spec.Replicas = ptr.To[int32](3)
+	scaleInFirst.Spec.RolloutStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(0))
+	scaleInFirst.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromInt32(1))
+
+	scaleInFirstWithOneReplica := scaleInFirst.DeepCopy()
+	scaleInFirstWithOneReplica.Spec.Replicas = ptr.To[int32](1)
+
 	invalidNamespace := valid.DeepCopy()
 	invalidNamespace.Spec.MachineTemplate.InfrastructureRef.Namespace = invalidNamespaceName
 
@@ -244,6 +305,26 @@ func TestKubeadmControlPlaneValidateCreate(t *testing.T) {
 			expectErr: false,
 			kcp:       stringMaxSurge,
 		},
+		{
+			name:      "should return error when maxUnavailable is not 0 or 1",
+			expectErr: true,
+			kcp:       invalidMaxUnavailable,
+		},
+		{
+			name:      "should return error when both maxSurge and maxUnavailable are 1",
+			expectErr: true,
+			kcp:       conflictingMaxSurgeAndMaxUnavailable,
+		},
+		{
+			name:      "should succeed when maxUnavailable is 1 and replica count is 3",
+			expectErr: false,
+			kcp:       scaleInFirst,
+		},
+		{
+			name:      "should return error when maxUnavailable is 1 and replica count is < 3",
+			expectErr: true,
+			kcp:       scaleInFirstWithOneReplica,
+		},
 		{
 			name:      "should return error when given an invalid rolloutBefore.certificatesExpiryDays value",
 			expectErr: true,
@@ -619,6 +700,25 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 		KeyFile: "another key file",
 	}
 
+	etcdSnapshot := before.DeepCopy()
+	etcdSnapshot.Spec.EtcdSnapshot = &controlplanev1.EtcdSnapshotPolicy{
+		IntervalSeconds: 3600,
+		Retention:       ptr.To[int32](5),
+	}
+
+	etcdSnapshotExternalEtcd := externalEtcd.DeepCopy()
+	etcdSnapshotExternalEtcd.Spec.EtcdSnapshot = &controlplanev1.EtcdSnapshotPolicy{
+		IntervalSeconds: 3600,
+	}
+
+	etcdDefragmentation := before.DeepCopy()
+	etcdDefragmentation.Spec.EtcdDefragmentation = &controlplanev1.EtcdDefragmentationPolicy{
+		ThresholdPercent: ptr.To[int32](30),
+	}
+
+	etcdDefragmentationExternalEtcd := externalEtcd.DeepCopy()
+	etcdDefragmentationExternalEtcd.Spec.EtcdDefragmentation = &controlplanev1.EtcdDefragmentationPolicy{}
+
 	localDataDir := before.DeepCopy()
 	localDataDir.Spec.KubeadmConfigSpec.ClusterConfiguration.Etcd.Local = &bootstrapv1.LocalEtcd{
 		DataDir: "some local data dir",
@@ -970,6 +1070,30 @@ func TestKubeadmControlPlaneValidateUpdate(t *testing.T) {
 			before:    externalEtcd,
 			kcp:       externalEtcdChanged,
 		},
+		{
+			name:      "should succeed when setting the etcd snapshot policy",
+			expectErr: false,
+			before:    before,
+			kcp:       etcdSnapshot,
+		},
+		{
+			name:      "should return error when setting the etcd snapshot policy with external etcd",
+			expectErr: true,
+			before:    externalEtcd,
+			kcp:       etcdSnapshotExternalEtcd,
+		},
+		{
+			name:      "should succeed when setting the etcd defragmentation policy",
+			expectErr: false,
+			before:    before,
+			kcp:       etcdDefragmentation,
+		},
+		{
+			name:      "should return error when setting the etcd defragmentation policy with external etcd",
+			expectErr: true,
+			before:    externalEtcd,
+			kcp:       etcdDefragmentationExternalEtcd,
+		},
 		{
 			name:      "should succeed when adding the cluster config's local etcd's configuration",
 			expectErr: false,
@@ -17,6 +17,7 @@ limitations under the License.
 package internal
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/rand"
@@ -25,6 +26,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"fmt"
+	"io"
 	"math/big"
 	"reflect"
 	"time"
@@ -37,7 +39,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/retry"
+	bootstrapapi "k8s.io/cluster-bootstrap/token/api"
 	"k8s.io/utils/ptr"
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -84,6 +88,8 @@ type WorkloadCluster interface {
 	UpdateStaticPodConditions(ctx context.Context, controlPlane *ControlPlane)
 	UpdateEtcdConditions(ctx context.Context, controlPlane *ControlPlane)
 	EtcdMembers(ctx context.Context) ([]string, error)
+	SnapshotEtcd(ctx context.Context, w io.Writer) (int64, error)
+	EtcdMemberDBStatuses(ctx context.Context, nodeNames []string) ([]EtcdMemberDBStatus, []etcd.MemberAlarm, error)
 	GetAPIServerCertificateExpiry(ctx context.Context, kubeadmConfig *bootstrapv1.KubeadmConfig, nodeName string) (*time.Time, error)
 
 	// Upgrade related tasks.
@@ -100,6 +106,9 @@ type WorkloadCluster interface {
 	ForwardEtcdLeadership(ctx context.Context, machine *clusterv1.Machine, leaderCandidate *clusterv1.Machine) error
 	AllowClusterAdminPermissions(ctx context.Context, version semver.Version) error
 	UpdateClusterConfiguration(ctx context.Context, version semver.Version, mutators ...func(*bootstrapv1.ClusterConfiguration)) error
+	DefragmentEtcdMember(ctx context.Context, nodeName string) error
+	DisarmEtcdAlarms(ctx context.Context, nodeNames []string, alarms []etcd.MemberAlarm) error
+	UpdateClusterInfoCertificateAuthority(ctx context.Context, caData []byte) error
 
 	// State recovery tasks.
 	ReconcileEtcdMembersAndControlPlaneNodes(ctx context.Context, members []*etcd.Member, nodeNames []string) ([]string, error)
@@ -268,6 +277,50 @@ func (w *Workload) UpdateClusterConfiguration(ctx context.Context, version semve
 	})
 }
 
+// UpdateClusterInfoCertificateAuthority updates the CA certificates in the cluster-info ConfigMap, which is used
+// by kubeadm join to discover the cluster CA, e.g. during a CA rotation.
+// NOTE: The ConfigMap is signed with bootstrap tokens by the bootstrap signer in kube-controller-manager,
+// which automatically updates signatures whenever the ConfigMap changes.
+func (w *Workload) UpdateClusterInfoCertificateAuthority(ctx context.Context, caData []byte) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		key := ctrlclient.ObjectKey{Name: bootstrapapi.ConfigMapClusterInfo, Namespace: metav1.NamespacePublic}
+		configMap, err := w.getConfigMap(ctx, key)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get %s ConfigMap", bootstrapapi.ConfigMapClusterInfo)
+		}
+
+		currentData, ok := configMap.Data[bootstrapapi.KubeConfigKey]
+		if !ok {
+			return errors.Errorf("unable to find %q in the %s ConfigMap", bootstrapapi.KubeConfigKey, bootstrapapi.ConfigMapClusterInfo)
+		}
+		config, err := clientcmd.Load([]byte(currentData))
+		if err != nil {
+			return errors.Wrapf(err, "unable to decode %q in the %s ConfigMap", bootstrapapi.KubeConfigKey, bootstrapapi.ConfigMapClusterInfo)
+		}
+
+		changed := false
+		for _, cluster := range config.Clusters {
+			if !bytes.Equal(cluster.CertificateAuthorityData, caData) {
+				cluster.CertificateAuthorityData = caData
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+
+		updatedData, err := clientcmd.Write(*config)
+		if err != nil {
+			return errors.Wrapf(err, "unable to encode %q in the %s ConfigMap", bootstrapapi.KubeConfigKey, bootstrapapi.ConfigMapClusterInfo)
+		}
+		configMap.Data[bootstrapapi.KubeConfigKey] = string(updatedData)
+		if err := w.Client.Update(ctx, configMap); err != nil {
+			return errors.Wrapf(err, "failed to update %s ConfigMap", bootstrapapi.ConfigMapClusterInfo)
+		}
+		return nil
+	})
+}
+
 // ClusterStatus holds stats information about the cluster.
 type ClusterStatus struct {
 	// Nodes are a total count of nodes
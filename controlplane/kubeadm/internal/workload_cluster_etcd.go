@@ -18,6 +18,7 @@ package internal
 
 import (
 	"context"
+	"io"
 
 	"github.com/pkg/errors"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -219,3 +220,117 @@ func (w *Workload) EtcdMembers(ctx context.Context) ([]string, error) {
 	}
 	return names, nil
 }
+
+// SnapshotEtcd streams a snapshot of the etcd cluster into w and returns the number of bytes written.
+//
+// NOTE: The snapshot is taken from the first etcd member which is reachable; it is a consistent
+// point-in-time copy of the data in that member.
+func (w *Workload) SnapshotEtcd(ctx context.Context, wr io.Writer) (int64, error) {
+	nodes, err := w.getControlPlaneNodes(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to list control plane nodes")
+	}
+	nodeNames := make([]string, 0, len(nodes.Items))
+	for _, node := range nodes.Items {
+		nodeNames = append(nodeNames, node.Name)
+	}
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, nodeNames)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	n, err := etcdClient.Snapshot(ctx, wr)
+	if err != nil {
+		return n, errors.Wrap(err, "failed to take etcd snapshot using etcd client")
+	}
+	return n, nil
+}
+
+// EtcdMemberDBStatus reports info about the database of the etcd member hosted on a control plane node.
+type EtcdMemberDBStatus struct {
+	// NodeName is the name of the node hosting the etcd member.
+	NodeName string
+
+	// MemberID is the ID of the etcd member.
+	MemberID uint64
+
+	// IsLeader is true if the etcd member is the leader of the etcd cluster.
+	IsLeader bool
+
+	// DBSize is the size of the member database, in bytes.
+	DBSize int64
+
+	// DBSizeInUse is the size of the member database which is actually in use, in bytes.
+	DBSizeInUse int64
+}
+
+// EtcdMemberDBStatuses returns the database status of the etcd members hosted on the given nodes, together with
+// the alarms currently raised in the etcd cluster.
+//
+// NOTE: An error is returned if it is not possible to connect to any of the given nodes, so callers
+// can rely on the returned statuses to be complete.
+func (w *Workload) EtcdMemberDBStatuses(ctx context.Context, nodeNames []string) ([]EtcdMemberDBStatus, []etcd.MemberAlarm, error) {
+	statuses := make([]EtcdMemberDBStatus, 0, len(nodeNames))
+	var alarms []etcd.MemberAlarm
+	for _, nodeName := range nodeNames {
+		etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to create etcd client for %s Node", nodeName)
+		}
+
+		statuses = append(statuses, EtcdMemberDBStatus{
+			NodeName:    nodeName,
+			MemberID:    etcdClient.MemberID,
+			IsLeader:    etcdClient.MemberID == etcdClient.LeaderID,
+			DBSize:      etcdClient.DBSize,
+			DBSizeInUse: etcdClient.DBSizeInUse,
+		})
+
+		// Alarms are stored in the etcd cluster, so it is enough to read them once.
+		if alarms == nil {
+			alarms, err = etcdClient.Alarms(ctx)
+			if err != nil {
+				_ = etcdClient.Close()
+				return nil, nil, errors.Wrap(err, "failed to get etcd alarms using etcd client")
+			}
+		}
+		_ = etcdClient.Close()
+	}
+	return statuses, alarms, nil
+}
+
+// DefragmentEtcdMember defragments the database of the etcd member hosted on the given node.
+func (w *Workload) DefragmentEtcdMember(ctx context.Context, nodeName string) error {
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, []string{nodeName})
+	if err != nil {
+		return errors.Wrapf(err, "failed to create etcd client for %s Node", nodeName)
+	}
+	defer etcdClient.Close()
+
+	if err := etcdClient.Defragment(ctx); err != nil {
+		return errors.Wrap(err, "failed to defragment etcd member using etcd client")
+	}
+	return nil
+}
+
+// DisarmEtcdAlarms disarms the given etcd alarms.
+func (w *Workload) DisarmEtcdAlarms(ctx context.Context, nodeNames []string, alarms []etcd.MemberAlarm) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	etcdClient, err := w.etcdClientGenerator.forFirstAvailableNode(ctx, nodeNames)
+	if err != nil {
+		return errors.Wrap(err, "failed to create etcd client")
+	}
+	defer etcdClient.Close()
+
+	errs := []error{}
+	for _, alarm := range alarms {
+		if err := etcdClient.DisarmAlarm(ctx, alarm); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
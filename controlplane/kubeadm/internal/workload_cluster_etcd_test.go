@@ -765,6 +765,84 @@ func TestReconcileEtcdMembersAndControlPlaneNodes(t *testing.T) {
 	}
 }
 
+func TestEtcdMemberDBStatuses(t *testing.T) {
+	t.Run("returns the database status of every member", func(t *testing.T) {
+		g := NewWithT(t)
+
+		clients := map[string]*etcd.Client{
+			"node-1": {EtcdClient: &fake2.FakeEtcdClient{AlarmResponse: &clientv3.AlarmResponse{
+				Alarms: []*pb.AlarmMember{{MemberID: 2, Alarm: pb.AlarmType_NOSPACE}},
+			}}, MemberID: 1, LeaderID: 2, DBSize: 100, DBSizeInUse: 40},
+			"node-2": {EtcdClient: &fake2.FakeEtcdClient{}, MemberID: 2, LeaderID: 2, DBSize: 200, DBSizeInUse: 190},
+		}
+		w := &Workload{
+			etcdClientGenerator: &fakeEtcdClientGenerator{
+				forNodesClientFunc: func(n []string) (*etcd.Client, error) {
+					return clients[n[0]], nil
+				},
+			},
+		}
+
+		statuses, alarms, err := w.EtcdMemberDBStatuses(ctx, []string{"node-1", "node-2"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(statuses).To(Equal([]EtcdMemberDBStatus{
+			{NodeName: "node-1", MemberID: 1, IsLeader: false, DBSize: 100, DBSizeInUse: 40},
+			{NodeName: "node-2", MemberID: 2, IsLeader: true, DBSize: 200, DBSizeInUse: 190},
+		}))
+		g.Expect(alarms).To(Equal([]etcd.MemberAlarm{{MemberID: 2, Type: etcd.AlarmNoSpace}}))
+	})
+	t.Run("returns an error if a member is not reachable", func(t *testing.T) {
+		g := NewWithT(t)
+
+		w := &Workload{
+			etcdClientGenerator: &fakeEtcdClientGenerator{
+				forNodesClientFunc: func(n []string) (*etcd.Client, error) {
+					if n[0] == "node-2" {
+						return nil, errors.New("failed to connect")
+					}
+					return &etcd.Client{EtcdClient: &fake2.FakeEtcdClient{AlarmResponse: &clientv3.AlarmResponse{}}}, nil
+				},
+			},
+		}
+
+		_, _, err := w.EtcdMemberDBStatuses(ctx, []string{"node-1", "node-2"})
+		g.Expect(err).To(HaveOccurred())
+	})
+}
+
+func TestDefragmentEtcdMember(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeEtcdClient := &fake2.FakeEtcdClient{}
+	var connectedTo []string
+	w := &Workload{
+		etcdClientGenerator: &fakeEtcdClientGenerator{
+			forNodesClientFunc: func(n []string) (*etcd.Client, error) {
+				connectedTo = n
+				return &etcd.Client{EtcdClient: fakeEtcdClient, Endpoint: "etcd-" + n[0]}, nil
+			},
+		},
+	}
+
+	g.Expect(w.DefragmentEtcdMember(ctx, "node-1")).To(Succeed())
+	g.Expect(connectedTo).To(Equal([]string{"node-1"}))
+	g.Expect(fakeEtcdClient.DefragmentedEndpoint).To(Equal("etcd-node-1"))
+}
+
+func TestDisarmEtcdAlarms(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeEtcdClient := &fake2.FakeEtcdClient{AlarmResponse: &clientv3.AlarmResponse{}}
+	w := &Workload{
+		etcdClientGenerator: &fakeEtcdClientGenerator{
+			forNodesClient: &etcd.Client{EtcdClient: fakeEtcdClient},
+		},
+	}
+
+	g.Expect(w.DisarmEtcdAlarms(ctx, []string{"node-1"}, []etcd.MemberAlarm{{MemberID: 2, Type: etcd.AlarmNoSpace}})).To(Succeed())
+	g.Expect(fakeEtcdClient.DisarmedAlarm).To(Equal(&clientv3.AlarmMember{MemberID: 2, Alarm: pb.AlarmType_NOSPACE}))
+}
+
 type fakeEtcdClientGenerator struct {
 	forNodesClient     *etcd.Client
 	forNodesClientFunc func([]string) (*etcd.Client, error)
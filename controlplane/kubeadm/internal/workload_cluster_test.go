@@ -28,6 +28,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/yaml"
@@ -504,6 +506,46 @@ func TestUpdateImageRepositoryInKubeadmConfigMap(t *testing.T) {
 	}
 }
 
+func TestUpdateClusterInfoCertificateAuthority(t *testing.T) {
+	g := NewWithT(t)
+
+	clusterInfo, err := clientcmd.Write(clientcmdapi.Config{
+		Clusters: map[string]*clientcmdapi.Cluster{
+			"": {
+				Server:                   "https://example.com:6443",
+				CertificateAuthorityData: []byte("old-ca"),
+			},
+		},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	fakeClient := fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-info",
+			Namespace: metav1.NamespacePublic,
+		},
+		Data: map[string]string{
+			"kubeconfig": string(clusterInfo),
+		},
+	}).Build()
+
+	w := &Workload{
+		Client: fakeClient,
+	}
+	g.Expect(w.UpdateClusterInfoCertificateAuthority(ctx, []byte("old-ca\nnew-ca"))).To(Succeed())
+
+	var actualConfig corev1.ConfigMap
+	g.Expect(w.Client.Get(
+		ctx,
+		client.ObjectKey{Name: "cluster-info", Namespace: metav1.NamespacePublic},
+		&actualConfig,
+	)).To(Succeed())
+	config, err := clientcmd.Load([]byte(actualConfig.Data["kubeconfig"]))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(config.Clusters[""].Server).To(Equal("https://example.com:6443"))
+	g.Expect(config.Clusters[""].CertificateAuthorityData).To(Equal([]byte("old-ca\nnew-ca")))
+}
+
 func TestUpdateApiServerInKubeadmConfigMap(t *testing.T) {
 	tests := []struct {
 		name                     string
@@ -53,15 +53,22 @@ import (
 	controlplanev1beta1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta1"
 	controlplanev1 "sigs.k8s.io/cluster-api/api/controlplane/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/crdmigrator"
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	kubeadmcontrolplanecontrollers "sigs.k8s.io/cluster-api/controlplane/kubeadm/controllers"
 	"sigs.k8s.io/cluster-api/controlplane/kubeadm/internal/etcd"
 	kcpwebhooks "sigs.k8s.io/cluster-api/controlplane/kubeadm/webhooks"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	runtimecontrollers "sigs.k8s.io/cluster-api/exp/runtime/controllers"
 	"sigs.k8s.io/cluster-api/feature"
 	controlplanev1alpha3 "sigs.k8s.io/cluster-api/internal/api/controlplane/kubeadm/v1alpha3"
 	controlplanev1alpha4 "sigs.k8s.io/cluster-api/internal/api/controlplane/kubeadm/v1alpha4"
+	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
+	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	"sigs.k8s.io/cluster-api/util/apiwarnings"
 	"sigs.k8s.io/cluster-api/util/flags"
 	"sigs.k8s.io/cluster-api/version"
@@ -69,6 +76,7 @@ import (
 
 var (
 	scheme         = runtime.NewScheme()
+	catalog        = runtimecatalog.New()
 	setupLog       = ctrl.Log.WithName("setup")
 	controllerName = "cluster-api-kubeadm-control-plane-manager"
 
@@ -100,6 +108,8 @@ var (
 	skipCRDMigrationPhases         []string
 	etcdDialTimeout                time.Duration
 	etcdCallTimeout                time.Duration
+	etcdSnapshotDir                string
+	etcdSnapshotDownloadURL        string
 )
 
 func init() {
@@ -111,6 +121,10 @@ func init() {
 	_ = controlplanev1.AddToScheme(scheme)
 	_ = bootstrapv1.AddToScheme(scheme)
 	_ = apiextensionsv1.AddToScheme(scheme)
+	_ = runtimev1.AddToScheme(scheme)
+
+	// Register the RuntimeHook types into the catalog.
+	_ = runtimehooksv1.AddToCatalog(catalog)
 }
 
 // InitFlags initializes the flags.
@@ -190,6 +204,13 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&etcdCallTimeout, "etcd-call-timeout-duration", etcd.DefaultCallTimeout,
 		"Duration that the etcd client waits at most for read and write operations to etcd.")
 
+	fs.StringVar(&etcdSnapshotDir, "etcd-snapshot-dir", "",
+		"Directory where etcd snapshots of workload clusters are stored. If not set, etcd snapshots and restores are disabled.")
+
+	fs.StringVar(&etcdSnapshotDownloadURL, "etcd-snapshot-download-url", "",
+		"Base URL the etcd snapshot directory is served at, used by control plane machines to download the snapshot when restoring etcd. "+
+			"Snapshots are downloaded from <url>/<namespace>/<kcp name>/<snapshot>.db. If not set, etcd restores are disabled.")
+
 	flags.AddManagerOptions(fs, &managerOptions)
 
 	feature.MutableGates.AddFlag(fs)
@@ -201,6 +222,9 @@ func InitFlags(fs *pflag.FlagSet) {
 // ADD CRD RBAC for CRD Migrator.
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions;customresourcedefinitions/status,verbs=update;patch,resourceNames=kubeadmcontrolplanes.controlplane.cluster.x-k8s.io;kubeadmcontrolplanetemplates.controlplane.cluster.x-k8s.io
+// ADD ExtensionConfig RBAC for calling in-place update extensions.
+// +kubebuilder:rbac:groups=runtime.cluster.x-k8s.io,resources=extensionconfigs,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 func main() {
 	setupLog.Info(fmt.Sprintf("Version: %+v", version.Get().String()))
@@ -318,7 +342,7 @@ func main() {
 	ctx := ctrl.SetupSignalHandler()
 
 	setupChecks(mgr)
-	setupReconcilers(ctx, mgr)
+	setupReconcilers(ctx, mgr, watchNamespaces)
 	setupWebhooks(mgr)
 
 	setupLog.Info("Starting manager", "version", version.Get().String())
@@ -340,7 +364,7 @@ func setupChecks(mgr ctrl.Manager) {
 	}
 }
 
-func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
+func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map[string]cache.Config) {
 	secretCachingClient, err := client.New(mgr.GetConfig(), client.Options{
 		HTTPClient: mgr.GetHTTPClient(),
 		Cache: &client.CacheOptions{
@@ -418,14 +442,70 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		os.Exit(1)
 	}
 
+	var runtimeClient runtimeclient.Client
+	if feature.Gates.Enabled(feature.RuntimeSDK) && feature.Gates.Enabled(feature.InPlaceUpdates) {
+		// This is the creation of the runtimeClient used to call in-place update extensions.
+		runtimeClient = internalruntimeclient.New(internalruntimeclient.Options{
+			Catalog:  catalog,
+			Registry: runtimeregistry.New(),
+			Client:   mgr.GetClient(),
+		})
+
+		// Setup a separate cache without label selector for secrets, to be used to watch for
+		// the secrets holding the credentials of Runtime Extensions.
+		partialSecretCache, err := cache.New(mgr.GetConfig(), cache.Options{
+			Scheme:            mgr.GetScheme(),
+			Mapper:            mgr.GetRESTMapper(),
+			HTTPClient:        mgr.GetHTTPClient(),
+			SyncPeriod:        &syncPeriod,
+			DefaultNamespaces: watchNamespaces,
+			DefaultTransform: func(in interface{}) (interface{}, error) {
+				// Use DefaultTransform to drop objects we don't expect to get into this cache.
+				obj, ok := in.(*metav1.PartialObjectMetadata)
+				if !ok {
+					panic(fmt.Sprintf("cache expected to only get PartialObjectMetadata, got %T", in))
+				}
+				if obj.GetObjectKind().GroupVersionKind() != corev1.SchemeGroupVersion.WithKind("Secret") {
+					panic(fmt.Sprintf("cache expected to only get Secrets, got %s", obj.GetObjectKind()))
+				}
+				// Additionally strip managed fields.
+				return cache.TransformStripManagedFields()(obj)
+			},
+		})
+		if err != nil {
+			setupLog.Error(err, "Failed to create cache for metadata only Secret watches")
+			os.Exit(1)
+		}
+		if err := mgr.Add(partialSecretCache); err != nil {
+			setupLog.Error(err, "Failed to start cache for metadata only Secret watches")
+			os.Exit(1)
+		}
+
+		// Note: ExtensionConfigs are discovered by the core controller manager; the KubeadmControlPlane
+		// controller manager only registers them into its own registry.
+		if err := (&runtimecontrollers.ExtensionConfigReconciler{
+			Client:           mgr.GetClient(),
+			APIReader:        mgr.GetAPIReader(),
+			RuntimeClient:    runtimeClient,
+			ReadOnly:         true,
+			WatchFilterValue: watchFilterValue,
+		}).SetupWithManager(ctx, mgr, concurrency(1), partialSecretCache); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ExtensionConfig")
+			os.Exit(1)
+		}
+	}
+
 	if err := (&kubeadmcontrolplanecontrollers.KubeadmControlPlaneReconciler{
 		Client:                      mgr.GetClient(),
 		SecretCachingClient:         secretCachingClient,
+		RuntimeClient:               runtimeClient,
 		ClusterCache:                clusterCache,
 		WatchFilterValue:            watchFilterValue,
 		EtcdDialTimeout:             etcdDialTimeout,
 		EtcdCallTimeout:             etcdCallTimeout,
 		RemoteConditionsGracePeriod: remoteConditionsGracePeriod,
+		EtcdSnapshotDir:             etcdSnapshotDir,
+		EtcdSnapshotDownloadURL:     etcdSnapshotDownloadURL,
 	}).SetupWithManager(ctx, mgr, concurrency(kubeadmControlPlaneConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "KubeadmControlPlane")
 		os.Exit(1)
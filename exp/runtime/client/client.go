@@ -86,6 +86,10 @@ type Client interface {
 	// Unregister unregisters the ExtensionConfig.
 	Unregister(extensionConfig *runtimev1.ExtensionConfig) error
 
+	// GetAllExtensions gets the names of all the ExtensionHandlers registered for the hook
+	// and matching the namespace of the given object.
+	GetAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object) ([]string, error)
+
 	// CallAllExtensions calls all the ExtensionHandler registered for the hook.
 	CallAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object, request runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject) error
 
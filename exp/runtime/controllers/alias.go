@@ -34,6 +34,10 @@ type ExtensionConfigReconciler struct {
 	APIReader     client.Reader
 	RuntimeClient runtimeclient.Client
 
+	// ReadOnly configures the ExtensionConfigReconciler to only register ExtensionConfigs with the handlers
+	// already discovered by the core controller manager, without writing ExtensionConfigs.
+	ReadOnly bool
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -43,6 +47,7 @@ func (r *ExtensionConfigReconciler) SetupWithManager(ctx context.Context, mgr ct
 		Client:           r.Client,
 		APIReader:        r.APIReader,
 		RuntimeClient:    r.RuntimeClient,
+		ReadOnly:         r.ReadOnly,
 		WatchFilterValue: r.WatchFilterValue,
 	}).SetupWithManager(ctx, mgr, options, partialSecretCache)
 }
@@ -27,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -59,6 +60,9 @@ type Reconciler struct {
 	Client        client.Client
 	APIReader     client.Reader
 	RuntimeClient runtimeclient.Client
+	// ReadOnly configures the Reconciler to only register ExtensionConfigs with the handlers already discovered by
+	// another controller (e.g. the one in the core controller manager), without writing ExtensionConfigs.
+	ReadOnly bool
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 }
@@ -69,9 +73,18 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 	}
 
 	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "extensionconfig")
-	err := ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&runtimev1.ExtensionConfig{}).
-		WatchesRawSource(source.Kind(
+		WithOptions(options).
+		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue))
+
+	// Secrets are watched to inject the CA bundle into ExtensionConfigs, which never happens in read-only mode,
+	// and to pick up changes to the credentials referenced in spec.clientConfig.
+	if !r.ReadOnly && partialSecretCache == nil {
+		return errors.New("partialSecretCache must not be nil")
+	}
+	if partialSecretCache != nil {
+		b = b.WatchesRawSource(source.Kind(
 			partialSecretCache,
 			&metav1.PartialObjectMetadata{
 				TypeMeta: metav1.TypeMeta{
@@ -83,24 +96,31 @@ func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, opt
 				r.secretToExtensionConfig,
 			),
 			predicates.TypedResourceIsChanged[*metav1.PartialObjectMetadata](mgr.GetScheme(), predicateLog),
-		)).
-		WithOptions(options).
-		WithEventFilter(predicates.ResourceHasFilterLabel(mgr.GetScheme(), predicateLog, r.WatchFilterValue)).
-		Complete(r)
-	if err != nil {
-		return errors.Wrap(err, "failed setting up with a controller manager")
+		))
 	}
 
-	if err := indexByExtensionInjectCAFromSecretName(ctx, mgr); err != nil {
+	if err := b.Complete(r); err != nil {
 		return errors.Wrap(err, "failed setting up with a controller manager")
 	}
 
+	if !r.ReadOnly {
+		if err := indexByExtensionInjectCAFromSecretName(ctx, mgr); err != nil {
+			return errors.Wrap(err, "failed setting up with a controller manager")
+		}
+	}
+	if partialSecretCache != nil {
+		if err := indexByClientConfigSecretName(ctx, mgr); err != nil {
+			return errors.Wrap(err, "failed setting up with a controller manager")
+		}
+	}
+
 	// warmupRunnable will attempt to sync the RuntimeSDK registry with existing ExtensionConfig objects to ensure extensions
 	// are discovered before controllers begin reconciling.
-	err = mgr.Add(&warmupRunnable{
+	err := mgr.Add(&warmupRunnable{
 		Client:        r.Client,
 		APIReader:     r.APIReader,
 		RuntimeClient: r.RuntimeClient,
+		ReadOnly:      r.ReadOnly,
 	})
 	if err != nil {
 		return errors.Wrap(err, "failed adding warmupRunnable to controller manager")
@@ -132,6 +152,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// In read-only mode the ExtensionConfig is registered with the handlers already discovered by another controller.
+	if r.ReadOnly {
+		return r.reconcileReadOnly(ctx, extensionConfig)
+	}
+
 	// Copy to avoid modifying the original extensionConfig.
 	original := extensionConfig.DeepCopy()
 
@@ -172,6 +197,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
+// reconcileReadOnly registers the ExtensionConfig as is, or removes it from the registry when it is deleted.
+// NOTE: The ExtensionConfig is registered only if discovery succeeded, so extension handlers which
+// are currently not reachable are not called.
+func (r *Reconciler) reconcileReadOnly(ctx context.Context, extensionConfig *runtimev1.ExtensionConfig) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !extensionConfig.DeletionTimestamp.IsZero() || !conditions.IsTrue(extensionConfig, runtimev1.ExtensionConfigDiscoveredCondition) {
+		return r.reconcileDelete(ctx, extensionConfig)
+	}
+
+	log.V(4).Info("Registering ExtensionConfig information into registry")
+	if err := r.RuntimeClient.Register(extensionConfig); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to register ExtensionConfig %s", klog.KObj(extensionConfig))
+	}
+	return ctrl.Result{}, nil
+}
+
 func patchExtensionConfig(ctx context.Context, client client.Client, original, modified *runtimev1.ExtensionConfig, options ...patch.Option) error {
 	patchHelper, err := patch.NewHelper(original, client)
 	if err != nil {
@@ -202,23 +244,34 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, extensionConfig *runti
 }
 
 // secretToExtensionConfig maps a secret to ExtensionConfigs with the corresponding InjectCAFromSecretAnnotation
-// to reconcile them on updates of the secrets.
+// or referencing it in spec.clientConfig to reconcile them on updates of the secrets.
 func (r *Reconciler) secretToExtensionConfig(ctx context.Context, secret *metav1.PartialObjectMetadata) []reconcile.Request {
 	result := []ctrl.Request{}
 
-	extensionConfigs := runtimev1.ExtensionConfigList{}
 	indexKey := secret.GetNamespace() + "/" + secret.GetName()
-
-	if err := r.Client.List(
-		ctx,
-		&extensionConfigs,
-		client.MatchingFields{injectCAFromSecretAnnotationField: indexKey},
-	); err != nil {
-		return nil
+	indexFields := []string{clientConfigSecretsField}
+	if !r.ReadOnly {
+		indexFields = append(indexFields, injectCAFromSecretAnnotationField)
 	}
 
-	for _, ext := range extensionConfigs.Items {
-		result = append(result, ctrl.Request{NamespacedName: client.ObjectKey{Name: ext.Name}})
+	names := sets.Set[string]{}
+	for _, indexField := range indexFields {
+		extensionConfigs := runtimev1.ExtensionConfigList{}
+		if err := r.Client.List(
+			ctx,
+			&extensionConfigs,
+			client.MatchingFields{indexField: indexKey},
+		); err != nil {
+			return nil
+		}
+
+		for _, ext := range extensionConfigs.Items {
+			if names.Has(ext.Name) {
+				continue
+			}
+			names.Insert(ext.Name)
+			result = append(result, ctrl.Request{NamespacedName: client.ObjectKey{Name: ext.Name}})
+		}
 	}
 
 	return result
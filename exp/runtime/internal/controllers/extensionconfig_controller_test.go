@@ -311,6 +311,54 @@ func TestExtensionReconciler_discoverExtensionConfig(t *testing.T) {
 	})
 }
 
+func TestExtensionReconciler_reconcileReadOnly(t *testing.T) {
+	g := NewWithT(t)
+
+	discoveredExtensionConfig := fakeExtensionConfigForURL("", "ext1", "https://127.0.0.1/")
+	discoveredExtensionConfig.Status.Handlers = []runtimev1.ExtensionHandler{
+		{
+			Name: "first.ext1",
+			RequestHook: runtimev1.GroupVersionHook{
+				APIVersion: fakev1alpha1.GroupVersion.String(),
+				Hook:       "FakeHook",
+			},
+		},
+	}
+	conditions.Set(discoveredExtensionConfig, metav1.Condition{
+		Type:   runtimev1.ExtensionConfigDiscoveredCondition,
+		Status: metav1.ConditionTrue,
+		Reason: runtimev1.ExtensionConfigDiscoveredReason,
+	})
+
+	registry := runtimeregistry.New()
+	g.Expect(registry.WarmUp(&runtimev1.ExtensionConfigList{})).To(Succeed())
+	r := &Reconciler{
+		RuntimeClient: internalruntimeclient.New(internalruntimeclient.Options{
+			Catalog:  runtimecatalog.New(),
+			Registry: registry,
+		}),
+		ReadOnly: true,
+	}
+
+	// A discovered ExtensionConfig is registered as is.
+	_, err := r.reconcileReadOnly(ctx, discoveredExtensionConfig)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = registry.Get("first.ext1")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// An ExtensionConfig whose discovery failed is removed from the registry.
+	notDiscoveredExtensionConfig := discoveredExtensionConfig.DeepCopy()
+	conditions.Set(notDiscoveredExtensionConfig, metav1.Condition{
+		Type:   runtimev1.ExtensionConfigDiscoveredCondition,
+		Status: metav1.ConditionFalse,
+		Reason: runtimev1.ExtensionConfigNotDiscoveredReason,
+	})
+	_, err = r.reconcileReadOnly(ctx, notDiscoveredExtensionConfig)
+	g.Expect(err).ToNot(HaveOccurred())
+	_, err = registry.Get("first.ext1")
+	g.Expect(err).To(HaveOccurred())
+}
+
 func Test_reconcileCABundle(t *testing.T) {
 	g := NewWithT(t)
 
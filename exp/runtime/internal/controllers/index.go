@@ -31,6 +31,10 @@ const (
 	// injectCAFromSecretAnnotationField is used by the Extension controller for indexing ExtensionConfigs
 	// which have the InjectCAFromSecretAnnotation set.
 	injectCAFromSecretAnnotationField = "metadata.annotations[" + runtimev1.InjectCAFromSecretAnnotation + "]"
+
+	// clientConfigSecretsField is used by the Extension controller for indexing ExtensionConfigs
+	// by the Secrets holding the credentials referenced in spec.clientConfig.
+	clientConfigSecretsField = "spec.clientConfig.secrets"
 )
 
 // indexByExtensionInjectCAFromSecretName adds the index by InjectCAFromSecretAnnotation to the
@@ -55,3 +59,32 @@ func extensionConfigByInjectCAFromSecretName(o client.Object) []string {
 	}
 	return nil
 }
+
+// indexByClientConfigSecretName adds the index by the Secrets referenced in spec.clientConfig to the
+// managers cache.
+func indexByClientConfigSecretName(ctx context.Context, mgr ctrl.Manager) error {
+	if err := mgr.GetCache().IndexField(ctx, &runtimev1.ExtensionConfig{},
+		clientConfigSecretsField,
+		extensionConfigByClientConfigSecretName,
+	); err != nil {
+		return errors.Wrap(err, "error setting index field for spec.clientConfig Secrets")
+	}
+	return nil
+}
+
+func extensionConfigByClientConfigSecretName(o client.Object) []string {
+	extensionConfig, ok := o.(*runtimev1.ExtensionConfig)
+	if !ok {
+		panic(fmt.Sprintf("Expected ExtensionConfig but got a %T", o))
+	}
+	var result []string
+	for _, ref := range []*runtimev1.SecretReference{
+		extensionConfig.Spec.ClientConfig.ClientCertificate,
+		extensionConfig.Spec.ClientConfig.BearerToken,
+	} {
+		if ref != nil {
+			result = append(result, ref.Namespace+"/"+ref.Name)
+		}
+	}
+	return result
+}
@@ -58,3 +58,37 @@ func TestExtensionConfigByInjectCAFromSecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestExtensionConfigByClientConfigSecretName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		object   client.Object
+		expected []string
+	}{
+		{
+			name:     "when extensionConfig has no credentials",
+			object:   &runtimev1.ExtensionConfig{},
+			expected: nil,
+		},
+		{
+			name: "when extensionConfig has a client certificate and a bearer token",
+			object: &runtimev1.ExtensionConfig{
+				Spec: runtimev1.ExtensionConfigSpec{
+					ClientConfig: runtimev1.ClientConfig{
+						ClientCertificate: &runtimev1.SecretReference{Namespace: "foo", Name: "client-cert"},
+						BearerToken:       &runtimev1.SecretReference{Namespace: "foo", Name: "token"},
+					},
+				},
+			},
+			expected: []string{"foo/client-cert", "foo/token"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got := extensionConfigByClientConfigSecretName(test.object)
+			g.Expect(got).To(Equal(test.expected))
+		})
+	}
+}
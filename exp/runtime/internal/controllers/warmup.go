@@ -30,6 +30,7 @@ import (
 
 	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 const (
@@ -44,6 +45,7 @@ type warmupRunnable struct {
 	Client         client.Client
 	APIReader      client.Reader
 	RuntimeClient  runtimeclient.Client
+	ReadOnly       bool
 	warmupTimeout  time.Duration
 	warmupInterval time.Duration
 }
@@ -73,7 +75,12 @@ func (r *warmupRunnable) Start(ctx context.Context) error {
 	defer cancel()
 
 	err := wait.PollUntilContextTimeout(ctx, r.warmupInterval, r.warmupTimeout, true, func(ctx context.Context) (done bool, err error) {
-		if err = warmupRegistry(ctx, r.Client, r.APIReader, r.RuntimeClient); err != nil {
+		if r.ReadOnly {
+			err = warmupRegistryReadOnly(ctx, r.APIReader, r.RuntimeClient)
+		} else {
+			err = warmupRegistry(ctx, r.Client, r.APIReader, r.RuntimeClient)
+		}
+		if err != nil {
 			log.Error(err, "ExtensionConfig registry warmup failed")
 			return false, nil
 		}
@@ -138,3 +145,29 @@ func warmupRegistry(ctx context.Context, client client.Client, reader client.Rea
 
 	return nil
 }
+
+// warmupRegistryReadOnly warms up the registry by passing it the list of ExtensionConfigs with Handlers already
+// discovered by another controller.
+func warmupRegistryReadOnly(ctx context.Context, reader client.Reader, runtimeClient runtimeclient.Client) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	extensionConfigList := runtimev1.ExtensionConfigList{}
+	if err := reader.List(ctx, &extensionConfigList); err != nil {
+		return errors.Wrapf(err, "failed to list ExtensionConfigs")
+	}
+
+	discoveredExtensionConfigs := runtimev1.ExtensionConfigList{}
+	for _, extensionConfig := range extensionConfigList.Items {
+		if conditions.IsTrue(&extensionConfig, runtimev1.ExtensionConfigDiscoveredCondition) {
+			discoveredExtensionConfigs.Items = append(discoveredExtensionConfigs.Items, extensionConfig)
+		}
+	}
+
+	if err := runtimeClient.WarmUp(&discoveredExtensionConfigs); err != nil {
+		return err
+	}
+
+	log.Info("The extension registry is warmed up")
+
+	return nil
+}
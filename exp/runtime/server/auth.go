@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// bearerTokenAuthenticator verifies that requests have an Authorization header with the token stored in a file.
+// The file is read again when it changes, so tokens can be rotated without restarting the server,
+// e.g. when the file is mounted from a Secret.
+type bearerTokenAuthenticator struct {
+	tokenFile string
+
+	lock    sync.Mutex
+	modTime time.Time
+	size    int64
+	token   []byte
+}
+
+func newBearerTokenAuthenticator(tokenFile string) (*bearerTokenAuthenticator, error) {
+	a := &bearerTokenAuthenticator{tokenFile: tokenFile}
+	if _, err := a.getToken(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// getToken returns the token, reading the token file if it changed since it was last read.
+func (a *bearerTokenAuthenticator) getToken() ([]byte, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	info, err := os.Stat(a.tokenFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read bearer token file %q", a.tokenFile)
+	}
+	if a.token != nil && info.ModTime().Equal(a.modTime) && info.Size() == a.size {
+		return a.token, nil
+	}
+
+	data, err := os.ReadFile(a.tokenFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read bearer token file %q", a.tokenFile)
+	}
+	token := bytes.TrimSpace(data)
+	if len(token) == 0 {
+		return nil, errors.Errorf("bearer token file %q is empty", a.tokenFile)
+	}
+	a.token = token
+	a.modTime = info.ModTime()
+	a.size = info.Size()
+	return a.token, nil
+}
+
+// authenticate wraps a handler so it is only called for requests with a valid bearer token.
+func (a *bearerTokenAuthenticator) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, err := a.getToken()
+		if err != nil {
+			log.Log.Error(err, "Failed to authenticate request")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		requestToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(requestToken), token) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestBearerTokenAuthenticator(t *testing.T) {
+	g := NewWithT(t)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	g.Expect(os.WriteFile(tokenFile, []byte("secret-token\n"), 0600)).To(Succeed())
+
+	authenticator, err := newBearerTokenAuthenticator(tokenFile)
+	g.Expect(err).ToNot(HaveOccurred())
+	handler := authenticator.authenticate(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(authorization string) int {
+		request := httptest.NewRequest(http.MethodPost, "/hooks.runtime.cluster.x-k8s.io/v1alpha1/discovery", http.NoBody)
+		if authorization != "" {
+			request.Header.Set("Authorization", authorization)
+		}
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+
+	g.Expect(serve("Bearer secret-token")).To(Equal(http.StatusOK))
+	g.Expect(serve("")).To(Equal(http.StatusUnauthorized))
+	g.Expect(serve("Bearer other-token")).To(Equal(http.StatusUnauthorized))
+	g.Expect(serve("Basic secret-token")).To(Equal(http.StatusUnauthorized))
+
+	// The token file is read again when it changes.
+	g.Expect(os.WriteFile(tokenFile, []byte("rotated-token"), 0600)).To(Succeed())
+	g.Expect(os.Chtimes(tokenFile, time.Now(), time.Now().Add(time.Minute))).To(Succeed())
+	g.Expect(serve("Bearer secret-token")).To(Equal(http.StatusUnauthorized))
+	g.Expect(serve("Bearer rotated-token")).To(Equal(http.StatusOK))
+
+	// Requests are rejected if the token file cannot be read.
+	g.Expect(os.Remove(tokenFile)).To(Succeed())
+	g.Expect(serve("Bearer rotated-token")).To(Equal(http.StatusInternalServerError))
+
+	_, err = newBearerTokenAuthenticator(tokenFile)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -46,6 +46,8 @@ type Server struct {
 	webhook.Server
 	catalog  *runtimecatalog.Catalog
 	handlers map[string]ExtensionHandler
+	// tokenAuthenticator verifies the bearer token of requests, if configured.
+	tokenAuthenticator *bearerTokenAuthenticator
 }
 
 // Options are the options for the Server.
@@ -80,6 +82,19 @@ type Options struct {
 	// Note: This option is only used when TLSOpts does not set GetCertificate.
 	KeyName string
 
+	// ClientCAName is the name of the CA certificate in CertDir which is used to verify the client certificate
+	// of callers, i.e. to require mutual TLS. It should match the CA of the client certificate configured
+	// in clientConfig.clientCertificate of the ExtensionConfig.
+	// Defaults to "", which means client certificates are not verified.
+	// It is used to set webhook.Server.ClientCAName.
+	ClientCAName string
+
+	// BearerTokenFile is the path of a file holding the token callers must send in the Authorization header.
+	// It should match the token configured in clientConfig.bearerToken of the ExtensionConfig.
+	// The file is read again when it changes, so the token can be rotated by updating the Secret it is mounted from.
+	// Defaults to "", which means callers are not required to send a token.
+	BearerTokenFile string
+
 	// TLSOpts is used to allow configuring the TLS config used for the server.
 	// This also allows providing a certificate via GetCertificate.
 	TLSOpts []func(*tls.Config)
@@ -105,20 +120,31 @@ func New(options Options) (*Server, error) {
 
 	webhookServer := webhook.NewServer(
 		webhook.Options{
-			Port:       options.Port,
-			Host:       options.Host,
-			CertDir:    options.CertDir,
-			CertName:   options.CertName,
-			KeyName:    options.KeyName,
-			TLSOpts:    options.TLSOpts,
-			WebhookMux: http.NewServeMux(),
+			Port:         options.Port,
+			Host:         options.Host,
+			CertDir:      options.CertDir,
+			CertName:     options.CertName,
+			KeyName:      options.KeyName,
+			ClientCAName: options.ClientCAName,
+			TLSOpts:      options.TLSOpts,
+			WebhookMux:   http.NewServeMux(),
 		},
 	)
 
+	var tokenAuthenticator *bearerTokenAuthenticator
+	if options.BearerTokenFile != "" {
+		var err error
+		tokenAuthenticator, err = newBearerTokenAuthenticator(options.BearerTokenFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Server{
-		Server:   webhookServer,
-		catalog:  options.Catalog,
-		handlers: map[string]ExtensionHandler{},
+		Server:             webhookServer,
+		catalog:            options.Catalog,
+		handlers:           map[string]ExtensionHandler{},
+		tokenAuthenticator: tokenAuthenticator,
 	}, nil
 }
 
@@ -249,8 +275,11 @@ func (s *Server) Start(ctx context.Context) error {
 	for handlerPath, h := range s.handlers {
 		handler := h
 
-		wrappedHandler := s.wrapHandler(handler)
-		s.Register(handlerPath, http.HandlerFunc(wrappedHandler))
+		var wrappedHandler http.Handler = http.HandlerFunc(s.wrapHandler(handler))
+		if s.tokenAuthenticator != nil {
+			wrappedHandler = s.tokenAuthenticator.authenticate(wrappedHandler)
+		}
+		s.Register(handlerPath, wrappedHandler)
 	}
 
 	return s.Server.Start(ctx)
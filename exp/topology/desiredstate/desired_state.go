@@ -59,13 +59,39 @@ type Generator interface {
 	Generate(ctx context.Context, s *scope.Scope) (*scope.ClusterState, error)
 }
 
+// GeneratorOption is a configuration option supplied to NewGenerator.
+type GeneratorOption func(*generatorOptions)
+
+type generatorOptions struct {
+	externalPatchesEnabled bool
+}
+
+// WithExternalPatchesEnabled allows the generator to call external patches using the given runtime client
+// even if the RuntimeSDK feature gate is disabled, e.g. when computing the desired state outside of the
+// topology controller. Lifecycle hooks are still called only if the RuntimeSDK feature gate is enabled.
+func WithExternalPatchesEnabled() GeneratorOption {
+	return func(o *generatorOptions) {
+		o.externalPatchesEnabled = true
+	}
+}
+
 // NewGenerator creates a new generator to generate desired state.
-func NewGenerator(client client.Client, clusterCache clustercache.ClusterCache, runtimeClient runtimeclient.Client) Generator {
+func NewGenerator(client client.Client, clusterCache clustercache.ClusterCache, runtimeClient runtimeclient.Client, options ...GeneratorOption) Generator {
+	o := &generatorOptions{}
+	for _, option := range options {
+		option(o)
+	}
+
+	var patchEngineOptions []patches.EngineOption
+	if o.externalPatchesEnabled {
+		patchEngineOptions = append(patchEngineOptions, patches.WithExternalPatchesEnabled())
+	}
+
 	return &generator{
 		Client:        client,
 		ClusterCache:  clusterCache,
 		RuntimeClient: runtimeClient,
-		patchEngine:   patches.NewEngine(runtimeClient),
+		patchEngine:   patches.NewEngine(runtimeClient, patchEngineOptions...),
 	}
 }
 
@@ -1449,13 +1475,16 @@ func computeMachineHealthCheck(ctx context.Context, healthCheckTarget client.Obj
 			},
 		},
 		Spec: clusterv1.MachineHealthCheckSpec{
-			ClusterName:               cluster.Name,
-			Selector:                  *selector,
-			UnhealthyNodeConditions:   check.UnhealthyNodeConditions,
-			MaxUnhealthy:              check.MaxUnhealthy,
-			UnhealthyRange:            check.UnhealthyRange,
-			NodeStartupTimeoutSeconds: check.NodeStartupTimeoutSeconds,
-			RemediationTemplate:       check.RemediationTemplate,
+			ClusterName:                cluster.Name,
+			Selector:                   *selector,
+			UnhealthyNodeConditions:    check.UnhealthyNodeConditions,
+			UnhealthyMachineConditions: check.UnhealthyMachineConditions,
+			UnhealthyNodeTaints:        check.UnhealthyNodeTaints,
+			MaxUnhealthy:               check.MaxUnhealthy,
+			UnhealthyRange:             check.UnhealthyRange,
+			NodeStartupTimeoutSeconds:  check.NodeStartupTimeoutSeconds,
+			RemediationTemplate:        check.RemediationTemplate,
+			RemediationBudget:          check.RemediationBudget,
 		},
 	}
 
@@ -77,6 +77,12 @@ const (
 	//
 	// alpha: v1.10
 	PriorityQueue featuregate.Feature = "PriorityQueue"
+
+	// InPlaceUpdates is a feature gate for the in-place update of control plane Machines via Runtime Extensions.
+	// NOTE: This feature gate requires the RuntimeSDK feature gate to be enabled.
+	//
+	// alpha: v1.11
+	InPlaceUpdates featuregate.Feature = "InPlaceUpdates"
 )
 
 func init() {
@@ -95,4 +101,5 @@ var defaultClusterAPIFeatureGates = map[featuregate.Feature]featuregate.FeatureS
 	ClusterTopology:                {Default: false, PreRelease: featuregate.Alpha},
 	KubeadmBootstrapFormatIgnition: {Default: false, PreRelease: featuregate.Alpha},
 	RuntimeSDK:                     {Default: false, PreRelease: featuregate.Alpha},
+	InPlaceUpdates:                 {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -51,6 +51,8 @@ func (src *ClusterResourceSet) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Status.Conditions = restored.Status.Conditions
+	restoreResourceRefs(restored.Spec.Resources, dst.Spec.Resources)
+	dst.Spec.DependsOn = restored.Spec.DependsOn
 
 	return nil
 }
@@ -91,6 +93,8 @@ func (src *ClusterResourceSetBinding) ConvertTo(dstRaw conversion.Hub) error {
 		return err
 	}
 	dst.Spec.ClusterName = restored.Spec.ClusterName
+	restoreResourceSetBindings(restored.Spec.Bindings, dst.Spec.Bindings)
+	dst.Status = restored.Status
 	return nil
 }
 
@@ -120,6 +124,84 @@ func Convert_v1beta2_ClusterResourceSetStatus_To_v1alpha4_ClusterResourceSetStat
 	return autoConvert_v1beta2_ClusterResourceSetStatus_To_v1alpha4_ClusterResourceSetStatus(in, out, s)
 }
 
+// restoreResourceRefs restores the fields of the ResourceRefs which do not exist in v1alpha4.
+func restoreResourceRefs(restored, dst []addonsv1.ResourceRef) {
+	for i := range dst {
+		if i >= len(restored) || restored[i].Name != dst[i].Name || restored[i].Kind != dst[i].Kind {
+			continue
+		}
+		dst[i].HelmChart = restored[i].HelmChart
+		dst[i].Kustomization = restored[i].Kustomization
+		dst[i].VariableSubstitution = restored[i].VariableSubstitution
+		dst[i].ReadinessChecks = restored[i].ReadinessChecks
+	}
+}
+
+// restoreResourceSetBindings restores the fields of the ResourceBindings in ResourceSetBindings which do not exist in v1alpha4.
+func restoreResourceSetBindings(restored, dst []*addonsv1.ResourceSetBinding) {
+	for i := range dst {
+		if i >= len(restored) || restored[i] == nil || dst[i] == nil || restored[i].ClusterResourceSetName != dst[i].ClusterResourceSetName {
+			continue
+		}
+		for j := range dst[i].Resources {
+			if j >= len(restored[i].Resources) || restored[i].Resources[j].Name != dst[i].Resources[j].Name || restored[i].Resources[j].Kind != dst[i].Resources[j].Kind {
+				continue
+			}
+			dst[i].Resources[j].HelmChart = restored[i].Resources[j].HelmChart
+			dst[i].Resources[j].Kustomization = restored[i].Resources[j].Kustomization
+			dst[i].Resources[j].VariableSubstitution = restored[i].Resources[j].VariableSubstitution
+			dst[i].Resources[j].ReadinessChecks = restored[i].Resources[j].ReadinessChecks
+			dst[i].Resources[j].Ready = restored[i].Resources[j].Ready
+			dst[i].Resources[j].AppliedObjects = restored[i].Resources[j].AppliedObjects
+			dst[i].Resources[j].DriftedObjects = restored[i].Resources[j].DriftedObjects
+		}
+	}
+}
+
+// Convert_Pointer_v1alpha4_ResourceSetBinding_To_Pointer_v1beta2_ResourceSetBinding is a conversion function.
+func Convert_Pointer_v1alpha4_ResourceSetBinding_To_Pointer_v1beta2_ResourceSetBinding(in **ResourceSetBinding, out **addonsv1.ResourceSetBinding, s apimachineryconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	*out = &addonsv1.ResourceSetBinding{}
+	return Convert_v1alpha4_ResourceSetBinding_To_v1beta2_ResourceSetBinding(*in, *out, s)
+}
+
+// Convert_Pointer_v1beta2_ResourceSetBinding_To_Pointer_v1alpha4_ResourceSetBinding is a conversion function.
+func Convert_Pointer_v1beta2_ResourceSetBinding_To_Pointer_v1alpha4_ResourceSetBinding(in **addonsv1.ResourceSetBinding, out **ResourceSetBinding, s apimachineryconversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	*out = &ResourceSetBinding{}
+	return Convert_v1beta2_ResourceSetBinding_To_v1alpha4_ResourceSetBinding(*in, *out, s)
+}
+
+// Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec is a conversion function.
+func Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in *addonsv1.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s apimachineryconversion.Scope) error {
+	// .DependsOn was added in v1beta2.
+	return autoConvert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in, out, s)
+}
+
+// Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding is a conversion function.
+func Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(in *addonsv1.ClusterResourceSetBinding, out *ClusterResourceSetBinding, s apimachineryconversion.Scope) error {
+	// .Status was added in v1beta2.
+	return autoConvert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(in, out, s)
+}
+
+// Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding is a conversion function.
+func Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(in *addonsv1.ResourceBinding, out *ResourceBinding, s apimachineryconversion.Scope) error {
+	// .AppliedObjects, .DriftedObjects and .Ready were added in v1beta2.
+	return autoConvert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(in, out, s)
+}
+
+// Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef is a conversion function.
+func Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(in *addonsv1.ResourceRef, out *ResourceRef, s apimachineryconversion.Scope) error {
+	// .HelmChart, .Kustomization, .VariableSubstitution and .ReadinessChecks were added in v1beta2.
+	return autoConvert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(in, out, s)
+}
+
 // Implement local conversion func because conversion-gen is not aware of conversion func in other packages (see https://github.com/kubernetes/code-generator/issues/94)
 
 func Convert_v1_Condition_To_v1alpha4_Condition(in *metav1.Condition, out *clusterv1alpha4.Condition, s apimachineryconversion.Scope) error {
@@ -53,11 +53,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterResourceSetBinding)(nil), (*ClusterResourceSetBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(a.(*v1beta2.ClusterResourceSetBinding), b.(*ClusterResourceSetBinding), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ClusterResourceSetBindingList)(nil), (*v1beta2.ClusterResourceSetBindingList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha4_ClusterResourceSetBindingList_To_v1beta2_ClusterResourceSetBindingList(a.(*ClusterResourceSetBindingList), b.(*v1beta2.ClusterResourceSetBindingList), scope)
 	}); err != nil {
@@ -88,11 +83,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ClusterResourceSetSpec)(nil), (*ClusterResourceSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(a.(*v1beta2.ClusterResourceSetSpec), b.(*ClusterResourceSetSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ClusterResourceSetStatus)(nil), (*v1beta2.ClusterResourceSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha4_ClusterResourceSetStatus_To_v1beta2_ClusterResourceSetStatus(a.(*ClusterResourceSetStatus), b.(*v1beta2.ClusterResourceSetStatus), scope)
 	}); err != nil {
@@ -103,21 +93,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ResourceBinding)(nil), (*ResourceBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(a.(*v1beta2.ResourceBinding), b.(*ResourceBinding), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceRef)(nil), (*v1beta2.ResourceRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha4_ResourceRef_To_v1beta2_ResourceRef(a.(*ResourceRef), b.(*v1beta2.ResourceRef), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ResourceRef)(nil), (*ResourceRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(a.(*v1beta2.ResourceRef), b.(*ResourceRef), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceSetBinding)(nil), (*v1beta2.ResourceSetBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha4_ResourceSetBinding_To_v1beta2_ResourceSetBinding(a.(*ResourceSetBinding), b.(*v1beta2.ResourceSetBinding), scope)
 	}); err != nil {
@@ -128,6 +108,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((**ResourceSetBinding)(nil), (**v1beta2.ResourceSetBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1alpha4_ResourceSetBinding_To_Pointer_v1beta2_ResourceSetBinding(a.(**ResourceSetBinding), b.(**v1beta2.ResourceSetBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**v1beta2.ResourceSetBinding)(nil), (**ResourceSetBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_v1beta2_ResourceSetBinding_To_Pointer_v1alpha4_ResourceSetBinding(a.(**v1beta2.ResourceSetBinding), b.(**ResourceSetBinding), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1.Condition)(nil), (*corev1alpha4.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Condition_To_v1alpha4_Condition(a.(*v1.Condition), b.(*corev1alpha4.Condition), scope)
 	}); err != nil {
@@ -143,11 +133,31 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ClusterResourceSetBinding)(nil), (*ClusterResourceSetBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(a.(*v1beta2.ClusterResourceSetBinding), b.(*ClusterResourceSetBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.ClusterResourceSetSpec)(nil), (*ClusterResourceSetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(a.(*v1beta2.ClusterResourceSetSpec), b.(*ClusterResourceSetSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ClusterResourceSetStatus)(nil), (*ClusterResourceSetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ClusterResourceSetStatus_To_v1alpha4_ClusterResourceSetStatus(a.(*v1beta2.ClusterResourceSetStatus), b.(*ClusterResourceSetStatus), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ResourceBinding)(nil), (*ResourceBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(a.(*v1beta2.ResourceBinding), b.(*ResourceBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.ResourceRef)(nil), (*ResourceRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(a.(*v1beta2.ResourceRef), b.(*ResourceRef), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -201,14 +211,10 @@ func autoConvert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSe
 	if err := Convert_v1beta2_ClusterResourceSetBindingSpec_To_v1alpha4_ClusterResourceSetBindingSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	// WARNING: in.Status requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding is an autogenerated conversion function.
-func Convert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(in *v1beta2.ClusterResourceSetBinding, out *ClusterResourceSetBinding, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClusterResourceSetBinding_To_v1alpha4_ClusterResourceSetBinding(in, out, s)
-}
-
 func autoConvert_v1alpha4_ClusterResourceSetBindingList_To_v1beta2_ClusterResourceSetBindingList(in *ClusterResourceSetBindingList, out *v1beta2.ClusterResourceSetBindingList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -252,7 +258,17 @@ func Convert_v1beta2_ClusterResourceSetBindingList_To_v1alpha4_ClusterResourceSe
 }
 
 func autoConvert_v1alpha4_ClusterResourceSetBindingSpec_To_v1beta2_ClusterResourceSetBindingSpec(in *ClusterResourceSetBindingSpec, out *v1beta2.ClusterResourceSetBindingSpec, s conversion.Scope) error {
-	out.Bindings = *(*[]*v1beta2.ResourceSetBinding)(unsafe.Pointer(&in.Bindings))
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]*v1beta2.ResourceSetBinding, len(*in))
+		for i := range *in {
+			if err := Convert_Pointer_v1alpha4_ResourceSetBinding_To_Pointer_v1beta2_ResourceSetBinding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Bindings = nil
+	}
 	return nil
 }
 
@@ -262,7 +278,17 @@ func Convert_v1alpha4_ClusterResourceSetBindingSpec_To_v1beta2_ClusterResourceSe
 }
 
 func autoConvert_v1beta2_ClusterResourceSetBindingSpec_To_v1alpha4_ClusterResourceSetBindingSpec(in *v1beta2.ClusterResourceSetBindingSpec, out *ClusterResourceSetBindingSpec, s conversion.Scope) error {
-	out.Bindings = *(*[]*ResourceSetBinding)(unsafe.Pointer(&in.Bindings))
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]*ResourceSetBinding, len(*in))
+		for i := range *in {
+			if err := Convert_Pointer_v1beta2_ResourceSetBinding_To_Pointer_v1alpha4_ResourceSetBinding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Bindings = nil
+	}
 	// WARNING: in.ClusterName requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -311,7 +337,17 @@ func Convert_v1beta2_ClusterResourceSetList_To_v1alpha4_ClusterResourceSetList(i
 
 func autoConvert_v1alpha4_ClusterResourceSetSpec_To_v1beta2_ClusterResourceSetSpec(in *ClusterResourceSetSpec, out *v1beta2.ClusterResourceSetSpec, s conversion.Scope) error {
 	out.ClusterSelector = in.ClusterSelector
-	out.Resources = *(*[]v1beta2.ResourceRef)(unsafe.Pointer(&in.Resources))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]v1beta2.ResourceRef, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha4_ResourceRef_To_v1beta2_ResourceRef(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
 	out.Strategy = in.Strategy
 	return nil
 }
@@ -323,16 +359,22 @@ func Convert_v1alpha4_ClusterResourceSetSpec_To_v1beta2_ClusterResourceSetSpec(i
 
 func autoConvert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in *v1beta2.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s conversion.Scope) error {
 	out.ClusterSelector = in.ClusterSelector
-	out.Resources = *(*[]ResourceRef)(unsafe.Pointer(&in.Resources))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceRef, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
 	out.Strategy = in.Strategy
+	// WARNING: in.DependsOn requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec is an autogenerated conversion function.
-func Convert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in *v1beta2.ClusterResourceSetSpec, out *ClusterResourceSetSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_ClusterResourceSetSpec_To_v1alpha4_ClusterResourceSetSpec(in, out, s)
-}
-
 func autoConvert_v1alpha4_ClusterResourceSetStatus_To_v1beta2_ClusterResourceSetStatus(in *ClusterResourceSetStatus, out *v1beta2.ClusterResourceSetStatus, s conversion.Scope) error {
 	out.ObservedGeneration = in.ObservedGeneration
 	if in.Conditions != nil {
@@ -393,14 +435,12 @@ func autoConvert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(in *v1beta2
 	out.Hash = in.Hash
 	out.LastAppliedTime = (*v1.Time)(unsafe.Pointer(in.LastAppliedTime))
 	out.Applied = in.Applied
+	// WARNING: in.Ready requires manual conversion: does not exist in peer-type
+	// WARNING: in.AppliedObjects requires manual conversion: does not exist in peer-type
+	// WARNING: in.DriftedObjects requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding is an autogenerated conversion function.
-func Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(in *v1beta2.ResourceBinding, out *ResourceBinding, s conversion.Scope) error {
-	return autoConvert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(in, out, s)
-}
-
 func autoConvert_v1alpha4_ResourceRef_To_v1beta2_ResourceRef(in *ResourceRef, out *v1beta2.ResourceRef, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Kind = in.Kind
@@ -415,17 +455,26 @@ func Convert_v1alpha4_ResourceRef_To_v1beta2_ResourceRef(in *ResourceRef, out *v
 func autoConvert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(in *v1beta2.ResourceRef, out *ResourceRef, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Kind = in.Kind
+	// WARNING: in.HelmChart requires manual conversion: does not exist in peer-type
+	// WARNING: in.Kustomization requires manual conversion: does not exist in peer-type
+	// WARNING: in.VariableSubstitution requires manual conversion: does not exist in peer-type
+	// WARNING: in.ReadinessChecks requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef is an autogenerated conversion function.
-func Convert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(in *v1beta2.ResourceRef, out *ResourceRef, s conversion.Scope) error {
-	return autoConvert_v1beta2_ResourceRef_To_v1alpha4_ResourceRef(in, out, s)
-}
-
 func autoConvert_v1alpha4_ResourceSetBinding_To_v1beta2_ResourceSetBinding(in *ResourceSetBinding, out *v1beta2.ResourceSetBinding, s conversion.Scope) error {
 	out.ClusterResourceSetName = in.ClusterResourceSetName
-	out.Resources = *(*[]v1beta2.ResourceBinding)(unsafe.Pointer(&in.Resources))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]v1beta2.ResourceBinding, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha4_ResourceBinding_To_v1beta2_ResourceBinding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
 	return nil
 }
 
@@ -436,7 +485,17 @@ func Convert_v1alpha4_ResourceSetBinding_To_v1beta2_ResourceSetBinding(in *Resou
 
 func autoConvert_v1beta2_ResourceSetBinding_To_v1alpha4_ResourceSetBinding(in *v1beta2.ResourceSetBinding, out *ResourceSetBinding, s conversion.Scope) error {
 	out.ClusterResourceSetName = in.ClusterResourceSetName
-	out.Resources = *(*[]ResourceBinding)(unsafe.Pointer(&in.Resources))
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceBinding, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_ResourceBinding_To_v1alpha4_ResourceBinding(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Resources = nil
+	}
 	return nil
 }
 
@@ -83,6 +83,13 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.MachineNamingStrategy != nil {
 			dst.Spec.MachineNamingStrategy = restored.Spec.MachineNamingStrategy
 		}
+		dst.Spec.EtcdSnapshot = restored.Spec.EtcdSnapshot
+		dst.Spec.EtcdDefragmentation = restored.Spec.EtcdDefragmentation
+		if restored.Spec.RolloutStrategy != nil && restored.Spec.RolloutStrategy.RollingUpdate != nil &&
+			dst.Spec.RolloutStrategy != nil && dst.Spec.RolloutStrategy.RollingUpdate != nil {
+			dst.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = restored.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable
+		}
+		dst.Status.EtcdSnapshot = restored.Status.EtcdSnapshot
 
 		bootstrapv1alpha3.RestoreKubeadmConfigSpec(&dst.Spec.KubeadmConfigSpec, &restored.Spec.KubeadmConfigSpec)
 
@@ -173,6 +180,11 @@ func Convert_v1alpha3_Condition_To_v1_Condition(in *clusterv1alpha3.Condition, o
 	return clusterv1alpha3.Convert_v1alpha3_Condition_To_v1_Condition(in, out, s)
 }
 
+func Convert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate(in *controlplanev1.RollingUpdate, out *RollingUpdate, s apimachineryconversion.Scope) error {
+	// .MaxUnavailable was added in v1beta2.
+	return autoConvert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate(in, out, s)
+}
+
 func Convert_v1beta2_KubeadmConfigSpec_To_v1alpha3_KubeadmConfigSpec(in *bootstrapv1.KubeadmConfigSpec, out *bootstrapv1alpha3.KubeadmConfigSpec, s apimachineryconversion.Scope) error {
 	return bootstrapv1alpha3.Convert_v1beta2_KubeadmConfigSpec_To_v1alpha3_KubeadmConfigSpec(in, out, s)
 }
@@ -207,7 +207,15 @@ func autoConvert_v1alpha3_KubeadmControlPlaneSpec_To_v1beta2_KubeadmControlPlane
 	}
 	// WARNING: in.UpgradeAfter requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodeDrainTimeout requires manual conversion: does not exist in peer-type
-	out.RolloutStrategy = (*v1beta2.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(v1beta2.RolloutStrategy)
+		if err := Convert_v1alpha3_RolloutStrategy_To_v1beta2_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	return nil
 }
 
@@ -220,9 +228,19 @@ func autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1alpha3_KubeadmControlPlane
 	}
 	// WARNING: in.RolloutBefore requires manual conversion: does not exist in peer-type
 	// WARNING: in.RolloutAfter requires manual conversion: does not exist in peer-type
-	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		if err := Convert_v1beta2_RolloutStrategy_To_v1alpha3_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	// WARNING: in.RemediationStrategy requires manual conversion: does not exist in peer-type
 	// WARNING: in.MachineNamingStrategy requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdDefragmentation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -280,6 +298,7 @@ func autoConvert_v1beta2_KubeadmControlPlaneStatus_To_v1alpha3_KubeadmControlPla
 	// WARNING: in.Version requires manual conversion: does not exist in peer-type
 	out.ObservedGeneration = in.ObservedGeneration
 	// WARNING: in.LastRemediation requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -296,17 +315,21 @@ func Convert_v1alpha3_RollingUpdate_To_v1beta2_RollingUpdate(in *RollingUpdate,
 
 func autoConvert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate(in *v1beta2.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	// WARNING: in.MaxUnavailable requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate is an autogenerated conversion function.
-func Convert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate(in *v1beta2.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
-	return autoConvert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate(in, out, s)
-}
-
 func autoConvert_v1alpha3_RolloutStrategy_To_v1beta2_RolloutStrategy(in *RolloutStrategy, out *v1beta2.RolloutStrategy, s conversion.Scope) error {
 	out.Type = v1beta2.RolloutStrategyType(in.Type)
-	out.RollingUpdate = (*v1beta2.RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(v1beta2.RollingUpdate)
+		if err := Convert_v1alpha3_RollingUpdate_To_v1beta2_RollingUpdate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -317,7 +340,15 @@ func Convert_v1alpha3_RolloutStrategy_To_v1beta2_RolloutStrategy(in *RolloutStra
 
 func autoConvert_v1beta2_RolloutStrategy_To_v1alpha3_RolloutStrategy(in *v1beta2.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
 	out.Type = RolloutStrategyType(in.Type)
-	out.RollingUpdate = (*RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdate)
+		if err := Convert_v1beta2_RollingUpdate_To_v1alpha3_RollingUpdate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -82,6 +82,13 @@ func (src *KubeadmControlPlane) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.MachineNamingStrategy != nil {
 			dst.Spec.MachineNamingStrategy = restored.Spec.MachineNamingStrategy
 		}
+		dst.Spec.EtcdSnapshot = restored.Spec.EtcdSnapshot
+		dst.Spec.EtcdDefragmentation = restored.Spec.EtcdDefragmentation
+		if restored.Spec.RolloutStrategy != nil && restored.Spec.RolloutStrategy.RollingUpdate != nil &&
+			dst.Spec.RolloutStrategy != nil && dst.Spec.RolloutStrategy.RollingUpdate != nil {
+			dst.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = restored.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable
+		}
+		dst.Status.EtcdSnapshot = restored.Status.EtcdSnapshot
 
 		bootstrapv1alpha4.RestoreKubeadmConfigSpec(&dst.Spec.KubeadmConfigSpec, &restored.Spec.KubeadmConfigSpec)
 		dst.Status.Conditions = restored.Status.Conditions
@@ -173,6 +180,12 @@ func (src *KubeadmControlPlaneTemplate) ConvertTo(dstRaw conversion.Hub) error {
 		if restored.Spec.Template.Spec.MachineNamingStrategy != nil {
 			dst.Spec.Template.Spec.MachineNamingStrategy = restored.Spec.Template.Spec.MachineNamingStrategy
 		}
+		dst.Spec.Template.Spec.EtcdSnapshot = restored.Spec.Template.Spec.EtcdSnapshot
+		dst.Spec.Template.Spec.EtcdDefragmentation = restored.Spec.Template.Spec.EtcdDefragmentation
+		if restored.Spec.Template.Spec.RolloutStrategy != nil && restored.Spec.Template.Spec.RolloutStrategy.RollingUpdate != nil &&
+			dst.Spec.Template.Spec.RolloutStrategy != nil && dst.Spec.Template.Spec.RolloutStrategy.RollingUpdate != nil {
+			dst.Spec.Template.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable = restored.Spec.Template.Spec.RolloutStrategy.RollingUpdate.MaxUnavailable
+		}
 
 		bootstrapv1alpha4.RestoreKubeadmConfigSpec(&dst.Spec.Template.Spec.KubeadmConfigSpec, &restored.Spec.Template.Spec.KubeadmConfigSpec)
 	}
@@ -266,6 +279,11 @@ func Convert_v1beta2_KubeadmControlPlaneSpec_To_v1alpha4_KubeadmControlPlaneSpec
 	return autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1alpha4_KubeadmControlPlaneSpec(in, out, scope)
 }
 
+func Convert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate(in *controlplanev1.RollingUpdate, out *RollingUpdate, s apimachineryconversion.Scope) error {
+	// .MaxUnavailable was added in v1beta2.
+	return autoConvert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate(in, out, s)
+}
+
 func Convert_v1beta2_KubeadmControlPlaneStatus_To_v1alpha4_KubeadmControlPlaneStatus(in *controlplanev1.KubeadmControlPlaneStatus, out *KubeadmControlPlaneStatus, scope apimachineryconversion.Scope) error {
 	// .LastRemediation was added in v1beta1.
 	// .V1Beta2 was added in v1beta1.
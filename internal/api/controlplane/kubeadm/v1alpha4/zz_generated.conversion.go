@@ -300,7 +300,15 @@ func autoConvert_v1alpha4_KubeadmControlPlaneSpec_To_v1beta2_KubeadmControlPlane
 		return err
 	}
 	out.RolloutAfter = (*v1.Time)(unsafe.Pointer(in.RolloutAfter))
-	out.RolloutStrategy = (*v1beta2.RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(v1beta2.RolloutStrategy)
+		if err := Convert_v1alpha4_RolloutStrategy_To_v1beta2_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	return nil
 }
 
@@ -320,9 +328,19 @@ func autoConvert_v1beta2_KubeadmControlPlaneSpec_To_v1alpha4_KubeadmControlPlane
 	}
 	// WARNING: in.RolloutBefore requires manual conversion: does not exist in peer-type
 	out.RolloutAfter = (*v1.Time)(unsafe.Pointer(in.RolloutAfter))
-	out.RolloutStrategy = (*RolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		if err := Convert_v1beta2_RolloutStrategy_To_v1alpha4_RolloutStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RolloutStrategy = nil
+	}
 	// WARNING: in.RemediationStrategy requires manual conversion: does not exist in peer-type
 	// WARNING: in.MachineNamingStrategy requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdDefragmentation requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -381,6 +399,7 @@ func autoConvert_v1beta2_KubeadmControlPlaneStatus_To_v1alpha4_KubeadmControlPla
 	out.Version = (*string)(unsafe.Pointer(in.Version))
 	out.ObservedGeneration = in.ObservedGeneration
 	// WARNING: in.LastRemediation requires manual conversion: does not exist in peer-type
+	// WARNING: in.EtcdSnapshot requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -509,17 +528,21 @@ func Convert_v1alpha4_RollingUpdate_To_v1beta2_RollingUpdate(in *RollingUpdate,
 
 func autoConvert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate(in *v1beta2.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	// WARNING: in.MaxUnavailable requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate is an autogenerated conversion function.
-func Convert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate(in *v1beta2.RollingUpdate, out *RollingUpdate, s conversion.Scope) error {
-	return autoConvert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate(in, out, s)
-}
-
 func autoConvert_v1alpha4_RolloutStrategy_To_v1beta2_RolloutStrategy(in *RolloutStrategy, out *v1beta2.RolloutStrategy, s conversion.Scope) error {
 	out.Type = v1beta2.RolloutStrategyType(in.Type)
-	out.RollingUpdate = (*v1beta2.RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(v1beta2.RollingUpdate)
+		if err := Convert_v1alpha4_RollingUpdate_To_v1beta2_RollingUpdate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
@@ -530,7 +553,15 @@ func Convert_v1alpha4_RolloutStrategy_To_v1beta2_RolloutStrategy(in *RolloutStra
 
 func autoConvert_v1beta2_RolloutStrategy_To_v1alpha4_RolloutStrategy(in *v1beta2.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
 	out.Type = RolloutStrategyType(in.Type)
-	out.RollingUpdate = (*RollingUpdate)(unsafe.Pointer(in.RollingUpdate))
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdate)
+		if err := Convert_v1beta2_RollingUpdate_To_v1alpha4_RollingUpdate(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RollingUpdate = nil
+	}
 	return nil
 }
 
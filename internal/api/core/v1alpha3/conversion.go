@@ -327,9 +327,12 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 				dst.Spec.Strategy.RollingUpdate = &clusterv1.MachineRollingUpdateDeployment{}
 			}
 			dst.Spec.Strategy.RollingUpdate.DeletePolicy = restored.Spec.Strategy.RollingUpdate.DeletePolicy
+			dst.Spec.Strategy.RollingUpdate.Steps = restored.Spec.Strategy.RollingUpdate.Steps
 		}
 		dst.Spec.Strategy.Remediation = restored.Spec.Strategy.Remediation
+		dst.Spec.Strategy.BlueGreen = restored.Spec.Strategy.BlueGreen
 	}
+	dst.Status.Rollout = restored.Status.Rollout
 
 	if restored.Spec.MachineNamingStrategy != nil {
 		dst.Spec.MachineNamingStrategy = restored.Spec.MachineNamingStrategy
@@ -409,7 +412,11 @@ func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	if restored.Spec.UnhealthyRange != nil {
 		dst.Spec.UnhealthyRange = restored.Spec.UnhealthyRange
 	}
+	dst.Spec.UnhealthyMachineConditions = restored.Spec.UnhealthyMachineConditions
+	dst.Spec.UnhealthyNodeTaints = restored.Spec.UnhealthyNodeTaints
+	dst.Spec.RemediationBudget = restored.Spec.RemediationBudget
 	dst.Status.Conditions = restored.Status.Conditions
+	dst.Status.Remediation = restored.Status.Remediation
 
 	return nil
 }
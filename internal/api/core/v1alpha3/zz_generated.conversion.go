@@ -843,6 +843,7 @@ func autoConvert_v1beta2_MachineDeploymentStatus_To_v1alpha3_MachineDeploymentSt
 	}
 	// WARNING: in.UpToDateReplicas requires manual conversion: does not exist in peer-type
 	out.Phase = in.Phase
+	// WARNING: in.Rollout requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -877,6 +878,7 @@ func autoConvert_v1beta2_MachineDeploymentStrategy_To_v1alpha3_MachineDeployment
 	} else {
 		out.RollingUpdate = nil
 	}
+	// WARNING: in.BlueGreen requires manual conversion: does not exist in peer-type
 	// WARNING: in.Remediation requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -969,10 +971,13 @@ func autoConvert_v1beta2_MachineHealthCheckSpec_To_v1alpha3_MachineHealthCheckSp
 	out.ClusterName = in.ClusterName
 	out.Selector = in.Selector
 	// WARNING: in.UnhealthyNodeConditions requires manual conversion: does not exist in peer-type
+	// WARNING: in.UnhealthyMachineConditions requires manual conversion: does not exist in peer-type
+	// WARNING: in.UnhealthyNodeTaints requires manual conversion: does not exist in peer-type
 	out.MaxUnhealthy = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnhealthy))
 	// WARNING: in.UnhealthyRange requires manual conversion: does not exist in peer-type
 	// WARNING: in.NodeStartupTimeoutSeconds requires manual conversion: does not exist in peer-type
 	out.RemediationTemplate = (*corev1.ObjectReference)(unsafe.Pointer(in.RemediationTemplate))
+	// WARNING: in.RemediationBudget requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1018,6 +1023,7 @@ func autoConvert_v1beta2_MachineHealthCheckStatus_To_v1alpha3_MachineHealthCheck
 	out.RemediationsAllowed = in.RemediationsAllowed
 	out.ObservedGeneration = in.ObservedGeneration
 	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	// WARNING: in.Remediation requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -1234,6 +1240,7 @@ func autoConvert_v1beta2_MachineRollingUpdateDeployment_To_v1alpha3_MachineRolli
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	// WARNING: in.DeletePolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Steps requires manual conversion: does not exist in peer-type
 	return nil
 }
 
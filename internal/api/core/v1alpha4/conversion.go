@@ -424,7 +424,12 @@ func (src *MachineDeployment) ConvertTo(dstRaw conversion.Hub) error {
 			dst.Spec.Strategy = &clusterv1.MachineDeploymentStrategy{}
 		}
 		dst.Spec.Strategy.Remediation = restored.Spec.Strategy.Remediation
+		dst.Spec.Strategy.BlueGreen = restored.Spec.Strategy.BlueGreen
+		if restored.Spec.Strategy.RollingUpdate != nil && dst.Spec.Strategy.RollingUpdate != nil {
+			dst.Spec.Strategy.RollingUpdate.Steps = restored.Spec.Strategy.RollingUpdate.Steps
+		}
 	}
+	dst.Status.Rollout = restored.Status.Rollout
 
 	if restored.Spec.MachineNamingStrategy != nil {
 		dst.Spec.MachineNamingStrategy = restored.Spec.MachineNamingStrategy
@@ -495,7 +500,11 @@ func (src *MachineHealthCheck) ConvertTo(dstRaw conversion.Hub) error {
 	if ok, err := utilconversion.UnmarshalData(src, restored); err != nil || !ok {
 		return err
 	}
+	dst.Spec.UnhealthyMachineConditions = restored.Spec.UnhealthyMachineConditions
+	dst.Spec.UnhealthyNodeTaints = restored.Spec.UnhealthyNodeTaints
+	dst.Spec.RemediationBudget = restored.Spec.RemediationBudget
 	dst.Status.Conditions = restored.Status.Conditions
+	dst.Status.Remediation = restored.Status.Remediation
 
 	return nil
 }
@@ -776,6 +785,10 @@ func Convert_v1beta2_MachineDeploymentStrategy_To_v1alpha4_MachineDeploymentStra
 	return autoConvert_v1beta2_MachineDeploymentStrategy_To_v1alpha4_MachineDeploymentStrategy(in, out, s)
 }
 
+func Convert_v1beta2_MachineRollingUpdateDeployment_To_v1alpha4_MachineRollingUpdateDeployment(in *clusterv1.MachineRollingUpdateDeployment, out *MachineRollingUpdateDeployment, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta2_MachineRollingUpdateDeployment_To_v1alpha4_MachineRollingUpdateDeployment(in, out, s)
+}
+
 func Convert_v1beta2_MachineSetSpec_To_v1alpha4_MachineSetSpec(in *clusterv1.MachineSetSpec, out *MachineSetSpec, s apimachineryconversion.Scope) error {
 	return autoConvert_v1beta2_MachineSetSpec_To_v1alpha4_MachineSetSpec(in, out, s)
 }
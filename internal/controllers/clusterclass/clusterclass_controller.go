@@ -395,6 +395,17 @@ func (r *Reconciler) reconcileVariables(ctx context.Context, s *scope) (ctrl.Res
 	return ctrl.Result{}, nil
 }
 
+// ReconcileVariables sets the variables in the ClusterClass status, including variables discovered from
+// Runtime Extensions, without persisting any change.
+// NOTE: This is used to preview changes to a ClusterClass before applying them, e.g. by clusterctl topology plan.
+func (r *Reconciler) ReconcileVariables(ctx context.Context, clusterClass *clusterv1.ClusterClass) error {
+	if r.discoverVariablesCache == nil {
+		r.discoverVariablesCache = cache.New[runtimeclient.CallExtensionCacheEntry](cache.DefaultTTL)
+	}
+	_, err := r.reconcileVariables(ctx, &scope{clusterClass: clusterClass})
+	return err
+}
+
 func addNewStatusVariable(variable clusterv1.ClusterClassVariable, from string) *clusterv1.ClusterClassStatusVariable {
 	return &clusterv1.ClusterClassStatusVariable{
 		Name:                variable.Name,
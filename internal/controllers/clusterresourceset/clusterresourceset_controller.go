@@ -19,6 +19,8 @@ package clusterresourceset
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -30,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -56,6 +59,22 @@ import (
 // ErrSecretTypeNotSupported signals that a Secret is not supported.
 var ErrSecretTypeNotSupported = errors.New("unsupported secret type")
 
+// resourceNotReadyError signals that applying the resources of a ClusterResourceSet to a Cluster
+// is waiting for the readiness checks of a resource to succeed.
+type resourceNotReadyError struct {
+	message string
+}
+
+func (e *resourceNotReadyError) Error() string {
+	return e.message
+}
+
+// notReadyRequeueAfter is the interval after which a ClusterResourceSet waiting for readiness checks or dependencies is reconciled again.
+const notReadyRequeueAfter = 10 * time.Second
+
+// clusterResourceSetManagerName is the field manager used to apply the objects of "Reconcile" ClusterResourceSets.
+const clusterResourceSetManagerName = "capi-clusterresourceset"
+
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;patch;update
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
@@ -68,6 +87,14 @@ type Reconciler struct {
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
+
+	// DriftCorrectionInterval is the interval at which the objects of "Reconcile" ClusterResourceSets
+	// are re-applied to correct out-of-band changes. Drift correction is disabled if it is 0.
+	DriftCorrectionInterval time.Duration
+
+	// Renderer renders the Helm charts and the kustomizations referenced by ClusterResourceSets.
+	// Resources of these kinds fail to be applied if it is nil.
+	Renderer Renderer
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options, partialSecretCache cache.Cache) error {
@@ -159,6 +186,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 			patch.WithOwnedConditions{Conditions: []string{
 				clusterv1.PausedCondition,
 				addonsv1.ClusterResourceSetResourcesAppliedCondition,
+				addonsv1.ClusterResourceSetDependenciesReadyCondition,
 			}},
 		}
 		if reterr == nil {
@@ -187,13 +215,57 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, r.reconcileDelete(ctx, clusters, clusterResourceSet)
 	}
 
+	// ClusterResourceSets in a dependency cycle would wait for each other forever; surface the cycle instead, and check
+	// again later, because the ClusterResourceSets are not reconciled when the other ClusterResourceSets in the cycle change.
+	dependencyCycle, err := r.getDependencyCycle(ctx, clusterResourceSet)
+	if err != nil {
+		setDependenciesReadyCondition(clusterResourceSet, nil, []error{err})
+		return ctrl.Result{}, err
+	}
+	if len(dependencyCycle) > 0 {
+		message := fmt.Sprintf("ClusterResourceSets form a dependency cycle: %s", strings.Join(dependencyCycle, " -> "))
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetDependenciesReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetDependenciesCycleReason,
+			Message: message,
+		})
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetResourcesWaitingForDependenciesReason,
+			Message: message,
+		})
+		return ctrl.Result{RequeueAfter: notReadyRequeueAfter}, nil
+	}
+
 	errs := []error{}
+	pendingDependencies := []string{}
+	notReady := []string{}
 	for _, cluster := range clusters {
+		// Resources are applied to a Cluster only once the ClusterResourceSets this ClusterResourceSet depends on are ready.
+		notReadyDependencies, err := r.getNotReadyDependencies(ctx, cluster, clusterResourceSet)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(notReadyDependencies) > 0 {
+			pendingDependencies = append(pendingDependencies, fmt.Sprintf("* Cluster %s: %s", cluster.Name, strings.Join(notReadyDependencies, ", ")))
+			continue
+		}
+
 		if err := r.ApplyClusterResourceSet(ctx, cluster, clusterResourceSet); err != nil {
+			notReadyErr := &resourceNotReadyError{}
+			if errors.As(err, &notReadyErr) {
+				notReady = append(notReady, fmt.Sprintf("* Cluster %s: %s", cluster.Name, notReadyErr.message))
+				continue
+			}
 			errs = append(errs, err)
 		}
 	}
 
+	setDependenciesReadyCondition(clusterResourceSet, pendingDependencies, errs)
+
 	// Return an aggregated error if errors occurred.
 	if len(errs) > 0 {
 		// When there are more than one ClusterResourceSet targeting the same cluster,
@@ -215,9 +287,144 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 		return ctrl.Result{}, kerrors.NewAggregate(errs)
 	}
 
+	// Surface the Clusters waiting for dependencies or readiness checks, and check again later;
+	// the condition is set here because ApplyClusterResourceSet only knows about a single Cluster.
+	if len(pendingDependencies) > 0 || len(notReady) > 0 {
+		reason := addonsv1.ClusterResourceSetResourcesWaitingForReadinessReason
+		if len(pendingDependencies) > 0 {
+			reason = addonsv1.ClusterResourceSetResourcesWaitingForDependenciesReason
+		}
+		messages := []string{}
+		if len(pendingDependencies) > 0 {
+			messages = append(messages, "Waiting for ClusterResourceSets to be ready:\n"+strings.Join(pendingDependencies, "\n"))
+		}
+		if len(notReady) > 0 {
+			messages = append(messages, "Waiting for resources to be ready:\n"+strings.Join(notReady, "\n"))
+		}
+		conditions.Set(clusterResourceSet, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetResourcesAppliedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  reason,
+			Message: strings.Join(messages, "\n"),
+		})
+		return ctrl.Result{RequeueAfter: notReadyRequeueAfter}, nil
+	}
+
+	// Requeue "Reconcile" ClusterResourceSets so their objects are periodically re-asserted.
+	if clusterResourceSet.Spec.Strategy == string(addonsv1.ClusterResourceSetStrategyReconcile) && r.DriftCorrectionInterval > 0 {
+		return ctrl.Result{RequeueAfter: r.DriftCorrectionInterval}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// getNotReadyDependencies returns the names of the ClusterResourceSets the ClusterResourceSet depends on
+// which are not ready for the Cluster yet, i.e. not all their resources are applied and passed their readiness checks.
+func (r *Reconciler) getNotReadyDependencies(ctx context.Context, cluster *clusterv1.Cluster, crs *addonsv1.ClusterResourceSet) ([]string, error) {
+	if len(crs.Spec.DependsOn) == 0 {
+		return nil, nil
+	}
+
+	clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name}, clusterResourceSetBinding); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to get ClusterResourceSetBinding for Cluster %s", klog.KObj(cluster))
+		}
+	}
+
+	notReady := []string{}
+	for _, dependency := range crs.Spec.DependsOn {
+		dependencyCRS := &addonsv1.ClusterResourceSet{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Namespace: crs.Namespace, Name: dependency.Name}, dependencyCRS); err != nil {
+			if apierrors.IsNotFound(err) {
+				notReady = append(notReady, dependency.Name)
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to get ClusterResourceSet %s", dependency.Name)
+		}
+
+		resourceSetBinding := clusterResourceSetBinding.GetBinding(dependency.Name)
+		if resourceSetBinding == nil {
+			notReady = append(notReady, dependency.Name)
+			continue
+		}
+		for _, resource := range dependencyCRS.Spec.Resources {
+			if !resourceSetBinding.IsReady(resource) {
+				notReady = append(notReady, dependency.Name)
+				break
+			}
+		}
+	}
+	return notReady, nil
+}
+
+// getDependencyCycle returns the names of the ClusterResourceSets forming a dependency cycle with the ClusterResourceSet,
+// starting and ending with the ClusterResourceSet, e.g. [a, b, a]; it returns nil if the ClusterResourceSet is not part of a cycle.
+// Dependencies which do not exist are ignored.
+func (r *Reconciler) getDependencyCycle(ctx context.Context, crs *addonsv1.ClusterResourceSet) ([]string, error) {
+	visited := map[string]bool{}
+	var visit func(path []string, dependencies []addonsv1.ClusterResourceSetDependency) ([]string, error)
+	visit = func(path []string, dependencies []addonsv1.ClusterResourceSetDependency) ([]string, error) {
+		for _, dependency := range dependencies {
+			if dependency.Name == crs.Name {
+				return append(slices.Clone(path), crs.Name), nil
+			}
+			if visited[dependency.Name] {
+				continue
+			}
+			visited[dependency.Name] = true
+
+			dependencyCRS := &addonsv1.ClusterResourceSet{}
+			if err := r.Client.Get(ctx, client.ObjectKey{Namespace: crs.Namespace, Name: dependency.Name}, dependencyCRS); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, errors.Wrapf(err, "failed to get ClusterResourceSet %s", dependency.Name)
+			}
+			cycle, err := visit(append(slices.Clone(path), dependency.Name), dependencyCRS.Spec.DependsOn)
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return visit([]string{crs.Name}, crs.Spec.DependsOn)
+}
+
+// setDependenciesReadyCondition sets the DependenciesReady condition on the ClusterResourceSet.
+func setDependenciesReadyCondition(crs *addonsv1.ClusterResourceSet, pendingDependencies []string, errs []error) {
+	if len(crs.Spec.DependsOn) == 0 {
+		conditions.Delete(crs, addonsv1.ClusterResourceSetDependenciesReadyCondition)
+		return
+	}
+
+	if len(errs) > 0 && len(pendingDependencies) == 0 {
+		conditions.Set(crs, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetDependenciesReadyCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  addonsv1.ClusterResourceSetDependenciesInternalErrorReason,
+			Message: "Please check controller logs for errors",
+		})
+		return
+	}
+
+	if len(pendingDependencies) > 0 {
+		conditions.Set(crs, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetDependenciesReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetDependenciesNotReadyReason,
+			Message: strings.Join(pendingDependencies, "\n"),
+		})
+		return
+	}
+
+	conditions.Set(crs, metav1.Condition{
+		Type:   addonsv1.ClusterResourceSetDependenciesReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: addonsv1.ClusterResourceSetDependenciesReadyReason,
+	})
+}
+
 // reconcileDelete removes the deleted ClusterResourceSet from all the ClusterResourceSetBindings it is added to.
 func (r *Reconciler) reconcileDelete(ctx context.Context, clusters []*clusterv1.Cluster, crs *addonsv1.ClusterResourceSet) error {
 	for _, cluster := range clusters {
@@ -299,7 +506,8 @@ func (r *Reconciler) getClustersByClusterResourceSetSelector(ctx context.Context
 // In ApplyOnce strategy, resources are applied only once to a particular cluster. ClusterResourceSetBinding is used to check if a resource is applied before.
 // It applies resources best effort and continue on scenarios like: unsupported resource types, failure during creation, missing resources.
 // In Reconcile strategy, resources are re-applied to a particular cluster when their definition changes. The hash in ClusterResourceSetBinding is used to check
-// if a resource has changed or not.
+// if a resource has changed or not. Resources are also re-applied every DriftCorrectionInterval to correct out-of-band changes,
+// and objects which are not defined by the resources anymore are pruned.
 // TODO: If a resource already exists in the cluster but not applied by ClusterResourceSet, the resource will be updated ?
 func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clusterv1.Cluster, clusterResourceSet *addonsv1.ClusterResourceSet) (rerr error) {
 	log := ctrl.LoggerFrom(ctx, "Cluster", klog.KObj(cluster))
@@ -361,8 +569,8 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 
 	defer func() {
 		// Always attempt to Patch the ClusterResourceSetBinding object after each reconciliation.
-		// Note only the ClusterResourceSetBinding spec will be patched as it does not have a status field, and so
-		// using the patch helper is unnecessary.
+		// Note only the ClusterResourceSetBinding spec will be patched, the status is set by the
+		// ClusterResourceSetBinding controller, and so using the patch helper is unnecessary.
 		if err := r.Client.Patch(ctx, clusterResourceSetBinding, patch); err != nil {
 			rerr = kerrors.NewAggregate([]error{rerr, errors.Wrapf(err, "failed to patch ClusterResourceSetBinding %s", klog.KObj(clusterResourceSetBinding))})
 		}
@@ -398,27 +606,54 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 	}
 
 	// Iterate all resources and apply them to the cluster and update the resource status in the ClusterResourceSetBinding object.
+	// Resources are applied in order; if a resource has readiness checks, the following resources are applied only once it is ready.
+	staleObjectsByResource := []resourceObjects{}
+	notReadyMessage := ""
+	render := r.resourceRenderer(ctx, cluster)
 	for i, resource := range clusterResourceSet.Spec.Resources {
 		unstructuredObj := objList[i]
 		if unstructuredObj == nil {
+			if len(resource.ReadinessChecks) > 0 {
+				notReadyMessage = fmt.Sprintf("%s %s does not exist", resource.Kind, resource.Name)
+				break
+			}
 			// Continue without adding the error to the aggregate if we can't find the resource.
 			continue
 		}
 
-		resourceScope, err := reconcileScopeForResource(clusterResourceSet, resource, resourceSetBinding, unstructuredObj)
+		// Keep tracking the objects applied previously in case of early continue due to a failure.
+		var previousAppliedObjects []addonsv1.ClusterResourceSetObjectReference
+		if resourceBinding := resourceSetBinding.GetResource(resource); resourceBinding != nil {
+			previousAppliedObjects = resourceBinding.AppliedObjects
+		}
+
+		resourceScope, err := reconcileScopeForResource(clusterResourceSet, cluster, resource, resourceSetBinding, unstructuredObj, render, r.DriftCorrectionInterval)
 		if err != nil {
 			resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
 				ResourceRef:     resource,
 				Hash:            "",
 				Applied:         false,
 				LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
+				AppliedObjects:  previousAppliedObjects,
 			})
 
 			errList = append(errList, err)
+			if len(resource.ReadinessChecks) > 0 {
+				break
+			}
 			continue
 		}
 
 		if !resourceScope.needsApply() {
+			message, err := checkReadiness(ctx, remoteClient, resourceSetBinding, resource, resourceScope.objs())
+			if err != nil {
+				errList = append(errList, err)
+				break
+			}
+			if message != "" {
+				notReadyMessage = message
+				break
+			}
 			continue
 		}
 
@@ -429,6 +664,7 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 			Hash:            "",
 			Applied:         false,
 			LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
+			AppliedObjects:  previousAppliedObjects,
 		})
 
 		// Apply all values in the key-value pair of the resource to the cluster.
@@ -447,17 +683,56 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 			errList = append(errList, err)
 		}
 
+		if drifted := resourceScope.driftedObjects(); len(drifted) > 0 {
+			log.Info("Corrected drift of ClusterResourceSet resource objects", resource.Kind, klog.KRef(clusterResourceSet.Namespace, resource.Name), "objects", drifted)
+		}
+
 		resourceSetBinding.SetBinding(addonsv1.ResourceBinding{
 			ResourceRef:     resource,
 			Hash:            resourceScope.hash(),
 			Applied:         isSuccessful,
 			LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
+			AppliedObjects:  resourceScope.appliedObjects(),
+			DriftedObjects:  resourceScope.driftedObjects(),
 		})
+		if staleObjects := resourceScope.staleObjects(); len(staleObjects) > 0 {
+			staleObjectsByResource = append(staleObjectsByResource, resourceObjects{resourceRef: resource, objects: staleObjects})
+		}
+
+		message, err := checkReadiness(ctx, remoteClient, resourceSetBinding, resource, resourceScope.objs())
+		if err != nil {
+			errList = append(errList, err)
+			break
+		}
+		if message != "" {
+			notReadyMessage = message
+			break
+		}
+	}
+
+	// Prune the objects which are not defined by the resources anymore, but only once every resource has been
+	// processed and applied successfully; otherwise objects moved to a resource which failed to apply or which has not
+	// been applied yet would be deleted.
+	if clusterResourceSet.Spec.Strategy == string(addonsv1.ClusterResourceSetStrategyReconcile) {
+		if len(errList) == 0 && notReadyMessage == "" {
+			if err := pruneClusterResourceSetObjects(ctx, remoteClient, clusterResourceSet, clusterResourceSetBinding, resourceSetBinding, staleObjectsByResource); err != nil {
+				log.Error(err, "Failed to prune ClusterResourceSet objects")
+				errList = append(errList, err)
+			}
+		} else {
+			deferClusterResourceSetObjectsPruning(resourceSetBinding, staleObjectsByResource)
+		}
 	}
+
 	if len(errList) > 0 {
 		return kerrors.NewAggregate(errList)
 	}
 
+	if notReadyMessage != "" {
+		log.Info("Waiting for ClusterResourceSet resource to be ready", "reason", notReadyMessage)
+		return &resourceNotReadyError{message: notReadyMessage}
+	}
+
 	v1beta1conditions.MarkTrue(clusterResourceSet, addonsv1.ResourcesAppliedV1Beta1Condition)
 	conditions.Set(clusterResourceSet, metav1.Condition{
 		Type:   addonsv1.ClusterResourceSetResourcesAppliedCondition,
@@ -468,14 +743,36 @@ func (r *Reconciler) ApplyClusterResourceSet(ctx context.Context, cluster *clust
 	return nil
 }
 
+// checkReadiness evaluates the readiness checks of a resource and records the result in the ResourceSetBinding.
+// If the resource is not ready, it returns a message describing why.
+func checkReadiness(ctx context.Context, c client.Client, resourceSetBinding *addonsv1.ResourceSetBinding, resource addonsv1.ResourceRef, objs []unstructured.Unstructured) (string, error) {
+	if len(resource.ReadinessChecks) == 0 {
+		return "", nil
+	}
+
+	resourceBinding := resourceSetBinding.GetResource(resource)
+	if resourceBinding == nil || !resourceBinding.Applied {
+		return fmt.Sprintf("%s %s is not applied", resource.Kind, resource.Name), nil
+	}
+
+	ready, message, err := checkResourceReadiness(ctx, c, resource.ReadinessChecks, objs)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to check readiness of %s %s", resource.Kind, resource.Name)
+	}
+	resourceBinding.Ready = ptr.To(ready)
+	resourceSetBinding.SetBinding(*resourceBinding)
+	return message, nil
+}
+
 // getResource retrieves the requested resource and convert it to unstructured type.
 // Unsupported resource kinds are not denied by validation webhook, hence no need to check here.
-// Only supports Secrets/Configmaps as resource types and allow using resources in the same namespace with the cluster.
+// Only supports Secrets/Configmaps as resource types and allow using resources in the same namespace with the cluster;
+// for Helm charts and kustomizations the Secret/ConfigMap they are stored in is retrieved.
 func (r *Reconciler) getResource(ctx context.Context, resourceRef addonsv1.ResourceRef, namespace string) (*unstructured.Unstructured, error) {
 	resourceName := types.NamespacedName{Name: resourceRef.Name, Namespace: namespace}
 
 	var resourceInterface interface{}
-	switch resourceRef.Kind {
+	switch resourceRef.GetSourceKind() {
 	case string(addonsv1.ConfigMapClusterResourceSetResourceKind):
 		resourceConfigMap, err := getConfigMap(ctx, r.Client, resourceName)
 		if err != nil {
@@ -493,6 +790,8 @@ func (r *Reconciler) getResource(ctx context.Context, resourceRef addonsv1.Resou
 			return nil, ErrSecretTypeNotSupported
 		}
 		resourceInterface = resourceSecret.DeepCopyObject()
+	default:
+		return nil, errors.Errorf("unsupported kind %q for resource %s", resourceRef.Kind, resourceRef.Name)
 	}
 
 	raw := &unstructured.Unstructured{}
@@ -593,7 +892,7 @@ func resourceToClusterResourceSetFunc[T client.Object](ctrlClient client.Client)
 		}
 		for _, crs := range crsList.Items {
 			for _, resource := range crs.Spec.Resources {
-				if resource.Kind == objKind.Kind && resource.Name == o.GetName() {
+				if resource.GetSourceKind() == objKind.Kind && resource.Name == o.GetName() {
 					name := client.ObjectKey{Namespace: o.GetNamespace(), Name: crs.Name}
 					result = append(result, ctrl.Request{NamespacedName: name})
 					break
@@ -24,7 +24,9 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 	"unicode"
 
 	"github.com/pkg/errors"
@@ -33,6 +35,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -106,6 +109,145 @@ func createUnstructured(ctx context.Context, c client.Client, obj *unstructured.
 	return nil
 }
 
+// hasFieldsManagedBy returns true if any of the fields of obj are owned by the ssaManager through server-side apply.
+func hasFieldsManagedBy(obj client.Object, ssaManager string) bool {
+	for _, managedField := range obj.GetManagedFields() {
+		if managedField.Manager == ssaManager && managedField.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
+
+// objectReference returns the ClusterResourceSetObjectReference for obj.
+func objectReference(obj *unstructured.Unstructured) addonsv1.ClusterResourceSetObjectReference {
+	return addonsv1.ClusterResourceSetObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// objectReferences returns the ClusterResourceSetObjectReferences for objs.
+func objectReferences(objs []unstructured.Unstructured) []addonsv1.ClusterResourceSetObjectReference {
+	refs := make([]addonsv1.ClusterResourceSetObjectReference, 0, len(objs))
+	for i := range objs {
+		refs = append(refs, objectReference(&objs[i]))
+	}
+	return refs
+}
+
+// unionObjectReferences returns the references in a followed by the references in b which are not in a.
+func unionObjectReferences(a, b []addonsv1.ClusterResourceSetObjectReference) []addonsv1.ClusterResourceSetObjectReference {
+	union := append([]addonsv1.ClusterResourceSetObjectReference{}, a...)
+	return append(union, differenceObjectReferences(b, a)...)
+}
+
+// differenceObjectReferences returns the references in a which are not in b.
+func differenceObjectReferences(a, b []addonsv1.ClusterResourceSetObjectReference) []addonsv1.ClusterResourceSetObjectReference {
+	var difference []addonsv1.ClusterResourceSetObjectReference
+	for _, ref := range a {
+		if !slices.Contains(b, ref) {
+			difference = append(difference, ref)
+		}
+	}
+	return difference
+}
+
+// pruneObjects deletes the referenced objects from the cluster; objects which are already gone are ignored.
+// It returns the references of the objects which could not be deleted.
+func pruneObjects(ctx context.Context, c client.Client, refs []addonsv1.ClusterResourceSetObjectReference) ([]addonsv1.ClusterResourceSetObjectReference, error) {
+	var failed []addonsv1.ClusterResourceSetObjectReference
+	errList := []error{}
+	for _, ref := range refs {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(ref.APIVersion)
+		obj.SetKind(ref.Kind)
+		obj.SetNamespace(ref.Namespace)
+		obj.SetName(ref.Name)
+		if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			failed = append(failed, ref)
+			errList = append(errList, errors.Wrapf(err, "pruning object %s", ref))
+		}
+	}
+	return failed, kerrors.NewAggregate(errList)
+}
+
+// resourceObjects groups object references by the resource they belong to.
+type resourceObjects struct {
+	resourceRef addonsv1.ResourceRef
+	objects     []addonsv1.ClusterResourceSetObjectReference
+}
+
+// pruneClusterResourceSetObjects deletes the objects which have been removed from the resources of a ClusterResourceSet
+// as well as the objects of resources which have been removed from the ClusterResourceSet.
+// Objects which are still applied by another resource or ClusterResourceSet are not deleted.
+// Objects which cannot be deleted are kept in the ResourceBinding, so deletion is retried on the next reconcile.
+func pruneClusterResourceSetObjects(ctx context.Context, c client.Client, clusterResourceSet *addonsv1.ClusterResourceSet, clusterResourceSetBinding *addonsv1.ClusterResourceSetBinding, resourceSetBinding *addonsv1.ResourceSetBinding, staleObjectsByResource []resourceObjects) error {
+	// Stop tracking resources which have been removed from the ClusterResourceSet and prune their objects.
+	resources := []addonsv1.ResourceBinding{}
+	for _, resourceBinding := range resourceSetBinding.Resources {
+		if slices.ContainsFunc(clusterResourceSet.Spec.Resources, func(resourceRef addonsv1.ResourceRef) bool {
+			return resourceRef.Kind == resourceBinding.Kind && resourceRef.Name == resourceBinding.Name
+		}) {
+			resources = append(resources, resourceBinding)
+			continue
+		}
+		if len(resourceBinding.AppliedObjects) > 0 {
+			staleObjectsByResource = append(staleObjectsByResource, resourceObjects{resourceRef: resourceBinding.ResourceRef, objects: resourceBinding.AppliedObjects})
+		}
+	}
+	resourceSetBinding.Resources = resources
+
+	// Collect the objects which are still applied to the cluster.
+	appliedObjects := []addonsv1.ClusterResourceSetObjectReference{}
+	for _, binding := range clusterResourceSetBinding.Spec.Bindings {
+		for _, resourceBinding := range binding.Resources {
+			appliedObjects = append(appliedObjects, resourceBinding.AppliedObjects...)
+		}
+	}
+
+	errList := []error{}
+	for _, stale := range staleObjectsByResource {
+		failed, err := pruneObjects(ctx, c, differenceObjectReferences(stale.objects, appliedObjects))
+		if err != nil {
+			errList = append(errList, err)
+		}
+		if len(failed) == 0 {
+			continue
+		}
+
+		resourceBinding := resourceSetBinding.GetResource(stale.resourceRef)
+		if resourceBinding == nil {
+			resourceBinding = &addonsv1.ResourceBinding{
+				ResourceRef:     stale.resourceRef,
+				Applied:         true,
+				LastAppliedTime: &metav1.Time{Time: time.Now().UTC()},
+			}
+		}
+		resourceBinding.AppliedObjects = unionObjectReferences(resourceBinding.AppliedObjects, failed)
+		resourceSetBinding.SetBinding(*resourceBinding)
+	}
+
+	return kerrors.NewAggregate(errList)
+}
+
+// deferClusterResourceSetObjectsPruning keeps tracking the stale objects of resources in the ResourceSetBinding when
+// they cannot be pruned yet. The hash of the ResourceBindings is cleared, so the resources are applied again and
+// their stale objects are pruned on a later reconcile.
+func deferClusterResourceSetObjectsPruning(resourceSetBinding *addonsv1.ResourceSetBinding, staleObjectsByResource []resourceObjects) {
+	for _, stale := range staleObjectsByResource {
+		resourceBinding := resourceSetBinding.GetResource(stale.resourceRef)
+		if resourceBinding == nil {
+			continue
+		}
+		resourceBinding.AppliedObjects = unionObjectReferences(resourceBinding.AppliedObjects, stale.objects)
+		resourceBinding.Hash = ""
+		resourceSetBinding.SetBinding(*resourceBinding)
+	}
+}
+
 // getOrCreateClusterResourceSetBinding retrieves ClusterResourceSetBinding resource owned by the cluster or create a new one if not found.
 func (r *Reconciler) getOrCreateClusterResourceSetBinding(ctx context.Context, cluster *clusterv1.Cluster, clusterResourceSet *addonsv1.ClusterResourceSet) (*addonsv1.ClusterResourceSetBinding, error) {
 	clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{
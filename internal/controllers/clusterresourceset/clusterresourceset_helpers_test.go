@@ -23,6 +23,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -271,3 +272,90 @@ func TestEnsureKubernetesServiceCreated(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneClusterResourceSetObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	configMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+	}
+	objRef := func(name string) addonsv1.ClusterResourceSetObjectReference {
+		return addonsv1.ClusterResourceSetObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: metav1.NamespaceDefault, Name: name}
+	}
+
+	keptResource := addonsv1.ResourceRef{Name: "kept", Kind: "ConfigMap"}
+	removedResource := addonsv1.ResourceRef{Name: "removed", Kind: "ConfigMap"}
+	crs := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "crs"},
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Strategy: string(addonsv1.ClusterResourceSetStrategyReconcile),
+			Resources: []addonsv1.ResourceRef{
+				// Resources are matched by kind and name, so changing other fields does not prune their objects.
+				{Name: keptResource.Name, Kind: keptResource.Kind, ReadinessChecks: []addonsv1.ResourceReadinessCheck{{Type: addonsv1.DeploymentAvailableResourceReadinessCheckType}}},
+			},
+		},
+	}
+	resourceSetBinding := &addonsv1.ResourceSetBinding{
+		ClusterResourceSetName: crs.Name,
+		Resources: []addonsv1.ResourceBinding{
+			{ResourceRef: keptResource, Applied: true, AppliedObjects: []addonsv1.ClusterResourceSetObjectReference{objRef("kept")}},
+			{ResourceRef: removedResource, Applied: true, AppliedObjects: []addonsv1.ClusterResourceSetObjectReference{objRef("removed"), objRef("shared")}},
+		},
+	}
+	clusterResourceSetBinding := &addonsv1.ClusterResourceSetBinding{
+		Spec: addonsv1.ClusterResourceSetBindingSpec{
+			Bindings: []*addonsv1.ResourceSetBinding{
+				resourceSetBinding,
+				{
+					ClusterResourceSetName: "other-crs",
+					Resources: []addonsv1.ResourceBinding{
+						{ResourceRef: keptResource, Applied: true, AppliedObjects: []addonsv1.ClusterResourceSetObjectReference{objRef("shared")}},
+					},
+				},
+			},
+		},
+	}
+
+	c := fake.NewClientBuilder().
+		WithObjects(configMap("kept"), configMap("stale"), configMap("removed"), configMap("shared")).
+		Build()
+
+	staleObjects := []resourceObjects{{resourceRef: keptResource, objects: []addonsv1.ClusterResourceSetObjectReference{objRef("stale")}}}
+	g.Expect(pruneClusterResourceSetObjects(ctx, c, crs, clusterResourceSetBinding, resourceSetBinding, staleObjects)).To(Succeed())
+
+	// The removed resource is not tracked anymore.
+	g.Expect(resourceSetBinding.Resources).To(HaveLen(1))
+	g.Expect(resourceSetBinding.Resources[0].ResourceRef).To(Equal(keptResource))
+
+	// Objects which are not applied anymore are deleted, objects applied by another ClusterResourceSet are kept.
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap("kept")), &corev1.ConfigMap{})).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap("shared")), &corev1.ConfigMap{})).To(Succeed())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(configMap("stale")), &corev1.ConfigMap{}))).To(BeTrue())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, client.ObjectKeyFromObject(configMap("removed")), &corev1.ConfigMap{}))).To(BeTrue())
+}
+
+func TestDeferClusterResourceSetObjectsPruning(t *testing.T) {
+	g := NewWithT(t)
+
+	objRef := func(name string) addonsv1.ClusterResourceSetObjectReference {
+		return addonsv1.ClusterResourceSetObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: metav1.NamespaceDefault, Name: name}
+	}
+
+	resource := addonsv1.ResourceRef{Name: "resource", Kind: "ConfigMap"}
+	resourceSetBinding := &addonsv1.ResourceSetBinding{
+		ClusterResourceSetName: "crs",
+		Resources: []addonsv1.ResourceBinding{
+			{ResourceRef: resource, Applied: true, Hash: "xyz", AppliedObjects: []addonsv1.ClusterResourceSetObjectReference{objRef("kept")}},
+		},
+	}
+
+	staleObjects := []resourceObjects{{resourceRef: resource, objects: []addonsv1.ClusterResourceSetObjectReference{objRef("stale")}}}
+	deferClusterResourceSetObjectsPruning(resourceSetBinding, staleObjects)
+
+	// Stale objects are still tracked and the resource is applied again on the next reconcile, so they can be pruned then.
+	resourceBinding := resourceSetBinding.GetResource(resource)
+	g.Expect(resourceBinding).ToNot(BeNil())
+	g.Expect(resourceBinding.AppliedObjects).To(ConsistOf(objRef("kept"), objRef("stale")))
+	g.Expect(resourceBinding.Hash).To(BeEmpty())
+	g.Expect(resourceBinding.Applied).To(BeTrue())
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/cel"
+)
+
+// checkResourceReadiness evaluates the readiness checks of a resource on its objects in the cluster.
+// If an object is not ready, it returns a message describing why.
+func checkResourceReadiness(ctx context.Context, c client.Client, checks []addonsv1.ResourceReadinessCheck, objs []unstructured.Unstructured) (bool, string, error) {
+	for _, check := range checks {
+		var program *cel.BoolProgram
+		if check.Type == addonsv1.ExpressionResourceReadinessCheckType {
+			var err error
+			program, err = cel.CompileBoolExpression(check.Expression)
+			if err != nil {
+				return false, "", errors.Wrapf(err, "invalid readiness check expression %q", check.Expression)
+			}
+		}
+
+		for i := range objs {
+			obj := &objs[i]
+			if !readinessCheckAppliesTo(check, obj) {
+				continue
+			}
+
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(obj.GroupVersionKind())
+			if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, fmt.Sprintf("%s %s does not exist", obj.GetKind(), klog.KObj(obj)), nil
+				}
+				return false, "", errors.Wrapf(err, "failed to get %s %s", obj.GetKind(), klog.KObj(obj))
+			}
+
+			ready, message, err := evaluateReadinessCheck(check, program, current)
+			if err != nil {
+				return false, "", err
+			}
+			if !ready {
+				return false, fmt.Sprintf("%s %s %s", obj.GetKind(), klog.KObj(obj), message), nil
+			}
+		}
+	}
+	return true, "", nil
+}
+
+// readinessCheckAppliesTo returns true if the readiness check has to be evaluated on obj.
+func readinessCheckAppliesTo(check addonsv1.ResourceReadinessCheck, obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	switch check.Type {
+	case addonsv1.CRDEstablishedResourceReadinessCheckType:
+		return gvk.Group == apiextensionsv1.GroupName && gvk.Kind == "CustomResourceDefinition"
+	case addonsv1.DeploymentAvailableResourceReadinessCheckType:
+		return gvk.Group == appsv1.GroupName && gvk.Kind == "Deployment"
+	case addonsv1.ExpressionResourceReadinessCheckType:
+		return check.Kind == "" || check.Kind == gvk.Kind
+	default:
+		return false
+	}
+}
+
+// evaluateReadinessCheck evaluates a readiness check on an object; if the object is not ready
+// it returns a message describing why.
+func evaluateReadinessCheck(check addonsv1.ResourceReadinessCheck, program *cel.BoolProgram, obj *unstructured.Unstructured) (bool, string, error) {
+	switch check.Type {
+	case addonsv1.CRDEstablishedResourceReadinessCheckType:
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, crd); err != nil {
+			return false, "", errors.Wrapf(err, "failed to convert %s %s", obj.GetKind(), klog.KObj(obj))
+		}
+		if !apiextensionshelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established) {
+			return false, "is not established", nil
+		}
+		return true, "", nil
+	case addonsv1.DeploymentAvailableResourceReadinessCheckType:
+		deployment := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deployment); err != nil {
+			return false, "", errors.Wrapf(err, "failed to convert %s %s", obj.GetKind(), klog.KObj(obj))
+		}
+		if deployment.Status.ObservedGeneration < deployment.Generation {
+			return false, "is not observed yet", nil
+		}
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == appsv1.DeploymentAvailable && condition.Status == corev1.ConditionTrue {
+				return true, "", nil
+			}
+		}
+		return false, "is not available", nil
+	case addonsv1.ExpressionResourceReadinessCheckType:
+		ready, err := program.Eval(obj.Object)
+		if err != nil {
+			// Expressions commonly fail while fields are not set yet, e.g. status fields.
+			return false, fmt.Sprintf("is not ready: evaluating %q failed: %v", check.Expression, err), nil
+		}
+		if !ready {
+			return false, fmt.Sprintf("is not ready: %q evaluated to false", check.Expression), nil
+		}
+		return true, "", nil
+	default:
+		return false, "", errors.Errorf("unsupported readiness check type %q", check.Type)
+	}
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util/conditions"
+)
+
+func TestCheckResourceReadiness(t *testing.T) {
+	crd := func(established string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata": map[string]interface{}{
+				"name": "widgets.example.com",
+			},
+			"status": map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Established", "status": established},
+				},
+			},
+		}}
+	}
+	deployment := func(generation, observedGeneration int64, available string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":       "csi-controller",
+				"namespace":  "kube-system",
+				"generation": generation,
+			},
+			"status": map[string]interface{}{
+				"observedGeneration": observedGeneration,
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Available", "status": available},
+				},
+			},
+		}}
+	}
+	daemonSet := func(numberReady int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "DaemonSet",
+			"metadata": map[string]interface{}{
+				"name":      "cni",
+				"namespace": "kube-system",
+			},
+			"status": map[string]interface{}{
+				"desiredNumberScheduled": int64(3),
+				"numberReady":            numberReady,
+			},
+		}}
+	}
+
+	tests := []struct {
+		name         string
+		checks       []addonsv1.ResourceReadinessCheck
+		objs         []*unstructured.Unstructured
+		existingObjs []client.Object
+		wantReady    bool
+		wantMessage  string
+	}{
+		{
+			name:         "CRD is established",
+			checks:       []addonsv1.ResourceReadinessCheck{{Type: addonsv1.CRDEstablishedResourceReadinessCheckType}},
+			objs:         []*unstructured.Unstructured{crd("True"), daemonSet(0)},
+			existingObjs: []client.Object{crd("True"), daemonSet(0)},
+			wantReady:    true,
+		},
+		{
+			name:         "CRD is not established",
+			checks:       []addonsv1.ResourceReadinessCheck{{Type: addonsv1.CRDEstablishedResourceReadinessCheckType}},
+			objs:         []*unstructured.Unstructured{crd("True")},
+			existingObjs: []client.Object{crd("False")},
+			wantReady:    false,
+			wantMessage:  "CustomResourceDefinition widgets.example.com is not established",
+		},
+		{
+			name:        "CRD does not exist",
+			checks:      []addonsv1.ResourceReadinessCheck{{Type: addonsv1.CRDEstablishedResourceReadinessCheckType}},
+			objs:        []*unstructured.Unstructured{crd("True")},
+			wantReady:   false,
+			wantMessage: "CustomResourceDefinition widgets.example.com does not exist",
+		},
+		{
+			name:         "Deployment is available",
+			checks:       []addonsv1.ResourceReadinessCheck{{Type: addonsv1.DeploymentAvailableResourceReadinessCheckType}},
+			objs:         []*unstructured.Unstructured{deployment(1, 1, "True")},
+			existingObjs: []client.Object{deployment(1, 1, "True")},
+			wantReady:    true,
+		},
+		{
+			name:         "Deployment is not available",
+			checks:       []addonsv1.ResourceReadinessCheck{{Type: addonsv1.DeploymentAvailableResourceReadinessCheckType}},
+			objs:         []*unstructured.Unstructured{deployment(1, 1, "True")},
+			existingObjs: []client.Object{deployment(1, 1, "False")},
+			wantReady:    false,
+			wantMessage:  "Deployment kube-system/csi-controller is not available",
+		},
+		{
+			name:         "Deployment is not observed yet",
+			checks:       []addonsv1.ResourceReadinessCheck{{Type: addonsv1.DeploymentAvailableResourceReadinessCheckType}},
+			objs:         []*unstructured.Unstructured{deployment(2, 1, "True")},
+			existingObjs: []client.Object{deployment(2, 1, "True")},
+			wantReady:    false,
+			wantMessage:  "Deployment kube-system/csi-controller is not observed yet",
+		},
+		{
+			name: "expression evaluates to true",
+			checks: []addonsv1.ResourceReadinessCheck{{
+				Type:       addonsv1.ExpressionResourceReadinessCheckType,
+				Kind:       "DaemonSet",
+				Expression: "self.status.numberReady == self.status.desiredNumberScheduled",
+			}},
+			objs:         []*unstructured.Unstructured{daemonSet(0), crd("False")},
+			existingObjs: []client.Object{daemonSet(3), crd("False")},
+			wantReady:    true,
+		},
+		{
+			name: "expression evaluates to false",
+			checks: []addonsv1.ResourceReadinessCheck{{
+				Type:       addonsv1.ExpressionResourceReadinessCheckType,
+				Kind:       "DaemonSet",
+				Expression: "self.status.numberReady == self.status.desiredNumberScheduled",
+			}},
+			objs:         []*unstructured.Unstructured{daemonSet(0)},
+			existingObjs: []client.Object{daemonSet(1)},
+			wantReady:    false,
+			wantMessage:  `DaemonSet kube-system/cni is not ready: "self.status.numberReady == self.status.desiredNumberScheduled" evaluated to false`,
+		},
+		{
+			name: "expression fails to evaluate",
+			checks: []addonsv1.ResourceReadinessCheck{{
+				Type:       addonsv1.ExpressionResourceReadinessCheckType,
+				Expression: "self.status.updatedNumberScheduled == 3",
+			}},
+			objs:         []*unstructured.Unstructured{daemonSet(0)},
+			existingObjs: []client.Object{daemonSet(3)},
+			wantReady:    false,
+			wantMessage:  `DaemonSet kube-system/cni is not ready: evaluating "self.status.updatedNumberScheduled == 3" failed: no such key: updatedNumberScheduled`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			c := fake.NewClientBuilder().WithObjects(tt.existingObjs...).Build()
+			objs := make([]unstructured.Unstructured, 0, len(tt.objs))
+			for _, obj := range tt.objs {
+				objs = append(objs, *obj)
+			}
+
+			ready, message, err := checkResourceReadiness(ctx, c, tt.checks, objs)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(ready).To(Equal(tt.wantReady))
+			g.Expect(message).To(Equal(tt.wantMessage))
+		})
+	}
+}
+
+func TestCheckReadiness(t *testing.T) {
+	g := NewWithT(t)
+
+	resource := addonsv1.ResourceRef{
+		Name: "cni",
+		Kind: string(addonsv1.ConfigMapClusterResourceSetResourceKind),
+		ReadinessChecks: []addonsv1.ResourceReadinessCheck{
+			{Type: addonsv1.CRDEstablishedResourceReadinessCheckType},
+		},
+	}
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]interface{}{
+			"name": "widgets.example.com",
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Established", "status": "True"},
+			},
+		},
+	}}
+	c := fake.NewClientBuilder().WithObjects(crd.DeepCopy()).Build()
+
+	// Resources which are not applied yet are not ready.
+	resourceSetBinding := &addonsv1.ResourceSetBinding{ClusterResourceSetName: "crs"}
+	message, err := checkReadiness(ctx, c, resourceSetBinding, resource, []unstructured.Unstructured{*crd})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(message).To(Equal("ConfigMap cni is not applied"))
+	g.Expect(resourceSetBinding.IsReady(resource)).To(BeFalse())
+
+	// Applied resources are not ready until their readiness checks succeeded.
+	resourceSetBinding.SetBinding(addonsv1.ResourceBinding{ResourceRef: resource, Applied: true})
+	g.Expect(resourceSetBinding.IsReady(resource)).To(BeFalse())
+
+	message, err = checkReadiness(ctx, c, resourceSetBinding, resource, []unstructured.Unstructured{*crd})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(message).To(BeEmpty())
+	g.Expect(resourceSetBinding.IsReady(resource)).To(BeTrue())
+}
+
+func TestGetNotReadyDependencies(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(addonsv1.AddToScheme(scheme)).To(Succeed())
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: metav1.NamespaceDefault}}
+	crds := addonsv1.ResourceRef{
+		Name: "crds",
+		Kind: string(addonsv1.ConfigMapClusterResourceSetResourceKind),
+		ReadinessChecks: []addonsv1.ResourceReadinessCheck{
+			{Type: addonsv1.CRDEstablishedResourceReadinessCheckType},
+		},
+	}
+	cni := addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.ConfigMapClusterResourceSetResourceKind)}
+	newCRS := func(name string, resources ...addonsv1.ResourceRef) *addonsv1.ClusterResourceSet {
+		return &addonsv1.ClusterResourceSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec:       addonsv1.ClusterResourceSetSpec{Resources: resources},
+		}
+	}
+	crs := newCRS("csi")
+	crs.Spec.DependsOn = []addonsv1.ClusterResourceSetDependency{{Name: "crds"}, {Name: "cni"}, {Name: "missing"}}
+
+	tests := []struct {
+		name     string
+		bindings []*addonsv1.ResourceSetBinding
+		want     []string
+	}{
+		{
+			name: "no ClusterResourceSetBinding",
+			want: []string{"crds", "cni", "missing"},
+		},
+		{
+			name: "dependencies applied but readiness checks not succeeded",
+			bindings: []*addonsv1.ResourceSetBinding{
+				{ClusterResourceSetName: "crds", Resources: []addonsv1.ResourceBinding{{ResourceRef: crds, Applied: true, Ready: ptr.To(false)}}},
+				{ClusterResourceSetName: "cni", Resources: []addonsv1.ResourceBinding{{ResourceRef: cni, Applied: true}}},
+			},
+			want: []string{"crds", "missing"},
+		},
+		{
+			name: "dependencies ready",
+			bindings: []*addonsv1.ResourceSetBinding{
+				{ClusterResourceSetName: "crds", Resources: []addonsv1.ResourceBinding{{ResourceRef: crds, Applied: true, Ready: ptr.To(true)}}},
+				{ClusterResourceSetName: "cni", Resources: []addonsv1.ResourceBinding{{ResourceRef: cni, Applied: true}}},
+			},
+			want: []string{"missing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := []client.Object{crs, newCRS("crds", crds), newCRS("cni", cni)}
+			if tt.bindings != nil {
+				objs = append(objs, &addonsv1.ClusterResourceSetBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: cluster.Name, Namespace: cluster.Namespace},
+					Spec:       addonsv1.ClusterResourceSetBindingSpec{ClusterName: cluster.Name, Bindings: tt.bindings},
+				})
+			}
+			r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+
+			got, err := r.getNotReadyDependencies(ctx, cluster, crs)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestGetDependencyCycle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := addonsv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	newCRS := func(name string, dependsOn ...string) *addonsv1.ClusterResourceSet {
+		crs := &addonsv1.ClusterResourceSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+		for _, dependency := range dependsOn {
+			crs.Spec.DependsOn = append(crs.Spec.DependsOn, addonsv1.ClusterResourceSetDependency{Name: dependency})
+		}
+		return crs
+	}
+
+	tests := []struct {
+		name string
+		crss []*addonsv1.ClusterResourceSet
+		want []string
+	}{
+		{
+			name: "no dependencies",
+			crss: []*addonsv1.ClusterResourceSet{newCRS("a")},
+		},
+		{
+			name: "dependencies without cycle",
+			crss: []*addonsv1.ClusterResourceSet{newCRS("a", "b", "c"), newCRS("b", "c"), newCRS("c")},
+		},
+		{
+			name: "missing dependency",
+			crss: []*addonsv1.ClusterResourceSet{newCRS("a", "missing")},
+		},
+		{
+			name: "two ClusterResourceSets depending on each other",
+			crss: []*addonsv1.ClusterResourceSet{newCRS("a", "b"), newCRS("b", "a")},
+			want: []string{"a", "b", "a"},
+		},
+		{
+			name: "cycle of three ClusterResourceSets",
+			crss: []*addonsv1.ClusterResourceSet{newCRS("a", "b"), newCRS("b", "c"), newCRS("c", "a")},
+			want: []string{"a", "b", "c", "a"},
+		},
+		{
+			name: "cycle between dependencies only",
+			crss: []*addonsv1.ClusterResourceSet{newCRS("a", "b"), newCRS("b", "c"), newCRS("c", "b")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := []client.Object{}
+			for _, crs := range tt.crss {
+				objs = append(objs, crs)
+			}
+			r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()}
+
+			got, err := r.getDependencyCycle(ctx, tt.crss[0])
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestReconcileDependencyCycle(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(addonsv1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+
+	newCRS := func(name, dependsOn string) *addonsv1.ClusterResourceSet {
+		return &addonsv1.ClusterResourceSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  metav1.NamespaceDefault,
+				Finalizers: []string{addonsv1.ClusterResourceSetFinalizer},
+			},
+			Spec: addonsv1.ClusterResourceSetSpec{
+				ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"cni": "calico"}},
+				DependsOn:       []addonsv1.ClusterResourceSetDependency{{Name: dependsOn}},
+			},
+		}
+	}
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster1", Namespace: metav1.NamespaceDefault, Labels: map[string]string{"cni": "calico"}}}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(newCRS("crs-a", "crs-b"), newCRS("crs-b", "crs-a"), cluster).
+		WithStatusSubresource(&addonsv1.ClusterResourceSet{}).
+		Build()
+	r := &Reconciler{Client: c}
+
+	for _, tt := range []struct {
+		name        string
+		wantMessage string
+	}{
+		{name: "crs-a", wantMessage: "ClusterResourceSets form a dependency cycle: crs-a -> crs-b -> crs-a"},
+		{name: "crs-b", wantMessage: "ClusterResourceSets form a dependency cycle: crs-b -> crs-a -> crs-b"},
+	} {
+		req := ctrl.Request{NamespacedName: client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: tt.name}}
+		// The first reconcile sets the Paused condition.
+		_, err := r.Reconcile(ctx, req)
+		g.Expect(err).ToNot(HaveOccurred())
+		result, err := r.Reconcile(ctx, req)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(notReadyRequeueAfter))
+
+		crs := &addonsv1.ClusterResourceSet{}
+		g.Expect(c.Get(ctx, req.NamespacedName, crs)).To(Succeed())
+		dependenciesReady := conditions.Get(crs, addonsv1.ClusterResourceSetDependenciesReadyCondition)
+		g.Expect(dependenciesReady).ToNot(BeNil())
+		g.Expect(dependenciesReady.Status).To(Equal(metav1.ConditionFalse))
+		g.Expect(dependenciesReady.Reason).To(Equal(addonsv1.ClusterResourceSetDependenciesCycleReason))
+		g.Expect(dependenciesReady.Message).To(Equal(tt.wantMessage))
+		resourcesApplied := conditions.Get(crs, addonsv1.ClusterResourceSetResourcesAppliedCondition)
+		g.Expect(resourcesApplied).ToNot(BeNil())
+		g.Expect(resourcesApplied.Reason).To(Equal(addonsv1.ClusterResourceSetResourcesWaitingForDependenciesReason))
+	}
+
+	// No binding is created, as the resources are not applied to the Cluster.
+	bindings := &addonsv1.ClusterResourceSetBindingList{}
+	g.Expect(c.List(ctx, bindings)).To(Succeed())
+	g.Expect(bindings.Items).To(BeEmpty())
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/discovery"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// Renderer renders the Helm charts and the kustomizations referenced by ClusterResourceSets.
+// The implementation lives in the render package, so the Helm and Kustomize dependencies are only
+// linked into binaries setting a Renderer on the Reconciler.
+type Renderer interface {
+	// Render renders the Helm chart or the kustomization stored in a Secret or ConfigMap for a Cluster.
+	// The rendered objects are returned as a single YAML document.
+	Render(ctx context.Context, input *RenderInput) ([]byte, error)
+}
+
+// RenderInput is the input of a Renderer.
+type RenderInput struct {
+	// Cluster is the Cluster the resource is rendered for.
+	Cluster *clusterv1.Cluster
+
+	// ResourceRef is the reference to the resource in the ClusterResourceSet.
+	ResourceRef addonsv1.ResourceRef
+
+	// Resource is the Secret or ConfigMap storing the Helm chart or the kustomization.
+	Resource *unstructured.Unstructured
+
+	// KubernetesVersion is the version of the workload cluster, e.g. v1.33.0.
+	// It is only set for Helm charts.
+	KubernetesVersion string
+
+	// APIVersions are the group versions and the group version kinds served by the workload cluster,
+	// e.g. apps/v1 and apps/v1/Deployment. They are only set for Helm charts.
+	APIVersions []string
+}
+
+// renderFunc renders a Helm chart or a kustomization stored in a Secret or ConfigMap.
+type renderFunc func(resourceRef addonsv1.ResourceRef, resource *unstructured.Unstructured) ([]byte, error)
+
+// resourceRenderer returns a renderFunc rendering resources for the Cluster with the Renderer, or nil if no Renderer is set.
+// The version and the API versions of the workload cluster are discovered once, when the first Helm chart is rendered.
+func (r *Reconciler) resourceRenderer(ctx context.Context, cluster *clusterv1.Cluster) renderFunc {
+	if r.Renderer == nil {
+		return nil
+	}
+
+	var kubernetesVersion string
+	var apiVersions []string
+	return func(resourceRef addonsv1.ResourceRef, resource *unstructured.Unstructured) ([]byte, error) {
+		input := &RenderInput{
+			Cluster:     cluster,
+			ResourceRef: resourceRef,
+			Resource:    resource,
+		}
+		if resourceRef.Kind == string(addonsv1.HelmChartClusterResourceSetResourceKind) {
+			if kubernetesVersion == "" {
+				var err error
+				kubernetesVersion, apiVersions, err = r.discoverWorkloadCluster(ctx, cluster)
+				if err != nil {
+					return nil, err
+				}
+			}
+			input.KubernetesVersion = kubernetesVersion
+			input.APIVersions = apiVersions
+		}
+		return r.Renderer.Render(ctx, input)
+	}
+}
+
+// discoverWorkloadCluster returns the version and the API versions served by the workload cluster, in the same format used
+// by Helm for the .Capabilities.APIVersions of a chart.
+func (r *Reconciler) discoverWorkloadCluster(ctx context.Context, cluster *clusterv1.Cluster) (string, []string, error) {
+	restConfig, err := r.ClusterCache.GetRESTConfig(ctx, util.ObjectKey(cluster))
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get REST config for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to create discovery client for Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+
+	version, err := discoveryClient.ServerVersion()
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get the Kubernetes version of Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+	groups, resources, err := discoveryClient.ServerGroupsAndResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return "", nil, errors.Wrapf(err, "failed to get the API versions of Cluster %s/%s", cluster.Namespace, cluster.Name)
+	}
+
+	seen := map[string]bool{}
+	apiVersions := []string{}
+	add := func(apiVersion string) {
+		if !seen[apiVersion] {
+			seen[apiVersion] = true
+			apiVersions = append(apiVersions, apiVersion)
+		}
+	}
+	for _, group := range groups {
+		for _, groupVersion := range group.Versions {
+			add(groupVersion.GroupVersion)
+		}
+	}
+	for _, resourceList := range resources {
+		for _, resource := range resourceList.APIResources {
+			add(path.Join(resourceList.GroupVersion, resource.Kind))
+		}
+	}
+	return version.GitVersion, apiVersions, nil
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// fakeRenderer renders a ConfigMap named after the Cluster and the resource.
+type fakeRenderer struct {
+	inputs []*RenderInput
+}
+
+func (f *fakeRenderer) Render(_ context.Context, input *RenderInput) ([]byte, error) {
+	f.inputs = append(f.inputs, input)
+	return []byte(fmt.Sprintf(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: %s-%s
+  namespace: kube-system
+`, input.Cluster.Name, input.ResourceRef.Name)), nil
+}
+
+func TestReconcileScopeForRenderedResource(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	crs := &addonsv1.ClusterResourceSet{
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Strategy: string(addonsv1.ClusterResourceSetStrategyReconcile),
+		},
+	}
+	resourceRef := addonsv1.ResourceRef{
+		Name: "csi",
+		Kind: string(addonsv1.KustomizationClusterResourceSetResourceKind),
+		Kustomization: &addonsv1.KustomizationResource{
+			SourceKind: "ConfigMap",
+		},
+	}
+	resource := testConfigMap(map[string][]byte{"kustomization.yaml": []byte("resources: []")})
+
+	t.Run("render resources with the Renderer", func(t *testing.T) {
+		g := NewWithT(t)
+
+		renderer := &fakeRenderer{}
+		r := &Reconciler{Renderer: renderer}
+
+		scope, err := reconcileScopeForResource(crs, cluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, r.resourceRenderer(ctx, cluster), 0)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(scope.needsApply()).To(BeTrue())
+		g.Expect(scope.objs()).To(HaveLen(1))
+		g.Expect(scope.objs()[0].GetName()).To(Equal("cluster1-csi"))
+
+		// The workload cluster is not discovered for kustomizations.
+		g.Expect(renderer.inputs).To(HaveLen(1))
+		g.Expect(renderer.inputs[0].Resource).To(Equal(resource))
+		g.Expect(renderer.inputs[0].KubernetesVersion).To(BeEmpty())
+		g.Expect(renderer.inputs[0].APIVersions).To(BeEmpty())
+
+		// The hash depends on the rendered objects, so it changes with the Cluster the resource is rendered for.
+		otherCluster := cluster.DeepCopy()
+		otherCluster.Name = "cluster2"
+		otherScope, err := reconcileScopeForResource(crs, otherCluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, r.resourceRenderer(ctx, otherCluster), 0)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(otherScope.hash()).ToNot(Equal(scope.hash()))
+	})
+
+	t.Run("fail if no Renderer is set", func(t *testing.T) {
+		g := NewWithT(t)
+
+		r := &Reconciler{}
+
+		_, err := reconcileScopeForResource(crs, cluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, r.resourceRenderer(ctx, cluster), 0)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("not enabled"))
+	})
+}
+
+func testConfigMap(data map[string][]byte) *unstructured.Unstructured {
+	plain := map[string]interface{}{}
+	for key, value := range data {
+		plain[key] = string(value)
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"data": plain}}
+	u.SetAPIVersion(corev1.SchemeGroupVersion.String())
+	u.SetKind("ConfigMap")
+	u.SetName("resource")
+	u.SetNamespace(metav1.NamespaceDefault)
+	return u
+}
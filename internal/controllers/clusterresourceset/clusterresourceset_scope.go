@@ -18,6 +18,7 @@ package clusterresourceset
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +28,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/util/ssa"
 )
 
 // resourceReconcileScope contains the scope for a CRS's resource
@@ -40,17 +43,53 @@ type resourceReconcileScope interface {
 	// hash returns a computed hash of the defined objects in the resource. It is consistent
 	// between runs.
 	hash() string
+	// objs returns the objects defined by the resource.
+	objs() []unstructured.Unstructured
+	// appliedObjects returns the objects that have to be tracked in the ResourceBinding after apply,
+	// so they can be pruned once they are removed from the resource.
+	appliedObjects() []addonsv1.ClusterResourceSetObjectReference
+	// staleObjects returns the objects applied previously which are not defined by the resource anymore.
+	staleObjects() []addonsv1.ClusterResourceSetObjectReference
+	// driftedObjects returns the objects that were modified or deleted out-of-band and have been re-applied.
+	driftedObjects() []addonsv1.ClusterResourceSetObjectReference
 }
 
 func reconcileScopeForResource(
 	crs *addonsv1.ClusterResourceSet,
+	cluster *clusterv1.Cluster,
 	resourceRef addonsv1.ResourceRef,
 	resourceSetBinding *addonsv1.ResourceSetBinding,
 	resource *unstructured.Unstructured,
+	render renderFunc,
+	driftCorrectionInterval time.Duration,
 ) (resourceReconcileScope, error) {
-	normalizedData, err := normalizeData(resource)
-	if err != nil {
-		return nil, err
+	var normalizedData [][]byte
+	switch resourceRef.Kind {
+	case string(addonsv1.HelmChartClusterResourceSetResourceKind), string(addonsv1.KustomizationClusterResourceSetResourceKind):
+		if render == nil {
+			return nil, errors.Errorf("failed to render %s %s: rendering Helm charts and kustomizations is not enabled", resourceRef.Kind, resourceRef.Name)
+		}
+		// Helm charts and kustomizations are rendered for the Cluster, so the hash changes when the rendered objects change.
+		rendered, err := render(resourceRef, resource)
+		if err != nil {
+			return nil, err
+		}
+		normalizedData = [][]byte{rendered}
+	default:
+		var err error
+		normalizedData, err = normalizeData(resource)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Variables are substituted per Cluster, so the hash changes when the substituted values change.
+	if resourceRef.VariableSubstitution == addonsv1.ResourceVariableSubstitutionEnabled {
+		var err error
+		normalizedData, err = substituteVariables(cluster, normalizedData)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to substitute variables in %s %s", resourceRef.Kind, resourceRef.Name)
+		}
 	}
 
 	objs, err := objsFromYamlData(normalizedData)
@@ -58,7 +97,7 @@ func reconcileScopeForResource(
 		return nil, err
 	}
 
-	return newResourceReconcileScope(crs, resourceRef, resourceSetBinding, normalizedData, objs)
+	return newResourceReconcileScope(crs, resourceRef, resourceSetBinding, normalizedData, objs, driftCorrectionInterval)
 }
 
 func newResourceReconcileScope(
@@ -67,6 +106,7 @@ func newResourceReconcileScope(
 	resourceSetBinding *addonsv1.ResourceSetBinding,
 	normalizedData [][]byte,
 	objs []unstructured.Unstructured,
+	driftCorrectionInterval time.Duration,
 ) (resourceReconcileScope, error) {
 	base := baseResourceReconcileScope{
 		clusterResourceSet: clusterResourceSet,
@@ -81,7 +121,11 @@ func newResourceReconcileScope(
 	case addonsv1.ClusterResourceSetStrategyApplyOnce:
 		return &reconcileApplyOnceScope{base}, nil
 	case addonsv1.ClusterResourceSetStrategyReconcile:
-		return &reconcileStrategyScope{base}, nil
+		return &reconcileStrategyScope{
+			baseResourceReconcileScope: base,
+			previousResourceBinding:    resourceSetBinding.GetResource(resourceRef),
+			driftCorrectionInterval:    driftCorrectionInterval,
+		}, nil
 	default:
 		return nil, errors.Errorf("unsupported or empty resource strategy: %q", clusterResourceSet.Spec.Strategy)
 	}
@@ -104,49 +148,143 @@ func (b baseResourceReconcileScope) hash() string {
 	return b.computedHash
 }
 
+func (b baseResourceReconcileScope) appliedObjects() []addonsv1.ClusterResourceSetObjectReference {
+	return nil
+}
+
+func (b baseResourceReconcileScope) staleObjects() []addonsv1.ClusterResourceSetObjectReference {
+	return nil
+}
+
+func (b baseResourceReconcileScope) driftedObjects() []addonsv1.ClusterResourceSetObjectReference {
+	return nil
+}
+
 type reconcileStrategyScope struct {
 	baseResourceReconcileScope
+
+	// previousResourceBinding is the ResourceBinding of the resource before it is applied.
+	// Note: resourceSetBinding is reset before apply, so apply must rely on previousResourceBinding.
+	previousResourceBinding *addonsv1.ResourceBinding
+	// driftCorrectionInterval is the interval after which the objects are re-applied even if the resource did not change.
+	driftCorrectionInterval time.Duration
+
+	applied bool
+	drifted []addonsv1.ClusterResourceSetObjectReference
 }
 
 func (r *reconcileStrategyScope) needsApply() bool {
 	resourceBinding := r.resourceSetBinding.GetResource(r.resourceRef)
 
-	return resourceBinding == nil || !resourceBinding.Applied || resourceBinding.Hash != r.computedHash
+	return resourceBinding == nil || !resourceBinding.Applied || resourceBinding.Hash != r.computedHash || r.needsDriftCorrection(resourceBinding)
+}
+
+// needsDriftCorrection returns true if the objects of an already applied resource have to be re-asserted.
+func (r *reconcileStrategyScope) needsDriftCorrection(resourceBinding *addonsv1.ResourceBinding) bool {
+	if r.driftCorrectionInterval <= 0 || resourceBinding.LastAppliedTime == nil {
+		return false
+	}
+	return time.Since(resourceBinding.LastAppliedTime.Time) >= r.driftCorrectionInterval
+}
+
+// isReassert returns true if the resource did not change since it was last applied successfully,
+// which means that every change applied to the objects in the cluster is a correction of drift.
+func (r *reconcileStrategyScope) isReassert() bool {
+	resourceBinding := r.previousResourceBinding
+	return resourceBinding != nil && resourceBinding.Applied && resourceBinding.Hash == r.computedHash
 }
 
 func (r *reconcileStrategyScope) apply(ctx context.Context, c client.Client) error {
-	return apply(ctx, c, r.applyObj, r.objs())
+	reassert := r.isReassert()
+	r.drifted = nil
+	err := apply(ctx, c, func(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+		drifted, err := r.applyObj(ctx, c, obj)
+		if err != nil {
+			return err
+		}
+		if reassert && drifted {
+			r.drifted = append(r.drifted, objectReference(obj))
+		}
+		return nil
+	}, r.objs())
+	r.applied = err == nil
+	return err
 }
 
-func (r *reconcileStrategyScope) applyObj(ctx context.Context, c client.Client, obj *unstructured.Unstructured) error {
+// applyObj applies an object using server-side apply and returns true if the object
+// was modified or deleted by someone else since it was last applied.
+func (r *reconcileStrategyScope) applyObj(ctx context.Context, c client.Client, obj *unstructured.Unstructured) (bool, error) {
 	currentObj := &unstructured.Unstructured{}
 	currentObj.SetAPIVersion(obj.GetAPIVersion())
 	currentObj.SetKind(obj.GetKind())
 	err := c.Get(ctx, client.ObjectKeyFromObject(obj), currentObj)
-	if apierrors.IsNotFound(err) {
-		return createUnstructured(ctx, c, obj)
-	}
-	if err != nil {
-		return errors.Wrapf(
+	if err != nil && !apierrors.IsNotFound(err) {
+		return false, errors.Wrapf(
 			err,
 			"reading object %s %s",
 			obj.GroupVersionKind(),
 			klog.KObj(obj),
 		)
 	}
+	exists := err == nil
 
-	patch := client.MergeFrom(currentObj.DeepCopy())
-	obj.SetResourceVersion(currentObj.GetResourceVersion())
-	if err = c.Patch(ctx, obj, patch); err != nil {
-		return errors.Wrapf(
+	// Note: objects created or patched before ClusterResourceSet adopted server-side apply are not
+	// managed by clusterResourceSetManagerName yet; the first apply is not considered a drift correction.
+	managed := exists && hasFieldsManagedBy(currentObj, clusterResourceSetManagerName)
+
+	// Drop the managed fields of the manager which created or patched the object before server-side apply was adopted,
+	// otherwise fields removed from the resource would be kept as still owned by that manager.
+	if exists && !managed {
+		if err := ssa.CleanUpManagedFieldsForSSAAdoption(ctx, c, currentObj, clusterResourceSetManagerName); err != nil {
+			return false, errors.Wrapf(
+				err,
+				"cleaning up managed fields of object %s %s",
+				obj.GroupVersionKind(),
+				klog.KObj(obj),
+			)
+		}
+	}
+
+	if err := c.Patch(ctx, obj, client.Apply, client.FieldOwner(clusterResourceSetManagerName), client.ForceOwnership); err != nil {
+		return false, errors.Wrapf(
 			err,
-			"patching object %s %s",
+			"applying object %s %s",
 			obj.GroupVersionKind(),
 			klog.KObj(obj),
 		)
 	}
 
-	return nil
+	if !exists {
+		return true, nil
+	}
+	return managed && currentObj.GetResourceVersion() != obj.GetResourceVersion(), nil
+}
+
+func (r *reconcileStrategyScope) appliedObjects() []addonsv1.ClusterResourceSetObjectReference {
+	current := objectReferences(r.objs())
+	if r.applied {
+		return current
+	}
+	// Keep tracking the objects applied previously, so they can be pruned once the resource is successfully applied.
+	return unionObjectReferences(r.previousObjects(), current)
+}
+
+func (r *reconcileStrategyScope) staleObjects() []addonsv1.ClusterResourceSetObjectReference {
+	if !r.applied {
+		return nil
+	}
+	return differenceObjectReferences(r.previousObjects(), objectReferences(r.objs()))
+}
+
+func (r *reconcileStrategyScope) driftedObjects() []addonsv1.ClusterResourceSetObjectReference {
+	return r.drifted
+}
+
+func (r *reconcileStrategyScope) previousObjects() []addonsv1.ClusterResourceSetObjectReference {
+	if r.previousResourceBinding == nil {
+		return nil
+	}
+	return r.previousResourceBinding.AppliedObjects
 }
 
 type reconcileApplyOnceScope struct {
@@ -18,14 +18,19 @@ package clusterresourceset
 
 import (
 	"context"
+	"reflect"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
 )
@@ -122,6 +127,60 @@ func TestReconcileStrategyScopeNeedsApply(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "applied ResourceBinding, same hash and drift correction interval not elapsed",
+			scope: &reconcileStrategyScope{
+				baseResourceReconcileScope: baseResourceReconcileScope{
+					resourceSetBinding: &addonsv1.ResourceSetBinding{
+						Resources: []addonsv1.ResourceBinding{
+							{
+								ResourceRef: addonsv1.ResourceRef{
+									Name: "cp",
+									Kind: "ConfigMap",
+								},
+								Applied:         true,
+								Hash:            "111",
+								LastAppliedTime: &metav1.Time{Time: time.Now().Add(-5 * time.Minute)},
+							},
+						},
+					},
+					resourceRef: addonsv1.ResourceRef{
+						Name: "cp",
+						Kind: "ConfigMap",
+					},
+					computedHash: "111",
+				},
+				driftCorrectionInterval: 10 * time.Minute,
+			},
+			want: false,
+		},
+		{
+			name: "applied ResourceBinding, same hash and drift correction interval elapsed",
+			scope: &reconcileStrategyScope{
+				baseResourceReconcileScope: baseResourceReconcileScope{
+					resourceSetBinding: &addonsv1.ResourceSetBinding{
+						Resources: []addonsv1.ResourceBinding{
+							{
+								ResourceRef: addonsv1.ResourceRef{
+									Name: "cp",
+									Kind: "ConfigMap",
+								},
+								Applied:         true,
+								Hash:            "111",
+								LastAppliedTime: &metav1.Time{Time: time.Now().Add(-15 * time.Minute)},
+							},
+						},
+					},
+					resourceRef: addonsv1.ResourceRef{
+						Name: "cp",
+						Kind: "ConfigMap",
+					},
+					computedHash: "111",
+				},
+				driftCorrectionInterval: 10 * time.Minute,
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -131,6 +190,228 @@ func TestReconcileStrategyScopeNeedsApply(t *testing.T) {
 	}
 }
 
+func TestReconcileStrategyScopeApply(t *testing.T) {
+	resourceRef := addonsv1.ResourceRef{
+		Name: "cp",
+		Kind: "ConfigMap",
+	}
+	data := [][]byte{[]byte("data")}
+	desiredObj := func(name, value string) unstructured.Unstructured {
+		return unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      name,
+					"namespace": "that-ns",
+				},
+				"data": map[string]interface{}{
+					"key": value,
+				},
+			},
+		}
+	}
+	existingObj := func(name, value string, managed bool) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "that-ns",
+			},
+			Data: map[string]string{
+				"key": value,
+			},
+		}
+		if managed {
+			cm.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: clusterResourceSetManagerName, Operation: metav1.ManagedFieldsOperationApply}}
+		}
+		return cm
+	}
+	objRef := func(name string) addonsv1.ClusterResourceSetObjectReference {
+		return addonsv1.ClusterResourceSetObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "that-ns", Name: name}
+	}
+	appliedBinding := func(appliedObjects ...addonsv1.ClusterResourceSetObjectReference) *addonsv1.ResourceSetBinding {
+		return &addonsv1.ResourceSetBinding{
+			Resources: []addonsv1.ResourceBinding{
+				{
+					ResourceRef:    resourceRef,
+					Applied:        true,
+					Hash:           computeHash(data),
+					AppliedObjects: appliedObjects,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name               string
+		resourceSetBinding *addonsv1.ResourceSetBinding
+		existingObjs       []client.Object
+		objs               []unstructured.Unstructured
+		wantApplied        []addonsv1.ClusterResourceSetObjectReference
+		wantStale          []addonsv1.ClusterResourceSetObjectReference
+		wantDrifted        []addonsv1.ClusterResourceSetObjectReference
+	}{
+		{
+			name:               "first apply creates the objects",
+			resourceSetBinding: &addonsv1.ResourceSetBinding{},
+			objs:               []unstructured.Unstructured{desiredObj("cm-1", "value")},
+			wantApplied:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+		},
+		{
+			name:               "re-assert does not report objects which did not change",
+			resourceSetBinding: appliedBinding(objRef("cm-1")),
+			existingObjs:       []client.Object{existingObj("cm-1", "value", true)},
+			objs:               []unstructured.Unstructured{desiredObj("cm-1", "value")},
+			wantApplied:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+		},
+		{
+			name:               "re-assert corrects and reports objects modified out-of-band",
+			resourceSetBinding: appliedBinding(objRef("cm-1")),
+			existingObjs:       []client.Object{existingObj("cm-1", "changed", true)},
+			objs:               []unstructured.Unstructured{desiredObj("cm-1", "value")},
+			wantApplied:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+			wantDrifted:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+		},
+		{
+			name:               "re-assert re-creates and reports objects deleted out-of-band",
+			resourceSetBinding: appliedBinding(objRef("cm-1")),
+			objs:               []unstructured.Unstructured{desiredObj("cm-1", "value")},
+			wantApplied:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+			wantDrifted:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+		},
+		{
+			name:               "re-assert does not report objects not yet applied with server-side apply",
+			resourceSetBinding: appliedBinding(),
+			existingObjs:       []client.Object{existingObj("cm-1", "changed", false)},
+			objs:               []unstructured.Unstructured{desiredObj("cm-1", "value")},
+			wantApplied:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+		},
+		{
+			name:               "objects removed from the resource are stale",
+			resourceSetBinding: &addonsv1.ResourceSetBinding{Resources: []addonsv1.ResourceBinding{{ResourceRef: resourceRef, Applied: true, Hash: "old", AppliedObjects: []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1"), objRef("cm-2")}}}},
+			existingObjs:       []client.Object{existingObj("cm-1", "value", true), existingObj("cm-2", "value", true)},
+			objs:               []unstructured.Unstructured{desiredObj("cm-1", "value")},
+			wantApplied:        []addonsv1.ClusterResourceSetObjectReference{objRef("cm-1")},
+			wantStale:          []addonsv1.ClusterResourceSetObjectReference{objRef("cm-2")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := NewWithT(t)
+			ctx := context.Background()
+			c := newFakeServerSideApplyClient(tt.existingObjs...)
+
+			crs := &addonsv1.ClusterResourceSet{Spec: addonsv1.ClusterResourceSetSpec{Strategy: string(addonsv1.ClusterResourceSetStrategyReconcile)}}
+			scope, err := newResourceReconcileScope(crs, resourceRef, tt.resourceSetBinding, data, tt.objs, 10*time.Minute)
+			gs.Expect(err).ToNot(HaveOccurred())
+			gs.Expect(scope.apply(ctx, c)).To(Succeed())
+
+			gs.Expect(scope.appliedObjects()).To(Equal(tt.wantApplied))
+			gs.Expect(scope.staleObjects()).To(Equal(tt.wantStale))
+			gs.Expect(scope.driftedObjects()).To(Equal(tt.wantDrifted))
+
+			for _, obj := range tt.objs {
+				cm := &corev1.ConfigMap{}
+				gs.Expect(c.Get(ctx, client.ObjectKeyFromObject(&obj), cm)).To(Succeed())
+				gs.Expect(cm.Data).To(HaveKeyWithValue("key", "value"))
+			}
+		})
+	}
+}
+
+func TestReconcileStrategyScopeApplyObjCleansUpManagedFields(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	// The object was created by a previous version of the controller, which did not use server-side apply.
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "cm-1",
+			Namespace:     "that-ns",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "manager", Operation: metav1.ManagedFieldsOperationUpdate}},
+		},
+		Data: map[string]string{
+			"key":     "value",
+			"removed": "value",
+		},
+	}
+	desired := unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "cm-1",
+				"namespace": "that-ns",
+			},
+			"data": map[string]interface{}{
+				"key": "value",
+			},
+		},
+	}
+
+	var managedFieldsBeforeApply []metav1.ManagedFieldsEntry
+	c := interceptor.NewClient(newFakeServerSideApplyClient(existing), interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() == types.ApplyPatchType {
+				current := &corev1.ConfigMap{}
+				if err := c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+					return err
+				}
+				managedFieldsBeforeApply = current.ManagedFields
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	})
+
+	crs := &addonsv1.ClusterResourceSet{Spec: addonsv1.ClusterResourceSetSpec{Strategy: string(addonsv1.ClusterResourceSetStrategyReconcile)}}
+	scope, err := newResourceReconcileScope(crs, addonsv1.ResourceRef{Name: "cp", Kind: "ConfigMap"}, &addonsv1.ResourceSetBinding{}, [][]byte{[]byte("data")}, []unstructured.Unstructured{desired}, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	drifted, err := scope.(*reconcileStrategyScope).applyObj(ctx, c, &desired)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(drifted).To(BeFalse())
+
+	// The fields owned by the previous manager are dropped before the first apply, so the apply takes ownership of all the fields.
+	g.Expect(managedFieldsBeforeApply).To(HaveLen(1))
+	g.Expect(managedFieldsBeforeApply[0].Manager).To(Equal(clusterResourceSetManagerName))
+	g.Expect(managedFieldsBeforeApply[0].Operation).To(Equal(metav1.ManagedFieldsOperationApply))
+}
+
+// newFakeServerSideApplyClient returns a fake client which emulates server-side apply for ConfigMaps,
+// because apply patches are not supported by the fake client.
+func newFakeServerSideApplyClient(objs ...client.Object) client.WithWatch {
+	return fake.NewClientBuilder().WithObjects(objs...).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+			desired := obj.(*unstructured.Unstructured)
+			managedFields := []metav1.ManagedFieldsEntry{{Manager: clusterResourceSetManagerName, Operation: metav1.ManagedFieldsOperationApply}}
+
+			current := &unstructured.Unstructured{}
+			current.SetGroupVersionKind(desired.GroupVersionKind())
+			if err := c.Get(ctx, client.ObjectKeyFromObject(desired), current); err != nil {
+				if !apierrors.IsNotFound(err) {
+					return err
+				}
+				desired.SetManagedFields(managedFields)
+				return c.Create(ctx, desired)
+			}
+			if reflect.DeepEqual(current.Object["data"], desired.Object["data"]) && hasFieldsManagedBy(current, clusterResourceSetManagerName) {
+				desired.Object = current.Object
+				return nil
+			}
+			current.Object["data"] = desired.Object["data"]
+			current.SetManagedFields(managedFields)
+			if err := c.Update(ctx, current); err != nil {
+				return err
+			}
+			desired.Object = current.Object
+			return nil
+		},
+	}).Build()
+}
+
 func TestReconcileApplyOnceScopeNeedsApply(t *testing.T) {
 	tests := []struct {
 		name  string
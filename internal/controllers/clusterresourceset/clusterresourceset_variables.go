@@ -0,0 +1,140 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/cmd/clusterctl/client/yamlprocessor"
+)
+
+const (
+	clusterLabelVariablePrefix      = "CLUSTER_LABEL_"
+	clusterAnnotationVariablePrefix = "CLUSTER_ANNOTATION_"
+	clusterTopologyVariablePrefix   = "CLUSTER_VARIABLE_"
+)
+
+// substituteVariables substitutes the variables in the data of a resource with the values computed from the Cluster,
+// using the same syntax supported by clusterctl for cluster templates, i.e. ${VAR} or ${VAR:=default}.
+func substituteVariables(cluster *clusterv1.Cluster, data [][]byte) ([][]byte, error) {
+	variables := clusterVariables(cluster)
+	processor := yamlprocessor.NewSimpleProcessor()
+
+	substituted := make([][]byte, 0, len(data))
+	for _, d := range data {
+		// Check for missing variables upfront, so the error points to the Cluster instead of the clusterctl configuration.
+		variableMap, err := processor.GetVariableMap(d)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse variables")
+		}
+		missing := []string{}
+		for name, defaultValue := range variableMap {
+			if _, ok := variables[name]; !ok && defaultValue == nil {
+				missing = append(missing, name)
+			}
+		}
+		if len(missing) > 0 {
+			sort.Strings(missing)
+			return nil, errors.Errorf("values for variables [%s] are not set for Cluster %s", strings.Join(missing, ", "), klog.KObj(cluster))
+		}
+
+		out, err := processor.Process(d, func(name string) (string, error) {
+			value, ok := variables[name]
+			if !ok {
+				return "", errors.Errorf("variable %s is not set", name)
+			}
+			return value, nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to substitute variables")
+		}
+		substituted = append(substituted, out)
+	}
+	return substituted, nil
+}
+
+// clusterVariables returns the variables which can be substituted in the resources of a ClusterResourceSet for a Cluster.
+// Variables for Cluster fields which are not set are omitted, so they must be used with a default value.
+func clusterVariables(cluster *clusterv1.Cluster) map[string]string {
+	variables := map[string]string{
+		"CLUSTER_NAME":      cluster.Name,
+		"CLUSTER_NAMESPACE": cluster.Namespace,
+	}
+
+	if network := cluster.Spec.ClusterNetwork; network != nil {
+		if network.Pods != nil && len(network.Pods.CIDRBlocks) > 0 {
+			variables["CLUSTER_POD_CIDR"] = network.Pods.CIDRBlocks[0]
+			variables["CLUSTER_POD_CIDRS"] = network.Pods.String()
+		}
+		if network.Services != nil && len(network.Services.CIDRBlocks) > 0 {
+			variables["CLUSTER_SERVICE_CIDR"] = network.Services.CIDRBlocks[0]
+			variables["CLUSTER_SERVICE_CIDRS"] = network.Services.String()
+		}
+		if network.ServiceDomain != "" {
+			variables["CLUSTER_SERVICE_DOMAIN"] = network.ServiceDomain
+		}
+		if network.APIServerPort != nil {
+			variables["CLUSTER_API_SERVER_PORT"] = strconv.Itoa(int(*network.APIServerPort))
+		}
+	}
+
+	for key, value := range cluster.Labels {
+		variables[variableName(clusterLabelVariablePrefix, key)] = value
+	}
+	for key, value := range cluster.Annotations {
+		variables[variableName(clusterAnnotationVariablePrefix, key)] = value
+	}
+
+	if cluster.Spec.Topology != nil {
+		for _, variable := range cluster.Spec.Topology.Variables {
+			variables[variableName(clusterTopologyVariablePrefix, variable.Name)] = variableValue(variable.Value.Raw)
+		}
+	}
+
+	return variables
+}
+
+// variableName returns the name of the variable for a label, annotation or topology variable, i.e.
+// the upper case key with all the characters which are not allowed in variable names replaced by _.
+func variableName(prefix, key string) string {
+	return prefix + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+}
+
+// variableValue returns the value of a topology variable; strings are unquoted, any other value is kept as JSON.
+func variableValue(raw []byte) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterresourceset
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestClusterVariables(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "cluster1",
+			Namespace:   metav1.NamespaceDefault,
+			Labels:      map[string]string{"cni.example.com/mtu": "1450"},
+			Annotations: map[string]string{"region": "eu-west"},
+		},
+		Spec: clusterv1.ClusterSpec{
+			ClusterNetwork: &clusterv1.ClusterNetwork{
+				APIServerPort: ptr.To[int32](6443),
+				Pods:          &clusterv1.NetworkRanges{CIDRBlocks: []string{"192.168.0.0/16", "fd00::/48"}},
+				Services:      &clusterv1.NetworkRanges{CIDRBlocks: []string{"10.128.0.0/12"}},
+				ServiceDomain: "cluster.local",
+			},
+			Topology: &clusterv1.Topology{
+				Variables: []clusterv1.ClusterVariable{
+					{Name: "imageRepository", Value: apiextensionsv1.JSON{Raw: []byte(`"registry.example.com"`)}},
+					{Name: "proxy", Value: apiextensionsv1.JSON{Raw: []byte(`{"http":"proxy:3128"}`)}},
+				},
+			},
+		},
+	}
+
+	g.Expect(clusterVariables(cluster)).To(Equal(map[string]string{
+		"CLUSTER_NAME":                      "cluster1",
+		"CLUSTER_NAMESPACE":                 metav1.NamespaceDefault,
+		"CLUSTER_POD_CIDR":                  "192.168.0.0/16",
+		"CLUSTER_POD_CIDRS":                 "192.168.0.0/16,fd00::/48",
+		"CLUSTER_SERVICE_CIDR":              "10.128.0.0/12",
+		"CLUSTER_SERVICE_CIDRS":             "10.128.0.0/12",
+		"CLUSTER_SERVICE_DOMAIN":            "cluster.local",
+		"CLUSTER_API_SERVER_PORT":           "6443",
+		"CLUSTER_LABEL_CNI_EXAMPLE_COM_MTU": "1450",
+		"CLUSTER_ANNOTATION_REGION":         "eu-west",
+		"CLUSTER_VARIABLE_IMAGEREPOSITORY":  "registry.example.com",
+		"CLUSTER_VARIABLE_PROXY":            `{"http":"proxy:3128"}`,
+	}))
+}
+
+func TestSubstituteVariables(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: clusterv1.ClusterSpec{
+			ClusterNetwork: &clusterv1.ClusterNetwork{
+				Pods: &clusterv1.NetworkRanges{CIDRBlocks: []string{"192.168.0.0/16"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "substitutes variables",
+			data: "name: ${CLUSTER_NAME}\ncidr: ${CLUSTER_POD_CIDR}",
+			want: "name: cluster1\ncidr: 192.168.0.0/16",
+		},
+		{
+			name: "uses default values for variables which are not set",
+			data: "domain: ${CLUSTER_SERVICE_DOMAIN:=cluster.local}\nname: ${CLUSTER_NAME:=other}",
+			want: "domain: cluster.local\nname: cluster1",
+		},
+		{
+			name:    "fails for variables which are not set and have no default value",
+			data:    "cidr: ${CLUSTER_SERVICE_CIDR}\nregion: ${CLUSTER_ANNOTATION_REGION}",
+			wantErr: "values for variables [CLUSTER_ANNOTATION_REGION, CLUSTER_SERVICE_CIDR] are not set for Cluster default/cluster1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			got, err := substituteVariables(cluster, [][]byte{[]byte(tt.data)})
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal([][]byte{[]byte(tt.want)}))
+		})
+	}
+}
+
+func TestReconcileScopeForSubstitutedResource(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	crs := &addonsv1.ClusterResourceSet{
+		Spec: addonsv1.ClusterResourceSetSpec{
+			Strategy: string(addonsv1.ClusterResourceSetStrategyReconcile),
+		},
+	}
+	resourceRef := addonsv1.ResourceRef{
+		Name:                 "resource",
+		Kind:                 string(addonsv1.ConfigMapClusterResourceSetResourceKind),
+		VariableSubstitution: addonsv1.ResourceVariableSubstitutionEnabled,
+	}
+	resource := testConfigMap(map[string][]byte{"cm.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cluster-info
+  namespace: kube-system
+data:
+  clusterName: ${CLUSTER_NAME}
+`)})
+
+	scope, err := reconcileScopeForResource(crs, cluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, nil, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The hash is computed after substitution, so it changes with the Cluster the variables are substituted for.
+	otherCluster := cluster.DeepCopy()
+	otherCluster.Name = "cluster2"
+	otherScope, err := reconcileScopeForResource(crs, otherCluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, nil, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(otherScope.hash()).ToNot(Equal(scope.hash()))
+
+	// Without variable substitution the content is applied as is.
+	resourceRef.VariableSubstitution = addonsv1.ResourceVariableSubstitutionDisabled
+	scope, err = reconcileScopeForResource(crs, cluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, nil, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+	otherScope, err = reconcileScopeForResource(crs, otherCluster, resourceRef, &addonsv1.ResourceSetBinding{}, resource, nil, 0)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(otherScope.hash()).To(Equal(scope.hash()))
+}
@@ -0,0 +1,541 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render implements the rendering of the Helm charts and the kustomizations referenced by ClusterResourceSets.
+package render
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"github.com/pkg/errors"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/kustomize/api/konfig"
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+	"sigs.k8s.io/yaml"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/controllers/clusterresourceset"
+)
+
+const (
+	// defaultHelmChartKey is the default key of the Secret or ConfigMap a Helm chart is stored in.
+	defaultHelmChartKey = "chart.tgz"
+
+	// defaultHelmReleaseNamespace is the default namespace of the release used when rendering a Helm chart.
+	defaultHelmReleaseNamespace = "default"
+
+	// ociImageIndexFile is the name of the image index file of an OCI image layout.
+	ociImageIndexFile = "index.json"
+
+	// helmChartLayerMediaType is the media type of the layer of an OCI artifact holding a Helm chart.
+	helmChartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	// maxRenderFileSize is the maximum size of a file extracted when rendering a resource.
+	maxRenderFileSize = 10 * 1024 * 1024
+
+	// maxRenderArchiveSize is the maximum total size of the files extracted from an archive when rendering a resource.
+	maxRenderArchiveSize = 100 * 1024 * 1024
+
+	// maxRenderArchiveEntries is the maximum number of entries of an archive extracted when rendering a resource.
+	maxRenderArchiveEntries = 10000
+)
+
+// NewRenderer returns a Renderer for the Helm charts and the kustomizations referenced by ClusterResourceSets.
+// Kustomizations are built only from the files stored in the Secret or ConfigMap; remote resources are not supported.
+// Rendering runs in the process of the caller, i.e. in the core manager.
+func NewRenderer() clusterresourceset.Renderer {
+	return &renderer{}
+}
+
+type renderer struct{}
+
+// Render renders the Helm chart or the kustomization stored in a Secret or ConfigMap for a Cluster.
+// The rendered objects are returned as a single YAML document.
+func (r *renderer) Render(_ context.Context, input *clusterresourceset.RenderInput) ([]byte, error) {
+	resourceRef, resource := input.ResourceRef, input.Resource
+	files, err := resourceFiles(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resourceRef.Kind {
+	case string(addonsv1.HelmChartClusterResourceSetResourceKind):
+		if resourceRef.HelmChart == nil {
+			return nil, errors.Errorf("failed to render resource %s: helmChart must be set", resourceRef.Name)
+		}
+		capabilities, err := helmCapabilities(input.KubernetesVersion, input.APIVersions)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render Helm chart from %s %s", resource.GetKind(), klog.KObj(resource))
+		}
+		rendered, err := renderHelmChart(input.Cluster, resourceRef.HelmChart, capabilities, files)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render Helm chart from %s %s", resource.GetKind(), klog.KObj(resource))
+		}
+		return rendered, nil
+	case string(addonsv1.KustomizationClusterResourceSetResourceKind):
+		if resourceRef.Kustomization == nil {
+			return nil, errors.Errorf("failed to render resource %s: kustomization must be set", resourceRef.Name)
+		}
+		rendered, err := renderKustomization(resourceRef.Kustomization, files)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render kustomization from %s %s", resource.GetKind(), klog.KObj(resource))
+		}
+		return rendered, nil
+	default:
+		return nil, errors.Errorf("failed to render resource %s: unsupported kind %q", resourceRef.Name, resourceRef.Kind)
+	}
+}
+
+// resourceFiles returns the content of a Secret or ConfigMap as files, one for each key.
+// Secret's data is base64 decoded, and ConfigMap's binaryData is included.
+func resourceFiles(resource *unstructured.Unstructured) (map[string][]byte, error) {
+	files := map[string][]byte{}
+
+	fields := []string{"data"}
+	if resource.GetKind() == string(addonsv1.ConfigMapClusterResourceSetResourceKind) {
+		fields = append(fields, "binaryData")
+	}
+	for _, field := range fields {
+		data, _, err := unstructured.NestedStringMap(resource.UnstructuredContent(), field)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get %s field from resource %s", field, klog.KObj(resource))
+		}
+		for key, val := range data {
+			// Secret's data and ConfigMap's binaryData need to be decoded.
+			if field == "binaryData" || resource.GetKind() == string(addonsv1.SecretClusterResourceSetResourceKind) {
+				decoded, err := base64.StdEncoding.DecodeString(val)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to decode value for field %s in %s from resource %s", key, field, klog.KObj(resource))
+				}
+				files[key] = decoded
+				continue
+			}
+			files[key] = []byte(val)
+		}
+	}
+	return files, nil
+}
+
+// helmCapabilities returns the capabilities of the workload cluster a Helm chart is rendered for.
+func helmCapabilities(kubernetesVersion string, apiVersions []string) (*chartutil.Capabilities, error) {
+	if kubernetesVersion == "" {
+		return nil, errors.New("the Kubernetes version of the workload cluster is required")
+	}
+	kubeVersion, err := chartutil.ParseKubeVersion(kubernetesVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse Kubernetes version %q", kubernetesVersion)
+	}
+
+	capabilities := chartutil.DefaultCapabilities.Copy()
+	capabilities.KubeVersion = *kubeVersion
+	if len(apiVersions) > 0 {
+		capabilities.APIVersions = chartutil.VersionSet(apiVersions)
+	}
+	return capabilities, nil
+}
+
+// renderHelmChart renders a Helm chart, either a packaged chart or a local OCI artifact, with values templated from the Cluster.
+// CRDs of the chart are rendered first, and the other objects are sorted in the order used by Helm to install them.
+// Hooks, tests and notes of the chart are not rendered.
+func renderHelmChart(cluster *clusterv1.Cluster, helmChart *addonsv1.HelmChartResource, capabilities *chartutil.Capabilities, files map[string][]byte) ([]byte, error) {
+	key := helmChart.Key
+	if key == "" {
+		key = defaultHelmChartKey
+	}
+	archive, ok := files[key]
+	if !ok {
+		return nil, errors.Errorf("key %q not found", key)
+	}
+
+	archive, err := chartArchiveFromOCILayout(archive)
+	if err != nil {
+		return nil, err
+	}
+	chrt, err := loader.LoadArchive(bytes.NewReader(archive))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load chart")
+	}
+
+	rawValues, err := templateHelmValues(cluster, helmChart.Values)
+	if err != nil {
+		return nil, err
+	}
+	values, err := chartutil.ReadValues(rawValues)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse values")
+	}
+
+	releaseOptions := chartutil.ReleaseOptions{
+		Name:      helmChart.ReleaseName,
+		Namespace: helmChart.ReleaseNamespace,
+		Revision:  1,
+		IsInstall: true,
+	}
+	if releaseOptions.Name == "" {
+		releaseOptions.Name = chrt.Name()
+	}
+	if releaseOptions.Namespace == "" {
+		releaseOptions.Namespace = defaultHelmReleaseNamespace
+	}
+	renderValues, err := chartutil.ToRenderValues(chrt, values, releaseOptions, capabilities)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute values")
+	}
+	renderedFiles, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to render templates")
+	}
+	for name := range renderedFiles {
+		if strings.HasSuffix(name, "NOTES.txt") {
+			delete(renderedFiles, name)
+		}
+	}
+	_, manifests, err := releaseutil.SortManifests(renderedFiles, nil, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sort rendered objects")
+	}
+
+	docs := []string{}
+	for _, crd := range chrt.CRDObjects() {
+		docs = append(docs, string(crd.File.Data))
+	}
+	for _, manifest := range manifests {
+		docs = append(docs, manifest.Content)
+	}
+	return []byte(strings.Join(docs, "\n---\n")), nil
+}
+
+// templateHelmValues executes the values of a Helm chart as a Go template with the Cluster.
+func templateHelmValues(cluster *clusterv1.Cluster, values string) ([]byte, error) {
+	if values == "" {
+		return nil, nil
+	}
+
+	tpl, err := template.New("values").Funcs(sprig.HermeticTxtFuncMap()).Option("missingkey=error").Parse(values)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse values template")
+	}
+	unstructuredCluster, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert Cluster %s to unstructured", klog.KObj(cluster))
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, map[string]interface{}{"Cluster": unstructuredCluster}); err != nil {
+		return nil, errors.Wrap(err, "failed to execute values template")
+	}
+	return buf.Bytes(), nil
+}
+
+// chartArchiveFromOCILayout returns the chart archive of a Helm chart stored as OCI artifact in an OCI image layout archive.
+// If the archive is not an OCI image layout, e.g. if it is a packaged chart, it is returned as is.
+func chartArchiveFromOCILayout(archive []byte) ([]byte, error) {
+	files, err := extractArchive(archive)
+	if err != nil {
+		// Not a valid archive; return it as is and let the chart loader surface the error.
+		return archive, nil //nolint:nilerr
+	}
+	index, ok := files[ociImageIndexFile]
+	if !ok {
+		return archive, nil
+	}
+
+	type descriptor struct {
+		MediaType string `json:"mediaType"`
+		Digest    string `json:"digest"`
+	}
+	blob := func(d descriptor) ([]byte, error) {
+		algorithm, encoded, ok := strings.Cut(d.Digest, ":")
+		if !ok {
+			return nil, errors.Errorf("invalid digest %q in OCI image layout", d.Digest)
+		}
+		data, ok := files[path.Join("blobs", algorithm, encoded)]
+		if !ok {
+			return nil, errors.Errorf("blob %s not found in OCI image layout", d.Digest)
+		}
+		return data, nil
+	}
+
+	imageIndex := struct {
+		Manifests []descriptor `json:"manifests"`
+	}{}
+	if err := json.Unmarshal(index, &imageIndex); err != nil {
+		return nil, errors.Wrap(err, "failed to parse OCI image index")
+	}
+	if len(imageIndex.Manifests) != 1 {
+		return nil, errors.Errorf("OCI image layout must contain exactly one manifest, found %d", len(imageIndex.Manifests))
+	}
+	rawManifest, err := blob(imageIndex.Manifests[0])
+	if err != nil {
+		return nil, err
+	}
+	manifest := struct {
+		Layers []descriptor `json:"layers"`
+	}{}
+	if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to parse OCI image manifest")
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == helmChartLayerMediaType {
+			return blob(layer)
+		}
+	}
+	return nil, errors.Errorf("OCI image manifest does not contain a layer with media type %s", helmChartLayerMediaType)
+}
+
+// renderKustomization builds a kustomization directory with the given files.
+// Archives, i.e. files with the .tar.gz or .tgz extension, are extracted into the kustomization directory.
+// The kustomization is built from an in-memory filesystem, and it must not reference remote resources.
+func renderKustomization(kustomization *addonsv1.KustomizationResource, files map[string][]byte) ([]byte, error) {
+	fSys := filesys.MakeFsInMemory()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !strings.HasSuffix(name, ".tar.gz") && !strings.HasSuffix(name, ".tgz") {
+			if err := writeKustomizationFile(fSys, name, files[name]); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		archiveFiles, err := extractArchive(files[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to extract %s", name)
+		}
+		for archiveFileName, data := range archiveFiles {
+			if err := writeKustomizationFile(fSys, archiveFileName, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Kustomize fetches remote bases and files with git and HTTP, and doesn't allow to disable it;
+	// references to anything but the files in the in-memory filesystem are rejected before building.
+	if err := validateKustomizationReferences(fSys); err != nil {
+		return nil, err
+	}
+
+	resMap, err := krusty.MakeKustomizer(krusty.MakeDefaultOptions()).Run(fSys, path.Join("/", kustomization.Path))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build kustomization")
+	}
+	return resMap.AsYaml()
+}
+
+// writeKustomizationFile writes a file into the kustomization directory.
+func writeKustomizationFile(fSys filesys.FileSystem, name string, data []byte) error {
+	filePath := path.Join("/", name)
+	if err := fSys.MkdirAll(path.Dir(filePath)); err != nil {
+		return errors.Wrapf(err, "failed to create directory for %s", name)
+	}
+	if err := fSys.WriteFile(filePath, data); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+// validateKustomizationReferences validates that the kustomization files in the filesystem only reference files and
+// directories in the filesystem.
+func validateKustomizationReferences(fSys filesys.FileSystem) error {
+	return fSys.Walk("/", func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !isKustomizationFile(path.Base(filePath)) {
+			return nil
+		}
+
+		data, err := fSys.ReadFile(filePath)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read %s", filePath)
+		}
+		kustomization := &types.Kustomization{}
+		if err := yaml.Unmarshal(data, kustomization); err != nil {
+			return errors.Wrapf(err, "failed to parse %s", filePath)
+		}
+		// Move the values of deprecated fields to the fields replacing them, as Kustomize does when loading the file.
+		kustomization.FixKustomization()
+
+		dir := path.Dir(filePath)
+		for _, ref := range kustomizationReferences(kustomization) {
+			if isRemoteReference(ref) {
+				return errors.Errorf("invalid kustomization %s: remote reference %q is not supported", filePath, ref)
+			}
+			if !path.IsAbs(ref) {
+				ref = path.Join(dir, ref)
+			}
+			if !fSys.Exists(ref) {
+				return errors.Errorf("invalid kustomization %s: %q not found", filePath, ref)
+			}
+		}
+		return nil
+	})
+}
+
+// isKustomizationFile returns true if the file name is one of the names of a kustomization file.
+func isKustomizationFile(name string) bool {
+	for _, kustomizationFileName := range konfig.RecognizedKustomizationFileNames() {
+		if name == kustomizationFileName {
+			return true
+		}
+	}
+	return false
+}
+
+// kustomizationReferences returns the paths of the files and directories referenced by a kustomization.
+// Inline values, e.g. inline patches or generator configurations, are not included.
+func kustomizationReferences(kustomization *types.Kustomization) []string {
+	refs := []string{}
+	refs = append(refs, kustomization.Resources...)
+	refs = append(refs, kustomization.Components...)
+	refs = append(refs, kustomization.Crds...)
+	refs = append(refs, kustomization.Configurations...)
+	for _, list := range [][]string{kustomization.Generators, kustomization.Transformers, kustomization.Validators} {
+		for _, ref := range list {
+			if !strings.Contains(ref, "\n") {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	if openAPIPath, ok := kustomization.OpenAPI["path"]; ok {
+		refs = append(refs, openAPIPath)
+	}
+	for _, patches := range [][]types.Patch{kustomization.Patches, kustomization.PatchesJson6902} { //nolint:staticcheck // Deprecated patches are still loaded by Kustomize.
+		for _, patch := range patches {
+			if patch.Path != "" {
+				refs = append(refs, patch.Path)
+			}
+		}
+	}
+	for _, patch := range kustomization.PatchesStrategicMerge { //nolint:staticcheck // Deprecated patches are still loaded by Kustomize.
+		if !strings.Contains(string(patch), "\n") {
+			refs = append(refs, string(patch))
+		}
+	}
+	for _, replacement := range kustomization.Replacements {
+		if replacement.Path != "" {
+			refs = append(refs, replacement.Path)
+		}
+	}
+	generatorArgs := []types.GeneratorArgs{}
+	for _, generator := range kustomization.ConfigMapGenerator {
+		generatorArgs = append(generatorArgs, generator.GeneratorArgs)
+	}
+	for _, generator := range kustomization.SecretGenerator {
+		generatorArgs = append(generatorArgs, generator.GeneratorArgs)
+	}
+	for _, args := range generatorArgs {
+		for _, fileSource := range args.FileSources {
+			// File sources are either a path or a key and a path separated by "=".
+			if _, filePath, ok := strings.Cut(fileSource, "="); ok {
+				fileSource = filePath
+			}
+			refs = append(refs, fileSource)
+		}
+		refs = append(refs, args.EnvSources...)
+	}
+	return refs
+}
+
+// isRemoteReference returns true if a reference in a kustomization would be fetched by Kustomize with git or HTTP,
+// i.e. if it has a URL scheme, the go-getter "git::" prefix, a "github.com" host without scheme, or an SCP-like
+// "user@host:path" form.
+func isRemoteReference(ref string) bool {
+	ref = strings.ToLower(strings.TrimSpace(ref))
+	if strings.Contains(ref, "://") || strings.HasPrefix(ref, "git::") ||
+		strings.HasPrefix(ref, "github.com/") || strings.HasPrefix(ref, "github.com:") {
+		return true
+	}
+	firstSegment, _, _ := strings.Cut(ref, "/")
+	return strings.Contains(firstSegment, "@")
+}
+
+// extractArchive returns the regular files of a tar archive, optionally gzip compressed, by their cleaned path.
+// The number of entries and the total size of the archive are capped, so a crafted archive cannot exhaust the memory of the controller.
+func extractArchive(archive []byte) (map[string][]byte, error) {
+	var r io.Reader = bytes.NewReader(archive)
+	if bytes.HasPrefix(archive, []byte{0x1f, 0x8b}) {
+		gzipReader, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read gzip archive")
+		}
+		defer gzipReader.Close()
+		r = gzipReader
+	}
+
+	files := map[string][]byte{}
+	entries := 0
+	var totalSize int64
+	tarReader := tar.NewReader(r)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read tar archive")
+		}
+		entries++
+		if entries > maxRenderArchiveEntries {
+			return nil, errors.Errorf("tar archive exceeds the maximum number of %d entries", maxRenderArchiveEntries)
+		}
+		// The size of skipped entries is counted too, because their content is read to get to the next entry.
+		totalSize += header.Size
+		if totalSize > maxRenderArchiveSize {
+			return nil, errors.Errorf("tar archive exceeds the maximum total size of %d bytes", maxRenderArchiveSize)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(header.Name, "/"))
+		if name == ".." || strings.HasPrefix(name, "../") {
+			return nil, errors.Errorf("invalid file path %s in tar archive", header.Name)
+		}
+		if header.Size > maxRenderFileSize {
+			return nil, errors.Errorf("file %s in tar archive exceeds the maximum size of %d bytes", header.Name, maxRenderFileSize)
+		}
+		data, err := io.ReadAll(io.LimitReader(tarReader, maxRenderFileSize))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s from tar archive", header.Name)
+		}
+		files[name] = data
+	}
+}
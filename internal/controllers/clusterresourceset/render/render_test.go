@@ -0,0 +1,510 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/controllers/clusterresourceset"
+	utilyaml "sigs.k8s.io/cluster-api/util/yaml"
+)
+
+var testChartFiles = map[string]string{
+	"cni/Chart.yaml": `apiVersion: v2
+name: cni
+version: 1.0.0
+`,
+	"cni/values.yaml": `podCIDR: 10.0.0.0/16
+`,
+	"cni/crds/crd.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: networks.cni.example.com
+`,
+	"cni/templates/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Release.Name }}-config
+  namespace: {{ .Release.Namespace }}
+data:
+  podCIDR: {{ .Values.podCIDR }}
+  clusterName: {{ .Values.clusterName }}
+  kubeVersion: {{ .Capabilities.KubeVersion.Version }}
+  {{- if .Capabilities.APIVersions.Has "cni.example.com/v1/Network" }}
+  networks: enabled
+  {{- end }}
+`,
+	"cni/templates/namespace.yaml": `apiVersion: v1
+kind: Namespace
+metadata:
+  name: {{ .Release.Namespace }}
+`,
+	"cni/templates/_helpers.tpl": `{{- define "cni.name" -}}cni{{- end -}}
+`,
+	"cni/templates/NOTES.txt": `Thanks for installing {{ include "cni.name" . }}.
+`,
+	"cni/templates/tests/test.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: cni-test
+  annotations:
+    helm.sh/hook: test
+`,
+}
+
+func TestRenderHelmChart(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	chart := testArchive(t, testChartFiles)
+
+	tests := []struct {
+		name              string
+		helmChart         *addonsv1.HelmChartResource
+		resource          *unstructured.Unstructured
+		kubernetesVersion string
+		apiVersions       []string
+		wantErr           bool
+		wantContains      []string
+		wantNotContains   []string
+	}{
+		{
+			name: "render a packaged chart stored in a Secret with values templated from the Cluster",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind:       "Secret",
+				ReleaseName:      "my-cni",
+				ReleaseNamespace: "kube-system",
+				Values:           "clusterName: {{ .Cluster.metadata.name }}",
+			},
+			resource:          testSecret(map[string][]byte{defaultHelmChartKey: chart}),
+			kubernetesVersion: "v1.33.0",
+			wantContains: []string{
+				"name: my-cni-config",
+				"namespace: kube-system",
+				"podCIDR: 10.0.0.0/16",
+				"clusterName: cluster1",
+			},
+		},
+		{
+			name: "render a local OCI artifact stored in a ConfigMap",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind: "ConfigMap",
+				Key:        "cni.tar",
+				Values:     "podCIDR: 192.168.0.0/16",
+			},
+			resource:          testConfigMap(map[string][]byte{"cni.tar": testOCILayout(t, chart)}),
+			kubernetesVersion: "v1.33.0",
+			wantContains: []string{
+				"name: cni-config",
+				"namespace: default",
+				"podCIDR: 192.168.0.0/16",
+			},
+		},
+		{
+			name: "render a chart with the capabilities of the workload cluster",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+			},
+			resource:          testSecret(map[string][]byte{defaultHelmChartKey: chart}),
+			kubernetesVersion: "v1.31.4",
+			apiVersions:       []string{"v1", "v1/ConfigMap", "cni.example.com/v1", "cni.example.com/v1/Network"},
+			wantContains: []string{
+				"kubeVersion: v1.31.4",
+				"networks: enabled",
+			},
+		},
+		{
+			name: "render a chart without API versions of the workload cluster",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+			},
+			resource:          testSecret(map[string][]byte{defaultHelmChartKey: chart}),
+			kubernetesVersion: "v1.32.1",
+			wantContains: []string{
+				"kubeVersion: v1.32.1",
+			},
+			wantNotContains: []string{
+				"networks: enabled",
+			},
+		},
+		{
+			name: "fail if the Kubernetes version of the workload cluster is not known",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+			},
+			resource: testSecret(map[string][]byte{defaultHelmChartKey: chart}),
+			wantErr:  true,
+		},
+		{
+			name: "fail if the key does not exist",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+				Key:        "missing.tgz",
+			},
+			resource:          testSecret(map[string][]byte{defaultHelmChartKey: chart}),
+			kubernetesVersion: "v1.33.0",
+			wantErr:           true,
+		},
+		{
+			name: "fail if the values template references a missing field",
+			helmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+				Values:     "clusterName: {{ .Cluster.metadata.missing }}",
+			},
+			resource:          testSecret(map[string][]byte{defaultHelmChartKey: chart}),
+			kubernetesVersion: "v1.33.0",
+			wantErr:           true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rendered, err := NewRenderer().Render(context.Background(), &clusterresourceset.RenderInput{
+				Cluster:           cluster,
+				ResourceRef:       addonsv1.ResourceRef{Name: "cni", Kind: string(addonsv1.HelmChartClusterResourceSetResourceKind), HelmChart: tt.helmChart},
+				Resource:          tt.resource,
+				KubernetesVersion: tt.kubernetesVersion,
+				APIVersions:       tt.apiVersions,
+			})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, s := range tt.wantContains {
+				g.Expect(string(rendered)).To(ContainSubstring(s))
+			}
+			for _, s := range tt.wantNotContains {
+				g.Expect(string(rendered)).ToNot(ContainSubstring(s))
+			}
+
+			objs, err := utilyaml.ToUnstructured(rendered)
+			g.Expect(err).ToNot(HaveOccurred())
+			// CRDs are rendered first, and hooks and notes are not rendered.
+			g.Expect(objs).To(HaveLen(3))
+			g.Expect(objs[0].GetKind()).To(Equal("CustomResourceDefinition"))
+			g.Expect(objs[1].GetKind()).To(Equal("Namespace"))
+			g.Expect(objs[2].GetKind()).To(Equal("ConfigMap"))
+		})
+	}
+}
+
+func TestRenderKustomization(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster1",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	base := testArchive(t, map[string]string{
+		"base/kustomization.yaml": `resources:
+- configmap.yaml
+`,
+		"base/configmap.yaml": `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: csi-config
+data:
+  driver: base
+`,
+	})
+
+	tests := []struct {
+		name          string
+		kustomization *addonsv1.KustomizationResource
+		resource      *unstructured.Unstructured
+		wantErr       bool
+		wantContains  []string
+	}{
+		{
+			name:          "render a kustomization stored in a ConfigMap",
+			kustomization: &addonsv1.KustomizationResource{SourceKind: "ConfigMap"},
+			resource: testConfigMap(map[string][]byte{
+				"kustomization.yaml": []byte(`namespace: kube-system
+resources:
+- configmap.yaml
+`),
+				"configmap.yaml": []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: csi-config
+`),
+			}),
+			wantContains: []string{
+				"name: csi-config",
+				"namespace: kube-system",
+			},
+		},
+		{
+			name:          "render an overlay with a base extracted from an archive stored in a Secret",
+			kustomization: &addonsv1.KustomizationResource{SourceKind: "Secret", Path: "overlay"},
+			resource: testSecret(map[string][]byte{
+				"base.tar.gz": base,
+				"overlay.tgz": testArchive(t, map[string]string{
+					"overlay/kustomization.yaml": `resources:
+- ../base
+namePrefix: prod-
+`,
+				}),
+			}),
+			wantContains: []string{
+				"name: prod-csi-config",
+				"driver: base",
+			},
+		},
+		{
+			name:          "fail if the kustomization does not exist",
+			kustomization: &addonsv1.KustomizationResource{SourceKind: "Secret", Path: "missing"},
+			resource:      testSecret(map[string][]byte{"base.tar.gz": base}),
+			wantErr:       true,
+		},
+		{
+			name:          "fail if a resource does not exist in the kustomization directory",
+			kustomization: &addonsv1.KustomizationResource{SourceKind: "ConfigMap"},
+			resource: testConfigMap(map[string][]byte{"kustomization.yaml": []byte(`resources:
+- missing.yaml
+`)}),
+			wantErr: true,
+		},
+		{
+			name:          "fail if an archived kustomization references a remote base",
+			kustomization: &addonsv1.KustomizationResource{SourceKind: "Secret"},
+			resource: testSecret(map[string][]byte{
+				"kustomization.yaml": []byte(`resources:
+- base
+`),
+				"base.tgz": testArchive(t, map[string]string{
+					"base/kustomization.yaml": `resources:
+- https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v5.0.0
+`,
+				}),
+			}),
+			wantErr: true,
+		},
+		{
+			name:          "fail if a patch is fetched over HTTP",
+			kustomization: &addonsv1.KustomizationResource{SourceKind: "ConfigMap"},
+			resource: testConfigMap(map[string][]byte{"kustomization.yaml": []byte(`patches:
+- path: http://example.com/patch.yaml
+`)}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rendered, err := NewRenderer().Render(context.Background(), &clusterresourceset.RenderInput{
+				Cluster:     cluster,
+				ResourceRef: addonsv1.ResourceRef{Name: "csi", Kind: string(addonsv1.KustomizationClusterResourceSetResourceKind), Kustomization: tt.kustomization},
+				Resource:    tt.resource,
+			})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			for _, s := range tt.wantContains {
+				g.Expect(string(rendered)).To(ContainSubstring(s))
+			}
+		})
+	}
+}
+
+func TestIsRemoteReference(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want bool
+	}{
+		{ref: "configmap.yaml", want: false},
+		{ref: "../base", want: false},
+		{ref: "/base/deployment.yaml", want: false},
+		{ref: "overlays/prod@v1/patch.yaml", want: false},
+		{ref: "https://github.com/kubernetes-sigs/kustomize//examples/multibases?ref=v5.0.0", want: true},
+		{ref: "http://example.com/deployment.yaml", want: true},
+		{ref: "file:///etc/kustomize", want: true},
+		{ref: "ssh://git@github.com/owner/repo", want: true},
+		{ref: "git::https://example.com/owner/repo", want: true},
+		{ref: "git@github.com:owner/repo", want: true},
+		{ref: "github.com/kubernetes-sigs/kustomize/examples/multibases", want: true},
+		{ref: "GitHub.com:owner/repo", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(isRemoteReference(tt.ref)).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestExtractArchive(t *testing.T) {
+	t.Run("extract the regular files of an archive", func(t *testing.T) {
+		g := NewWithT(t)
+
+		files, err := extractArchive(testArchive(t, map[string]string{"/a/b.yaml": "b", "c.yaml": "c"}))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(files).To(Equal(map[string][]byte{"a/b.yaml": []byte("b"), "c.yaml": []byte("c")}))
+	})
+
+	t.Run("fail if a file is outside of the archive root", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := extractArchive(testArchive(t, map[string]string{"../a.yaml": "a"}))
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("fail if the archive has too many entries", func(t *testing.T) {
+		g := NewWithT(t)
+
+		files := map[string]string{}
+		for i := 0; i <= maxRenderArchiveEntries; i++ {
+			files[fmt.Sprintf("file-%d.yaml", i)] = ""
+		}
+		_, err := extractArchive(testArchive(t, files))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("maximum number"))
+	})
+
+	t.Run("fail if the archive exceeds the maximum total size", func(t *testing.T) {
+		g := NewWithT(t)
+
+		files := map[string]string{}
+		content := strings.Repeat("a", maxRenderFileSize)
+		for i := 0; i <= maxRenderArchiveSize/maxRenderFileSize; i++ {
+			files[fmt.Sprintf("file-%d.yaml", i)] = content
+		}
+		_, err := extractArchive(testArchive(t, files))
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("maximum total size"))
+	})
+}
+
+func testSecret(data map[string][]byte) *unstructured.Unstructured {
+	encoded := map[string]interface{}{}
+	for key, value := range data {
+		encoded[key] = base64.StdEncoding.EncodeToString(value)
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"data": encoded}}
+	u.SetAPIVersion(corev1.SchemeGroupVersion.String())
+	u.SetKind("Secret")
+	u.SetName("resource")
+	u.SetNamespace(metav1.NamespaceDefault)
+	return u
+}
+
+func testConfigMap(data map[string][]byte) *unstructured.Unstructured {
+	plain := map[string]interface{}{}
+	binary := map[string]interface{}{}
+	for key, value := range data {
+		if bytes.HasPrefix(value, []byte{0x1f, 0x8b}) || bytes.HasSuffix([]byte(key), []byte(".tar")) {
+			binary[key] = base64.StdEncoding.EncodeToString(value)
+			continue
+		}
+		plain[key] = string(value)
+	}
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"data": plain, "binaryData": binary}}
+	u.SetAPIVersion(corev1.SchemeGroupVersion.String())
+	u.SetKind("ConfigMap")
+	u.SetName("resource")
+	u.SetNamespace(metav1.NamespaceDefault)
+	return u
+}
+
+// testArchive returns a gzip compressed tar archive with the given files.
+func testArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	writeTar(t, gzipWriter, func(name string) []byte { return []byte(files[name]) }, keys(files))
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// testOCILayout returns an uncompressed tar archive with an OCI image layout holding the given chart.
+func testOCILayout(t *testing.T, chart []byte) []byte {
+	t.Helper()
+
+	digest := func(data []byte) string {
+		return fmt.Sprintf("%x", sha256.Sum256(data))
+	}
+	config := []byte(`{"name":"cni","version":"1.0.0"}`)
+	manifest := []byte(fmt.Sprintf(`{"schemaVersion":2,"config":{"mediaType":"application/vnd.cncf.helm.config.v1+json","digest":"sha256:%s","size":%d},"layers":[{"mediaType":"%s","digest":"sha256:%s","size":%d}]}`,
+		digest(config), len(config), helmChartLayerMediaType, digest(chart), len(chart)))
+	files := map[string][]byte{
+		"oci-layout":                       []byte(`{"imageLayoutVersion":"1.0.0"}`),
+		ociImageIndexFile:                  []byte(fmt.Sprintf(`{"schemaVersion":2,"manifests":[{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"sha256:%s","size":%d}]}`, digest(manifest), len(manifest))),
+		"blobs/sha256/" + digest(config):   config,
+		"blobs/sha256/" + digest(manifest): manifest,
+		"blobs/sha256/" + digest(chart):    chart,
+	}
+
+	var buf bytes.Buffer
+	writeTar(t, &buf, func(name string) []byte { return files[name] }, keys(files))
+	return buf.Bytes()
+}
+
+func writeTar(t *testing.T, w interface{ Write([]byte) (int, error) }, data func(string) []byte, names []string) {
+	t.Helper()
+
+	tarWriter := tar.NewWriter(w)
+	for _, name := range names {
+		content := data(name)
+		if err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func keys[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	return names
+}
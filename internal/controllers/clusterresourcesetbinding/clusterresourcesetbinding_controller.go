@@ -18,6 +18,8 @@ package clusterresourcesetbinding
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -35,12 +37,16 @@ import (
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/cluster-api/util/predicates"
 )
 
 // +kubebuilder:rbac:groups=addons.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 
+// maxDriftedObjectsInMessage is the maximum number of drifted objects listed per ClusterResourceSet in the ResourcesInSync condition message.
+const maxDriftedObjectsInMessage = 5
+
 // Reconciler reconciles a ClusterResourceSetBinding object.
 type Reconciler struct {
 	Client client.Client
@@ -89,6 +95,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 	if err := r.updateClusterReference(ctx, binding); err != nil {
 		return ctrl.Result{}, err
 	}
+	if err := r.updateStatus(ctx, binding); err != nil {
+		return ctrl.Result{}, err
+	}
 	cluster, err := util.GetClusterByName(ctx, r.Client, req.Namespace, binding.Spec.ClusterName)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
@@ -156,6 +165,59 @@ func (r *Reconciler) updateClusterReference(ctx context.Context, binding *addons
 	return patchHelper.Patch(ctx, binding)
 }
 
+// updateStatus sets the ResourcesInSync condition based on the objects which drifted from the desired state
+// when they were last re-asserted by the ClusterResourceSet controller.
+func (r *Reconciler) updateStatus(ctx context.Context, binding *addonsv1.ClusterResourceSetBinding) error {
+	patchHelper, err := patch.NewHelper(binding, r.Client)
+	if err != nil {
+		return err
+	}
+
+	setResourcesInSyncCondition(binding)
+
+	return patchHelper.Patch(ctx, binding, patch.WithOwnedConditions{Conditions: []string{
+		addonsv1.ClusterResourceSetBindingResourcesInSyncCondition,
+	}})
+}
+
+func setResourcesInSyncCondition(binding *addonsv1.ClusterResourceSetBinding) {
+	messages := []string{}
+	for _, resourceSetBinding := range binding.Spec.Bindings {
+		if resourceSetBinding == nil {
+			continue
+		}
+		drifted := []string{}
+		for _, resource := range resourceSetBinding.Resources {
+			for _, obj := range resource.DriftedObjects {
+				drifted = append(drifted, obj.String())
+			}
+		}
+		if len(drifted) == 0 {
+			continue
+		}
+		if len(drifted) > maxDriftedObjectsInMessage {
+			drifted = append(drifted[:maxDriftedObjectsInMessage], fmt.Sprintf("... (%d more)", len(drifted)-maxDriftedObjectsInMessage))
+		}
+		messages = append(messages, fmt.Sprintf("* ClusterResourceSet %s: %s", resourceSetBinding.ClusterResourceSetName, strings.Join(drifted, ", ")))
+	}
+
+	if len(messages) > 0 {
+		conditions.Set(binding, metav1.Condition{
+			Type:    addonsv1.ClusterResourceSetBindingResourcesInSyncCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  addonsv1.ClusterResourceSetBindingResourcesDriftedReason,
+			Message: "Objects modified or deleted out-of-band have been re-applied:\n" + strings.Join(messages, "\n"),
+		})
+		return
+	}
+
+	conditions.Set(binding, metav1.Condition{
+		Type:   addonsv1.ClusterResourceSetBindingResourcesInSyncCondition,
+		Status: metav1.ConditionTrue,
+		Reason: addonsv1.ClusterResourceSetBindingResourcesInSyncReason,
+	})
+}
+
 func getClusterNameFromOwnerRef(obj metav1.ObjectMeta) (string, error) {
 	for _, ref := range obj.GetOwnerReferences() {
 		if ref.Kind != "Cluster" {
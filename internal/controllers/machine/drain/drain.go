@@ -358,9 +358,49 @@ evictionLoop:
 		default:
 		}
 
+		if pd.Status.DeleteInsteadOfEvict {
+			log.V(4).Info("Deleting Pod")
+
+			err := d.deletePod(ctx, pd)
+			switch {
+			case err == nil:
+				log.V(4).Info("Pod deletion successfully triggered")
+				res.PodsDeleted = append(res.PodsDeleted, pd.Pod)
+			case apierrors.IsNotFound(err):
+				log.V(4).Info("Deletion not needed, Pod doesn't exist anymore")
+				res.PodsNotFound = append(res.PodsNotFound, pd.Pod)
+			default:
+				msg := fmt.Sprintf("failed to delete Pod, %v", err)
+				log.V(4).Info("Error when deleting Pod", "err", err)
+				res.PodsFailedEviction[msg] = append(res.PodsFailedEviction[msg], pd.Pod)
+			}
+			continue
+		}
+
 		log.V(4).Info("Evicting Pod")
 
-		err := d.evictPod(ctx, pd.Pod)
+		err := d.evictPod(ctx, pd)
+		if err != nil && !apierrors.IsNotFound(err) && d.evictionTimeoutExpired(ctx, pd) {
+			// The Pod could not be evicted within the eviction timeout, delete it.
+			log.V(4).Info("Eviction timeout expired, deleting Pod", "err", err)
+			deleteErr := d.deletePod(ctx, pd)
+			switch {
+			case deleteErr == nil:
+				log.V(4).Info("Pod deletion successfully triggered")
+				res.PodsDeletedAfterEvictionTimeout = append(res.PodsDeletedAfterEvictionTimeout, pd.Pod)
+				continue
+			case apierrors.IsNotFound(deleteErr):
+				log.V(4).Info("Deletion not needed, Pod doesn't exist anymore")
+				res.PodsNotFound = append(res.PodsNotFound, pd.Pod)
+				continue
+			default:
+				msg := fmt.Sprintf("failed to delete Pod after eviction timeout expired, %v", deleteErr)
+				log.V(4).Info("Error when deleting Pod", "err", deleteErr)
+				res.PodsFailedEviction[msg] = append(res.PodsFailedEviction[msg], pd.Pod)
+				continue
+			}
+		}
+
 		switch {
 		case err == nil:
 			log.V(4).Info("Pod eviction successfully triggered")
@@ -431,12 +471,9 @@ func minDrainOrderOfPodsToDrain(pds []PodDelete) int32 {
 }
 
 // evictPod evicts the given Pod, or return an error if it couldn't.
-func (d *Helper) evictPod(ctx context.Context, pod *corev1.Pod) error {
-	delOpts := metav1.DeleteOptions{}
-	if d.GracePeriodSeconds >= 0 {
-		gracePeriodSeconds := int64(d.GracePeriodSeconds)
-		delOpts.GracePeriodSeconds = &gracePeriodSeconds
-	}
+func (d *Helper) evictPod(ctx context.Context, pd PodDelete) error {
+	pod := pd.Pod
+	delOpts := d.deleteOptions(pd)
 
 	eviction := &policyv1.Eviction{
 		ObjectMeta: metav1.ObjectMeta{
@@ -449,6 +486,59 @@ func (d *Helper) evictPod(ctx context.Context, pod *corev1.Pod) error {
 	return d.RemoteClient.SubResource("eviction").Create(ctx, pod, eviction)
 }
 
+// deletePod deletes the given Pod without using the Eviction API, or return an error if it couldn't.
+func (d *Helper) deletePod(ctx context.Context, pd PodDelete) error {
+	var opts []client.DeleteOption
+	if gracePeriodSeconds := d.deleteOptions(pd).GracePeriodSeconds; gracePeriodSeconds != nil {
+		opts = append(opts, client.GracePeriodSeconds(*gracePeriodSeconds))
+	}
+	return d.RemoteClient.Delete(ctx, pd.Pod, opts...)
+}
+
+// deleteOptions returns the DeleteOptions to be used when evicting or deleting a Pod.
+// The grace period of the Helper takes precedence over the grace period defined for the Pod, if any.
+func (d *Helper) deleteOptions(pd PodDelete) metav1.DeleteOptions {
+	delOpts := metav1.DeleteOptions{}
+	switch {
+	case d.GracePeriodSeconds >= 0:
+		gracePeriodSeconds := int64(d.GracePeriodSeconds)
+		delOpts.GracePeriodSeconds = &gracePeriodSeconds
+	case pd.Status.GracePeriodSeconds != nil:
+		delOpts.GracePeriodSeconds = ptr.To(*pd.Status.GracePeriodSeconds)
+	}
+	return delOpts
+}
+
+// evictionTimeoutExpired returns true if the eviction timeout of the Pod expired.
+// The eviction timeout is measured from the first failed eviction of the Pod, which is recorded
+// on the Pod using the PodEvictionStartTimeAnnotation.
+func (d *Helper) evictionTimeoutExpired(ctx context.Context, pd PodDelete) bool {
+	if pd.Status.EvictionTimeout == nil {
+		return false
+	}
+
+	if value, ok := pd.Pod.Annotations[clusterv1.PodEvictionStartTimeAnnotation]; ok {
+		evictionStartTime, err := time.Parse(time.RFC3339, value)
+		if err == nil {
+			return time.Since(evictionStartTime) >= *pd.Status.EvictionTimeout
+		}
+		// Invalid values are overwritten, so the eviction timeout is measured from now on.
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	patch := client.MergeFrom(pd.Pod.DeepCopy())
+	if pd.Pod.Annotations == nil {
+		pd.Pod.Annotations = map[string]string{}
+	}
+	pd.Pod.Annotations[clusterv1.PodEvictionStartTimeAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := d.RemoteClient.Patch(ctx, pd.Pod, patch); err != nil {
+		// The eviction start time will be recorded on the next eviction attempt.
+		log.V(4).Info("Failed to record the eviction start time on the Pod", "err", err)
+		return false
+	}
+	return *pd.Status.EvictionTimeout <= 0
+}
+
 // EvictionResult contains the results of an eviction.
 type EvictionResult struct {
 	PodsDeletionTimestampSet   []*corev1.Pod
@@ -458,13 +548,20 @@ type EvictionResult struct {
 	PodsToWaitCompletedLater   []*corev1.Pod
 	PodsNotFound               []*corev1.Pod
 	PodsIgnored                []*corev1.Pod
+
+	// PodsDeleted are Pods which have been deleted instead of evicted.
+	PodsDeleted []*corev1.Pod
+	// PodsDeletedAfterEvictionTimeout are Pods which have been deleted because
+	// they could not be evicted within the eviction timeout.
+	PodsDeletedAfterEvictionTimeout []*corev1.Pod
 }
 
 // DrainCompleted returns if a Node is entirely drained, i.e. if all relevant Pods have gone away.
 func (r EvictionResult) DrainCompleted() bool {
 	return len(r.PodsDeletionTimestampSet) == 0 && len(r.PodsFailedEviction) == 0 &&
 		len(r.PodsToTriggerEvictionLater) == 0 && len(r.PodsToWaitCompletedLater) == 0 &&
-		len(r.PodsToWaitCompletedNow) == 0
+		len(r.PodsToWaitCompletedNow) == 0 && len(r.PodsDeleted) == 0 &&
+		len(r.PodsDeletedAfterEvictionTimeout) == 0
 }
 
 // ConditionMessage returns a condition message for the case where a drain is not completed.
@@ -484,6 +581,22 @@ func (r EvictionResult) ConditionMessage(nodeDrainStartTime *metav1.Time) string
 		conditionMessage = fmt.Sprintf("%s\n* %s %s: deletionTimestamp set, but still not removed from the Node",
 			conditionMessage, kind, PodListToString(r.PodsDeletionTimestampSet, 3))
 	}
+	if len(r.PodsDeleted) > 0 {
+		kind := "Pod"
+		if len(r.PodsDeleted) > 1 {
+			kind = "Pods"
+		}
+		conditionMessage = fmt.Sprintf("%s\n* %s %s: deleted instead of evicted",
+			conditionMessage, kind, PodListToString(r.PodsDeleted, 3))
+	}
+	if len(r.PodsDeletedAfterEvictionTimeout) > 0 {
+		kind := "Pod"
+		if len(r.PodsDeletedAfterEvictionTimeout) > 1 {
+			kind = "Pods"
+		}
+		conditionMessage = fmt.Sprintf("%s\n* %s %s: deleted after eviction timeout expired",
+			conditionMessage, kind, PodListToString(r.PodsDeletedAfterEvictionTimeout, 3))
+	}
 	if len(r.PodsFailedEviction) > 0 {
 		sortedFailureMessages := slices.Sorted(maps.Keys(r.PodsFailedEviction))
 
@@ -501,7 +614,8 @@ func (r EvictionResult) ConditionMessage(nodeDrainStartTime *metav1.Time) string
 			// Note: the code computing stale warning for the machine deleting condition is making assumptions on the format/content of this message.
 			// Same applies for other conditions where deleting is involved, e.g. MachineSet's Deleting and ScalingDown condition.
 			failureMessage = strings.ReplaceAll(failureMessage, "Cannot evict pod as it would violate the pod's disruption budget.", "cannot evict pod as it would violate the pod's disruption budget.")
-			if !strings.HasPrefix(failureMessage, "cannot evict pod as it would violate the pod's disruption budget.") {
+			if !strings.HasPrefix(failureMessage, "cannot evict pod as it would violate the pod's disruption budget.") &&
+				!strings.HasPrefix(failureMessage, "failed to delete Pod") {
 				failureMessage = "failed to evict Pod, " + failureMessage
 			}
 			conditionMessage = fmt.Sprintf("%s\n* %s %s: %s", conditionMessage, kind, PodListToString(pods, 3), failureMessage)
@@ -26,6 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -166,6 +167,30 @@ func TestGetPodsForEviction(t *testing.T) {
 			},
 		},
 	}
+	mdrDeleteJobs := &clusterv1.MachineDrainRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mdr-delete-jobs",
+			Namespace: "test-namespace",
+		},
+		Spec: clusterv1.MachineDrainRuleSpec{
+			Drain: clusterv1.MachineDrainRuleDrainConfig{
+				Behavior:               clusterv1.MachineDrainRuleDrainBehaviorDrain,
+				EvictionTimeoutSeconds: ptr.To[int32](300),
+				GracePeriodSeconds:     ptr.To[int32](10),
+				DeleteOwnerKinds:       []string{"Job"},
+			},
+			Machines: nil, // Match all machines
+			Pods: []clusterv1.MachineDrainRulePodSelector{
+				{
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"app": "delete-jobs",
+						},
+					},
+				},
+			},
+		},
+	}
 	mdrBehaviorUnknown := &clusterv1.MachineDrainRule{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "mdr-behavior-unknown",
@@ -816,6 +841,77 @@ func TestGetPodsForEviction(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "machineDrainRulesFilter with eviction options",
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod-1-owned-by-job",
+						Namespace: "test-namespace",
+						Labels: map[string]string{
+							"app": "delete-jobs", // matches mdrDeleteJobs.
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: "batch/v1",
+								Kind:       "Job",
+								Name:       "job-1",
+								Controller: ptr.To(true),
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod-2-owned-by-replicaset",
+						Namespace: "test-namespace",
+						Labels: map[string]string{
+							"app": "delete-jobs", // matches mdrDeleteJobs.
+						},
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: "apps/v1",
+								Kind:       "ReplicaSet",
+								Name:       "replicaset-1",
+								Controller: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			machineDrainRules: []*clusterv1.MachineDrainRule{mdrDeleteJobs},
+			wantPodDeleteList: PodDeleteList{items: []PodDelete{
+				{
+					Pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pod-1-owned-by-job",
+							Namespace: "test-namespace",
+						},
+					},
+					Status: PodDeleteStatus{
+						DrainBehavior:        clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						EvictionTimeout:      ptr.To(5 * time.Minute),
+						GracePeriodSeconds:   ptr.To[int64](10),
+						DeleteInsteadOfEvict: true,
+						Reason:               PodDeleteStatusTypeOkay,
+					},
+				},
+				{
+					Pod: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "pod-2-owned-by-replicaset",
+							Namespace: "test-namespace",
+						},
+					},
+					Status: PodDeleteStatus{
+						DrainBehavior:      clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						EvictionTimeout:    ptr.To(5 * time.Minute),
+						GracePeriodSeconds: ptr.To[int64](10),
+						Reason:             PodDeleteStatusTypeOkay,
+					},
+				},
+			}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1719,6 +1815,123 @@ func TestEvictPods(t *testing.T) {
 	}
 }
 
+func TestEvictPodsWithDeletion(t *testing.T) {
+	g := NewWithT(t)
+
+	jobPod := func(name string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+		}
+	}
+	// Pods whose eviction failed for the first time 10 minutes ago.
+	jobPodWithEvictionStartTime := func(name string) *corev1.Pod {
+		pod := jobPod(name)
+		pod.Annotations = map[string]string{
+			clusterv1.PodEvictionStartTimeAnnotation: time.Now().Add(-10 * time.Minute).UTC().Format(time.RFC3339),
+		}
+		return pod
+	}
+	drainStatus := func(evictionTimeout *time.Duration, deleteInsteadOfEvict bool) PodDeleteStatus {
+		return PodDeleteStatus{
+			DrainBehavior:        clusterv1.MachineDrainRuleDrainBehaviorDrain,
+			DrainOrder:           ptr.To[int32](0),
+			EvictionTimeout:      evictionTimeout,
+			GracePeriodSeconds:   ptr.To[int64](5),
+			DeleteInsteadOfEvict: deleteInsteadOfEvict,
+			Reason:               PodDeleteStatusTypeOkay,
+		}
+	}
+
+	podDeleteList := &PodDeleteList{items: []PodDelete{
+		{
+			Pod:    jobPod("pod-1-to-delete"),
+			Status: drainStatus(nil, true),
+		},
+		{
+			Pod:    jobPodWithEvictionStartTime("pod-2-pdb-violated-eviction-timeout-expired"),
+			Status: drainStatus(ptr.To(time.Minute), false),
+		},
+		{
+			Pod:    jobPodWithEvictionStartTime("pod-3-pdb-violated-eviction-timeout-not-expired"),
+			Status: drainStatus(ptr.To(time.Hour), false),
+		},
+		{
+			Pod:    jobPod("pod-4-pdb-violated-no-eviction-timeout"),
+			Status: drainStatus(nil, false),
+		},
+		{
+			// The eviction timeout is measured from the first failed eviction of the Pod, not from the start of the drain.
+			Pod:    jobPod("pod-5-pdb-violated-first-eviction"),
+			Status: drainStatus(ptr.To(time.Minute), false),
+		},
+	}}
+
+	pdbViolatedErr := &apierrors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusTooManyRequests,
+			Reason:  metav1.StatusReasonTooManyRequests,
+			Message: "Cannot evict pod as it would violate the pod's disruption budget.",
+		},
+	}
+
+	objs := []client.Object{}
+	for _, pd := range podDeleteList.items {
+		objs = append(objs, pd.Pod.DeepCopy())
+	}
+	deletedPods := map[string]int64{}
+	fakeClient := interceptor.NewClient(fake.NewClientBuilder().WithObjects(objs...).Build(), interceptor.Funcs{
+		SubResourceCreate: func(_ context.Context, _ client.Client, subResourceName string, _ client.Object, subResource client.Object, _ ...client.SubResourceCreateOption) error {
+			g.Expect(subResourceName).To(Equal("eviction"))
+			eviction := subResource.(*policyv1.Eviction)
+			g.Expect(eviction.DeleteOptions.GracePeriodSeconds).To(Equal(ptr.To[int64](5)))
+			return pdbViolatedErr
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			deleteOpts := &client.DeleteOptions{}
+			deleteOpts.ApplyOptions(opts)
+			deletedPods[obj.GetName()] = ptr.Deref(deleteOpts.GracePeriodSeconds, -1)
+			return c.Delete(ctx, obj, opts...)
+		},
+	})
+
+	drainer := &Helper{
+		RemoteClient:       fakeClient,
+		GracePeriodSeconds: -1,
+	}
+
+	gotEvictionResult := drainer.EvictPods(context.Background(), podDeleteList)
+	g.Expect(gotEvictionResult.PodsDeleted).To(HaveLen(1))
+	g.Expect(gotEvictionResult.PodsDeleted[0].Name).To(Equal("pod-1-to-delete"))
+	g.Expect(gotEvictionResult.PodsDeletedAfterEvictionTimeout).To(HaveLen(1))
+	g.Expect(gotEvictionResult.PodsDeletedAfterEvictionTimeout[0].Name).To(Equal("pod-2-pdb-violated-eviction-timeout-expired"))
+	g.Expect(gotEvictionResult.PodsFailedEviction).To(HaveLen(1))
+	g.Expect(gotEvictionResult.PodsFailedEviction["Cannot evict pod as it would violate the pod's disruption budget."]).To(HaveLen(3))
+	g.Expect(gotEvictionResult.DrainCompleted()).To(BeFalse())
+	g.Expect(deletedPods).To(Equal(map[string]int64{
+		"pod-1-to-delete": 5,
+		"pod-2-pdb-violated-eviction-timeout-expired": 5,
+	}))
+
+	// The first failed eviction is recorded only on Pods with an eviction timeout.
+	pod := &corev1.Pod{}
+	g.Expect(fakeClient.Get(context.Background(), client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "pod-5-pdb-violated-first-eviction"}, pod)).To(Succeed())
+	g.Expect(pod.Annotations).To(HaveKey(clusterv1.PodEvictionStartTimeAnnotation))
+	g.Expect(fakeClient.Get(context.Background(), client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "pod-4-pdb-violated-no-eviction-timeout"}, pod)).To(Succeed())
+	g.Expect(pod.Annotations).ToNot(HaveKey(clusterv1.PodEvictionStartTimeAnnotation))
+
+	// The eviction timeout expires once it is over since the first failed eviction.
+	g.Expect(fakeClient.Get(context.Background(), client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "pod-5-pdb-violated-first-eviction"}, pod)).To(Succeed())
+	pod.Annotations[clusterv1.PodEvictionStartTimeAnnotation] = time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339)
+	gotEvictionResult = drainer.EvictPods(context.Background(), &PodDeleteList{items: []PodDelete{
+		{Pod: pod, Status: drainStatus(ptr.To(time.Minute), false)},
+	}})
+	g.Expect(gotEvictionResult.PodsDeletedAfterEvictionTimeout).To(HaveLen(1))
+}
+
 func TestEvictionResult_ConditionMessage(t *testing.T) {
 	g := NewWithT(t)
 
@@ -1781,6 +1994,43 @@ func TestEvictionResult_ConditionMessage(t *testing.T) {
 * Pod pod-5-to-trigger-eviction-pdb-violated-1: cannot evict pod as it would violate the pod's disruption budget. The disruption budget pod-5-pdb needs 20 healthy pods and has 20 currently
 * Pod pod-6-to-trigger-eviction-some-other-error: failed to evict Pod, some other error 1
 After above Pods have been removed from the Node, the following Pods will be evicted: pod-7-eviction-later, pod-8-eviction-later`,
+		},
+		{
+			name: "Compute condition message correctly for deleted Pods",
+			evictionResult: EvictionResult{
+				PodsDeleted: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-1-deleted",
+						},
+					},
+				},
+				PodsDeletedAfterEvictionTimeout: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-2-deleted-after-eviction-timeout-1",
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "pod-2-deleted-after-eviction-timeout-2",
+						},
+					},
+				},
+				PodsFailedEviction: map[string][]*corev1.Pod{
+					"failed to delete Pod, some error": {
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "pod-3-failed-deletion",
+							},
+						},
+					},
+				},
+			},
+			wantConditionMessage: `Drain not completed yet (started at 2024-10-09T16:13:59Z):
+* Pod pod-1-deleted: deleted instead of evicted
+* Pods pod-2-deleted-after-eviction-timeout-1, pod-2-deleted-after-eviction-timeout-2: deleted after eviction timeout expired
+* Pod pod-3-failed-deletion: failed to delete Pod, some error`,
 		},
 		{
 			name: "Compute long condition message correctly",
@@ -19,6 +19,7 @@ package drain
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -113,6 +114,18 @@ type PodDeleteStatus struct {
 	// DrainOrder is only used if DrainBehavior is "Drain".
 	DrainOrder *int32
 
+	// EvictionTimeout defines after which time, measured from the start of the drain, a Pod that
+	// cannot be evicted is deleted. EvictionTimeout is only used if DrainBehavior is "Drain".
+	EvictionTimeout *time.Duration
+
+	// GracePeriodSeconds overrides the grace period used when evicting or deleting the Pod.
+	// GracePeriodSeconds is only used if DrainBehavior is "Drain".
+	GracePeriodSeconds *int64
+
+	// DeleteInsteadOfEvict defines if the Pod is deleted instead of evicted.
+	// DeleteInsteadOfEvict is only used if DrainBehavior is "Drain".
+	DeleteInsteadOfEvict bool
+
 	Reason  string
 	Message string
 }
@@ -316,7 +329,18 @@ func (d *Helper) machineDrainRulesFilter(machineDrainRules []*clusterv1.MachineD
 			log := ctrl.LoggerFrom(ctx, "Pod", klog.KObj(pod))
 			switch mdr.Spec.Drain.Behavior {
 			case clusterv1.MachineDrainRuleDrainBehaviorDrain:
-				return MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
+				status := MakePodDeleteStatusOkayWithOrder(mdr.Spec.Drain.Order)
+				if mdr.Spec.Drain.EvictionTimeoutSeconds != nil {
+					status.EvictionTimeout = ptr.To(time.Duration(*mdr.Spec.Drain.EvictionTimeoutSeconds) * time.Second)
+				}
+				if mdr.Spec.Drain.GracePeriodSeconds != nil {
+					status.GracePeriodSeconds = ptr.To(int64(*mdr.Spec.Drain.GracePeriodSeconds))
+				}
+				if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && slices.Contains(mdr.Spec.Drain.DeleteOwnerKinds, controllerRef.Kind) {
+					log.V(4).Info(fmt.Sprintf("Pod will be deleted instead of evicted, because MachineDrainRule %s applies to Pods owned by %s", mdr.Name, controllerRef.Kind))
+					status.DeleteInsteadOfEvict = true
+				}
+				return status
 			case clusterv1.MachineDrainRuleDrainBehaviorSkip:
 				log.V(4).Info(fmt.Sprintf("Skip evicting Pod, because MachineDrainRule %s with behavior %s applies to the Pod", mdr.Name, clusterv1.MachineDrainRuleDrainBehaviorSkip))
 				return MakePodDeleteStatusSkip()
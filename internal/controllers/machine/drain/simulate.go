@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// SimulationResult contains the results of a drain simulation.
+type SimulationResult struct {
+	// PodsToEvict are the Pods that would be evicted (or deleted) by the drain.
+	PodsToEvict []*corev1.Pod
+	// PodsToWaitCompleted are the Pods that would not be evicted, but the drain would wait for their completion.
+	PodsToWaitCompleted []*corev1.Pod
+	// PodsDeletionTimestampSet are the Pods that are already terminating.
+	PodsDeletionTimestampSet []*corev1.Pod
+	// PodsIgnored are the Pods that would be ignored by the drain.
+	PodsIgnored []*corev1.Pod
+
+	// PodsNotFitting are the Pods to evict which do not fit on the remaining Nodes
+	// considering their resource requests.
+	PodsNotFitting []*corev1.Pod
+	// RemainingNodes are the names of the Nodes considered for rescheduling the Pods to evict.
+	RemainingNodes []string
+}
+
+// CapacityAvailable returns true if all the Pods to evict fit on the remaining Nodes.
+func (r SimulationResult) CapacityAvailable() bool {
+	return len(r.PodsNotFitting) == 0
+}
+
+// SimulateDrain simulates the drain of the Nodes hosted on the given Machines, without cordoning Nodes
+// or evicting Pods. It reports which Pods would be evicted, ignored or waited for, and checks if the Pods
+// to evict fit on the remaining schedulable and ready Nodes of the workload cluster.
+// Note: The capacity check only considers CPU, memory and Pod count based on Pod resource requests;
+// other scheduling constraints like affinity, taints or topology spread constraints are not considered.
+func (d *Helper) SimulateDrain(ctx context.Context, cluster *clusterv1.Cluster, machines []*clusterv1.Machine) (*SimulationResult, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	res := &SimulationResult{}
+	drainedNodes := sets.Set[string]{}
+	for _, machine := range machines {
+		if machine.Status.NodeRef == nil {
+			continue
+		}
+		nodeName := machine.Status.NodeRef.Name
+		drainedNodes.Insert(nodeName)
+
+		podDeleteList, err := d.GetPodsForEviction(ctx, cluster, machine, nodeName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to simulate drain of Node %s", nodeName)
+		}
+		for _, pd := range podDeleteList.items {
+			switch {
+			case pd.Status.DrainBehavior == clusterv1.MachineDrainRuleDrainBehaviorDrain && pd.Pod.DeletionTimestamp.IsZero():
+				res.PodsToEvict = append(res.PodsToEvict, pd.Pod)
+			case pd.Status.DrainBehavior == clusterv1.MachineDrainRuleDrainBehaviorDrain:
+				res.PodsDeletionTimestampSet = append(res.PodsDeletionTimestampSet, pd.Pod)
+			case pd.Status.DrainBehavior == clusterv1.MachineDrainRuleDrainBehaviorWaitCompleted:
+				res.PodsToWaitCompleted = append(res.PodsToWaitCompleted, pd.Pod)
+			default:
+				res.PodsIgnored = append(res.PodsIgnored, pd.Pod)
+			}
+		}
+	}
+
+	if len(res.PodsToEvict) == 0 {
+		return res, nil
+	}
+
+	nodes, err := d.remainingNodesCapacity(ctx, drainedNodes)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to simulate drain")
+	}
+	for _, n := range nodes {
+		res.RemainingNodes = append(res.RemainingNodes, n.name)
+	}
+
+	// Place the biggest Pods first, so smaller Pods can use the remaining space.
+	podsToEvict := make([]*corev1.Pod, len(res.PodsToEvict))
+	copy(podsToEvict, res.PodsToEvict)
+	sort.SliceStable(podsToEvict, func(i, j int) bool {
+		ri, rj := PodRequests(podsToEvict[i]), PodRequests(podsToEvict[j])
+		if c := ri.Cpu().Cmp(*rj.Cpu()); c != 0 {
+			return c > 0
+		}
+		return ri.Memory().Cmp(*rj.Memory()) > 0
+	})
+	for _, pod := range podsToEvict {
+		requests := PodRequests(pod)
+		fits := false
+		for _, n := range nodes {
+			if n.fits(requests) {
+				n.add(requests)
+				fits = true
+				break
+			}
+		}
+		if !fits {
+			log.V(4).Info("Pod does not fit on the remaining Nodes", "Pod", klog.KObj(pod))
+			res.PodsNotFitting = append(res.PodsNotFitting, pod)
+		}
+	}
+	return res, nil
+}
+
+// nodeCapacity tracks the resources available on a Node.
+type nodeCapacity struct {
+	name     string
+	cpu      resource.Quantity
+	memory   resource.Quantity
+	podCount int64
+}
+
+func (n *nodeCapacity) fits(requests corev1.ResourceList) bool {
+	return n.podCount >= 1 && n.cpu.Cmp(*requests.Cpu()) >= 0 && n.memory.Cmp(*requests.Memory()) >= 0
+}
+
+func (n *nodeCapacity) add(requests corev1.ResourceList) {
+	n.cpu.Sub(*requests.Cpu())
+	n.memory.Sub(*requests.Memory())
+	n.podCount--
+}
+
+// remainingNodesCapacity returns the resources available on schedulable and ready Nodes which are not drained.
+func (d *Helper) remainingNodesCapacity(ctx context.Context, drainedNodes sets.Set[string]) ([]*nodeCapacity, error) {
+	nodeList := &corev1.NodeList{}
+	if err := d.RemoteClient.List(ctx, nodeList); err != nil {
+		return nil, errors.Wrapf(err, "failed to list Nodes")
+	}
+
+	capacities := map[string]*nodeCapacity{}
+	for _, node := range nodeList.Items {
+		if drainedNodes.Has(node.Name) || node.Spec.Unschedulable || !isNodeReady(&node) {
+			continue
+		}
+		capacities[node.Name] = &nodeCapacity{
+			name:     node.Name,
+			cpu:      node.Status.Allocatable.Cpu().DeepCopy(),
+			memory:   node.Status.Allocatable.Memory().DeepCopy(),
+			podCount: node.Status.Allocatable.Pods().Value(),
+		}
+	}
+	if len(capacities) == 0 {
+		return nil, nil
+	}
+
+	// Subtract the resources requested by the Pods running on the Nodes.
+	podList := &corev1.PodList{}
+	for {
+		listOpts := []client.ListOption{
+			client.InNamespace(metav1.NamespaceAll),
+			client.Continue(podList.Continue),
+			client.Limit(100),
+		}
+		if err := d.RemoteClient.List(ctx, podList, listOpts...); err != nil {
+			return nil, errors.Wrapf(err, "failed to list Pods")
+		}
+		for _, pod := range podList.Items {
+			n, ok := capacities[pod.Spec.NodeName]
+			if !ok || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			n.add(PodRequests(&pod))
+		}
+		if podList.Continue == "" {
+			break
+		}
+	}
+
+	nodes := make([]*nodeCapacity, 0, len(capacities))
+	for _, n := range capacities {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].name < nodes[j].name
+	})
+	return nodes, nil
+}
+
+// PodRequests returns the CPU and memory requested by a Pod, computed like the scheduler does,
+// i.e. the max between the sum of the requests of the containers and the requests of each init container,
+// plus the Pod overhead.
+func PodRequests(pod *corev1.Pod) corev1.ResourceList {
+	cpu := resource.Quantity{}
+	memory := resource.Quantity{}
+	for _, c := range pod.Spec.Containers {
+		cpu.Add(*c.Resources.Requests.Cpu())
+		memory.Add(*c.Resources.Requests.Memory())
+	}
+	for _, c := range pod.Spec.InitContainers {
+		if c.Resources.Requests.Cpu().Cmp(cpu) > 0 {
+			cpu = c.Resources.Requests.Cpu().DeepCopy()
+		}
+		if c.Resources.Requests.Memory().Cmp(memory) > 0 {
+			memory = c.Resources.Requests.Memory().DeepCopy()
+		}
+	}
+	cpu.Add(*pod.Spec.Overhead.Cpu())
+	memory.Add(*pod.Spec.Overhead.Memory())
+	return corev1.ResourceList{
+		corev1.ResourceCPU:    cpu,
+		corev1.ResourceMemory: memory,
+	}
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// SimulationMessage returns a message describing why the Pods to evict cannot be rescheduled, if any.
+func (r SimulationResult) SimulationMessage() string {
+	if r.CapacityAvailable() {
+		return ""
+	}
+	kind := "Pod"
+	if len(r.PodsNotFitting) > 1 {
+		kind = "Pods"
+	}
+	return fmt.Sprintf("%s %s cannot be rescheduled on the remaining Nodes because of insufficient CPU, memory or Pod capacity",
+		kind, PodListToString(r.PodsNotFitting, 3))
+}
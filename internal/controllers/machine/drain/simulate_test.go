@@ -0,0 +1,228 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drain
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestSimulateDrain(t *testing.T) {
+	node := func(name string, cpu string, ready, unschedulable bool) *corev1.Node {
+		readyStatus := corev1.ConditionTrue
+		if !ready {
+			readyStatus = corev1.ConditionFalse
+		}
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+					corev1.ResourcePods:   resource.MustParse("110"),
+				},
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: readyStatus},
+				},
+			},
+		}
+	}
+	pod := func(name, nodeName, cpu string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+				Containers: []corev1.Container{
+					{
+						Name: "container",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(cpu),
+								corev1.ResourceMemory: resource.MustParse("1Gi"),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	mirrorPod := pod("pod-mirror", "node-1", "100m")
+	mirrorPod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: ""}
+	succeededPod := pod("pod-succeeded", "node-2", "2")
+	succeededPod.Status.Phase = corev1.PodSucceeded
+
+	tests := []struct {
+		name               string
+		objs               []client.Object
+		wantPodsToEvict    []string
+		wantPodsIgnored    []string
+		wantPodsNotFitting []string
+		wantRemainingNodes []string
+	}{
+		{
+			name: "all Pods fit on the remaining Nodes",
+			objs: []client.Object{
+				node("node-1", "4", true, false),
+				node("node-2", "4", true, false),
+				pod("pod-1", "node-1", "1"),
+				pod("pod-2", "node-1", "2"),
+				pod("pod-3", "node-2", "500m"),
+				mirrorPod,
+				succeededPod,
+			},
+			wantPodsToEvict:    []string{"pod-1", "pod-2"},
+			wantPodsIgnored:    []string{"pod-mirror"},
+			wantRemainingNodes: []string{"node-2"},
+		},
+		{
+			name: "Pods do not fit on the remaining Nodes",
+			objs: []client.Object{
+				node("node-1", "4", true, false),
+				node("node-2", "2", true, false),
+				node("node-3", "8", true, true),
+				node("node-4", "8", false, false),
+				pod("pod-1", "node-1", "1"),
+				pod("pod-2", "node-1", "2"),
+				pod("pod-3", "node-2", "500m"),
+				mirrorPod,
+				succeededPod,
+			},
+			wantPodsToEvict:    []string{"pod-1", "pod-2"},
+			wantPodsIgnored:    []string{"pod-mirror"},
+			wantPodsNotFitting: []string{"pod-2"},
+			wantRemainingNodes: []string{"node-2"},
+		},
+		{
+			name: "Pods do not fit if there are no remaining Nodes",
+			objs: []client.Object{
+				node("node-1", "4", true, false),
+				pod("pod-1", "node-1", "1"),
+			},
+			wantPodsToEvict:    []string{"pod-1"},
+			wantPodsNotFitting: []string{"pod-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			objs := append([]client.Object{&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault},
+			}}, tt.objs...)
+			fakeRemoteClient := fake.NewClientBuilder().
+				WithObjects(objs...).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", podByNodeName).
+				Build()
+			scheme := runtime.NewScheme()
+			g.Expect(clusterv1.AddToScheme(scheme)).To(Succeed())
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			drainer := &Helper{
+				Client:       fakeClient,
+				RemoteClient: fakeRemoteClient,
+			}
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+			}
+			machines := []*clusterv1.Machine{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine-1", Namespace: metav1.NamespaceDefault},
+					Status:     clusterv1.MachineStatus{NodeRef: &clusterv1.MachineNodeReference{Name: "node-1"}},
+				},
+				{
+					// Machines without a Node are ignored.
+					ObjectMeta: metav1.ObjectMeta{Name: "machine-2", Namespace: metav1.NamespaceDefault},
+				},
+			}
+
+			res, err := drainer.SimulateDrain(context.Background(), cluster, machines)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(podNames(res.PodsToEvict)).To(ConsistOf(tt.wantPodsToEvict))
+			g.Expect(podNames(res.PodsIgnored)).To(ConsistOf(tt.wantPodsIgnored))
+			g.Expect(podNames(res.PodsNotFitting)).To(ConsistOf(tt.wantPodsNotFitting))
+			g.Expect(res.RemainingNodes).To(ConsistOf(tt.wantRemainingNodes))
+			g.Expect(res.CapacityAvailable()).To(Equal(len(tt.wantPodsNotFitting) == 0))
+		})
+	}
+}
+
+func TestPodRequests(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{
+				{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("3"),
+							corev1.ResourceMemory: resource.MustParse("100Mi"),
+						},
+					},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+					},
+				},
+				{
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("500m"),
+						},
+					},
+				},
+			},
+			Overhead: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+				corev1.ResourceMemory: resource.MustParse("10Mi"),
+			},
+		},
+	}
+
+	requests := PodRequests(pod)
+	g.Expect(requests.Cpu().MilliValue()).To(Equal(int64(3100)))
+	g.Expect(requests.Memory().Equal(resource.MustParse("1034Mi"))).To(BeTrue())
+}
+
+func podNames(pods []*corev1.Pod) []string {
+	names := []string{}
+	for _, p := range pods {
+		names = append(names, p.Name)
+	}
+	return names
+}
@@ -49,12 +49,15 @@ import (
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/api/core/v1beta2/index"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+	"sigs.k8s.io/cluster-api/internal/hooks"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/cache"
@@ -97,6 +100,9 @@ type Reconciler struct {
 	APIReader    client.Reader
 	ClusterCache clustercache.ClusterCache
 
+	// RuntimeClient is used to call the Machine lifecycle hooks.
+	RuntimeClient runtimeclient.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
@@ -206,6 +212,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 
 	ctx = ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("Cluster", klog.KRef(m.Namespace, m.Spec.ClusterName)))
 
+	// Track the intent to call the BeforeMachineCreate and AfterMachineProvisioned hooks for new Machines,
+	// i.e. Machines which do not have the Machine finalizer yet.
+	if r.lifecycleHooksEnabled() && m.DeletionTimestamp.IsZero() && !controllerutil.ContainsFinalizer(m, clusterv1.MachineFinalizer) {
+		if err := hooks.MarkAsPending(ctx, r.Client, m, runtimehooksv1.BeforeMachineCreate, runtimehooksv1.AfterMachineProvisioned); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Add finalizer first if not set to avoid the race condition between init and delete.
 	if finalizerAdded, err := finalizers.EnsureFinalizer(ctx, r.Client, m, clusterv1.MachineFinalizer); err != nil || finalizerAdded {
 		return ctrl.Result{}, err
@@ -265,9 +279,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Re
 
 	alwaysReconcile := []machineReconcileFunc{
 		r.reconcileMachineOwnerAndLabels,
+		r.reconcileBeforeMachineCreateHook,
 		r.reconcileBootstrap,
 		r.reconcileInfrastructure,
 		r.reconcileNode,
+		r.reconcileAfterMachineProvisionedHook,
 		r.reconcileCertificateExpiry,
 	}
 
@@ -471,6 +487,13 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 			s.deletingMessage = fmt.Sprintf("Waiting for pre-drain hooks to succeed (hooks: %s)", strings.Join(hooks, ","))
 			return ctrl.Result{}, nil
 		}
+
+		// BeforeMachineDrain lifecycle hook, called only if the Node is going to be drained.
+		if r.isNodeDrainAllowed(m) {
+			if result, err := r.reconcileBeforeMachineDrainHook(ctx, s); err != nil || !result.IsZero() {
+				return result, err
+			}
+		}
 		v1beta1conditions.MarkTrue(m, clusterv1.PreDrainDeleteHookSucceededV1Beta1Condition)
 
 		// Drain node before deletion and issue a patch in order to make this operation visible to the users.
@@ -568,6 +591,11 @@ func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) (ctrl.Result
 		s.deletingMessage = fmt.Sprintf("Waiting for pre-terminate hooks to succeed (hooks: %s)", strings.Join(hooks, ","))
 		return ctrl.Result{}, nil
 	}
+
+	// BeforeMachineDelete lifecycle hook.
+	if result, err := r.reconcileBeforeMachineDeleteHook(ctx, s); err != nil || !result.IsZero() {
+		return result, err
+	}
 	v1beta1conditions.MarkTrue(m, clusterv1.PreTerminateDeleteHookSucceededV1Beta1Condition)
 
 	infrastructureDeleted, err := r.reconcileDeleteInfrastructure(ctx, s)
@@ -832,7 +860,6 @@ func (r *Reconciler) drainNode(ctx context.Context, s *scope) (ctrl.Result, erro
 		RemoteClient:       remoteClient,
 		GracePeriodSeconds: -1,
 	}
-
 	if noderefutil.IsNodeUnreachable(node) {
 		// Kubelet is unreachable, pods will never disappear.
 
@@ -875,6 +902,12 @@ func (r *Reconciler) drainNode(ctx context.Context, s *scope) (ctrl.Result, erro
 	log.Info("Draining Node")
 
 	evictionResult := drainer.EvictPods(ctx, podDeleteList)
+	for _, pod := range evictionResult.PodsDeleted {
+		r.recorder.Eventf(machine, corev1.EventTypeNormal, "DeletedPod", "Pod %s deleted instead of evicted while draining Machine's node %q", klog.KObj(pod), nodeName)
+	}
+	for _, pod := range evictionResult.PodsDeletedAfterEvictionTimeout {
+		r.recorder.Eventf(machine, corev1.EventTypeWarning, "DeletedPodAfterEvictionTimeout", "Pod %s deleted after eviction timeout expired while draining Machine's node %q", klog.KObj(pod), nodeName)
+	}
 
 	if evictionResult.DrainCompleted() {
 		log.Info("Drain completed, remaining Pods on the Node have been evicted")
@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	v1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
+)
+
+// lifecycleHooksEnabled returns true if the Machine lifecycle hooks should be called.
+func (r *Reconciler) lifecycleHooksEnabled() bool {
+	return r.RuntimeClient != nil && feature.Gates.Enabled(feature.RuntimeSDK)
+}
+
+// isWaitingForBeforeMachineCreateHook returns true if the provisioning of the Machine must not start yet
+// because the BeforeMachineCreate hook did not complete.
+func (r *Reconciler) isWaitingForBeforeMachineCreateHook(m *clusterv1.Machine) bool {
+	return r.lifecycleHooksEnabled() && m.DeletionTimestamp.IsZero() && hooks.IsPending(runtimehooksv1.BeforeMachineCreate, m)
+}
+
+// reconcileBeforeMachineCreateHook calls the BeforeMachineCreate hook until all the Runtime Extensions return a
+// non-blocking response. Until then BootstrapConfig and InfrastructureMachine are not linked to the Machine,
+// and thus the provisioning of the Machine does not start.
+func (r *Reconciler) reconcileBeforeMachineCreateHook(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !r.isWaitingForBeforeMachineCreateHook(s.machine) {
+		return ctrl.Result{}, nil
+	}
+
+	v1beta1Cluster, v1beta1Machine, err := convertForLifecycleHookRequest(s.cluster, s.machine)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	hookRequest := &runtimehooksv1.BeforeMachineCreateRequest{
+		Cluster: *v1beta1Cluster,
+		Machine: *v1beta1Machine,
+	}
+	hookResponse := &runtimehooksv1.BeforeMachineCreateResponse{}
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeMachineCreate, s.machine, hookRequest, hookResponse); err != nil {
+		return ctrl.Result{}, err
+	}
+	if hookResponse.RetryAfterSeconds != 0 {
+		log.Info(fmt.Sprintf("Machine provisioning is blocked by %q hook", runtimecatalog.HookName(runtimehooksv1.BeforeMachineCreate)))
+		return ctrl.Result{RequeueAfter: time.Duration(hookResponse.RetryAfterSeconds) * time.Second}, nil
+	}
+
+	return ctrl.Result{}, hooks.MarkAsDone(ctx, r.Client, s.machine, runtimehooksv1.BeforeMachineCreate)
+}
+
+// reconcileAfterMachineProvisionedHook calls the AfterMachineProvisioned hook once the Node hosted on the Machine
+// joined the Cluster for the first time.
+func (r *Reconciler) reconcileAfterMachineProvisionedHook(ctx context.Context, s *scope) (ctrl.Result, error) {
+	if !r.lifecycleHooksEnabled() || !s.machine.DeletionTimestamp.IsZero() || s.machine.Status.NodeRef == nil ||
+		!hooks.IsPending(runtimehooksv1.AfterMachineProvisioned, s.machine) {
+		return ctrl.Result{}, nil
+	}
+
+	v1beta1Cluster, v1beta1Machine, err := convertForLifecycleHookRequest(s.cluster, s.machine)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	hookRequest := &runtimehooksv1.AfterMachineProvisionedRequest{
+		Cluster: *v1beta1Cluster,
+		Machine: *v1beta1Machine,
+	}
+	hookResponse := &runtimehooksv1.AfterMachineProvisionedResponse{}
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.AfterMachineProvisioned, s.machine, hookRequest, hookResponse); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, hooks.MarkAsDone(ctx, r.Client, s.machine, runtimehooksv1.AfterMachineProvisioned)
+}
+
+// reconcileBeforeMachineDrainHook calls the BeforeMachineDrain hook before the Node hosted on the Machine is drained.
+// The hook is called until all the Runtime Extensions return a non-blocking response; it is not called anymore
+// once the drain started.
+func (r *Reconciler) reconcileBeforeMachineDrainHook(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machine
+
+	if !r.lifecycleHooksEnabled() || (m.Status.Deletion != nil && m.Status.Deletion.NodeDrainStartTime != nil) {
+		return ctrl.Result{}, nil
+	}
+
+	v1beta1Cluster, v1beta1Machine, err := convertForLifecycleHookRequest(s.cluster, m)
+	if err != nil {
+		s.deletingReason = clusterv1.MachineDeletingInternalErrorReason
+		s.deletingMessage = "Please check controller logs for errors"
+		return ctrl.Result{}, err
+	}
+	hookRequest := &runtimehooksv1.BeforeMachineDrainRequest{
+		Cluster: *v1beta1Cluster,
+		Machine: *v1beta1Machine,
+	}
+	hookResponse := &runtimehooksv1.BeforeMachineDrainResponse{}
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeMachineDrain, m, hookRequest, hookResponse); err != nil {
+		s.deletingReason = clusterv1.MachineDeletingWaitingForPreDrainHookReason
+		s.deletingMessage = fmt.Sprintf("Error calling %s hook, please check controller logs for errors", runtimecatalog.HookName(runtimehooksv1.BeforeMachineDrain))
+		return ctrl.Result{}, err
+	}
+	if hookResponse.RetryAfterSeconds != 0 {
+		log.Info(fmt.Sprintf("Node drain is blocked by %q hook", runtimecatalog.HookName(runtimehooksv1.BeforeMachineDrain)))
+		v1beta1conditions.MarkFalse(m, clusterv1.PreDrainDeleteHookSucceededV1Beta1Condition, clusterv1.WaitingExternalHookV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
+		s.deletingReason = clusterv1.MachineDeletingWaitingForPreDrainHookReason
+		s.deletingMessage = fmt.Sprintf("Waiting for pre-drain hooks to succeed (hooks: %s)", runtimecatalog.HookName(runtimehooksv1.BeforeMachineDrain))
+		return ctrl.Result{RequeueAfter: time.Duration(hookResponse.RetryAfterSeconds) * time.Second}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// reconcileBeforeMachineDeleteHook calls the BeforeMachineDelete hook before the infrastructure of the Machine is deleted,
+// and marks the Machine as ok to delete once all the Runtime Extensions return a non-blocking response.
+func (r *Reconciler) reconcileBeforeMachineDeleteHook(ctx context.Context, s *scope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	m := s.machine
+
+	if !r.lifecycleHooksEnabled() || hooks.IsOkToDelete(m) {
+		return ctrl.Result{}, nil
+	}
+
+	v1beta1Cluster, v1beta1Machine, err := convertForLifecycleHookRequest(s.cluster, m)
+	if err != nil {
+		s.deletingReason = clusterv1.MachineDeletingInternalErrorReason
+		s.deletingMessage = "Please check controller logs for errors"
+		return ctrl.Result{}, err
+	}
+	hookRequest := &runtimehooksv1.BeforeMachineDeleteRequest{
+		Cluster: *v1beta1Cluster,
+		Machine: *v1beta1Machine,
+	}
+	hookResponse := &runtimehooksv1.BeforeMachineDeleteResponse{}
+	if err := r.RuntimeClient.CallAllExtensions(ctx, runtimehooksv1.BeforeMachineDelete, m, hookRequest, hookResponse); err != nil {
+		s.deletingReason = clusterv1.MachineDeletingWaitingForPreTerminateHookReason
+		s.deletingMessage = fmt.Sprintf("Error calling %s hook, please check controller logs for errors", runtimecatalog.HookName(runtimehooksv1.BeforeMachineDelete))
+		return ctrl.Result{}, err
+	}
+	if hookResponse.RetryAfterSeconds != 0 {
+		log.Info(fmt.Sprintf("Machine deletion is blocked by %q hook", runtimecatalog.HookName(runtimehooksv1.BeforeMachineDelete)))
+		v1beta1conditions.MarkFalse(m, clusterv1.PreTerminateDeleteHookSucceededV1Beta1Condition, clusterv1.WaitingExternalHookV1Beta1Reason, clusterv1.ConditionSeverityInfo, "")
+		s.deletingReason = clusterv1.MachineDeletingWaitingForPreTerminateHookReason
+		s.deletingMessage = fmt.Sprintf("Waiting for pre-terminate hooks to succeed (hooks: %s)", runtimecatalog.HookName(runtimehooksv1.BeforeMachineDelete))
+		return ctrl.Result{RequeueAfter: time.Duration(hookResponse.RetryAfterSeconds) * time.Second}, nil
+	}
+
+	// The BeforeMachineDelete hook returned a non-blocking response, mark the Machine as ok to delete
+	// so the hook is not called anymore.
+	if err := hooks.MarkAsOkToDelete(ctx, r.Client, m); err != nil {
+		s.deletingReason = clusterv1.MachineDeletingInternalErrorReason
+		s.deletingMessage = "Please check controller logs for errors"
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// convertForLifecycleHookRequest converts Cluster and Machine to the API version used in lifecycle hook requests.
+func convertForLifecycleHookRequest(cluster *clusterv1.Cluster, machine *clusterv1.Machine) (*clusterv1beta1.Cluster, *clusterv1beta1.Machine, error) {
+	v1beta1Cluster := &clusterv1beta1.Cluster{}
+	if err := clusterv1beta1.Convert_v1beta2_Cluster_To_v1beta1_Cluster(cluster, v1beta1Cluster, nil); err != nil {
+		return nil, nil, errors.Wrap(err, "error converting Cluster to v1beta1 Cluster")
+	}
+	v1beta1Machine := &clusterv1beta1.Machine{}
+	if err := clusterv1beta1.Convert_v1beta2_Machine_To_v1beta1_Machine(machine, v1beta1Machine, nil); err != nil {
+		return nil, nil, errors.Wrap(err, "error converting Machine to v1beta1 Machine")
+	}
+	return v1beta1Cluster, v1beta1Machine, nil
+}
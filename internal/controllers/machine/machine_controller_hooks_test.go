@@ -0,0 +1,392 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/hooks"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+)
+
+var (
+	lifecycleHookBlockingResponse = runtimehooksv1.CommonRetryResponse{
+		RetryAfterSeconds: int32(10),
+		CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+	}
+	lifecycleHookNonBlockingResponse = runtimehooksv1.CommonRetryResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+	}
+	lifecycleHookFailureResponse = runtimehooksv1.CommonRetryResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure},
+	}
+)
+
+func TestReconcileBeforeMachineCreateHook(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	tests := []struct {
+		name               string
+		pending            bool
+		deleting           bool
+		response           runtimehooksv1.CommonRetryResponse
+		wantHookToBeCalled bool
+		wantResult         ctrl.Result
+		wantPending        bool
+		wantErr            bool
+	}{
+		{
+			name:     "hook not called if not pending",
+			pending:  false,
+			response: lifecycleHookBlockingResponse,
+		},
+		{
+			name:        "hook not called if the Machine is being deleted",
+			pending:     true,
+			deleting:    true,
+			response:    lifecycleHookBlockingResponse,
+			wantPending: true,
+		},
+		{
+			name:               "hook still pending if the hook returns a blocking response",
+			pending:            true,
+			response:           lifecycleHookBlockingResponse,
+			wantHookToBeCalled: true,
+			wantResult:         ctrl.Result{RequeueAfter: 10 * time.Second},
+			wantPending:        true,
+		},
+		{
+			name:               "hook done if the hook returns a non-blocking response",
+			pending:            true,
+			response:           lifecycleHookNonBlockingResponse,
+			wantHookToBeCalled: true,
+		},
+		{
+			name:               "hook still pending if the hook fails",
+			pending:            true,
+			response:           lifecycleHookFailureResponse,
+			wantHookToBeCalled: true,
+			wantPending:        true,
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := newLifecycleHookTestMachine(tt.pending, tt.deleting, runtimehooksv1.BeforeMachineCreate)
+			runtimeClient := newLifecycleHookTestRuntimeClient(g, runtimehooksv1.BeforeMachineCreate, &runtimehooksv1.BeforeMachineCreateResponse{CommonRetryResponse: tt.response})
+			r := &Reconciler{
+				Client:        fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(machine).Build(),
+				RuntimeClient: runtimeClient,
+			}
+
+			res, err := r.reconcileBeforeMachineCreateHook(ctx, &scope{cluster: newLifecycleHookTestCluster(), machine: machine})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(res).To(Equal(tt.wantResult))
+			g.Expect(runtimeClient.CallAllCount(runtimehooksv1.BeforeMachineCreate) == 1).To(Equal(tt.wantHookToBeCalled))
+			g.Expect(hooks.IsPending(runtimehooksv1.BeforeMachineCreate, machine)).To(Equal(tt.wantPending))
+			g.Expect(r.isWaitingForBeforeMachineCreateHook(machine)).To(Equal(tt.wantPending && !tt.deleting))
+		})
+	}
+}
+
+func TestReconcileAfterMachineProvisionedHook(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	tests := []struct {
+		name               string
+		pending            bool
+		nodeRef            bool
+		response           runtimehooksv1.CommonResponse
+		wantHookToBeCalled bool
+		wantPending        bool
+		wantErr            bool
+	}{
+		{
+			name:     "hook not called if not pending",
+			nodeRef:  true,
+			response: lifecycleHookNonBlockingResponse.CommonResponse,
+		},
+		{
+			name:        "hook not called if the Machine does not have a Node yet",
+			pending:     true,
+			response:    lifecycleHookNonBlockingResponse.CommonResponse,
+			wantPending: true,
+		},
+		{
+			name:               "hook done if the hook succeeds",
+			pending:            true,
+			nodeRef:            true,
+			response:           lifecycleHookNonBlockingResponse.CommonResponse,
+			wantHookToBeCalled: true,
+		},
+		{
+			name:               "hook still pending if the hook fails",
+			pending:            true,
+			nodeRef:            true,
+			response:           lifecycleHookFailureResponse.CommonResponse,
+			wantHookToBeCalled: true,
+			wantPending:        true,
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := newLifecycleHookTestMachine(tt.pending, false, runtimehooksv1.AfterMachineProvisioned)
+			if tt.nodeRef {
+				machine.Status.NodeRef = &clusterv1.MachineNodeReference{Name: "node-1"}
+			}
+			runtimeClient := newLifecycleHookTestRuntimeClient(g, runtimehooksv1.AfterMachineProvisioned, &runtimehooksv1.AfterMachineProvisionedResponse{CommonResponse: tt.response})
+			r := &Reconciler{
+				Client:        fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(machine).Build(),
+				RuntimeClient: runtimeClient,
+			}
+
+			_, err := r.reconcileAfterMachineProvisionedHook(ctx, &scope{cluster: newLifecycleHookTestCluster(), machine: machine})
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(runtimeClient.CallAllCount(runtimehooksv1.AfterMachineProvisioned) == 1).To(Equal(tt.wantHookToBeCalled))
+			g.Expect(hooks.IsPending(runtimehooksv1.AfterMachineProvisioned, machine)).To(Equal(tt.wantPending))
+		})
+	}
+}
+
+func TestReconcileBeforeMachineDrainHook(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	tests := []struct {
+		name               string
+		drainStarted       bool
+		response           runtimehooksv1.CommonRetryResponse
+		wantHookToBeCalled bool
+		wantResult         ctrl.Result
+		wantDeletingReason string
+		wantErr            bool
+	}{
+		{
+			name:         "hook not called if drain already started",
+			drainStarted: true,
+			response:     lifecycleHookBlockingResponse,
+		},
+		{
+			name:               "requeue if the hook returns a blocking response",
+			response:           lifecycleHookBlockingResponse,
+			wantHookToBeCalled: true,
+			wantResult:         ctrl.Result{RequeueAfter: 10 * time.Second},
+			wantDeletingReason: clusterv1.MachineDeletingWaitingForPreDrainHookReason,
+		},
+		{
+			name:               "proceed if the hook returns a non-blocking response",
+			response:           lifecycleHookNonBlockingResponse,
+			wantHookToBeCalled: true,
+		},
+		{
+			name:               "error if the hook fails",
+			response:           lifecycleHookFailureResponse,
+			wantHookToBeCalled: true,
+			wantDeletingReason: clusterv1.MachineDeletingWaitingForPreDrainHookReason,
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := newLifecycleHookTestMachine(false, true)
+			if tt.drainStarted {
+				machine.Status.Deletion = &clusterv1.MachineDeletionStatus{NodeDrainStartTime: ptr.To(metav1.Now())}
+			}
+			runtimeClient := newLifecycleHookTestRuntimeClient(g, runtimehooksv1.BeforeMachineDrain, &runtimehooksv1.BeforeMachineDrainResponse{CommonRetryResponse: tt.response})
+			r := &Reconciler{
+				Client:        fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(machine).Build(),
+				RuntimeClient: runtimeClient,
+			}
+
+			s := &scope{cluster: newLifecycleHookTestCluster(), machine: machine}
+			res, err := r.reconcileBeforeMachineDrainHook(ctx, s)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(res).To(Equal(tt.wantResult))
+			g.Expect(runtimeClient.CallAllCount(runtimehooksv1.BeforeMachineDrain) == 1).To(Equal(tt.wantHookToBeCalled))
+			g.Expect(s.deletingReason).To(Equal(tt.wantDeletingReason))
+		})
+	}
+}
+
+func TestReconcileBeforeMachineDeleteHook(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	tests := []struct {
+		name               string
+		okToDelete         bool
+		response           runtimehooksv1.CommonRetryResponse
+		wantHookToBeCalled bool
+		wantResult         ctrl.Result
+		wantDeletingReason string
+		wantOkToDelete     bool
+		wantErr            bool
+	}{
+		{
+			name:           "hook not called if the Machine is already ok to delete",
+			okToDelete:     true,
+			response:       lifecycleHookBlockingResponse,
+			wantOkToDelete: true,
+		},
+		{
+			name:               "requeue if the hook returns a blocking response",
+			response:           lifecycleHookBlockingResponse,
+			wantHookToBeCalled: true,
+			wantResult:         ctrl.Result{RequeueAfter: 10 * time.Second},
+			wantDeletingReason: clusterv1.MachineDeletingWaitingForPreTerminateHookReason,
+		},
+		{
+			name:               "mark the Machine as ok to delete if the hook returns a non-blocking response",
+			response:           lifecycleHookNonBlockingResponse,
+			wantHookToBeCalled: true,
+			wantOkToDelete:     true,
+		},
+		{
+			name:               "error if the hook fails",
+			response:           lifecycleHookFailureResponse,
+			wantHookToBeCalled: true,
+			wantDeletingReason: clusterv1.MachineDeletingWaitingForPreTerminateHookReason,
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machine := newLifecycleHookTestMachine(false, true)
+			if tt.okToDelete {
+				machine.Annotations = map[string]string{runtimev1.OkToDeleteAnnotation: ""}
+			}
+			runtimeClient := newLifecycleHookTestRuntimeClient(g, runtimehooksv1.BeforeMachineDelete, &runtimehooksv1.BeforeMachineDeleteResponse{CommonRetryResponse: tt.response})
+			fakeClient := fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(machine).Build()
+			r := &Reconciler{
+				Client:        fakeClient,
+				RuntimeClient: runtimeClient,
+			}
+
+			s := &scope{cluster: newLifecycleHookTestCluster(), machine: machine}
+			res, err := r.reconcileBeforeMachineDeleteHook(ctx, s)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(res).To(Equal(tt.wantResult))
+			g.Expect(runtimeClient.CallAllCount(runtimehooksv1.BeforeMachineDelete) == 1).To(Equal(tt.wantHookToBeCalled))
+			g.Expect(s.deletingReason).To(Equal(tt.wantDeletingReason))
+
+			gotMachine := &clusterv1.Machine{}
+			g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machine), gotMachine)).To(Succeed())
+			g.Expect(hooks.IsOkToDelete(gotMachine)).To(Equal(tt.wantOkToDelete))
+		})
+	}
+}
+
+func TestLifecycleHooksDisabled(t *testing.T) {
+	g := NewWithT(t)
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, false)
+
+	machine := newLifecycleHookTestMachine(true, false, runtimehooksv1.BeforeMachineCreate)
+	r := &Reconciler{
+		Client:        fake.NewClientBuilder().WithScheme(fakeScheme).WithObjects(machine).Build(),
+		RuntimeClient: fakeruntimeclient.NewRuntimeClientBuilder().Build(),
+	}
+
+	// Pending hooks are ignored if the RuntimeSDK feature gate is disabled, so Machine provisioning is not blocked.
+	g.Expect(r.isWaitingForBeforeMachineCreateHook(machine)).To(BeFalse())
+	res, err := r.reconcileBeforeMachineCreateHook(ctx, &scope{cluster: newLifecycleHookTestCluster(), machine: machine})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.IsZero()).To(BeTrue())
+}
+
+func newLifecycleHookTestCluster() *clusterv1.Cluster {
+	return &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cluster",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+}
+
+func newLifecycleHookTestMachine(pending, deleting bool, pendingHooks ...runtimecatalog.Hook) *clusterv1.Machine {
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-machine",
+			Namespace:  metav1.NamespaceDefault,
+			Finalizers: []string{clusterv1.MachineFinalizer},
+		},
+		Spec: clusterv1.MachineSpec{
+			ClusterName: "test-cluster",
+		},
+	}
+	if pending {
+		hookNames := ""
+		for _, hook := range pendingHooks {
+			hookNames += runtimecatalog.HookName(hook)
+		}
+		machine.Annotations = map[string]string{runtimev1.PendingHooksAnnotation: hookNames}
+	}
+	if deleting {
+		machine.DeletionTimestamp = ptr.To(metav1.Now())
+	}
+	return machine
+}
+
+func newLifecycleHookTestRuntimeClient(g *WithT, hook runtimecatalog.Hook, response runtimehooksv1.ResponseObject) *fakeruntimeclient.RuntimeClient {
+	catalog := runtimecatalog.New()
+	g.Expect(runtimehooksv1.AddToCatalog(catalog)).To(Succeed())
+	gvh, err := catalog.GroupVersionHook(hook)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	return fakeruntimeclient.NewRuntimeClientBuilder().
+		WithCatalog(catalog).
+		WithCallAllExtensionResponses(map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject{
+			gvh: response,
+		}).
+		Build()
+}
@@ -106,6 +106,12 @@ func (r *Reconciler) ensureExternalOwnershipAndWatch(ctx context.Context, cluste
 		return nil, err
 	}
 
+	// Do not link the external object to the Machine until the BeforeMachineCreate hook completed;
+	// this prevents bootstrap and infrastructure providers from starting the provisioning of the Machine.
+	if r.isWaitingForBeforeMachineCreateHook(m) {
+		return obj, nil
+	}
+
 	desiredOwnerRef := metav1.OwnerReference{
 		APIVersion: clusterv1.GroupVersion.String(),
 		Kind:       "Machine",
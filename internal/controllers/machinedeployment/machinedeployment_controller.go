@@ -37,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/util/ssa"
@@ -68,8 +69,9 @@ const machineDeploymentManagerName = "capi-machinedeployment"
 
 // Reconciler reconciles a MachineDeployment object.
 type Reconciler struct {
-	Client    client.Client
-	APIReader client.Reader
+	Client       client.Client
+	APIReader    client.Reader
+	ClusterCache clustercache.ClusterCache
 
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
@@ -79,8 +81,8 @@ type Reconciler struct {
 }
 
 func (r *Reconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
-	if r.Client == nil || r.APIReader == nil {
-		return errors.New("Client and APIReader must not be nil")
+	if r.Client == nil || r.APIReader == nil || r.ClusterCache == nil {
+		return errors.New("Client, APIReader and ClusterCache must not be nil")
 	}
 
 	predicateLog := ctrl.LoggerFrom(ctx).WithValues("controller", "machinedeployment")
@@ -186,7 +188,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		return ctrl.Result{}, r.reconcileDelete(ctx, s)
 	}
 
-	return ctrl.Result{}, r.reconcile(ctx, s)
+	return r.reconcile(ctx, s)
 }
 
 type scope struct {
@@ -229,7 +231,7 @@ func patchMachineDeployment(ctx context.Context, patchHelper *patch.Helper, md *
 	return patchHelper.Patch(ctx, md, options...)
 }
 
-func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
+func (r *Reconciler) reconcile(ctx context.Context, s *scope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	log.V(4).Info("Reconcile MachineDeployment")
 
@@ -258,11 +260,11 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
 	}))
 
 	if err := r.getTemplatesAndSetOwner(ctx, s); err != nil {
-		return err
+		return ctrl.Result{}, err
 	}
 
 	if err := r.getAndAdoptMachineSetsForDeployment(ctx, s); err != nil {
-		return err
+		return ctrl.Result{}, err
 	}
 
 	// If not already present, add a label specifying the MachineDeployment name to MachineSets.
@@ -278,11 +280,11 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
 
 		helper, err := patch.NewHelper(machineSet, r.Client)
 		if err != nil {
-			return errors.Wrapf(err, "failed to apply %s label to MachineSet %q", clusterv1.MachineDeploymentNameLabel, machineSet.Name)
+			return ctrl.Result{}, errors.Wrapf(err, "failed to apply %s label to MachineSet %q", clusterv1.MachineDeploymentNameLabel, machineSet.Name)
 		}
 		machineSet.Labels[clusterv1.MachineDeploymentNameLabel] = md.Name
 		if err := helper.Patch(ctx, machineSet); err != nil {
-			return errors.Wrapf(err, "failed to apply %s label to MachineSet %q", clusterv1.MachineDeploymentNameLabel, machineSet.Name)
+			return ctrl.Result{}, errors.Wrapf(err, "failed to apply %s label to MachineSet %q", clusterv1.MachineDeploymentNameLabel, machineSet.Name)
 		}
 	}
 
@@ -295,32 +297,40 @@ func (r *Reconciler) reconcile(ctx context.Context, s *scope) error {
 	for idx := range s.machineSets {
 		machineSet := s.machineSets[idx]
 		if err := ssa.CleanUpManagedFieldsForSSAAdoption(ctx, r.Client, machineSet, machineDeploymentManagerName); err != nil {
-			return errors.Wrapf(err, "failed to clean up managedFields of MachineSet %s", klog.KObj(machineSet))
+			return ctrl.Result{}, errors.Wrapf(err, "failed to clean up managedFields of MachineSet %s", klog.KObj(machineSet))
 		}
 	}
 
 	templateExists := s.infrastructureTemplateExists && (md.Spec.Template.Spec.Bootstrap.ConfigRef == nil || s.bootstrapTemplateExists)
 
+	// Continue a staged rollout paused after a step, if the step has been resumed
+	// or the analysis condition of the step is true.
+	r.resumeRolloutStep(ctx, md)
+
 	if md.Spec.Paused {
-		return r.sync(ctx, md, s.machineSets, templateExists)
+		return ctrl.Result{}, r.sync(ctx, md, s.machineSets, templateExists)
 	}
 
 	if md.Spec.Strategy == nil {
-		return errors.Errorf("missing MachineDeployment strategy")
+		return ctrl.Result{}, errors.Errorf("missing MachineDeployment strategy")
 	}
 
 	if md.Spec.Strategy.Type == clusterv1.RollingUpdateMachineDeploymentStrategyType {
 		if md.Spec.Strategy.RollingUpdate == nil {
-			return errors.Errorf("missing MachineDeployment settings for strategy type: %s", md.Spec.Strategy.Type)
+			return ctrl.Result{}, errors.Errorf("missing MachineDeployment settings for strategy type: %s", md.Spec.Strategy.Type)
 		}
-		return r.rolloutRolling(ctx, md, s.machineSets, templateExists)
+		return ctrl.Result{}, r.rolloutRolling(ctx, md, s.machineSets, templateExists)
 	}
 
 	if md.Spec.Strategy.Type == clusterv1.OnDeleteMachineDeploymentStrategyType {
-		return r.rolloutOnDelete(ctx, md, s.machineSets, templateExists)
+		return ctrl.Result{}, r.rolloutOnDelete(ctx, md, s.machineSets, templateExists)
+	}
+
+	if md.Spec.Strategy.Type == clusterv1.BlueGreenMachineDeploymentStrategyType {
+		return r.rolloutBlueGreen(ctx, md, s.machineSets, templateExists)
 	}
 
-	return errors.Errorf("unexpected deployment strategy type: %s", md.Spec.Strategy.Type)
+	return ctrl.Result{}, errors.Errorf("unexpected deployment strategy type: %s", md.Spec.Strategy.Type)
 }
 
 func (r *Reconciler) reconcileDelete(ctx context.Context, s *scope) error {
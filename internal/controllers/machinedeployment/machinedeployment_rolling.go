@@ -45,8 +45,34 @@ func (r *Reconciler) rolloutRolling(ctx context.Context, md *clusterv1.MachineDe
 		return nil
 	}
 
+	// If the staged rollout of the new MachineSet has been rolled back, roll out the previous MachineSet instead,
+	// until the machine template of the MachineDeployment is changed.
+	// Note: the rolled back MachineSet is never cleaned up, otherwise it would be created again.
+	cleanableMSs := oldMSs
+	previousMS, err := rolledBackMachineSet(md, newMS, oldMSs)
+	if err != nil {
+		return err
+	}
+	if previousMS != nil {
+		rolledBackMS := newMS
+		cleanableMSs = mdutil.FilterMachineSets(oldMSs, func(ms *clusterv1.MachineSet) bool { return ms != previousMS })
+		oldMSs = append(append([]*clusterv1.MachineSet{}, cleanableMSs...), rolledBackMS)
+		newMS = previousMS
+	}
+
 	allMSs := append(oldMSs, newMS)
 
+	if previousMS == nil {
+		// Track the progress of a staged rollout, if any; this might pause or roll back the rollout.
+		stop, err := r.reconcileRolloutSteps(ctx, md, newMS, oldMSs)
+		if err != nil {
+			return err
+		}
+		if stop {
+			return r.syncDeploymentStatus(allMSs, newMS, md)
+		}
+	}
+
 	// Scale up, if we can.
 	if err := r.reconcileNewMachineSet(ctx, allMSs, newMS, md); err != nil {
 		return err
@@ -66,7 +92,7 @@ func (r *Reconciler) rolloutRolling(ctx context.Context, md *clusterv1.MachineDe
 	}
 
 	if mdutil.DeploymentComplete(md, &md.Status) {
-		if err := r.cleanupDeployment(ctx, oldMSs, md); err != nil {
+		if err := r.cleanupDeployment(ctx, cleanableMSs, md); err != nil {
 			return err
 		}
 	}
@@ -101,6 +127,15 @@ func (r *Reconciler) reconcileNewMachineSet(ctx context.Context, allMSs []*clust
 	if err != nil {
 		return err
 	}
+
+	// Do not scale up the new MachineSet above the replicas of the current step of a staged rollout, if any.
+	stepReplicas, err := rolloutStepReplicas(deployment, newMS)
+	if err != nil {
+		return err
+	}
+	if stepReplicas != nil {
+		newReplicasCount = min(newReplicasCount, max(*stepReplicas, *newMS.Spec.Replicas))
+	}
 	return r.scaleMachineSet(ctx, newMS, newReplicasCount, deployment)
 }
 
@@ -0,0 +1,306 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+)
+
+// rolloutBlueGreen implements the logic for the BlueGreen MachineDeploymentStrategyType.
+func (r *Reconciler) rolloutBlueGreen(ctx context.Context, md *clusterv1.MachineDeployment, msList []*clusterv1.MachineSet, templateExists bool) (ctrl.Result, error) {
+	newMS, oldMSs, err := r.getAllMachineSetsAndSyncRevision(ctx, md, msList, true, templateExists)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// newMS can be nil in case there is already a MachineSet associated with this deployment,
+	// but there are only either changes in annotations or MinReadySeconds. Or in other words,
+	// this can be nil if there are changes, but no replacement of existing machines is needed.
+	if newMS == nil {
+		return ctrl.Result{}, nil
+	}
+
+	allMSs := append(oldMSs, newMS)
+
+	// Scale up the new MachineSet to the desired replicas.
+	if err := r.reconcileNewMachineSetBlueGreen(ctx, allMSs, newMS, md); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.syncDeploymentStatus(allMSs, newMS, md); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Scale down the old MachineSets, if the new MachineSet can be promoted.
+	result, err := r.reconcileOldMachineSetsBlueGreen(ctx, oldMSs, newMS, md)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err := r.syncDeploymentStatus(allMSs, newMS, md); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if mdutil.DeploymentComplete(md, &md.Status) {
+		if err := r.cleanupDeployment(ctx, oldMSs, md); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return result, nil
+}
+
+// reconcileNewMachineSetBlueGreen handles reconciliation of the latest MachineSet associated with the MachineDeployment in the BlueGreen MachineDeploymentStrategyType.
+func (r *Reconciler) reconcileNewMachineSetBlueGreen(ctx context.Context, allMSs []*clusterv1.MachineSet, newMS *clusterv1.MachineSet, deployment *clusterv1.MachineDeployment) error {
+	// When rolling back to a MachineSet that was already promoted away from, its Nodes have been cordoned and
+	// it has been stopped from creating Machines; undo both before scaling it up again, otherwise its Machines
+	// never become available and the MachineSet can never be promoted.
+	// NOTE: Nodes are uncordoned before removing the annotation, so a failure is retried at the next reconcile.
+	if _, ok := newMS.Annotations[clusterv1.DisableMachineCreateAnnotation]; ok {
+		if err := r.uncordonMachineSetNodes(ctx, deployment, newMS); err != nil {
+			return err
+		}
+		if err := r.cleanupDisableMachineCreateAnnotation(ctx, newMS); err != nil {
+			return err
+		}
+	}
+
+	return r.reconcileNewMachineSet(ctx, allMSs, newMS, deployment)
+}
+
+// reconcileOldMachineSetsBlueGreen handles reconciliation of Old MachineSets associated with the MachineDeployment in the BlueGreen MachineDeploymentStrategyType.
+// Old MachineSets are kept untouched until the new MachineSet can be promoted; then all of them are scaled down to zero at once.
+func (r *Reconciler) reconcileOldMachineSetsBlueGreen(ctx context.Context, oldMSs []*clusterv1.MachineSet, newMS *clusterv1.MachineSet, deployment *clusterv1.MachineDeployment) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	activeOldMSs := mdutil.FilterActiveMachineSets(oldMSs)
+	if len(activeOldMSs) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	promote, requeueAfter, message, err := r.canPromoteMachineSet(ctx, deployment, newMS, activeOldMSs)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !promote {
+		log.V(4).Info(fmt.Sprintf("Not scaling down old MachineSets: %s", message), "MachineSet", klog.KObj(newMS))
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.Info("Promoting MachineSet, scaling down old MachineSets", "MachineSet", klog.KObj(newMS))
+
+	// Stop old MachineSets from creating Machines and cordon all the old Nodes before scaling down any old MachineSet,
+	// so workloads evicted while draining the first old Machines are not rescheduled on the old Nodes still to be drained.
+	for _, oldMS := range activeOldMSs {
+		if _, ok := oldMS.Annotations[clusterv1.DisableMachineCreateAnnotation]; !ok {
+			patchHelper, err := patch.NewHelper(oldMS, r.Client)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if oldMS.Annotations == nil {
+				oldMS.Annotations = map[string]string{}
+			}
+			oldMS.Annotations[clusterv1.DisableMachineCreateAnnotation] = "true"
+			if err := patchHelper.Patch(ctx, oldMS); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+	if err := r.cordonMachineSetsNodes(ctx, deployment, activeOldMSs); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	for _, oldMS := range activeOldMSs {
+		if err := r.scaleMachineSet(ctx, oldMS, 0, deployment); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	return ctrl.Result{}, nil
+}
+
+// cordonMachineSetsNodes cordons the Nodes of all the Machines of the given MachineSets.
+func (r *Reconciler) cordonMachineSetsNodes(ctx context.Context, deployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet) error {
+	return r.forEachMachineSetsNode(ctx, deployment, machineSets, func(ctx context.Context, remoteClient client.Client, machine *clusterv1.Machine, node *corev1.Node) error {
+		drainer := &drain.Helper{RemoteClient: remoteClient}
+		if err := drainer.CordonNode(ctx, node); err != nil {
+			return errors.Wrapf(err, "failed to cordon Node %s of Machine %s", node.Name, klog.KObj(machine))
+		}
+		return nil
+	})
+}
+
+// uncordonMachineSetNodes uncordons the Nodes of the Machines of the given MachineSet which are not being deleted.
+func (r *Reconciler) uncordonMachineSetNodes(ctx context.Context, deployment *clusterv1.MachineDeployment, ms *clusterv1.MachineSet) error {
+	return r.forEachMachineSetsNode(ctx, deployment, []*clusterv1.MachineSet{ms}, func(ctx context.Context, remoteClient client.Client, machine *clusterv1.Machine, node *corev1.Node) error {
+		// Nodes of Machines being deleted are drained, so they must stay cordoned.
+		if !machine.DeletionTimestamp.IsZero() || !node.Spec.Unschedulable {
+			return nil
+		}
+
+		ctrl.LoggerFrom(ctx).Info("Uncordoning Node")
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = false
+		if err := remoteClient.Patch(ctx, node, patch); err != nil {
+			return errors.Wrapf(err, "failed to uncordon Node %s of Machine %s", node.Name, klog.KObj(machine))
+		}
+		return nil
+	})
+}
+
+// forEachMachineSetsNode calls f for the Node of each Machine of the given MachineSets; Machines without a Node are skipped.
+func (r *Reconciler) forEachMachineSetsNode(ctx context.Context, deployment *clusterv1.MachineDeployment, machineSets []*clusterv1.MachineSet, f func(ctx context.Context, remoteClient client.Client, machine *clusterv1.Machine, node *corev1.Node) error) error {
+	var remoteClient client.Client
+	for _, ms := range machineSets {
+		machines, err := r.getMachineSetMachines(ctx, ms)
+		if err != nil {
+			return err
+		}
+		for _, machine := range machines {
+			if machine.Status.NodeRef == nil {
+				continue
+			}
+			if remoteClient == nil {
+				remoteClient, err = r.ClusterCache.GetClient(ctx, client.ObjectKey{Namespace: deployment.Namespace, Name: deployment.Spec.ClusterName})
+				if err != nil {
+					return errors.Wrapf(err, "failed to get Nodes of MachineSet %s", klog.KObj(ms))
+				}
+			}
+
+			node := &corev1.Node{}
+			if err := remoteClient.Get(ctx, client.ObjectKey{Name: machine.Status.NodeRef.Name}, node); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return errors.Wrapf(err, "failed to get Node %s of Machine %s", machine.Status.NodeRef.Name, klog.KObj(machine))
+			}
+			if err := f(ctrl.LoggerInto(ctx, ctrl.LoggerFrom(ctx).WithValues("Machine", klog.KObj(machine), "Node", klog.KObj(node))), remoteClient, machine, node); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getMachineSetMachines returns the Machines of a MachineSet.
+func (r *Reconciler) getMachineSetMachines(ctx context.Context, ms *clusterv1.MachineSet) ([]*clusterv1.Machine, error) {
+	selectorMap, err := metav1.LabelSelectorAsMap(&ms.Spec.Selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to convert label selector of MachineSet %s to a map", klog.KObj(ms))
+	}
+	machineList := &clusterv1.MachineList{}
+	if err := r.Client.List(ctx, machineList, client.InNamespace(ms.Namespace), client.MatchingLabels(selectorMap)); err != nil {
+		return nil, errors.Wrap(err, "failed to list machines")
+	}
+	machines := make([]*clusterv1.Machine, 0, len(machineList.Items))
+	for i := range machineList.Items {
+		machines = append(machines, &machineList.Items[i])
+	}
+	return machines, nil
+}
+
+// canPromoteMachineSet returns true if the new MachineSet of a MachineDeployment using the BlueGreen strategy can be promoted,
+// i.e. if all its Machines are available, the promotion delay is elapsed and, if required, the MachineSet has been promoted manually.
+// If the new MachineSet cannot be promoted yet, a message explaining why is returned, together with the time after which
+// the promotion should be checked again if it depends only on time passing.
+func (r *Reconciler) canPromoteMachineSet(ctx context.Context, deployment *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet, activeOldMSs []*clusterv1.MachineSet) (bool, time.Duration, string, error) {
+	if deployment.Spec.Replicas == nil {
+		return false, 0, "", errors.Errorf("spec.replicas for MachineDeployment %v is nil, this is unexpected", client.ObjectKeyFromObject(deployment))
+	}
+	replicas := *deployment.Spec.Replicas
+
+	if ptr.Deref(newMS.Spec.Replicas, 0) != replicas || newMS.Status.ObservedGeneration < newMS.Generation {
+		return false, 0, fmt.Sprintf("waiting for MachineSet %s to be scaled up to %d replicas", newMS.Name, replicas), nil
+	}
+	if available := ptr.Deref(newMS.Status.AvailableReplicas, 0); available < replicas {
+		return false, 0, fmt.Sprintf("waiting for Machines of MachineSet %s to be available (%d of %d available)", newMS.Name, available, replicas), nil
+	}
+
+	blueGreen := deployment.Spec.Strategy.BlueGreen
+	if blueGreen == nil {
+		blueGreen = &clusterv1.MachineBlueGreenDeployment{}
+	}
+
+	// Rolling back to a MachineSet older than all the MachineSets to be scaled down does not require manual promotion.
+	rollback := true
+	for _, oldMS := range activeOldMSs {
+		if !newMS.CreationTimestamp.Before(&oldMS.CreationTimestamp) {
+			rollback = false
+			break
+		}
+	}
+	if ptr.Deref(blueGreen.ManualPromotion, false) && !rollback && deployment.Annotations[clusterv1.MachineDeploymentPromoteAnnotation] != newMS.Name {
+		return false, 0, fmt.Sprintf("waiting for MachineSet %s to be promoted using the %s annotation", newMS.Name, clusterv1.MachineDeploymentPromoteAnnotation), nil
+	}
+
+	promotionDelay := time.Duration(ptr.Deref(blueGreen.PromotionDelaySeconds, 0)) * time.Second
+	if promotionDelay <= 0 {
+		return true, 0, "", nil
+	}
+
+	availableSince, err := r.getMachineSetAvailableSince(ctx, newMS)
+	if err != nil {
+		return false, 0, "", err
+	}
+	if availableSince == nil {
+		return false, 0, fmt.Sprintf("waiting for Machines of MachineSet %s to be available", newMS.Name), nil
+	}
+	if remaining := time.Until(availableSince.Add(promotionDelay)); remaining > 0 {
+		return false, remaining, fmt.Sprintf("waiting %s before promoting MachineSet %s", remaining.Round(time.Second), newMS.Name), nil
+	}
+	return true, 0, "", nil
+}
+
+// getMachineSetAvailableSince returns the time since when all the Machines of a MachineSet are available,
+// or nil if at least one Machine is not available.
+func (r *Reconciler) getMachineSetAvailableSince(ctx context.Context, ms *clusterv1.MachineSet) (*metav1.Time, error) {
+	machines, err := r.getMachineSetMachines(ctx, ms)
+	if err != nil {
+		return nil, err
+	}
+
+	var availableSince *metav1.Time
+	for _, machine := range machines {
+		if !machine.DeletionTimestamp.IsZero() {
+			continue
+		}
+		condition := conditions.Get(machine, clusterv1.MachineAvailableCondition)
+		if condition == nil || condition.Status != metav1.ConditionTrue {
+			return nil, nil
+		}
+		if availableSince == nil || availableSince.Before(&condition.LastTransitionTime) {
+			availableSince = &condition.LastTransitionTime
+		}
+	}
+	return availableSince, nil
+}
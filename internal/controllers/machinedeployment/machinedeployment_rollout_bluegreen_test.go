@@ -0,0 +1,339 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+)
+
+func TestReconcileOldMachineSetsBlueGreen(t *testing.T) {
+	now := time.Now()
+
+	machineDeployment := func(blueGreen *clusterv1.MachineBlueGreenDeployment, annotations map[string]string) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   metav1.NamespaceDefault,
+				Name:        "md",
+				Annotations: annotations,
+			},
+			Spec: clusterv1.MachineDeploymentSpec{
+				ClusterName: "cluster",
+				Replicas:    ptr.To[int32](2),
+				Strategy: &clusterv1.MachineDeploymentStrategy{
+					Type:      clusterv1.BlueGreenMachineDeploymentStrategyType,
+					BlueGreen: blueGreen,
+				},
+			},
+		}
+	}
+	machineSet := func(name string, created time.Time, replicas, availableReplicas int32) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         metav1.NamespaceDefault,
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: clusterv1.MachineSetSpec{
+				Replicas: ptr.To(replicas),
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{clusterv1.MachineDeploymentUniqueLabel: name},
+				},
+			},
+			Status: clusterv1.MachineSetStatus{
+				Replicas:          ptr.To(replicas),
+				AvailableReplicas: ptr.To(availableReplicas),
+			},
+		}
+	}
+	availableMachine := func(name, msName string, availableSince time.Time) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      name,
+				Labels:    map[string]string{clusterv1.MachineDeploymentUniqueLabel: msName},
+			},
+			Status: clusterv1.MachineStatus{
+				Conditions: []metav1.Condition{{
+					Type:               clusterv1.MachineAvailableCondition,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(availableSince),
+				}},
+			},
+		}
+	}
+
+	machineWithNode := func(msName string) (*clusterv1.Machine, *corev1.Node) {
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      msName + "-machine",
+				Labels:    map[string]string{clusterv1.MachineDeploymentUniqueLabel: msName},
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: &clusterv1.MachineNodeReference{Name: msName + "-node"},
+			},
+		}
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: msName + "-node",
+			},
+		}
+		return machine, node
+	}
+
+	testCases := []struct {
+		name                           string
+		machineDeployment              *clusterv1.MachineDeployment
+		newMachineSet                  *clusterv1.MachineSet
+		oldMachineSets                 []*clusterv1.MachineSet
+		machines                       []client.Object
+		expectedOldMachineSetsReplicas int32
+		expectRequeue                  bool
+	}{
+		{
+			name:                           "Old MachineSets are not scaled down if the new MachineSet is not scaled up",
+			machineDeployment:              machineDeployment(nil, nil),
+			newMachineSet:                  machineSet("new", now, 1, 1),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now.Add(-time.Hour), 2, 2)},
+			expectedOldMachineSetsReplicas: 2,
+		},
+		{
+			name:                           "Old MachineSets are not scaled down if not all the Machines of the new MachineSet are available",
+			machineDeployment:              machineDeployment(nil, nil),
+			newMachineSet:                  machineSet("new", now, 2, 1),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now.Add(-time.Hour), 2, 2)},
+			expectedOldMachineSetsReplicas: 2,
+		},
+		{
+			name:              "Old MachineSets are scaled down at once when all the Machines of the new MachineSet are available",
+			machineDeployment: machineDeployment(nil, nil),
+			newMachineSet:     machineSet("new", now, 2, 2),
+			oldMachineSets: []*clusterv1.MachineSet{
+				machineSet("old-1", now.Add(-2*time.Hour), 1, 1),
+				machineSet("old-2", now.Add(-time.Hour), 2, 2),
+			},
+			expectedOldMachineSetsReplicas: 0,
+		},
+		{
+			name:                           "Old MachineSets are not scaled down if manual promotion is required and the new MachineSet is not promoted",
+			machineDeployment:              machineDeployment(&clusterv1.MachineBlueGreenDeployment{ManualPromotion: ptr.To(true)}, map[string]string{clusterv1.MachineDeploymentPromoteAnnotation: "old"}),
+			newMachineSet:                  machineSet("new", now, 2, 2),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now.Add(-time.Hour), 2, 2)},
+			expectedOldMachineSetsReplicas: 2,
+		},
+		{
+			name:                           "Old MachineSets are scaled down if manual promotion is required and the new MachineSet is promoted",
+			machineDeployment:              machineDeployment(&clusterv1.MachineBlueGreenDeployment{ManualPromotion: ptr.To(true)}, map[string]string{clusterv1.MachineDeploymentPromoteAnnotation: "new"}),
+			newMachineSet:                  machineSet("new", now, 2, 2),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now.Add(-time.Hour), 2, 2)},
+			expectedOldMachineSetsReplicas: 0,
+		},
+		{
+			name:                           "Old MachineSets are scaled down without manual promotion when rolling back",
+			machineDeployment:              machineDeployment(&clusterv1.MachineBlueGreenDeployment{ManualPromotion: ptr.To(true)}, nil),
+			newMachineSet:                  machineSet("new", now.Add(-time.Hour), 2, 2),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now, 2, 1)},
+			expectedOldMachineSetsReplicas: 0,
+		},
+		{
+			name:                           "Old MachineSets are not scaled down before the promotion delay is elapsed",
+			machineDeployment:              machineDeployment(&clusterv1.MachineBlueGreenDeployment{PromotionDelaySeconds: ptr.To[int32](600)}, nil),
+			newMachineSet:                  machineSet("new", now, 2, 2),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now.Add(-time.Hour), 2, 2)},
+			machines:                       []client.Object{availableMachine("new-1", "new", now.Add(-time.Hour)), availableMachine("new-2", "new", now.Add(-time.Minute))},
+			expectedOldMachineSetsReplicas: 2,
+			expectRequeue:                  true,
+		},
+		{
+			name:                           "Old MachineSets are scaled down after the promotion delay is elapsed",
+			machineDeployment:              machineDeployment(&clusterv1.MachineBlueGreenDeployment{PromotionDelaySeconds: ptr.To[int32](600)}, nil),
+			newMachineSet:                  machineSet("new", now, 2, 2),
+			oldMachineSets:                 []*clusterv1.MachineSet{machineSet("old", now.Add(-time.Hour), 2, 2)},
+			machines:                       []client.Object{availableMachine("new-1", "new", now.Add(-time.Hour)), availableMachine("new-2", "new", now.Add(-20*time.Minute))},
+			expectedOldMachineSetsReplicas: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			resources := append([]client.Object{tc.machineDeployment, tc.newMachineSet}, tc.machines...)
+			nodes := []client.Object{}
+			for key := range tc.oldMachineSets {
+				machine, node := machineWithNode(tc.oldMachineSets[key].Name)
+				resources = append(resources, tc.oldMachineSets[key], machine)
+				nodes = append(nodes, node)
+			}
+			workloadClient := fake.NewClientBuilder().WithObjects(nodes...).Build()
+
+			r := &Reconciler{
+				Client:       fake.NewClientBuilder().WithObjects(resources...).Build(),
+				ClusterCache: clustercache.NewFakeClusterCache(workloadClient, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster"}),
+				recorder:     record.NewFakeRecorder(32),
+			}
+
+			result, err := r.reconcileOldMachineSetsBlueGreen(ctx, tc.oldMachineSets, tc.newMachineSet, tc.machineDeployment)
+			g.Expect(err).ToNot(HaveOccurred())
+			if tc.expectRequeue {
+				g.Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+			} else {
+				g.Expect(result.IsZero()).To(BeTrue())
+			}
+
+			for key := range tc.oldMachineSets {
+				freshOldMachineSet := &clusterv1.MachineSet{}
+				g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(tc.oldMachineSets[key]), freshOldMachineSet)).To(Succeed())
+				g.Expect(*freshOldMachineSet.Spec.Replicas).To(Equal(tc.expectedOldMachineSetsReplicas))
+				_, disabled := freshOldMachineSet.Annotations[clusterv1.DisableMachineCreateAnnotation]
+				g.Expect(disabled).To(Equal(tc.expectedOldMachineSetsReplicas == 0))
+			}
+			for _, node := range nodes {
+				freshNode := &corev1.Node{}
+				g.Expect(workloadClient.Get(ctx, client.ObjectKeyFromObject(node), freshNode)).To(Succeed())
+				g.Expect(freshNode.Spec.Unschedulable).To(Equal(tc.expectedOldMachineSetsReplicas == 0))
+			}
+		})
+	}
+
+	t.Run("Old MachineSets are not scaled down if not all the old Nodes can be cordoned", func(t *testing.T) {
+		g := NewWithT(t)
+
+		md := machineDeployment(nil, nil)
+		newMS := machineSet("new", now, 2, 2)
+		oldMS1 := machineSet("old-1", now.Add(-2*time.Hour), 1, 1)
+		oldMS2 := machineSet("old-2", now.Add(-time.Hour), 1, 1)
+		machine1, node1 := machineWithNode(oldMS1.Name)
+		machine2, _ := machineWithNode(oldMS2.Name)
+		workloadClient := fake.NewClientBuilder().WithObjects(node1).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if key.Name == machine2.Status.NodeRef.Name {
+					return errors.New("connection refused")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).Build()
+
+		r := &Reconciler{
+			Client:       fake.NewClientBuilder().WithObjects(md, newMS, oldMS1, oldMS2, machine1, machine2).Build(),
+			ClusterCache: clustercache.NewFakeClusterCache(workloadClient, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster"}),
+			recorder:     record.NewFakeRecorder(32),
+		}
+
+		_, err := r.reconcileOldMachineSetsBlueGreen(ctx, []*clusterv1.MachineSet{oldMS1, oldMS2}, newMS, md)
+		g.Expect(err).To(HaveOccurred())
+
+		for _, oldMS := range []*clusterv1.MachineSet{oldMS1, oldMS2} {
+			freshOldMachineSet := &clusterv1.MachineSet{}
+			g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(oldMS), freshOldMachineSet)).To(Succeed())
+			g.Expect(*freshOldMachineSet.Spec.Replicas).To(Equal(int32(1)))
+		}
+	})
+}
+
+func TestReconcileNewMachineSetBlueGreen(t *testing.T) {
+	g := NewWithT(t)
+
+	md := &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "md",
+		},
+		Spec: clusterv1.MachineDeploymentSpec{
+			ClusterName: "cluster",
+			Replicas:    ptr.To[int32](2),
+			Strategy: &clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.BlueGreenMachineDeploymentStrategyType,
+			},
+		},
+	}
+	// Rolling back to a MachineSet which was being scaled down after promoting another MachineSet.
+	newMS := &clusterv1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   metav1.NamespaceDefault,
+			Name:        "new",
+			Annotations: map[string]string{clusterv1.DisableMachineCreateAnnotation: "true"},
+		},
+		Spec: clusterv1.MachineSetSpec{
+			Replicas: ptr.To[int32](0),
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{clusterv1.MachineDeploymentUniqueLabel: "new"},
+			},
+		},
+	}
+	machineWithNode := func(name string, deleting bool) (*clusterv1.Machine, *corev1.Node) {
+		machine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      name,
+				Labels:    map[string]string{clusterv1.MachineDeploymentUniqueLabel: "new"},
+			},
+			Status: clusterv1.MachineStatus{
+				NodeRef: &clusterv1.MachineNodeReference{Name: name + "-node"},
+			},
+		}
+		if deleting {
+			machine.DeletionTimestamp = ptr.To(metav1.Now())
+			machine.Finalizers = []string{clusterv1.MachineFinalizer}
+		}
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name + "-node",
+			},
+			Spec: corev1.NodeSpec{
+				Unschedulable: true,
+			},
+		}
+		return machine, node
+	}
+	machine, node := machineWithNode("machine", false)
+	deletingMachine, deletingNode := machineWithNode("deleting-machine", true)
+
+	workloadClient := fake.NewClientBuilder().WithObjects(node, deletingNode).Build()
+	r := &Reconciler{
+		Client:       fake.NewClientBuilder().WithObjects(md, newMS, machine, deletingMachine).Build(),
+		ClusterCache: clustercache.NewFakeClusterCache(workloadClient, client.ObjectKey{Namespace: metav1.NamespaceDefault, Name: "cluster"}),
+		recorder:     record.NewFakeRecorder(32),
+	}
+
+	g.Expect(r.reconcileNewMachineSetBlueGreen(ctx, []*clusterv1.MachineSet{newMS}, newMS, md)).To(Succeed())
+
+	freshNewMachineSet := &clusterv1.MachineSet{}
+	g.Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(newMS), freshNewMachineSet)).To(Succeed())
+	g.Expect(freshNewMachineSet.Annotations).ToNot(HaveKey(clusterv1.DisableMachineCreateAnnotation))
+	g.Expect(*freshNewMachineSet.Spec.Replicas).To(Equal(int32(2)))
+
+	freshNode := &corev1.Node{}
+	g.Expect(workloadClient.Get(ctx, client.ObjectKeyFromObject(node), freshNode)).To(Succeed())
+	g.Expect(freshNode.Spec.Unschedulable).To(BeFalse())
+	g.Expect(workloadClient.Get(ctx, client.ObjectKeyFromObject(deletingNode), freshNode)).To(Succeed())
+	g.Expect(freshNode.Spec.Unschedulable).To(BeTrue())
+}
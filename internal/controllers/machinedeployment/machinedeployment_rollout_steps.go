@@ -0,0 +1,262 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/labels"
+)
+
+// rolloutSteps returns the steps of a staged rollout defined in the MachineDeployment's rollingUpdate strategy, if any.
+func rolloutSteps(md *clusterv1.MachineDeployment) []clusterv1.MachineRolloutStep {
+	if md.Spec.Strategy == nil || md.Spec.Strategy.Type != clusterv1.RollingUpdateMachineDeploymentStrategyType || md.Spec.Strategy.RollingUpdate == nil {
+		return nil
+	}
+	return md.Spec.Strategy.RollingUpdate.Steps
+}
+
+// rolloutStepReplicas returns the maximum number of replicas of the new MachineSet during the current step
+// of a staged rollout, or nil if there is no step in progress for the new MachineSet.
+func rolloutStepReplicas(md *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet) (*int32, error) {
+	steps := rolloutSteps(md)
+	rollout := md.Status.Rollout
+	if rollout == nil || rollout.MachineSetName != newMS.Name || int(rollout.CurrentStep) >= len(steps) ||
+		(rollout.Phase != clusterv1.MachineDeploymentRolloutProgressingPhase && rollout.Phase != clusterv1.MachineDeploymentRolloutPausedPhase) {
+		return nil, nil
+	}
+
+	stepReplicas, err := computeRolloutStepReplicas(md, steps, int(rollout.CurrentStep))
+	if err != nil {
+		return nil, err
+	}
+	return &stepReplicas, nil
+}
+
+// computeRolloutStepReplicas computes the replicas of a step of a staged rollout, capped to the MachineDeployment's replicas.
+func computeRolloutStepReplicas(md *clusterv1.MachineDeployment, steps []clusterv1.MachineRolloutStep, index int) (int32, error) {
+	replicas := ptr.Deref(md.Spec.Replicas, 0)
+	stepReplicas, err := intstrutil.GetScaledValueFromIntOrPercent(&steps[index].Replicas, int(replicas), true)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to compute replicas of rollout step %d", index)
+	}
+	return min(int32(stepReplicas), replicas), nil //nolint:gosec // the number of replicas fits into an int32
+}
+
+// resumeRolloutStep moves a staged rollout paused after a step to the next step, if the step has been resumed
+// using the MachineDeploymentResumeRolloutStepAnnotation or the analysis condition of the step became true after the step started.
+func (r *Reconciler) resumeRolloutStep(ctx context.Context, md *clusterv1.MachineDeployment) {
+	log := ctrl.LoggerFrom(ctx)
+
+	rollout := md.Status.Rollout
+	if rollout == nil || rollout.Phase != clusterv1.MachineDeploymentRolloutPausedPhase {
+		return
+	}
+
+	steps := rolloutSteps(md)
+	switch {
+	case int(rollout.CurrentStep) < len(steps) && rolloutStepAnalysisPassed(md, steps[rollout.CurrentStep]):
+		log.Info(fmt.Sprintf("Resuming rollout after step %d, analysis condition %s is true", rollout.CurrentStep+1, steps[rollout.CurrentStep].AnalysisCondition), "MachineSet", klog.KRef(md.Namespace, rollout.MachineSetName))
+	case md.Annotations[clusterv1.MachineDeploymentResumeRolloutStepAnnotation] == rolloutStepID(rollout):
+		log.Info(fmt.Sprintf("Resuming rollout after step %d", rollout.CurrentStep+1), "MachineSet", klog.KRef(md.Namespace, rollout.MachineSetName))
+	default:
+		return
+	}
+	rollout.CurrentStep++
+	rollout.Phase = clusterv1.MachineDeploymentRolloutProgressingPhase
+	rollout.CurrentStepStartTime = metav1.Now()
+	rollout.Message = ""
+}
+
+// rolloutStepID returns the value of the MachineDeploymentResumeRolloutStepAnnotation which resumes
+// the current step of a staged rollout.
+func rolloutStepID(rollout *clusterv1.MachineDeploymentRolloutStatus) string {
+	return fmt.Sprintf("%s/%d", rollout.MachineSetName, rollout.CurrentStep+1)
+}
+
+// rolloutStepAnalysisPassed returns true if the analysis condition of a step is true and became true after the step started.
+func rolloutStepAnalysisPassed(md *clusterv1.MachineDeployment, step clusterv1.MachineRolloutStep) bool {
+	if step.AnalysisCondition == "" {
+		return false
+	}
+	condition := conditions.Get(md, step.AnalysisCondition)
+	if condition == nil || condition.Status != metav1.ConditionTrue {
+		return false
+	}
+	return !condition.LastTransitionTime.Before(&md.Status.Rollout.CurrentStepStartTime)
+}
+
+// reconcileRolloutSteps tracks the progress of a staged rollout in the MachineDeployment's status.
+// It pauses the rollout once all the Machines of the current step are available, unless the analysis
+// condition of the step is already true, and it rolls back the rollout to the previous MachineSet
+// if the new MachineSet remediates any Machine while the rollout is in progress.
+// It returns true if the rollout must not proceed further in the current reconcile.
+func (r *Reconciler) reconcileRolloutSteps(ctx context.Context, md *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet, oldMSs []*clusterv1.MachineSet) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	steps := rolloutSteps(md)
+	if len(steps) == 0 {
+		md.Status.Rollout = nil
+		return false, nil
+	}
+
+	activeOldMSs := mdutil.FilterActiveMachineSets(oldMSs)
+	rollout := md.Status.Rollout
+	if rollout == nil || rollout.MachineSetName != newMS.Name {
+		// Steps are used only when replacing Machines of old MachineSets.
+		if len(activeOldMSs) == 0 {
+			md.Status.Rollout = nil
+			return false, nil
+		}
+		rollout = &clusterv1.MachineDeploymentRolloutStatus{
+			MachineSetName:       newMS.Name,
+			Revision:             newMS.Annotations[clusterv1.RevisionAnnotation],
+			Phase:                clusterv1.MachineDeploymentRolloutProgressingPhase,
+			CurrentStepStartTime: metav1.Now(),
+		}
+		md.Status.Rollout = rollout
+	}
+
+	if rollout.Phase == clusterv1.MachineDeploymentRolloutPausedPhase {
+		return true, nil
+	}
+	if rollout.Phase != clusterv1.MachineDeploymentRolloutProgressingPhase {
+		return false, nil
+	}
+
+	if len(activeOldMSs) == 0 {
+		rollout.CurrentStep = int32(len(steps)) //nolint:gosec // the number of steps fits into an int32
+		rollout.Phase = clusterv1.MachineDeploymentRolloutCompletedPhase
+		rollout.Message = ""
+		return false, nil
+	}
+
+	// Roll back if Machines of the new MachineSet are remediated while the rollout is in progress.
+	if conditions.IsTrue(newMS, clusterv1.MachineSetRemediatingCondition) {
+		return true, r.rollbackRollout(ctx, md, newMS, oldMSs)
+	}
+
+	if int(rollout.CurrentStep) >= len(steps) {
+		return false, nil
+	}
+
+	stepReplicas, err := rolloutStepReplicas(md, newMS)
+	if err != nil {
+		return false, err
+	}
+	if ptr.Deref(newMS.Spec.Replicas, 0) < *stepReplicas ||
+		ptr.Deref(newMS.Status.AvailableReplicas, 0) < *stepReplicas ||
+		newMS.Status.ObservedGeneration < newMS.Generation {
+		return false, nil
+	}
+
+	step := steps[rollout.CurrentStep]
+	if rolloutStepAnalysisPassed(md, step) {
+		log.Info(fmt.Sprintf("Rollout step %d of %d completed, analysis condition %s is true", rollout.CurrentStep+1, len(steps), step.AnalysisCondition), "MachineSet", klog.KObj(newMS))
+		rollout.CurrentStep++
+		rollout.CurrentStepStartTime = metav1.Now()
+		return false, nil
+	}
+
+	message := fmt.Sprintf("Step %d of %d completed, waiting for the %s annotation to be set to %s", rollout.CurrentStep+1, len(steps), clusterv1.MachineDeploymentResumeRolloutStepAnnotation, rolloutStepID(rollout))
+	if step.AnalysisCondition != "" {
+		message += fmt.Sprintf(" or for condition %s to be true", step.AnalysisCondition)
+	}
+	log.Info(fmt.Sprintf("Pausing rollout: %s", message), "MachineSet", klog.KObj(newMS))
+	r.recorder.Eventf(md, corev1.EventTypeNormal, "RolloutStepCompleted", "Rollout of MachineSet %s paused: %s", newMS.Name, message)
+	rollout.Phase = clusterv1.MachineDeploymentRolloutPausedPhase
+	rollout.Message = message
+	return true, nil
+}
+
+// rollbackRollout rolls back the rollout of the new MachineSet to the old MachineSet with the highest revision.
+// The rollback is recorded in the MachineDeployment's status only, and the rollout logic then rolls out the previous
+// MachineSet again until the machine template of the MachineDeployment is changed, see rolledBackMachineSet.
+// MachineDeployments managed by a Cluster topology are not rolled back, because the Cluster topology defines their
+// machine template; in this case the rollout is paused instead.
+func (r *Reconciler) rollbackRollout(ctx context.Context, md *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet, oldMSs []*clusterv1.MachineSet) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	previousMS, err := previousMachineSet(oldMSs)
+	if err != nil {
+		return err
+	}
+	if previousMS == nil {
+		log.Info("Cannot roll back, no previous MachineSet found", "MachineSet", klog.KObj(newMS))
+		return nil
+	}
+
+	rollout := md.Status.Rollout
+	if labels.IsTopologyOwned(md) {
+		message := fmt.Sprintf("Machines of MachineSet %s have been remediated, not rolling back to MachineSet %s because the MachineDeployment is managed by a Cluster topology; revert the Cluster topology or set the %s annotation to %s to continue",
+			newMS.Name, previousMS.Name, clusterv1.MachineDeploymentResumeRolloutStepAnnotation, rolloutStepID(rollout))
+		log.Info(fmt.Sprintf("Pausing rollout: %s", message), "MachineSet", klog.KObj(newMS))
+		r.recorder.Event(md, corev1.EventTypeWarning, "RolloutRollbackRefused", message)
+		rollout.Phase = clusterv1.MachineDeploymentRolloutPausedPhase
+		rollout.Message = message
+		return nil
+	}
+
+	message := fmt.Sprintf("Rolled back to MachineSet %s because Machines of MachineSet %s have been remediated", previousMS.Name, newMS.Name)
+	log.Info(message, "MachineSet", klog.KObj(newMS))
+	r.recorder.Event(md, corev1.EventTypeWarning, "RolloutRolledBack", message)
+	rollout.Phase = clusterv1.MachineDeploymentRolloutRolledBackPhase
+	rollout.Message = message
+	return nil
+}
+
+// rolledBackMachineSet returns the MachineSet to roll out instead of the new MachineSet, if the rollout
+// of the new MachineSet has been rolled back, or nil otherwise.
+func rolledBackMachineSet(md *clusterv1.MachineDeployment, newMS *clusterv1.MachineSet, oldMSs []*clusterv1.MachineSet) (*clusterv1.MachineSet, error) {
+	rollout := md.Status.Rollout
+	if rollout == nil || rollout.Phase != clusterv1.MachineDeploymentRolloutRolledBackPhase || rollout.MachineSetName != newMS.Name {
+		return nil, nil
+	}
+	return previousMachineSet(oldMSs)
+}
+
+// previousMachineSet returns the MachineSet with the highest revision which is not being deleted, if any.
+func previousMachineSet(oldMSs []*clusterv1.MachineSet) (*clusterv1.MachineSet, error) {
+	var previousMS *clusterv1.MachineSet
+	var previousRevision int64
+	for _, oldMS := range oldMSs {
+		if !oldMS.DeletionTimestamp.IsZero() {
+			continue
+		}
+		revision, err := mdutil.Revision(oldMS)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get revision of MachineSet %s", klog.KObj(oldMS))
+		}
+		if previousMS == nil || revision > previousRevision {
+			previousMS = oldMS
+			previousRevision = revision
+		}
+	}
+	return previousMS, nil
+}
@@ -0,0 +1,405 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinedeployment
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileRolloutSteps(t *testing.T) {
+	stepStartTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	machineDeployment := func(rollout *clusterv1.MachineDeploymentRolloutStatus, conditions ...metav1.Condition) *clusterv1.MachineDeployment {
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: metav1.NamespaceDefault,
+				Name:      "md",
+			},
+			Spec: clusterv1.MachineDeploymentSpec{
+				Replicas: ptr.To[int32](4),
+				Strategy: &clusterv1.MachineDeploymentStrategy{
+					Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+					RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+						Steps: []clusterv1.MachineRolloutStep{
+							{Replicas: intstr.FromInt32(1), AnalysisCondition: "CanaryHealthy"},
+							{Replicas: intstr.FromString("50%")},
+						},
+					},
+				},
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Version: ptr.To("v1.31.0"),
+					},
+				},
+			},
+			Status: clusterv1.MachineDeploymentStatus{
+				Rollout:    rollout,
+				Conditions: conditions,
+			},
+		}
+	}
+	machineSet := func(name, revision, version string, replicas, availableReplicas int32, conditions ...metav1.Condition) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   metav1.NamespaceDefault,
+				Name:        name,
+				Annotations: map[string]string{clusterv1.RevisionAnnotation: revision},
+			},
+			Spec: clusterv1.MachineSetSpec{
+				Replicas: ptr.To(replicas),
+				Template: clusterv1.MachineTemplateSpec{
+					Spec: clusterv1.MachineSpec{
+						Version: ptr.To(version),
+					},
+				},
+			},
+			Status: clusterv1.MachineSetStatus{
+				AvailableReplicas: ptr.To(availableReplicas),
+				Conditions:        conditions,
+			},
+		}
+	}
+	rolloutStatus := func(msName string, step int32, phase clusterv1.MachineDeploymentRolloutPhase) *clusterv1.MachineDeploymentRolloutStatus {
+		return &clusterv1.MachineDeploymentRolloutStatus{
+			MachineSetName:       msName,
+			Revision:             "2",
+			CurrentStep:          step,
+			Phase:                phase,
+			CurrentStepStartTime: stepStartTime,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		machineDeployment *clusterv1.MachineDeployment
+		newMachineSet     *clusterv1.MachineSet
+		oldMachineSets    []*clusterv1.MachineSet
+		expectStop        bool
+		expectRollout     *clusterv1.MachineDeploymentRolloutStatus
+	}{
+		{
+			name:              "Rollout status is not set without old MachineSets",
+			machineDeployment: machineDeployment(nil),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 4, 4),
+		},
+		{
+			name:              "Rollout status is initialized when replacing old MachineSets",
+			machineDeployment: machineDeployment(nil),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 0, 0),
+			oldMachineSets:    []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectRollout:     &clusterv1.MachineDeploymentRolloutStatus{MachineSetName: "new", Revision: "2", Phase: clusterv1.MachineDeploymentRolloutProgressingPhase},
+		},
+		{
+			name:              "Rollout does not pause before the Machines of the step are available",
+			machineDeployment: machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase)),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 1, 0),
+			oldMachineSets:    []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectRollout:     rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase),
+		},
+		{
+			name:              "Rollout pauses when the Machines of the step are available",
+			machineDeployment: machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase)),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 1, 1),
+			oldMachineSets:    []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectStop:        true,
+			expectRollout:     rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutPausedPhase),
+		},
+		{
+			name:              "Rollout does not proceed while paused",
+			machineDeployment: machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutPausedPhase)),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 1, 1),
+			oldMachineSets:    []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectStop:        true,
+			expectRollout:     rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutPausedPhase),
+		},
+		{
+			name: "Rollout moves to the next step without pausing if the analysis condition is true",
+			machineDeployment: machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase), metav1.Condition{
+				Type:               "CanaryHealthy",
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(stepStartTime.Add(time.Minute)),
+			}),
+			newMachineSet:  machineSet("new", "2", "v1.31.0", 1, 1),
+			oldMachineSets: []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectRollout:  rolloutStatus("new", 1, clusterv1.MachineDeploymentRolloutProgressingPhase),
+		},
+		{
+			name: "Rollout pauses if the analysis condition became true before the step started",
+			machineDeployment: machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase), metav1.Condition{
+				Type:               "CanaryHealthy",
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(stepStartTime.Add(-time.Minute)),
+			}),
+			newMachineSet:  machineSet("new", "2", "v1.31.0", 1, 1),
+			oldMachineSets: []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectStop:     true,
+			expectRollout:  rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutPausedPhase),
+		},
+		{
+			name:              "Rollout proceeds after the last step",
+			machineDeployment: machineDeployment(rolloutStatus("new", 2, clusterv1.MachineDeploymentRolloutProgressingPhase)),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 3, 3),
+			oldMachineSets:    []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 1, 1)},
+			expectRollout:     rolloutStatus("new", 2, clusterv1.MachineDeploymentRolloutProgressingPhase),
+		},
+		{
+			name:              "Rollout is completed when old MachineSets are scaled down",
+			machineDeployment: machineDeployment(rolloutStatus("new", 1, clusterv1.MachineDeploymentRolloutProgressingPhase)),
+			newMachineSet:     machineSet("new", "2", "v1.31.0", 4, 4),
+			oldMachineSets:    []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 0, 0)},
+			expectRollout:     rolloutStatus("new", 2, clusterv1.MachineDeploymentRolloutCompletedPhase),
+		},
+		{
+			name:              "Rollout is rolled back when Machines of the new MachineSet are remediated",
+			machineDeployment: machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase)),
+			newMachineSet: machineSet("new", "2", "v1.31.0", 1, 0, metav1.Condition{
+				Type:   clusterv1.MachineSetRemediatingCondition,
+				Status: metav1.ConditionTrue,
+			}),
+			oldMachineSets: []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectStop:     true,
+			expectRollout:  rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutRolledBackPhase),
+		},
+		{
+			name: "Rollout of a MachineDeployment managed by a Cluster topology is paused instead of rolled back when Machines of the new MachineSet are remediated",
+			machineDeployment: func() *clusterv1.MachineDeployment {
+				md := machineDeployment(rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutProgressingPhase))
+				md.Labels = map[string]string{clusterv1.ClusterTopologyOwnedLabel: ""}
+				return md
+			}(),
+			newMachineSet: machineSet("new", "2", "v1.31.0", 1, 0, metav1.Condition{
+				Type:   clusterv1.MachineSetRemediatingCondition,
+				Status: metav1.ConditionTrue,
+			}),
+			oldMachineSets: []*clusterv1.MachineSet{machineSet("old", "1", "v1.30.0", 4, 4)},
+			expectStop:     true,
+			expectRollout:  rolloutStatus("new", 0, clusterv1.MachineDeploymentRolloutPausedPhase),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &Reconciler{
+				recorder: record.NewFakeRecorder(32),
+			}
+
+			stop, err := r.reconcileRolloutSteps(ctx, tc.machineDeployment, tc.newMachineSet, tc.oldMachineSets)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(stop).To(Equal(tc.expectStop))
+			// The spec of the MachineDeployment is never changed.
+			g.Expect(tc.machineDeployment.Spec.Paused).To(BeFalse())
+			g.Expect(tc.machineDeployment.Spec.Template.Spec.Version).To(HaveValue(Equal("v1.31.0")))
+
+			rollout := tc.machineDeployment.Status.Rollout
+			if tc.expectRollout == nil {
+				g.Expect(rollout).To(BeNil())
+				return
+			}
+			g.Expect(rollout).ToNot(BeNil())
+			g.Expect(rollout.MachineSetName).To(Equal(tc.expectRollout.MachineSetName))
+			g.Expect(rollout.Revision).To(Equal(tc.expectRollout.Revision))
+			g.Expect(rollout.CurrentStep).To(Equal(tc.expectRollout.CurrentStep))
+			g.Expect(rollout.Phase).To(Equal(tc.expectRollout.Phase))
+		})
+	}
+}
+
+func TestResumeRolloutStep(t *testing.T) {
+	stepStartTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	machineDeployment := func(resumeAnnotation string, phase clusterv1.MachineDeploymentRolloutPhase, conditions ...metav1.Condition) *clusterv1.MachineDeployment {
+		annotations := map[string]string{}
+		if resumeAnnotation != "" {
+			annotations[clusterv1.MachineDeploymentResumeRolloutStepAnnotation] = resumeAnnotation
+		}
+		return &clusterv1.MachineDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   metav1.NamespaceDefault,
+				Name:        "md",
+				Annotations: annotations,
+			},
+			Spec: clusterv1.MachineDeploymentSpec{
+				Replicas: ptr.To[int32](4),
+				Strategy: &clusterv1.MachineDeploymentStrategy{
+					Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+					RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+						Steps: []clusterv1.MachineRolloutStep{
+							{Replicas: intstr.FromInt32(1), AnalysisCondition: "CanaryHealthy"},
+						},
+					},
+				},
+			},
+			Status: clusterv1.MachineDeploymentStatus{
+				Rollout: &clusterv1.MachineDeploymentRolloutStatus{
+					MachineSetName:       "new",
+					Phase:                phase,
+					CurrentStepStartTime: stepStartTime,
+				},
+				Conditions: conditions,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		machineDeployment *clusterv1.MachineDeployment
+		expectStep        int32
+		expectPhase       clusterv1.MachineDeploymentRolloutPhase
+	}{
+		{
+			name:              "Rollout stays paused until the step is resumed",
+			machineDeployment: machineDeployment("", clusterv1.MachineDeploymentRolloutPausedPhase),
+			expectStep:        0,
+			expectPhase:       clusterv1.MachineDeploymentRolloutPausedPhase,
+		},
+		{
+			name:              "Rollout stays paused if another step is resumed",
+			machineDeployment: machineDeployment("old/1", clusterv1.MachineDeploymentRolloutPausedPhase),
+			expectStep:        0,
+			expectPhase:       clusterv1.MachineDeploymentRolloutPausedPhase,
+		},
+		{
+			name:              "Rollout moves to the next step when the step is resumed",
+			machineDeployment: machineDeployment("new/1", clusterv1.MachineDeploymentRolloutPausedPhase),
+			expectStep:        1,
+			expectPhase:       clusterv1.MachineDeploymentRolloutProgressingPhase,
+		},
+		{
+			name: "Rollout moves to the next step when the analysis condition is true",
+			machineDeployment: machineDeployment("", clusterv1.MachineDeploymentRolloutPausedPhase, metav1.Condition{
+				Type:               "CanaryHealthy",
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(stepStartTime.Add(time.Minute)),
+			}),
+			expectStep:  1,
+			expectPhase: clusterv1.MachineDeploymentRolloutProgressingPhase,
+		},
+		{
+			name:              "Rollout which is not paused after a step is not changed",
+			machineDeployment: machineDeployment("new/1", clusterv1.MachineDeploymentRolloutProgressingPhase),
+			expectStep:        0,
+			expectPhase:       clusterv1.MachineDeploymentRolloutProgressingPhase,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			r := &Reconciler{}
+			r.resumeRolloutStep(ctx, tc.machineDeployment)
+
+			g.Expect(tc.machineDeployment.Spec.Paused).To(BeFalse())
+			g.Expect(tc.machineDeployment.Status.Rollout.CurrentStep).To(Equal(tc.expectStep))
+			g.Expect(tc.machineDeployment.Status.Rollout.Phase).To(Equal(tc.expectPhase))
+		})
+	}
+}
+
+func TestRolledBackMachineSet(t *testing.T) {
+	g := NewWithT(t)
+
+	machineSet := func(name, revision string) *clusterv1.MachineSet {
+		return &clusterv1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{clusterv1.RevisionAnnotation: revision},
+			},
+		}
+	}
+	newMS := machineSet("new", "3")
+	oldMSs := []*clusterv1.MachineSet{machineSet("older", "1"), machineSet("old", "2")}
+	md := &clusterv1.MachineDeployment{
+		Status: clusterv1.MachineDeploymentStatus{
+			Rollout: &clusterv1.MachineDeploymentRolloutStatus{
+				MachineSetName: "new",
+				Phase:          clusterv1.MachineDeploymentRolloutRolledBackPhase,
+			},
+		},
+	}
+
+	// The old MachineSet with the highest revision is rolled out instead of the rolled back MachineSet.
+	previousMS, err := rolledBackMachineSet(md, newMS, oldMSs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(previousMS).To(Equal(oldMSs[1]))
+
+	// A new MachineSet, e.g. after the machine template has been changed, is rolled out as usual.
+	previousMS, err = rolledBackMachineSet(md, machineSet("newer", "4"), append(oldMSs, newMS))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(previousMS).To(BeNil())
+
+	// Rollouts which are not rolled back are not changed.
+	md.Status.Rollout.Phase = clusterv1.MachineDeploymentRolloutProgressingPhase
+	previousMS, err = rolledBackMachineSet(md, newMS, oldMSs)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(previousMS).To(BeNil())
+}
+
+func TestRolloutStepReplicas(t *testing.T) {
+	g := NewWithT(t)
+
+	md := &clusterv1.MachineDeployment{
+		Spec: clusterv1.MachineDeploymentSpec{
+			Replicas: ptr.To[int32](5),
+			Strategy: &clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+					Steps: []clusterv1.MachineRolloutStep{
+						{Replicas: intstr.FromInt32(1)},
+						{Replicas: intstr.FromString("50%")},
+						{Replicas: intstr.FromInt32(10)},
+					},
+				},
+			},
+		},
+		Status: clusterv1.MachineDeploymentStatus{
+			Rollout: &clusterv1.MachineDeploymentRolloutStatus{
+				MachineSetName: "new",
+				Phase:          clusterv1.MachineDeploymentRolloutProgressingPhase,
+			},
+		},
+	}
+	newMS := &clusterv1.MachineSet{ObjectMeta: metav1.ObjectMeta{Name: "new"}}
+
+	for step, expected := range []int32{1, 3, 5} {
+		md.Status.Rollout.CurrentStep = int32(step) //nolint:gosec // the number of steps fits into an int32
+		replicas, err := rolloutStepReplicas(md, newMS)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(replicas).To(HaveValue(Equal(expected)))
+	}
+
+	// No cap after the last step.
+	md.Status.Rollout.CurrentStep = 3
+	replicas, err := rolloutStepReplicas(md, newMS)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(replicas).To(BeNil())
+
+	// No cap for a different MachineSet.
+	md.Status.Rollout.CurrentStep = 0
+	replicas, err = rolloutStepReplicas(md, &clusterv1.MachineSet{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(replicas).To(BeNil())
+}
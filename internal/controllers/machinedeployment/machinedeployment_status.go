@@ -244,10 +244,24 @@ func setRollingOutCondition(_ context.Context, machineDeployment *clusterv1.Mach
 		})
 		message += fmt.Sprintf("\n%s", strings.Join(reasons, "\n"))
 	}
+
+	// Surface staged rollouts paused after a step or rolled back.
+	reason := clusterv1.MachineDeploymentRollingOutReason
+	if rollout := machineDeployment.Status.Rollout; rollout != nil {
+		switch rollout.Phase {
+		case clusterv1.MachineDeploymentRolloutPausedPhase:
+			reason = clusterv1.MachineDeploymentRollingOutPausedReason
+		case clusterv1.MachineDeploymentRolloutRolledBackPhase:
+			reason = clusterv1.MachineDeploymentRollingOutRolledBackReason
+		}
+		if reason != clusterv1.MachineDeploymentRollingOutReason && rollout.Message != "" {
+			message = fmt.Sprintf("%s\n%s", rollout.Message, message)
+		}
+	}
 	conditions.Set(machineDeployment, metav1.Condition{
 		Type:    clusterv1.MachineDeploymentRollingOutCondition,
 		Status:  metav1.ConditionTrue,
-		Reason:  clusterv1.MachineDeploymentRollingOutReason,
+		Reason:  reason,
 		Message: message,
 	})
 }
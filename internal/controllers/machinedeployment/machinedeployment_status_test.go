@@ -320,6 +320,36 @@ func Test_setRollingOutCondition(t *testing.T) {
 					"* InfrastructureMachine is not up-to-date",
 			},
 		},
+		{
+			name: "staged rollout paused after a step",
+			machineDeployment: &clusterv1.MachineDeployment{
+				Status: clusterv1.MachineDeploymentStatus{
+					Rollout: &clusterv1.MachineDeploymentRolloutStatus{
+						MachineSetName: "ms-new",
+						Phase:          clusterv1.MachineDeploymentRolloutPausedPhase,
+						Message:        "Step 1 of 2 completed",
+					},
+				},
+			},
+			machines: []*clusterv1.Machine{
+				fakeMachine("machine-1", withCondition(upToDateCondition)),
+				fakeMachine("machine-2", withCondition(metav1.Condition{
+					Type:    clusterv1.MachineUpToDateCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  clusterv1.MachineNotUpToDateReason,
+					Message: "* Version v1.25.0, v1.26.0 required",
+				})),
+			},
+			getMachinesSucceeded: true,
+			expectCondition: metav1.Condition{
+				Type:   clusterv1.MachineDeploymentRollingOutCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineDeploymentRollingOutPausedReason,
+				Message: "Step 1 of 2 completed\n" +
+					"Rolling out 1 not up-to-date replicas\n" +
+					"* Version v1.25.0, v1.26.0 required",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -246,6 +246,14 @@ func (r *Reconciler) computeDesiredMachineSet(ctx context.Context, deployment *c
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to compute desired MachineSet")
 		}
+		// The first step of a staged rollout, if any, limits the replicas of the new MachineSet.
+		if steps := rolloutSteps(deployment); len(steps) > 0 && len(mdutil.FilterActiveMachineSets(oldMSs)) > 0 {
+			stepReplicas, err := computeRolloutStepReplicas(deployment, steps, 0)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to compute desired MachineSet")
+			}
+			replicas = min(replicas, stepReplicas)
+		}
 
 		machineTemplateSpec = *deployment.Spec.Template.Spec.DeepCopy()
 	} else {
@@ -752,6 +752,11 @@ func TestNewMSNewReplicas(t *testing.T) {
 			clusterv1.RollingUpdateMachineDeploymentStrategyType,
 			6, 2, 10, 6,
 		},
+		{
+			"blue/green - scale up to depReplicas ignoring old MachineSets",
+			clusterv1.BlueGreenMachineDeploymentStrategyType,
+			3, 0, 0, 3,
+		},
 	}
 	newDeployment := generateDeployment("nginx")
 	newRC := generateMS(newDeployment)
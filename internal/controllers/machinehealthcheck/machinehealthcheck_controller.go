@@ -201,6 +201,10 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 		UID:        cluster.UID,
 	}))
 
+	// Reset the remediation circuit breaker if requested and drop remediation records which are not relevant anymore.
+	now := time.Now()
+	reconcileRemediationBudget(logger, m, now)
+
 	// If the cluster is already initialized, get the remote cluster cache to use as a client.Reader.
 	var remoteClient client.Client
 	if conditions.IsTrue(cluster, clusterv1.ClusterControlPlaneInitializedCondition) {
@@ -350,8 +354,34 @@ func (r *Reconciler) reconcile(ctx context.Context, logger logr.Logger, cluster
 		Reason: clusterv1.MachineHealthCheckRemediationAllowedReason,
 	})
 
-	errList := r.patchUnhealthyTargets(ctx, logger, unhealthy, cluster, m)
+	errList, remediationRetryAfter := r.patchUnhealthyTargets(ctx, logger, unhealthy, cluster, m, now)
 	errList = append(errList, r.patchHealthyTargets(ctx, logger, healthy, m)...)
+	if remediationRetryAfter > 0 {
+		nextCheckTimes = append(nextCheckTimes, remediationRetryAfter)
+	}
+
+	// If a remediation budget is set, limit the remediations allowed to the remaining budget.
+	if m.Spec.RemediationBudget != nil {
+		m.Status.RemediationsAllowed = min(m.Status.RemediationsAllowed, remainingRemediationBudget(m, now))
+		if isRemediationCircuitBreakerTripped(m) {
+			message := fmt.Sprintf("Remediation is not allowed, the remediation circuit breaker tripped at %s; add the %s annotation to the MachineHealthCheck to reset it",
+				m.Status.Remediation.CircuitBreakerTrippedTime.UTC().Format(time.RFC3339), clusterv1.ResetRemediationCircuitBreakerAnnotation)
+			v1beta1conditions.Set(m, &clusterv1.Condition{
+				Type:     clusterv1.RemediationAllowedV1Beta1Condition,
+				Status:   corev1.ConditionFalse,
+				Severity: clusterv1.ConditionSeverityWarning,
+				Reason:   clusterv1.RemediationCircuitBreakerTrippedV1Beta1Reason,
+				Message:  message,
+			})
+
+			conditions.Set(m, metav1.Condition{
+				Type:    clusterv1.MachineHealthCheckRemediationAllowedCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineHealthCheckRemediationCircuitBreakerTrippedReason,
+				Message: message,
+			})
+		}
+	}
 
 	// handle update errors
 	if len(errList) > 0 {
@@ -413,9 +443,32 @@ func (r *Reconciler) patchHealthyTargets(ctx context.Context, logger logr.Logger
 }
 
 // patchUnhealthyTargets patches machines with MachineOwnerRemediatedCondition for remediation.
-func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logger, unhealthy []healthCheckTarget, cluster *clusterv1.Cluster, m *clusterv1.MachineHealthCheck) []error {
+// If remediation of some machines is delayed by the remediation budget, the time after which
+// remediation could be allowed is returned.
+func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logger, unhealthy []healthCheckTarget, cluster *clusterv1.Cluster, m *clusterv1.MachineHealthCheck, now time.Time) ([]error, time.Duration) {
 	// mark for remediation
 	errList := []error{}
+	var retryAfter time.Duration
+	// reserve checks if the remediation budget allows to start a new remediation for the machine.
+	reserve := func(logger logr.Logger, machine *clusterv1.Machine) bool {
+		allowed, machineRetryAfter, message := reserveRemediation(m, machine, now)
+		if allowed {
+			return true
+		}
+		if machineRetryAfter > 0 && (retryAfter == 0 || machineRetryAfter < retryAfter) {
+			retryAfter = machineRetryAfter
+		}
+		logger.Info("Machine has failed health check, but remediation is not allowed by the remediation budget", "reason", message)
+		r.recorder.Eventf(
+			m,
+			corev1.EventTypeWarning,
+			EventRemediationRestricted,
+			"Remediation of Machine %s is not allowed: %s",
+			klog.KObj(machine),
+			message,
+		)
+		return false
+	}
 	for _, t := range unhealthy {
 		logger := logger.WithValues("Machine", klog.KObj(t.Machine), "Node", klog.KObj(t.Node))
 		condition := conditions.Get(t.Machine, clusterv1.MachineHealthCheckSucceededCondition)
@@ -427,80 +480,91 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 				// If external remediation request already exists,
 				// return early
 				if r.externalRemediationRequestExists(ctx, m, t.Machine.Name) {
-					return errList
-				}
-
-				cloneOwnerRef := &metav1.OwnerReference{
-					APIVersion: clusterv1.GroupVersion.String(),
-					Kind:       "Machine",
-					Name:       t.Machine.Name,
-					UID:        t.Machine.UID,
-				}
-
-				from, err := external.Get(ctx, r.Client, m.Spec.RemediationTemplate)
-				if err != nil {
-					v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationTemplateAvailableV1Beta1Condition, clusterv1.ExternalRemediationTemplateNotFoundV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
-
-					conditions.Set(t.Machine, metav1.Condition{
-						Type:    clusterv1.MachineExternallyRemediatedCondition,
-						Status:  metav1.ConditionFalse,
-						Reason:  clusterv1.MachineExternallyRemediatedRemediationTemplateNotFoundReason,
-						Message: fmt.Sprintf("Error retrieving remediation template %s %s", m.Spec.RemediationTemplate.Kind, klog.KRef(m.Spec.RemediationTemplate.Namespace, m.Spec.RemediationTemplate.Name)),
-					})
-					errList = append(errList, errors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", m.Spec.RemediationTemplate.GroupVersionKind(), m.Spec.RemediationTemplate.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
-				}
-
-				generateTemplateInput := &external.GenerateTemplateInput{
-					Template:    from,
-					TemplateRef: m.Spec.RemediationTemplate,
-					Namespace:   t.Machine.Namespace,
-					ClusterName: t.Machine.Spec.ClusterName,
-					OwnerRef:    cloneOwnerRef,
-				}
-				to, err := external.GenerateTemplate(generateTemplateInput)
-				if err != nil {
-					errList = append(errList, errors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", m.Spec.RemediationTemplate.GroupVersionKind(), m.Spec.RemediationTemplate.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
-					return errList
+					return errList, retryAfter
 				}
 
-				// Set the Remediation Request to match the Machine name, the name is used to
-				// guarantee uniqueness between runs. A Machine should only ever have a single
-				// remediation object of a specific GVK created.
-				//
-				// NOTE: This doesn't guarantee uniqueness across different MHC objects watching
-				// the same Machine, users are in charge of setting health checks and remediation properly.
-				to.SetName(t.Machine.Name)
-
-				logger.Info("Machine has failed health check, creating an external remediation request", "remediation request name", to.GetName(), "reason", condition.Reason, "message", condition.Message)
-				// Create the external clone.
-				if err := r.Client.Create(ctx, to); err != nil {
-					v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationRequestAvailableV1Beta1Condition, clusterv1.ExternalRemediationRequestCreationFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+				// Create the remediation request only if allowed by the remediation budget.
+				if reserve(logger, t.Machine) {
+					cloneOwnerRef := &metav1.OwnerReference{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       t.Machine.Name,
+						UID:        t.Machine.UID,
+					}
+
+					from, err := external.Get(ctx, r.Client, m.Spec.RemediationTemplate)
+					if err != nil {
+						v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationTemplateAvailableV1Beta1Condition, clusterv1.ExternalRemediationTemplateNotFoundV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+
+						conditions.Set(t.Machine, metav1.Condition{
+							Type:    clusterv1.MachineExternallyRemediatedCondition,
+							Status:  metav1.ConditionFalse,
+							Reason:  clusterv1.MachineExternallyRemediatedRemediationTemplateNotFoundReason,
+							Message: fmt.Sprintf("Error retrieving remediation template %s %s", m.Spec.RemediationTemplate.Kind, klog.KRef(m.Spec.RemediationTemplate.Namespace, m.Spec.RemediationTemplate.Name)),
+						})
+						errList = append(errList, errors.Wrapf(err, "error retrieving remediation template %v %q for machine %q in namespace %q within cluster %q", m.Spec.RemediationTemplate.GroupVersionKind(), m.Spec.RemediationTemplate.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
+						return errList, retryAfter
+					}
+
+					generateTemplateInput := &external.GenerateTemplateInput{
+						Template:    from,
+						TemplateRef: m.Spec.RemediationTemplate,
+						Namespace:   t.Machine.Namespace,
+						ClusterName: t.Machine.Spec.ClusterName,
+						OwnerRef:    cloneOwnerRef,
+					}
+					to, err := external.GenerateTemplate(generateTemplateInput)
+					if err != nil {
+						errList = append(errList, errors.Wrapf(err, "failed to create template for remediation request %v %q for machine %q in namespace %q within cluster %q", m.Spec.RemediationTemplate.GroupVersionKind(), m.Spec.RemediationTemplate.Name, t.Machine.Name, t.Machine.Namespace, m.Spec.ClusterName))
+						return errList, retryAfter
+					}
+
+					// Set the Remediation Request to match the Machine name, the name is used to
+					// guarantee uniqueness between runs. A Machine should only ever have a single
+					// remediation object of a specific GVK created.
+					//
+					// NOTE: This doesn't guarantee uniqueness across different MHC objects watching
+					// the same Machine, users are in charge of setting health checks and remediation properly.
+					to.SetName(t.Machine.Name)
+
+					logger.Info("Machine has failed health check, creating an external remediation request", "remediation request name", to.GetName(), "reason", condition.Reason, "message", condition.Message)
+					// Create the external clone.
+					if err := r.Client.Create(ctx, to); err != nil {
+						v1beta1conditions.MarkFalse(m, clusterv1.ExternalRemediationRequestAvailableV1Beta1Condition, clusterv1.ExternalRemediationRequestCreationFailedV1Beta1Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+
+						conditions.Set(t.Machine, metav1.Condition{
+							Type:    clusterv1.MachineExternallyRemediatedCondition,
+							Status:  metav1.ConditionFalse,
+							Reason:  clusterv1.MachineExternallyRemediatedRemediationRequestCreationFailedReason,
+							Message: "Please check controller logs for errors",
+						})
+						errList = append(errList, errors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName))
+						return errList, retryAfter
+					}
 
 					conditions.Set(t.Machine, metav1.Condition{
-						Type:    clusterv1.MachineExternallyRemediatedCondition,
-						Status:  metav1.ConditionFalse,
-						Reason:  clusterv1.MachineExternallyRemediatedRemediationRequestCreationFailedReason,
-						Message: "Please check controller logs for errors",
+						Type:   clusterv1.MachineExternallyRemediatedCondition,
+						Status: metav1.ConditionFalse,
+						Reason: clusterv1.MachineExternallyRemediatedWaitingForRemediationReason,
 					})
-					errList = append(errList, errors.Wrapf(err, "error creating remediation request for machine %q in namespace %q within cluster %q", t.Machine.Name, t.Machine.Namespace, t.Machine.Spec.ClusterName))
-					return errList
 				}
-
-				conditions.Set(t.Machine, metav1.Condition{
-					Type:   clusterv1.MachineExternallyRemediatedCondition,
-					Status: metav1.ConditionFalse,
-					Reason: clusterv1.MachineExternallyRemediatedWaitingForRemediationReason,
-				})
 			} else if t.Machine.DeletionTimestamp.IsZero() { // Only setting the OwnerRemediated conditions when machine is not already in deletion.
 				logger.Info("Machine has failed health check, marking for remediation", "reason", condition.Reason, "message", condition.Message)
 				// NOTE: MHC is responsible for creating MachineOwnerRemediatedCondition if missing or to trigger another remediation if the previous one is completed;
 				// instead, if a remediation is in already progress, the remediation owner is responsible for completing the process and MHC should not overwrite the condition.
-				if !v1beta1conditions.Has(t.Machine, clusterv1.MachineOwnerRemediatedV1Beta1Condition) || v1beta1conditions.IsTrue(t.Machine, clusterv1.MachineOwnerRemediatedV1Beta1Condition) {
+				startV1Beta1Remediation := !v1beta1conditions.Has(t.Machine, clusterv1.MachineOwnerRemediatedV1Beta1Condition) || v1beta1conditions.IsTrue(t.Machine, clusterv1.MachineOwnerRemediatedV1Beta1Condition)
+				ownerRemediatedCondition := conditions.Get(t.Machine, clusterv1.MachineOwnerRemediatedCondition)
+				startRemediation := ownerRemediatedCondition == nil || ownerRemediatedCondition.Status == metav1.ConditionTrue
+				if (startV1Beta1Remediation || startRemediation) && !reserve(logger, t.Machine) {
+					startV1Beta1Remediation = false
+					startRemediation = false
+				}
+
+				if startV1Beta1Remediation {
 					v1beta1conditions.MarkFalse(t.Machine, clusterv1.MachineOwnerRemediatedV1Beta1Condition, clusterv1.WaitingForRemediationV1Beta1Reason, clusterv1.ConditionSeverityWarning, "")
 				}
 
-				if ownerRemediatedCondition := conditions.Get(t.Machine, clusterv1.MachineOwnerRemediatedCondition); ownerRemediatedCondition == nil || ownerRemediatedCondition.Status == metav1.ConditionTrue {
+				if startRemediation {
 					conditions.Set(t.Machine, metav1.Condition{
 						Type:    clusterv1.MachineOwnerRemediatedCondition,
 						Status:  metav1.ConditionFalse,
@@ -535,7 +599,7 @@ func (r *Reconciler) patchUnhealthyTargets(ctx context.Context, logger logr.Logg
 			klog.KObj(t.MHC),
 		)
 	}
-	return errList
+	return errList, retryAfter
 }
 
 // clusterToMachineHealthCheck maps events from Cluster objects to
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+// reconcileRemediationBudget resets the remediation status when the reset annotation is set on the MachineHealthCheck
+// or when the remediation budget is not set anymore, and drops remediation records which are not relevant anymore.
+func reconcileRemediationBudget(logger logr.Logger, m *clusterv1.MachineHealthCheck, now time.Time) {
+	if _, ok := m.Annotations[clusterv1.ResetRemediationCircuitBreakerAnnotation]; ok {
+		logger.Info(fmt.Sprintf("Resetting remediation circuit breaker as requested by the %s annotation", clusterv1.ResetRemediationCircuitBreakerAnnotation))
+		delete(m.Annotations, clusterv1.ResetRemediationCircuitBreakerAnnotation)
+		m.Status.Remediation = nil
+	}
+
+	if m.Spec.RemediationBudget == nil {
+		m.Status.Remediation = nil
+		return
+	}
+
+	if m.Status.Remediation == nil {
+		return
+	}
+
+	window := time.Duration(m.Spec.RemediationBudget.WindowSeconds) * time.Second
+	history := []clusterv1.MachineHealthCheckRemediationRecord{}
+	for _, record := range m.Status.Remediation.History {
+		if record.Timestamp.Add(window).After(now) {
+			history = append(history, record)
+		}
+	}
+	m.Status.Remediation.History = history
+
+	// If the budget has not been exhausted in the window following the last exhausted window, the sequence of
+	// consecutive exhausted windows is interrupted.
+	if last := m.Status.Remediation.LastExhaustedTime; last != nil && !last.Add(2*window).After(now) {
+		m.Status.Remediation.ExhaustedWindows = 0
+		m.Status.Remediation.LastExhaustedTime = nil
+	}
+}
+
+// isRemediationCircuitBreakerTripped returns true if the remediation circuit breaker of the MachineHealthCheck tripped.
+func isRemediationCircuitBreakerTripped(m *clusterv1.MachineHealthCheck) bool {
+	return m.Spec.RemediationBudget != nil && m.Status.Remediation != nil && m.Status.Remediation.CircuitBreakerTrippedTime != nil
+}
+
+// remainingRemediationBudget returns the number of remediations still allowed within the remediation budget window.
+func remainingRemediationBudget(m *clusterv1.MachineHealthCheck, now time.Time) int32 {
+	budget := m.Spec.RemediationBudget
+	if isRemediationCircuitBreakerTripped(m) {
+		return 0
+	}
+	return max(budget.MaxRemediations-remediationsInWindow(m, now), 0)
+}
+
+// reserveRemediation checks if the remediation budget of the MachineHealthCheck allows to remediate the Machine,
+// and if yes it records the remediation in the MachineHealthCheck status.
+// If the remediation is not allowed, a message explaining why is returned, together with the duration after which
+// remediation could be allowed, if any.
+func reserveRemediation(m *clusterv1.MachineHealthCheck, machine *clusterv1.Machine, now time.Time) (bool, time.Duration, string) {
+	budget := m.Spec.RemediationBudget
+	if budget == nil {
+		return true, 0, ""
+	}
+
+	if m.Status.Remediation == nil {
+		m.Status.Remediation = &clusterv1.MachineHealthCheckRemediationStatus{}
+	}
+
+	// If the remediation of this Machine has been already recorded, e.g. because patching the Machine
+	// failed in a previous reconcile, do not record it again.
+	for _, record := range m.Status.Remediation.History {
+		if record.Machine == machine.Name {
+			return true, 0, ""
+		}
+	}
+
+	if isRemediationCircuitBreakerTripped(m) {
+		return false, 0, fmt.Sprintf("remediation circuit breaker tripped at %s, add the %s annotation to the MachineHealthCheck to reset it",
+			m.Status.Remediation.CircuitBreakerTrippedTime.UTC().Format(time.RFC3339), clusterv1.ResetRemediationCircuitBreakerAnnotation)
+	}
+
+	// Check if remediation of Machines with the same owner must be delayed.
+	owner := remediationOwner(machine)
+	if owner != "" && budget.BackoffSeconds != nil {
+		var previousRemediations int
+		var lastRemediation time.Time
+		for _, record := range m.Status.Remediation.History {
+			if record.Owner != owner {
+				continue
+			}
+			previousRemediations++
+			if record.Timestamp.After(lastRemediation) {
+				lastRemediation = record.Timestamp.Time
+			}
+		}
+		if previousRemediations > 0 {
+			backoff := remediationBackoff(budget, previousRemediations)
+			if retryAfter := lastRemediation.Add(backoff).Sub(now); retryAfter > 0 {
+				return false, retryAfter, fmt.Sprintf("waiting %s before remediating a Machine owned by %s (%d previous remediations)",
+					retryAfter.Truncate(time.Second), owner, previousRemediations)
+			}
+		}
+	}
+
+	// Delay remediation until the oldest remediation falls out of the window if the remediation budget is exhausted,
+	// and trip the circuit breaker if the remediation budget has been exhausted for too many consecutive windows.
+	if remediationsInWindow(m, now) >= budget.MaxRemediations {
+		window := time.Duration(budget.WindowSeconds) * time.Second
+		if last := m.Status.Remediation.LastExhaustedTime; last == nil || !last.Add(window).After(now) {
+			m.Status.Remediation.ExhaustedWindows++
+			m.Status.Remediation.LastExhaustedTime = ptr.To(metav1.NewTime(now))
+		}
+
+		if budget.CircuitBreakerThreshold != nil && m.Status.Remediation.ExhaustedWindows >= *budget.CircuitBreakerThreshold {
+			m.Status.Remediation.CircuitBreakerTrippedTime = ptr.To(metav1.NewTime(now))
+			return false, 0, fmt.Sprintf("remediation circuit breaker tripped: %d remediations in %s for %d consecutive windows, add the %s annotation to the MachineHealthCheck to reset it",
+				budget.MaxRemediations, window, m.Status.Remediation.ExhaustedWindows, clusterv1.ResetRemediationCircuitBreakerAnnotation)
+		}
+
+		retryAfter := oldestRemediationInWindow(m, now).Add(window).Sub(now)
+		return false, retryAfter, fmt.Sprintf("waiting %s before remediating, %d remediations in %s",
+			retryAfter.Truncate(time.Second), budget.MaxRemediations, window)
+	}
+
+	m.Status.Remediation.History = append(m.Status.Remediation.History, clusterv1.MachineHealthCheckRemediationRecord{
+		Machine:   machine.Name,
+		Owner:     owner,
+		Timestamp: metav1.NewTime(now),
+	})
+	return true, 0, ""
+}
+
+// remediationOwner returns the key used to group remediations of Machines with the same owner when computing backoff,
+// i.e. the kind and the name of the controller owner of the Machine, e.g. the MachineSet or the control plane.
+// Stand-alone Machines do not have an owner, and thus they are remediated without backoff.
+func remediationOwner(machine *clusterv1.Machine) string {
+	ref := metav1.GetControllerOf(machine)
+	if ref == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", ref.Kind, ref.Name)
+}
+
+// oldestRemediationInWindow returns the time of the oldest remediation recorded within the remediation budget window.
+func oldestRemediationInWindow(m *clusterv1.MachineHealthCheck, now time.Time) time.Time {
+	window := time.Duration(m.Spec.RemediationBudget.WindowSeconds) * time.Second
+	oldest := now
+	for _, record := range m.Status.Remediation.History {
+		if record.Timestamp.Add(window).After(now) && record.Timestamp.Time.Before(oldest) {
+			oldest = record.Timestamp.Time
+		}
+	}
+	return oldest
+}
+
+// remediationsInWindow returns the number of remediations recorded within the remediation budget window.
+func remediationsInWindow(m *clusterv1.MachineHealthCheck, now time.Time) int32 {
+	if m.Status.Remediation == nil {
+		return 0
+	}
+	window := time.Duration(m.Spec.RemediationBudget.WindowSeconds) * time.Second
+	var count int32
+	for _, record := range m.Status.Remediation.History {
+		if record.Timestamp.Add(window).After(now) {
+			count++
+		}
+	}
+	return count
+}
+
+// remediationBackoff returns the time to wait before remediating a Machine when Machines with the same
+// owner have been previously remediated; the time doubles for each previous remediation.
+func remediationBackoff(budget *clusterv1.MachineHealthCheckRemediationBudget, previousRemediations int) time.Duration {
+	maxBackoff := remediationMaxBackoff(budget)
+	backoff := time.Duration(*budget.BackoffSeconds) * time.Second
+	for i := 1; i < previousRemediations && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// remediationMaxBackoff returns the maximum time to wait before remediating a Machine.
+func remediationMaxBackoff(budget *clusterv1.MachineHealthCheckRemediationBudget) time.Duration {
+	if budget.MaxBackoffSeconds != nil {
+		return time.Duration(*budget.MaxBackoffSeconds) * time.Second
+	}
+	return time.Duration(budget.WindowSeconds) * time.Second
+}
@@ -0,0 +1,364 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinehealthcheck
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+)
+
+func TestReconcileRemediationBudget(t *testing.T) {
+	now := time.Now()
+	budget := &clusterv1.MachineHealthCheckRemediationBudget{
+		MaxRemediations: 2,
+		WindowSeconds:   600,
+		BackoffSeconds:  ptr.To[int32](60),
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		budget      *clusterv1.MachineHealthCheckRemediationBudget
+		remediation *clusterv1.MachineHealthCheckRemediationStatus
+		want        *clusterv1.MachineHealthCheckRemediationStatus
+	}{
+		{
+			name:   "no-op if there is no remediation status",
+			budget: budget,
+		},
+		{
+			name: "reset remediation status if the remediation budget is not set",
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					{Machine: "m1", Timestamp: metav1.NewTime(now)},
+				},
+			},
+		},
+		{
+			name:        "reset remediation status if the reset annotation is set",
+			annotations: map[string]string{clusterv1.ResetRemediationCircuitBreakerAnnotation: ""},
+			budget:      budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					{Machine: "m1", Timestamp: metav1.NewTime(now)},
+				},
+				CircuitBreakerTrippedTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		{
+			name:   "drop records older than the window",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					{Machine: "m1", Timestamp: metav1.NewTime(now.Add(-20 * time.Minute))},
+					{Machine: "m2", Timestamp: metav1.NewTime(now.Add(-5 * time.Minute))},
+				},
+				CircuitBreakerTrippedTime: ptr.To(metav1.NewTime(now)),
+			},
+			want: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					{Machine: "m2", Timestamp: metav1.NewTime(now.Add(-5 * time.Minute))},
+				},
+				CircuitBreakerTrippedTime: ptr.To(metav1.NewTime(now)),
+			},
+		},
+		{
+			name:   "keep exhausted windows if the budget has been exhausted in the previous window",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				ExhaustedWindows:  2,
+				LastExhaustedTime: ptr.To(metav1.NewTime(now.Add(-15 * time.Minute))),
+			},
+			want: &clusterv1.MachineHealthCheckRemediationStatus{
+				History:           []clusterv1.MachineHealthCheckRemediationRecord{},
+				ExhaustedWindows:  2,
+				LastExhaustedTime: ptr.To(metav1.NewTime(now.Add(-15 * time.Minute))),
+			},
+		},
+		{
+			name:   "reset exhausted windows if the budget has not been exhausted in the window following the last exhausted window",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				ExhaustedWindows:  2,
+				LastExhaustedTime: ptr.To(metav1.NewTime(now.Add(-25 * time.Minute))),
+			},
+			want: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.MachineHealthCheck{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tt.annotations},
+				Spec:       clusterv1.MachineHealthCheckSpec{RemediationBudget: tt.budget},
+				Status:     clusterv1.MachineHealthCheckStatus{Remediation: tt.remediation},
+			}
+			reconcileRemediationBudget(ctrl.LoggerFrom(ctx), m, now)
+
+			g.Expect(m.Annotations).ToNot(HaveKey(clusterv1.ResetRemediationCircuitBreakerAnnotation))
+			g.Expect(m.Status.Remediation).To(BeComparableTo(tt.want))
+		})
+	}
+}
+
+func TestReserveRemediation(t *testing.T) {
+	now := time.Now()
+	budget := &clusterv1.MachineHealthCheckRemediationBudget{
+		MaxRemediations:   3,
+		WindowSeconds:     3600,
+		BackoffSeconds:    ptr.To[int32](60),
+		MaxBackoffSeconds: ptr.To[int32](300),
+	}
+	machine := func(name, owner string) *clusterv1.Machine {
+		m := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+		if owner != "" {
+			m.OwnerReferences = []metav1.OwnerReference{
+				{
+					APIVersion: clusterv1.GroupVersion.String(),
+					Kind:       "MachineSet",
+					Name:       owner,
+					Controller: ptr.To(true),
+				},
+			}
+		}
+		return m
+	}
+	record := func(name, owner string, age time.Duration) clusterv1.MachineHealthCheckRemediationRecord {
+		r := clusterv1.MachineHealthCheckRemediationRecord{
+			Machine:   name,
+			Timestamp: metav1.NewTime(now.Add(-age)),
+		}
+		if owner != "" {
+			r.Owner = "MachineSet/" + owner
+		}
+		return r
+	}
+
+	tests := []struct {
+		name             string
+		budget           *clusterv1.MachineHealthCheckRemediationBudget
+		remediation      *clusterv1.MachineHealthCheckRemediationStatus
+		machine          *clusterv1.Machine
+		wantAllowed      bool
+		wantRetryAfter   time.Duration
+		wantHistoryLen   int
+		wantExhausted    int32
+		wantBreakerTrips bool
+	}{
+		{
+			name:        "allowed if the remediation budget is not set",
+			machine:     machine("m1", "a"),
+			wantAllowed: true,
+		},
+		{
+			name:           "allowed and recorded for the first remediation",
+			budget:         budget,
+			machine:        machine("m1", "a"),
+			wantAllowed:    true,
+			wantHistoryLen: 1,
+		},
+		{
+			name:   "allowed without recording if the Machine remediation was already recorded",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{record("m1", "a", 0)},
+			},
+			machine:        machine("m1", "a"),
+			wantAllowed:    true,
+			wantHistoryLen: 1,
+		},
+		{
+			name:   "allowed for a different owner",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{record("m1", "a", 0)},
+			},
+			machine:        machine("m2", "b"),
+			wantAllowed:    true,
+			wantHistoryLen: 2,
+		},
+		{
+			name:   "delayed by backoff for the same owner",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{record("m1", "a", 20*time.Second)},
+			},
+			machine:        machine("m2", "a"),
+			wantAllowed:    false,
+			wantRetryAfter: 40 * time.Second,
+			wantHistoryLen: 1,
+		},
+		{
+			name:   "backoff doubles for each previous remediation",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					record("m1", "a", 5*time.Minute),
+					record("m2", "a", 100*time.Second),
+				},
+			},
+			machine:        machine("m3", "a"),
+			wantAllowed:    false,
+			wantRetryAfter: 20 * time.Second,
+			wantHistoryLen: 2,
+		},
+		{
+			name:   "allowed after backoff",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{record("m1", "a", 2*time.Minute)},
+			},
+			machine:        machine("m2", "a"),
+			wantAllowed:    true,
+			wantHistoryLen: 2,
+		},
+		{
+			name:   "allowed without backoff for Machines without an owner",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{record("m1", "", 0)},
+			},
+			machine:        machine("m2", ""),
+			wantAllowed:    true,
+			wantHistoryLen: 2,
+		},
+		{
+			name:   "delayed until the oldest remediation falls out of the window when the budget is exhausted",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					record("m1", "a", 30*time.Minute),
+					record("m2", "b", 20*time.Minute),
+					record("m3", "c", 10*time.Minute),
+				},
+			},
+			machine:        machine("m4", "d"),
+			wantAllowed:    false,
+			wantRetryAfter: 30 * time.Minute,
+			wantHistoryLen: 3,
+			wantExhausted:  1,
+		},
+		{
+			name: "trips the circuit breaker when the budget is exhausted for circuitBreakerThreshold consecutive windows",
+			budget: &clusterv1.MachineHealthCheckRemediationBudget{
+				MaxRemediations:         3,
+				WindowSeconds:           3600,
+				CircuitBreakerThreshold: ptr.To[int32](2),
+			},
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					record("m1", "a", 30*time.Minute),
+					record("m2", "b", 20*time.Minute),
+					record("m3", "c", 10*time.Minute),
+				},
+				ExhaustedWindows:  1,
+				LastExhaustedTime: ptr.To(metav1.NewTime(now.Add(-90 * time.Minute))),
+			},
+			machine:          machine("m4", "d"),
+			wantAllowed:      false,
+			wantHistoryLen:   3,
+			wantExhausted:    2,
+			wantBreakerTrips: true,
+		},
+		{
+			name:   "records outside of the window do not count against the budget",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					record("m1", "a", 90*time.Minute),
+					record("m2", "b", 20*time.Minute),
+					record("m3", "c", 10*time.Minute),
+				},
+			},
+			machine:        machine("m4", "d"),
+			wantAllowed:    true,
+			wantHistoryLen: 4,
+		},
+		{
+			name:   "not allowed if the circuit breaker tripped",
+			budget: budget,
+			remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				CircuitBreakerTrippedTime: ptr.To(metav1.NewTime(now)),
+			},
+			machine:          machine("m1", "a"),
+			wantAllowed:      false,
+			wantBreakerTrips: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			m := &clusterv1.MachineHealthCheck{
+				Spec:   clusterv1.MachineHealthCheckSpec{RemediationBudget: tt.budget},
+				Status: clusterv1.MachineHealthCheckStatus{Remediation: tt.remediation},
+			}
+			allowed, retryAfter, message := reserveRemediation(m, tt.machine, now)
+
+			g.Expect(allowed).To(Equal(tt.wantAllowed))
+			g.Expect(retryAfter).To(Equal(tt.wantRetryAfter))
+			g.Expect(message == "").To(Equal(tt.wantAllowed))
+			g.Expect(isRemediationCircuitBreakerTripped(m)).To(Equal(tt.wantBreakerTrips))
+			if tt.budget == nil {
+				g.Expect(m.Status.Remediation).To(BeNil())
+				return
+			}
+			g.Expect(m.Status.Remediation.History).To(HaveLen(tt.wantHistoryLen))
+			g.Expect(m.Status.Remediation.ExhaustedWindows).To(Equal(tt.wantExhausted))
+		})
+	}
+}
+
+func TestRemainingRemediationBudget(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	m := &clusterv1.MachineHealthCheck{
+		Spec: clusterv1.MachineHealthCheckSpec{
+			RemediationBudget: &clusterv1.MachineHealthCheckRemediationBudget{
+				MaxRemediations: 3,
+				WindowSeconds:   600,
+			},
+		},
+		Status: clusterv1.MachineHealthCheckStatus{
+			Remediation: &clusterv1.MachineHealthCheckRemediationStatus{
+				History: []clusterv1.MachineHealthCheckRemediationRecord{
+					{Machine: "m1", Timestamp: metav1.NewTime(now.Add(-20 * time.Minute))},
+					{Machine: "m2", Timestamp: metav1.NewTime(now.Add(-5 * time.Minute))},
+				},
+			},
+		},
+	}
+	g.Expect(remainingRemediationBudget(m, now)).To(Equal(int32(2)))
+
+	m.Status.Remediation.CircuitBreakerTrippedTime = ptr.To(metav1.NewTime(now))
+	g.Expect(remainingRemediationBudget(m, now)).To(Equal(int32(0)))
+}
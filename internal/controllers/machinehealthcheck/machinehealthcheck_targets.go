@@ -78,7 +78,9 @@ func (t *healthCheckTarget) nodeName() string {
 // - The Machine has failed for some reason
 // - The Machine did not get a node before `timeoutForMachineToHaveNode` elapses
 // - The Node has gone away
+// - Any condition on the machine is matched for the given timeout
 // - Any condition on the node is matched for the given timeout
+// - Any taint on the node is matched for the given timeout
 // If the target doesn't currently need rememdiation, provide a duration after
 // which the target should next be checked.
 // The target should be requeued after this duration.
@@ -128,12 +130,46 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, timeoutForMachi
 		return false, 0
 	}
 
+	// check machine conditions
+	for _, c := range t.MHC.Spec.UnhealthyMachineConditions {
+		machineCondition := conditions.Get(t.Machine, c.Type)
+
+		// Skip when current machine condition is different from the one reported
+		// in the MachineHealthCheck.
+		if machineCondition == nil || machineCondition.Status != c.Status {
+			continue
+		}
+
+		// If the condition has been in the unhealthy state for longer than the
+		// timeout, return true with no requeue time.
+		timeoutSecondsDuration := time.Duration(c.TimeoutSeconds) * time.Second
+
+		if machineCondition.LastTransitionTime.Add(timeoutSecondsDuration).Before(now) {
+			v1beta1conditions.MarkFalse(t.Machine, clusterv1.MachineHealthCheckSucceededV1Beta1Condition, clusterv1.UnhealthyMachineConditionV1Beta1Reason, clusterv1.ConditionSeverityWarning, "Condition %s on machine is reporting status %s for more than %s", c.Type, c.Status, timeoutSecondsDuration.String())
+			logger.V(3).Info("Target is unhealthy: machine condition is in state longer than allowed timeout", "condition", c.Type, "state", c.Status, "timeout", timeoutSecondsDuration.String())
+
+			conditions.Set(t.Machine, metav1.Condition{
+				Type:    clusterv1.MachineHealthCheckSucceededCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineHealthCheckUnhealthyMachineReason,
+				Message: fmt.Sprintf("Health check failed: Condition %s on Machine is reporting status %s for more than %s", c.Type, c.Status, timeoutSecondsDuration.String()),
+			})
+			return true, time.Duration(0)
+		}
+
+		durationUnhealthy := now.Sub(machineCondition.LastTransitionTime.Time)
+		nextCheck := timeoutSecondsDuration - durationUnhealthy + time.Second
+		if nextCheck > 0 {
+			nextCheckTimes = append(nextCheckTimes, nextCheck)
+		}
+	}
+
 	// the node has not been set yet
 	if t.Node == nil {
 		if timeoutForMachineToHaveNode == disabledNodeStartupTimeout {
 			// Startup timeout is disabled so no need to go any further.
 			// No node yet to check conditions, can return early here.
-			return false, 0
+			return false, minDuration(nextCheckTimes)
 		}
 
 		controlPlaneInitialized := conditions.GetLastTransitionTime(t.Cluster, clusterv1.ClusterControlPlaneInitializedCondition)
@@ -176,8 +212,9 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, timeoutForMachi
 
 		durationUnhealthy := now.Sub(comparisonTime)
 		nextCheck := timeoutDuration - durationUnhealthy + time.Second
+		nextCheckTimes = append(nextCheckTimes, nextCheck)
 
-		return false, nextCheck
+		return false, minDuration(nextCheckTimes)
 	}
 
 	// check conditions
@@ -213,6 +250,38 @@ func (t *healthCheckTarget) needsRemediation(logger logr.Logger, timeoutForMachi
 			nextCheckTimes = append(nextCheckTimes, nextCheck)
 		}
 	}
+
+	// check taints
+	for _, unhealthyTaint := range t.MHC.Spec.UnhealthyNodeTaints {
+		nodeTaint := getNodeTaint(t.Node, unhealthyTaint)
+		if nodeTaint == nil {
+			continue
+		}
+
+		// If the taint has been on the node for longer than the timeout, return true with no requeue time.
+		// Note: NoExecute taints always have TimeAdded when added by Kubernetes components or by kubectl; taints
+		// without it cannot be timed, so they are considered unhealthy as soon as they are found.
+		timeoutSecondsDuration := time.Duration(unhealthyTaint.TimeoutSeconds) * time.Second
+
+		if nodeTaint.TimeAdded == nil || nodeTaint.TimeAdded.Add(timeoutSecondsDuration).Before(now) {
+			v1beta1conditions.MarkFalse(t.Machine, clusterv1.MachineHealthCheckSucceededV1Beta1Condition, clusterv1.UnhealthyNodeTaintV1Beta1Reason, clusterv1.ConditionSeverityWarning, "Node has taint %s for more than %s", nodeTaint.ToString(), timeoutSecondsDuration.String())
+			logger.V(3).Info("Target is unhealthy: taint is on the node longer than allowed timeout", "taint", nodeTaint.ToString(), "timeout", timeoutSecondsDuration.String())
+
+			conditions.Set(t.Machine, metav1.Condition{
+				Type:    clusterv1.MachineHealthCheckSucceededCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  clusterv1.MachineHealthCheckUnhealthyNodeTaintReason,
+				Message: fmt.Sprintf("Health check failed: Node has taint %s for more than %s", nodeTaint.ToString(), timeoutSecondsDuration.String()),
+			})
+			return true, time.Duration(0)
+		}
+
+		durationUnhealthy := now.Sub(nodeTaint.TimeAdded.Time)
+		nextCheck := timeoutSecondsDuration - durationUnhealthy + time.Second
+		if nextCheck > 0 {
+			nextCheckTimes = append(nextCheckTimes, nextCheck)
+		}
+	}
 	return false, minDuration(nextCheckTimes)
 }
 
@@ -359,6 +428,23 @@ func getNodeCondition(node *corev1.Node, conditionType corev1.NodeConditionType)
 	return nil
 }
 
+// getNodeTaint returns the first taint on the node matching the given UnhealthyNodeTaint.
+func getNodeTaint(node *corev1.Node, unhealthyTaint clusterv1.UnhealthyNodeTaint) *corev1.Taint {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key != unhealthyTaint.Key {
+			continue
+		}
+		if unhealthyTaint.Value != "" && taint.Value != unhealthyTaint.Value {
+			continue
+		}
+		if taint.Effect != unhealthyTaint.Effect {
+			continue
+		}
+		return &taint
+	}
+	return nil
+}
+
 func minDuration(durations []time.Duration) time.Duration {
 	if len(durations) == 0 {
 		return time.Duration(0)
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -392,6 +393,86 @@ func TestHealthCheckTargets(t *testing.T) {
 	machineAnnotationRemediationCondition := newFailedHealthCheckV1Beta1Condition(clusterv1.HasRemediateMachineAnnotationV1Beta1Reason, annotationRemediationMsg)
 	machineAnnotationRemediationV1Beta2Condition := newFailedHealthCheckCondition(clusterv1.MachineHealthCheckHasRemediateAnnotationReason, annotationRemediationV1Beta2Msg)
 
+	// Create a test MHC with unhealthy Machine conditions and Node taints
+	testMHCMachineConditionsAndTaints := testMHC.DeepCopy()
+	testMHCMachineConditionsAndTaints.Spec.UnhealthyMachineConditions = []clusterv1.UnhealthyMachineCondition{
+		{
+			Type:           "InfrastructureHealthy",
+			Status:         metav1.ConditionFalse,
+			TimeoutSeconds: timeoutForUnhealthyNodeConditions,
+		},
+	}
+	testMHCMachineConditionsAndTaints.Spec.UnhealthyNodeTaints = []clusterv1.UnhealthyNodeTaint{
+		{
+			Key:            "node.kubernetes.io/out-of-service",
+			Effect:         corev1.TaintEffectNoExecute,
+			TimeoutSeconds: timeoutForUnhealthyNodeConditions,
+		},
+	}
+
+	// Target for when the machine condition has been in an unhealthy state for shorter than the timeout
+	testMachineUnhealthy200 := newTestUnhealthyMachine(testMachine, "InfrastructureHealthy", metav1.ConditionFalse, 200*time.Second)
+	machineUnhealthy200 := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachineUnhealthy200,
+		Node:    testNodeHealthy,
+	}
+
+	// Target for when the machine condition has been in an unhealthy state for longer than the timeout
+	testMachineUnhealthy400 := newTestUnhealthyMachine(testMachine, "InfrastructureHealthy", metav1.ConditionFalse, 400*time.Second)
+	machineUnhealthy400 := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachineUnhealthy400,
+		Node:    testNodeHealthy,
+	}
+	machineUnhealthy400Condition := newFailedHealthCheckV1Beta1Condition(clusterv1.UnhealthyMachineConditionV1Beta1Reason, "Condition InfrastructureHealthy on machine is reporting status False for more than %s", (time.Duration(timeoutForUnhealthyNodeConditions) * time.Second).String())
+	machineUnhealthy400V1Beta2Condition := newFailedHealthCheckCondition(clusterv1.MachineHealthCheckUnhealthyMachineReason, "Health check failed: Condition InfrastructureHealthy on Machine is reporting status False for more than %s", (time.Duration(timeoutForUnhealthyNodeConditions) * time.Second).String())
+
+	// Target for when the machine condition has been in an unhealthy state for shorter than the timeout, and the node has not yet started
+	testMachineUnhealthy200Created400s := newTestUnhealthyMachine(testMachineCreated400s, "InfrastructureHealthy", metav1.ConditionFalse, 200*time.Second)
+	machineUnhealthy200NodeNotYetStarted := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachineUnhealthy200Created400s,
+		Node:    nil,
+	}
+
+	// Target for when the node has an unhealthy taint for shorter than the timeout
+	nodeTainted200 := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachine.DeepCopy(),
+		Node:    newTestTaintedNode("node1", "node.kubernetes.io/out-of-service", corev1.TaintEffectNoExecute, ptr.To(200*time.Second)),
+	}
+
+	// Target for when the node has an unhealthy taint for longer than the timeout
+	nodeTainted400 := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachine.DeepCopy(),
+		Node:    newTestTaintedNode("node1", "node.kubernetes.io/out-of-service", corev1.TaintEffectNoExecute, ptr.To(400*time.Second)),
+	}
+	nodeTainted400Condition := newFailedHealthCheckV1Beta1Condition(clusterv1.UnhealthyNodeTaintV1Beta1Reason, "Node has taint node.kubernetes.io/out-of-service:NoExecute for more than %s", (time.Duration(timeoutForUnhealthyNodeConditions) * time.Second).String())
+	nodeTainted400V1Beta2Condition := newFailedHealthCheckCondition(clusterv1.MachineHealthCheckUnhealthyNodeTaintReason, "Health check failed: Node has taint node.kubernetes.io/out-of-service:NoExecute for more than %s", (time.Duration(timeoutForUnhealthyNodeConditions) * time.Second).String())
+
+	// Target for when the node has an unhealthy taint without the time the taint has been added
+	nodeTaintedWithoutTimeAdded := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachine.DeepCopy(),
+		Node:    newTestTaintedNode("node1", "node.kubernetes.io/out-of-service", corev1.TaintEffectNoExecute, nil),
+	}
+
+	// Target for when the node has a taint with the same key but a different effect
+	nodeTaintedDifferentEffect := healthCheckTarget{
+		Cluster: cluster,
+		MHC:     testMHCMachineConditionsAndTaints,
+		Machine: testMachine.DeepCopy(),
+		Node:    newTestTaintedNode("node1", "node.kubernetes.io/out-of-service", corev1.TaintEffectNoSchedule, ptr.To(400*time.Second)),
+	}
+
 	testCases := []struct {
 		desc                                     string
 		targets                                  []healthCheckTarget
@@ -492,6 +573,61 @@ func TestHealthCheckTargets(t *testing.T) {
 			expectedNeedsRemediationV1Beta2Condition: []metav1.Condition{nodeGoneAwayV1Beta2Condition},
 			expectedNextCheckTimes:                   []time.Duration{},
 		},
+		{
+			desc:                     "when the machine condition has been in an unhealthy state for shorter than the timeout",
+			targets:                  []healthCheckTarget{machineUnhealthy200},
+			expectedHealthy:          []healthCheckTarget{},
+			expectedNeedsRemediation: []healthCheckTarget{},
+			expectedNextCheckTimes:   []time.Duration{100 * time.Second},
+		},
+		{
+			desc:                                     "when the machine condition has been in an unhealthy state for longer than the timeout",
+			targets:                                  []healthCheckTarget{machineUnhealthy400},
+			expectedHealthy:                          []healthCheckTarget{},
+			expectedNeedsRemediation:                 []healthCheckTarget{machineUnhealthy400},
+			expectedNeedsRemediationCondition:        []clusterv1.Condition{machineUnhealthy400Condition},
+			expectedNeedsRemediationV1Beta2Condition: []metav1.Condition{machineUnhealthy400V1Beta2Condition},
+			expectedNextCheckTimes:                   []time.Duration{},
+		},
+		{
+			desc:                     "when the machine condition has been in an unhealthy state for shorter than the timeout and the node has not yet started",
+			targets:                  []healthCheckTarget{machineUnhealthy200NodeNotYetStarted},
+			expectedHealthy:          []healthCheckTarget{},
+			expectedNeedsRemediation: []healthCheckTarget{},
+			expectedNextCheckTimes:   []time.Duration{100 * time.Second},
+		},
+		{
+			desc:                     "when the node has an unhealthy taint for shorter than the timeout",
+			targets:                  []healthCheckTarget{nodeTainted200},
+			expectedHealthy:          []healthCheckTarget{},
+			expectedNeedsRemediation: []healthCheckTarget{},
+			expectedNextCheckTimes:   []time.Duration{100 * time.Second},
+		},
+		{
+			desc:                                     "when the node has an unhealthy taint for longer than the timeout",
+			targets:                                  []healthCheckTarget{nodeTainted400},
+			expectedHealthy:                          []healthCheckTarget{},
+			expectedNeedsRemediation:                 []healthCheckTarget{nodeTainted400},
+			expectedNeedsRemediationCondition:        []clusterv1.Condition{nodeTainted400Condition},
+			expectedNeedsRemediationV1Beta2Condition: []metav1.Condition{nodeTainted400V1Beta2Condition},
+			expectedNextCheckTimes:                   []time.Duration{},
+		},
+		{
+			desc:                                     "when the node has an unhealthy taint without the time the taint has been added",
+			targets:                                  []healthCheckTarget{nodeTaintedWithoutTimeAdded},
+			expectedHealthy:                          []healthCheckTarget{},
+			expectedNeedsRemediation:                 []healthCheckTarget{nodeTaintedWithoutTimeAdded},
+			expectedNeedsRemediationCondition:        []clusterv1.Condition{nodeTainted400Condition},
+			expectedNeedsRemediationV1Beta2Condition: []metav1.Condition{nodeTainted400V1Beta2Condition},
+			expectedNextCheckTimes:                   []time.Duration{},
+		},
+		{
+			desc:                     "when the node has a taint not matching the unhealthy taints",
+			targets:                  []healthCheckTarget{nodeTaintedDifferentEffect},
+			expectedHealthy:          []healthCheckTarget{nodeTaintedDifferentEffect},
+			expectedNeedsRemediation: []healthCheckTarget{},
+			expectedNextCheckTimes:   []time.Duration{},
+		},
 		{
 			desc:                              "health check with empty unhealthy conditions and node",
 			targets:                           []healthCheckTarget{nodeEmptyConditions},
@@ -635,6 +771,36 @@ func newTestUnhealthyNode(name string, condition corev1.NodeConditionType, statu
 	}
 }
 
+func newTestUnhealthyMachine(machine *clusterv1.Machine, conditionType string, status metav1.ConditionStatus, unhealthyDuration time.Duration) *clusterv1.Machine {
+	m := machine.DeepCopy()
+	conditions.Set(m, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             "Unhealthy",
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-unhealthyDuration)),
+	})
+	return m
+}
+
+func newTestTaintedNode(name string, key string, effect corev1.TaintEffect, taintedDuration *time.Duration) *corev1.Node {
+	taint := corev1.Taint{
+		Key:    key,
+		Effect: effect,
+	}
+	if taintedDuration != nil {
+		taint.TimeAdded = ptr.To(metav1.NewTime(time.Now().Add(-*taintedDuration)))
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			UID:  "12345",
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{taint},
+		},
+	}
+}
+
 func newFailedHealthCheckV1Beta1Condition(reason string, messageFormat string, messageArgs ...interface{}) clusterv1.Condition {
 	return *v1beta1conditions.FalseCondition(clusterv1.MachineHealthCheckSucceededV1Beta1Condition, reason, clusterv1.ConditionSeverityWarning, messageFormat, messageArgs...)
 }
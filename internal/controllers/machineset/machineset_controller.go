@@ -48,6 +48,7 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/clustercache"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/controllers/noderefutil"
+	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
 	"sigs.k8s.io/cluster-api/internal/contract"
 	"sigs.k8s.io/cluster-api/internal/controllers/machine"
 	"sigs.k8s.io/cluster-api/internal/controllers/machinedeployment/mdutil"
@@ -96,6 +97,9 @@ type Reconciler struct {
 
 	PreflightChecks sets.Set[clusterv1.MachineSetPreflightCheck]
 
+	// RuntimeClient is used to call the SelectMachinesForDeletion hook.
+	RuntimeClient runtimeclient.Client
+
 	// WatchFilterValue is the label value used to filter events prior to reconciliation.
 	WatchFilterValue string
 
@@ -202,6 +206,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (retres ct
 		cluster:            cluster,
 		machineSet:         machineSet,
 		reconciliationTime: time.Now(),
+		nodeInfo:           newClusterNodeInfo(r.ClusterCache, cluster),
 	}
 
 	defer func() {
@@ -275,6 +280,8 @@ type scope struct {
 	getAndAdoptMachinesForMachineSetSucceeded bool
 	owningMachineDeployment                   *clusterv1.MachineDeployment
 	scaleUpPreflightCheckErrMessages          []string
+	scaleDownPreflightCheckErrMessages        []string
+	nodeInfo                                  nodeInfo
 	reconciliationTime                        time.Time
 }
 
@@ -810,13 +817,27 @@ func (r *Reconciler) syncReplicas(ctx context.Context, s *scope) (ctrl.Result, e
 	case diff > 0:
 		log.Info(fmt.Sprintf("MachineSet is scaling down to %d replicas by deleting %d machines", *(ms.Spec.Replicas), diff), "replicas", *(ms.Spec.Replicas), "machineCount", len(machines), "deletePolicy", ms.Spec.DeletePolicy)
 
-		deletePriorityFunc, err := getDeletePriorityFunc(ms)
+		machinesToDelete, err := r.getMachinesToDelete(ctx, s, machines, diff)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 
 		var errs []error
-		machinesToDelete := getMachinesToDeletePrioritized(machines, diff, deletePriorityFunc)
+
+		preflightCheckErrMessages, err := r.runScaleDownPreflightChecks(ctx, cluster, ms, machinesToDelete)
+		if err != nil || len(preflightCheckErrMessages) > 0 {
+			if err != nil {
+				// If err is not nil use that as the preflightCheckErrMessage
+				preflightCheckErrMessages = append(preflightCheckErrMessages, err.Error())
+			}
+
+			s.scaleDownPreflightCheckErrMessages = preflightCheckErrMessages
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: preflightFailedRequeueAfter}, nil
+		}
+
 		for i, machine := range machinesToDelete {
 			log := log.WithValues("Machine", klog.KObj(machine))
 			if machine.GetDeletionTimestamp().IsZero() {
@@ -55,7 +55,7 @@ func (r *Reconciler) updateStatus(ctx context.Context, s *scope) error {
 
 	// Update the ScalingUp and ScalingDown condition.
 	setScalingUpCondition(ctx, s.machineSet, s.machines, s.bootstrapObjectNotFound, s.infrastructureObjectNotFound, s.getAndAdoptMachinesForMachineSetSucceeded, s.scaleUpPreflightCheckErrMessages)
-	setScalingDownCondition(ctx, s.machineSet, s.machines, s.getAndAdoptMachinesForMachineSetSucceeded)
+	setScalingDownCondition(ctx, s.machineSet, s.machines, s.getAndAdoptMachinesForMachineSetSucceeded, s.scaleDownPreflightCheckErrMessages)
 
 	// MachinesReady condition: aggregate the Machine's Ready condition.
 	setMachinesReadyCondition(ctx, s.machineSet, s.machines, s.getAndAdoptMachinesForMachineSetSucceeded)
@@ -164,7 +164,7 @@ func setScalingUpCondition(_ context.Context, ms *clusterv1.MachineSet, machines
 	})
 }
 
-func setScalingDownCondition(_ context.Context, ms *clusterv1.MachineSet, machines []*clusterv1.Machine, getAndAdoptMachinesForMachineSetSucceeded bool) {
+func setScalingDownCondition(_ context.Context, ms *clusterv1.MachineSet, machines []*clusterv1.Machine, getAndAdoptMachinesForMachineSetSucceeded bool, scaleDownPreflightCheckErrMessages []string) {
 	// If we got unexpected errors in listing the machines (this should never happen), surface them.
 	if !getAndAdoptMachinesForMachineSetSucceeded {
 		conditions.Set(ms, metav1.Condition{
@@ -196,6 +196,13 @@ func setScalingDownCondition(_ context.Context, ms *clusterv1.MachineSet, machin
 	// Scaling down.
 	if currentReplicas > desiredReplicas {
 		message := fmt.Sprintf("Scaling down from %d to %d replicas", currentReplicas, desiredReplicas)
+		if len(scaleDownPreflightCheckErrMessages) > 0 {
+			listMessages := make([]string, len(scaleDownPreflightCheckErrMessages))
+			for i, msg := range scaleDownPreflightCheckErrMessages {
+				listMessages[i] = fmt.Sprintf("* %s", msg)
+			}
+			message += fmt.Sprintf(" is blocked because:\n%s", strings.Join(listMessages, "\n"))
+		}
 		staleMessage := aggregateStaleMachines(machines)
 		if staleMessage != "" {
 			message += fmt.Sprintf("\n* %s", staleMessage)
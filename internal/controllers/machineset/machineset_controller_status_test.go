@@ -372,6 +372,7 @@ func Test_setScalingDownCondition(t *testing.T) {
 		ms                                        *clusterv1.MachineSet
 		machines                                  []*clusterv1.Machine
 		getAndAdoptMachinesForMachineSetSucceeded bool
+		scaleDownPreflightCheckErrMessages        []string
 		expectCondition                           metav1.Condition
 	}{
 		{
@@ -422,6 +423,22 @@ func Test_setScalingDownCondition(t *testing.T) {
 				Message: "Scaling down from 1 to 0 replicas",
 			},
 		},
+		{
+			name: "scaling down blocked by preflight checks",
+			ms:   machineSet,
+			machines: []*clusterv1.Machine{
+				fakeMachine("machine-1"),
+			},
+			getAndAdoptMachinesForMachineSetSucceeded: true,
+			scaleDownPreflightCheckErrMessages:        []string{"Pod default/pod-1 cannot be rescheduled on the remaining Nodes because of insufficient CPU, memory or Pod capacity (\"DrainCapacity\" preflight check failed)"},
+			expectCondition: metav1.Condition{
+				Type:   clusterv1.MachineSetScalingDownCondition,
+				Status: metav1.ConditionTrue,
+				Reason: clusterv1.MachineSetScalingDownReason,
+				Message: "Scaling down from 1 to 0 replicas is blocked because:\n" +
+					"* Pod default/pod-1 cannot be rescheduled on the remaining Nodes because of insufficient CPU, memory or Pod capacity (\"DrainCapacity\" preflight check failed)",
+			},
+		},
 		{
 			name: "scaling down with 1 stale machine",
 			ms:   machineSet1Replica,
@@ -525,7 +542,7 @@ After above Pods have been removed from the Node, the following Pods will be evi
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
 
-			setScalingDownCondition(ctx, tt.ms, tt.machines, tt.getAndAdoptMachinesForMachineSetSucceeded)
+			setScalingDownCondition(ctx, tt.ms, tt.machines, tt.getAndAdoptMachinesForMachineSetSucceeded, tt.scaleDownPreflightCheckErrMessages)
 
 			condition := conditions.Get(tt.ms, clusterv1.MachineSetScalingDownCondition)
 			g.Expect(condition).ToNot(BeNil())
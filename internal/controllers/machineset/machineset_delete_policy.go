@@ -17,11 +17,18 @@ limitations under the License.
 package machineset
 
 import (
+	"context"
+	"fmt"
 	"math"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/util/conditions"
@@ -32,6 +39,43 @@ type (
 	deletePriorityFunc func(machine *clusterv1.Machine) deletePriority
 )
 
+// deletePolicy implements a MachineSetDeletePolicy, i.e. it defines which Machines are deleted first on scale down.
+type deletePolicy interface {
+	// DeletePriorityFunc returns the deletePriorityFunc to prioritize the given Machines for deletion.
+	// Delete policies that need information about the Nodes of the Machines can read it from nodes.
+	DeletePriorityFunc(ctx context.Context, machines []*clusterv1.Machine, nodes nodeInfo) (deletePriorityFunc, error)
+}
+
+// deletePolicyFunc is an adapter to allow the use of ordinary functions as delete policies.
+type deletePolicyFunc func(ctx context.Context, machines []*clusterv1.Machine, nodes nodeInfo) (deletePriorityFunc, error)
+
+// DeletePriorityFunc implements deletePolicy.
+func (f deletePolicyFunc) DeletePriorityFunc(ctx context.Context, machines []*clusterv1.Machine, nodes nodeInfo) (deletePriorityFunc, error) {
+	return f(ctx, machines, nodes)
+}
+
+// deletePolicies holds the delete policies supported by the MachineSet controller; delete policies are
+// added with registerDeletePolicy.
+var deletePolicies = map[clusterv1.MachineSetDeletePolicy]deletePolicy{}
+
+// registerDeletePolicy registers the implementation of a MachineSetDeletePolicy.
+// It panics if a delete policy with the same name is already registered.
+func registerDeletePolicy(name clusterv1.MachineSetDeletePolicy, policy deletePolicy) {
+	if _, ok := deletePolicies[name]; ok {
+		panic(fmt.Sprintf("delete policy %q is already registered", name))
+	}
+	deletePolicies[name] = policy
+}
+
+func init() {
+	registerDeletePolicy("", staticDeletePolicy(randomDeletePolicy))
+	registerDeletePolicy(clusterv1.RandomMachineSetDeletePolicy, staticDeletePolicy(randomDeletePolicy))
+	registerDeletePolicy(clusterv1.NewestMachineSetDeletePolicy, staticDeletePolicy(newestDeletePriority))
+	registerDeletePolicy(clusterv1.OldestMachineSetDeletePolicy, staticDeletePolicy(oldestDeletePriority))
+	registerDeletePolicy(clusterv1.LeastUtilizedMachineSetDeletePolicy, deletePolicyFunc(leastUtilizedDeletePolicy))
+	registerDeletePolicy(clusterv1.PriorityMachineSetDeletePolicy, deletePolicyFunc(customDeletePriorityPolicy))
+}
+
 const (
 	mustDelete    deletePriority = 100.0
 	shouldDelete  deletePriority = 75.0
@@ -89,6 +133,109 @@ func randomDeletePolicy(machine *clusterv1.Machine) deletePriority {
 	return couldDelete
 }
 
+// staticDeletePolicy returns a deletePolicyFunc for delete policies which only depend on the Machine itself.
+func staticDeletePolicy(f deletePriorityFunc) deletePolicy {
+	return deletePolicyFunc(func(context.Context, []*clusterv1.Machine, nodeInfo) (deletePriorityFunc, error) {
+		return f, nil
+	})
+}
+
+// leastUtilizedDeletePolicy prioritizes Machines whose Nodes have the lowest utilization.
+// If the utilization of the Nodes cannot be read, e.g. because the workload cluster is not reachable,
+// Machines are prioritized by the Oldest delete policy, so scale down is not blocked.
+func leastUtilizedDeletePolicy(ctx context.Context, machines []*clusterv1.Machine, nodes nodeInfo) (deletePriorityFunc, error) {
+	utilization := map[string]float64{}
+	for _, m := range machines {
+		if !isMachineHealthy(m) || !m.DeletionTimestamp.IsZero() {
+			continue
+		}
+		u, err := nodes.Utilization(ctx, m.Status.NodeRef.Name)
+		if err != nil {
+			ctrl.LoggerFrom(ctx).Error(err, fmt.Sprintf("Failed to compute Node utilization, falling back to %s delete policy", clusterv1.OldestMachineSetDeletePolicy), "Machine", klog.KObj(m))
+			return oldestDeletePriority, nil
+		}
+		utilization[m.Name] = u
+	}
+
+	return func(machine *clusterv1.Machine) deletePriority {
+		if p, ok := defaultDeletePriority(machine); ok {
+			return p
+		}
+		// Maps the utilization onto the priority range below betterDelete, the least utilized first.
+		return deletePriority(float64(betterDelete-1) * (1.0 - math.Min(utilization[machine.Name], 1.0)))
+	}, nil
+}
+
+// customDeletePriorityPolicy prioritizes Machines with the highest value of the delete priority annotation or label.
+func customDeletePriorityPolicy(ctx context.Context, machines []*clusterv1.Machine, nodes nodeInfo) (deletePriorityFunc, error) {
+	priorities := map[string]int64{}
+	values := sets.New[int64](0)
+	for _, m := range machines {
+		p, err := machineDeletePriority(ctx, m, nodes)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to compute delete priority for Machine %s", klog.KObj(m))
+		}
+		priorities[m.Name] = p
+		values.Insert(p)
+	}
+
+	// Rank the values, so arbitrary priorities can be mapped onto the priority range below betterDelete.
+	ranks := map[int64]int{}
+	for i, v := range sets.List(values) {
+		ranks[v] = i + 1
+	}
+
+	return func(machine *clusterv1.Machine) deletePriority {
+		if p, ok := defaultDeletePriority(machine); ok {
+			return p
+		}
+		return deletePriority(float64(betterDelete-1) * float64(ranks[priorities[machine.Name]]) / float64(len(ranks)))
+	}, nil
+}
+
+// machineDeletePriority returns the value of the delete priority annotation or label of a Machine,
+// falling back to the label of its Node. Invalid values are ignored.
+func machineDeletePriority(ctx context.Context, machine *clusterv1.Machine, nodes nodeInfo) (int64, error) {
+	value, ok := machine.Annotations[clusterv1.DeletePriorityAnnotation]
+	if !ok {
+		value, ok = machine.Labels[clusterv1.DeletePriorityAnnotation]
+	}
+	if !ok && machine.Status.NodeRef != nil && machine.DeletionTimestamp.IsZero() {
+		node, err := nodes.GetNode(ctx, machine.Status.NodeRef.Name)
+		if err != nil {
+			return 0, err
+		}
+		if node != nil {
+			value, ok = node.Labels[clusterv1.DeletePriorityAnnotation]
+		}
+	}
+	if !ok {
+		return 0, nil
+	}
+
+	p, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).V(4).Info("Ignoring invalid delete priority", "Machine", klog.KObj(machine), "value", value)
+		return 0, nil
+	}
+	return p, nil
+}
+
+// defaultDeletePriority returns the priority for Machines which are prioritized for deletion by all
+// the delete policies, i.e. Machines already being deleted, marked for deletion or unhealthy.
+func defaultDeletePriority(machine *clusterv1.Machine) (deletePriority, bool) {
+	if !machine.DeletionTimestamp.IsZero() {
+		return mustDelete, true
+	}
+	if _, ok := machine.Annotations[clusterv1.DeleteMachineAnnotation]; ok {
+		return shouldDelete, true
+	}
+	if !isMachineHealthy(machine) {
+		return betterDelete, true
+	}
+	return 0, false
+}
+
 type sortableMachines struct {
 	machines []*clusterv1.Machine
 	priority deletePriorityFunc
@@ -122,20 +269,21 @@ func getMachinesToDeletePrioritized(filteredMachines []*clusterv1.Machine, diff
 	return sortable.machines[:diff]
 }
 
-func getDeletePriorityFunc(ms *clusterv1.MachineSet) (deletePriorityFunc, error) {
+func getDeletePriorityFunc(ctx context.Context, ms *clusterv1.MachineSet, machines []*clusterv1.Machine, nodes nodeInfo) (deletePriorityFunc, error) {
 	// Map the Spec.DeletePolicy value to the appropriate delete priority function
-	switch msdp := clusterv1.MachineSetDeletePolicy(ms.Spec.DeletePolicy); msdp {
-	case clusterv1.RandomMachineSetDeletePolicy:
-		return randomDeletePolicy, nil
-	case clusterv1.NewestMachineSetDeletePolicy:
-		return newestDeletePriority, nil
-	case clusterv1.OldestMachineSetDeletePolicy:
-		return oldestDeletePriority, nil
-	case "":
-		return randomDeletePolicy, nil
-	default:
-		return nil, errors.Errorf("Unsupported delete policy %s. Must be one of 'Random', 'Newest', or 'Oldest'", msdp)
+	msdp := clusterv1.MachineSetDeletePolicy(ms.Spec.DeletePolicy)
+	policy, ok := deletePolicies[msdp]
+	if !ok {
+		names := []string{}
+		for name := range deletePolicies {
+			if name != "" {
+				names = append(names, fmt.Sprintf("'%s'", name))
+			}
+		}
+		sort.Strings(names)
+		return nil, errors.Errorf("Unsupported delete policy %s. Must be one of %s", msdp, strings.Join(names, ", "))
 	}
+	return policy.DeletePriorityFunc(ctx, machines, nodes)
 }
 
 func isMachineHealthy(machine *clusterv1.Machine) bool {
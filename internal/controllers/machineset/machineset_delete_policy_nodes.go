@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+)
+
+// nodeInfo provides information about the Nodes of the workload cluster to delete policies.
+type nodeInfo interface {
+	// GetNode returns the Node with the given name, or nil if the Node does not exist.
+	GetNode(ctx context.Context, name string) (*corev1.Node, error)
+
+	// Utilization returns the highest ratio between the CPU or memory requested by the Pods running
+	// on the Node with the given name and the allocatable CPU or memory of the Node.
+	// If the Node does not exist, 0 is returned.
+	Utilization(ctx context.Context, name string) (float64, error)
+}
+
+// clusterNodeInfo implements nodeInfo by reading Nodes and Pods from the workload cluster.
+// Every Node and the Pods running on it are read at most once, so a new clusterNodeInfo
+// should be created for every reconcile.
+type clusterNodeInfo struct {
+	clusterCache clustercache.ClusterCache
+	cluster      *clusterv1.Cluster
+
+	remoteClient client.Client
+	nodes        map[string]*corev1.Node
+	utilization  map[string]float64
+}
+
+var _ nodeInfo = &clusterNodeInfo{}
+
+func newClusterNodeInfo(clusterCache clustercache.ClusterCache, cluster *clusterv1.Cluster) *clusterNodeInfo {
+	return &clusterNodeInfo{
+		clusterCache: clusterCache,
+		cluster:      cluster,
+		nodes:        map[string]*corev1.Node{},
+		utilization:  map[string]float64{},
+	}
+}
+
+func (n *clusterNodeInfo) getRemoteClient(ctx context.Context) (client.Client, error) {
+	if n.remoteClient != nil {
+		return n.remoteClient, nil
+	}
+	remoteClient, err := n.clusterCache.GetClient(ctx, client.ObjectKeyFromObject(n.cluster))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get client for workload cluster")
+	}
+	n.remoteClient = remoteClient
+	return remoteClient, nil
+}
+
+func (n *clusterNodeInfo) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
+	if node, ok := n.nodes[name]; ok {
+		return node, nil
+	}
+
+	remoteClient, err := n.getRemoteClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	node := &corev1.Node{}
+	if err := remoteClient.Get(ctx, client.ObjectKey{Name: name}, node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "failed to get Node %s", name)
+		}
+		node = nil
+	}
+	n.nodes[name] = node
+	return node, nil
+}
+
+func (n *clusterNodeInfo) Utilization(ctx context.Context, name string) (float64, error) {
+	if u, ok := n.utilization[name]; ok {
+		return u, nil
+	}
+
+	node, err := n.GetNode(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	if node == nil {
+		n.utilization[name] = 0
+		return 0, nil
+	}
+
+	// Note: Pods are not cached in the ClusterCache, so we are listing only the Pods running on the Node.
+	remoteClient, err := n.getRemoteClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	cpu := resource.Quantity{}
+	memory := resource.Quantity{}
+	podList := &corev1.PodList{}
+	for {
+		listOpts := []client.ListOption{
+			client.InNamespace(metav1.NamespaceAll),
+			client.MatchingFields{"spec.nodeName": name},
+			client.Continue(podList.Continue),
+			client.Limit(100),
+		}
+		if err := remoteClient.List(ctx, podList, listOpts...); err != nil {
+			return 0, errors.Wrapf(err, "failed to list Pods on Node %s", name)
+		}
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+				continue
+			}
+			requests := drain.PodRequests(&pod)
+			cpu.Add(*requests.Cpu())
+			memory.Add(*requests.Memory())
+		}
+		if podList.Continue == "" {
+			break
+		}
+	}
+
+	u := max(ratio(cpu, *node.Status.Allocatable.Cpu()), ratio(memory, *node.Status.Allocatable.Memory()))
+	n.utilization[name] = u
+	return u, nil
+}
+
+func ratio(requested, allocatable resource.Quantity) float64 {
+	if allocatable.IsZero() {
+		return 0
+	}
+	return requested.AsApproximateFloat64() / allocatable.AsApproximateFloat64()
+}
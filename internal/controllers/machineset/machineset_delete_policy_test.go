@@ -17,14 +17,23 @@ limitations under the License.
 package machineset
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
 )
 
 func TestMachineToDelete(t *testing.T) {
@@ -790,3 +799,277 @@ func TestIsMachineHealthy(t *testing.T) {
 		})
 	}
 }
+
+type fakeNodeInfo struct {
+	nodes       map[string]*corev1.Node
+	utilization map[string]float64
+	err         error
+}
+
+func (f *fakeNodeInfo) GetNode(_ context.Context, name string) (*corev1.Node, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.nodes[name], nil
+}
+
+func (f *fakeNodeInfo) Utilization(_ context.Context, name string) (float64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+	return f.utilization[name], nil
+}
+
+func TestMachineLeastUtilizedDelete(t *testing.T) {
+	machine := func(name, nodeName string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     clusterv1.MachineStatus{NodeRef: &clusterv1.MachineNodeReference{Name: nodeName}},
+		}
+	}
+	lowUtilization := machine("low", "node-low")
+	mediumUtilization := machine("medium", "node-medium")
+	highUtilization := machine("high", "node-high")
+	overUtilization := machine("over", "node-over")
+	nodeNotFound := machine("not-found", "node-not-found")
+	deleteMachineWithMachineAnnotation := machine("annotated", "node-high")
+	deleteMachineWithMachineAnnotation.Annotations = map[string]string{clusterv1.DeleteMachineAnnotation: ""}
+	unhealthyMachine := &clusterv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "unhealthy"}}
+
+	nodes := &fakeNodeInfo{
+		utilization: map[string]float64{
+			"node-low":    0.1,
+			"node-medium": 0.5,
+			"node-high":   0.9,
+			"node-over":   1.5,
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		diff     int
+		machines []*clusterv1.Machine
+		expect   []*clusterv1.Machine
+	}{
+		{
+			desc:     "func=leastUtilizedDeletePolicy, diff=1",
+			diff:     1,
+			machines: []*clusterv1.Machine{highUtilization, lowUtilization, mediumUtilization},
+			expect:   []*clusterv1.Machine{lowUtilization},
+		},
+		{
+			desc:     "func=leastUtilizedDeletePolicy, diff=2",
+			diff:     2,
+			machines: []*clusterv1.Machine{highUtilization, overUtilization, lowUtilization, mediumUtilization},
+			expect:   []*clusterv1.Machine{lowUtilization, mediumUtilization},
+		},
+		{
+			desc:     "func=leastUtilizedDeletePolicy, diff=1 (Node not found)",
+			diff:     1,
+			machines: []*clusterv1.Machine{highUtilization, lowUtilization, nodeNotFound},
+			expect:   []*clusterv1.Machine{nodeNotFound},
+		},
+		{
+			desc:     "func=leastUtilizedDeletePolicy, diff=2 (unhealthy and annotated)",
+			diff:     2,
+			machines: []*clusterv1.Machine{highUtilization, lowUtilization, unhealthyMachine, deleteMachineWithMachineAnnotation},
+			expect:   []*clusterv1.Machine{deleteMachineWithMachineAnnotation, unhealthyMachine},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			priorityFunc, err := leastUtilizedDeletePolicy(ctx, test.machines, nodes)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			result := getMachinesToDeletePrioritized(test.machines, test.diff, priorityFunc)
+			g.Expect(result).To(BeComparableTo(test.expect))
+		})
+	}
+
+	t.Run("func=leastUtilizedDeletePolicy, falls back to the Oldest delete policy if Node utilization cannot be read", func(t *testing.T) {
+		g := NewWithT(t)
+
+		older := machine("older", "node-older")
+		older.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+		newer := machine("newer", "node-newer")
+		newer.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+		machines := []*clusterv1.Machine{newer, older}
+
+		priorityFunc, err := leastUtilizedDeletePolicy(ctx, machines, &fakeNodeInfo{err: errors.New("connection refused")})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		result := getMachinesToDeletePrioritized(machines, 1, priorityFunc)
+		g.Expect(result).To(BeComparableTo([]*clusterv1.Machine{older}))
+	})
+}
+
+func TestMachinePriorityDelete(t *testing.T) {
+	machine := func(name string, annotations, labels map[string]string) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations, Labels: labels},
+			Status:     clusterv1.MachineStatus{NodeRef: &clusterv1.MachineNodeReference{Name: "node-" + name}},
+		}
+	}
+	noPriority := machine("no-priority", nil, nil)
+	invalidPriority := machine("invalid-priority", map[string]string{clusterv1.DeletePriorityAnnotation: "foo"}, nil)
+	negativePriority := machine("negative-priority", map[string]string{clusterv1.DeletePriorityAnnotation: "-10"}, nil)
+	annotationPriority := machine("annotation-priority", map[string]string{clusterv1.DeletePriorityAnnotation: "100"}, map[string]string{clusterv1.DeletePriorityAnnotation: "1"})
+	labelPriority := machine("label-priority", nil, map[string]string{clusterv1.DeletePriorityAnnotation: "50"})
+	nodeLabelPriority := machine("node-label-priority", nil, nil)
+	deleteMachineWithMachineAnnotation := machine("annotated", map[string]string{clusterv1.DeleteMachineAnnotation: ""}, nil)
+
+	nodes := &fakeNodeInfo{
+		nodes: map[string]*corev1.Node{
+			"node-node-label-priority": {
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "node-node-label-priority",
+					Labels: map[string]string{clusterv1.DeletePriorityAnnotation: "10"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc     string
+		diff     int
+		machines []*clusterv1.Machine
+		expect   []*clusterv1.Machine
+	}{
+		{
+			desc:     "func=customDeletePriorityPolicy, diff=1 (annotation takes precedence over label)",
+			diff:     1,
+			machines: []*clusterv1.Machine{noPriority, labelPriority, annotationPriority},
+			expect:   []*clusterv1.Machine{annotationPriority},
+		},
+		{
+			desc:     "func=customDeletePriorityPolicy, diff=3",
+			diff:     3,
+			machines: []*clusterv1.Machine{noPriority, nodeLabelPriority, negativePriority, labelPriority, annotationPriority},
+			expect:   []*clusterv1.Machine{annotationPriority, labelPriority, nodeLabelPriority},
+		},
+		{
+			desc:     "func=customDeletePriorityPolicy, diff=2 (invalid priority is ignored)",
+			diff:     2,
+			machines: []*clusterv1.Machine{negativePriority, noPriority, invalidPriority},
+			expect:   []*clusterv1.Machine{invalidPriority, noPriority},
+		},
+		{
+			desc:     "func=customDeletePriorityPolicy, diff=1 (annotated)",
+			diff:     1,
+			machines: []*clusterv1.Machine{annotationPriority, deleteMachineWithMachineAnnotation},
+			expect:   []*clusterv1.Machine{deleteMachineWithMachineAnnotation},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			g := NewWithT(t)
+
+			priorityFunc, err := customDeletePriorityPolicy(ctx, test.machines, nodes)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			result := getMachinesToDeletePrioritized(test.machines, test.diff, priorityFunc)
+			g.Expect(result).To(BeComparableTo(test.expect))
+		})
+	}
+}
+
+func TestGetDeletePriorityFunc(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, policy := range []string{"", "Random", "Newest", "Oldest", "LeastUtilized", "Priority"} {
+		ms := &clusterv1.MachineSet{Spec: clusterv1.MachineSetSpec{DeletePolicy: policy}}
+		f, err := getDeletePriorityFunc(ctx, ms, nil, &fakeNodeInfo{})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(f).ToNot(BeNil())
+	}
+
+	ms := &clusterv1.MachineSet{Spec: clusterv1.MachineSetSpec{DeletePolicy: "Unknown"}}
+	_, err := getDeletePriorityFunc(ctx, ms, nil, &fakeNodeInfo{})
+	g.Expect(err).To(MatchError("Unsupported delete policy Unknown. Must be one of 'LeastUtilized', 'Newest', 'Oldest', 'Priority', 'Random'"))
+
+	g.Expect(func() {
+		registerDeletePolicy(clusterv1.RandomMachineSetDeletePolicy, staticDeletePolicy(randomDeletePolicy))
+	}).To(PanicWith(ContainSubstring("already registered")))
+}
+
+func TestClusterNodeInfo(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster", Namespace: "default"}}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		},
+	}
+	pod := func(name, cpu, memory string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec: corev1.PodSpec{
+				NodeName: "node-1",
+				Containers: []corev1.Container{
+					{
+						Name: "container",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse(cpu),
+								corev1.ResourceMemory: resource.MustParse(memory),
+							},
+						},
+					},
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	listCalls := 0
+	remoteClient := fake.NewClientBuilder().
+		WithObjects(node,
+			pod("pod-1", "1", "1Gi", corev1.PodRunning),
+			pod("pod-2", "500m", "5Gi", corev1.PodRunning),
+			pod("pod-3", "2", "1Gi", corev1.PodSucceeded),
+		).
+		WithIndex(&corev1.Pod{}, "spec.nodeName", func(o client.Object) []string {
+			return []string{o.(*corev1.Pod).Spec.NodeName}
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				listCalls++
+				return c.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+
+	nodes := newClusterNodeInfo(clustercache.NewFakeClusterCache(remoteClient, client.ObjectKeyFromObject(cluster)), cluster)
+
+	got, err := nodes.GetNode(ctx, "node-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got.Name).To(Equal("node-1"))
+
+	got, err = nodes.GetNode(ctx, "node-does-not-exist")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(got).To(BeNil())
+
+	// Memory utilization is the highest: 6Gi / 8Gi.
+	u, err := nodes.Utilization(ctx, "node-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(u).To(BeNumerically("~", 0.75, 0.001))
+
+	// Utilization is cached.
+	u, err = nodes.Utilization(ctx, "node-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(u).To(BeNumerically("~", 0.75, 0.001))
+	g.Expect(listCalls).To(Equal(1))
+
+	u, err = nodes.Utilization(ctx, "node-does-not-exist")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(u).To(BeZero())
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+)
+
+// getMachinesToDelete returns the Machines to delete when scaling down the MachineSet by diff Machines.
+// Machines which are already being deleted are always selected first; then the Machines selected by the
+// SelectMachinesForDeletion Runtime Extensions, if any, and finally the Machines selected by the delete policy.
+// If calling the Runtime Extensions fails, the Machines are selected by the delete policy only.
+//
+// Note: the hook is intentionally called only here. The MachineDeployment controller never deletes Machines,
+// it only scales its MachineSets (and deletes MachineSets once they are scaled down to zero), so every Machine
+// deleted while scaling down or rolling out a MachineDeployment goes through this func in the MachineSet controller.
+// Which MachineSet is scaled down is decided by the MachineDeployment rollout strategy, not by the hook.
+func (r *Reconciler) getMachinesToDelete(ctx context.Context, s *scope, machines []*clusterv1.Machine, diff int) ([]*clusterv1.Machine, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ms := s.machineSet
+
+	deletePriorityFunc, err := getDeletePriorityFunc(ctx, ms, machines, s.nodeInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := r.callSelectMachinesForDeletionHook(ctx, s, machines, diff)
+	if err != nil {
+		log.Error(err, fmt.Sprintf("Failed to call %s hook, falling back to delete policy", runtimecatalog.HookName(runtimehooksv1.SelectMachinesForDeletion)), "deletePolicy", ms.Spec.DeletePolicy)
+		r.recorder.Eventf(ms, corev1.EventTypeWarning, "FailedSelectMachinesForDeletion", "Failed to call %s hook, falling back to delete policy: %v", runtimecatalog.HookName(runtimehooksv1.SelectMachinesForDeletion), err)
+		selected = nil
+	}
+	if len(selected) == 0 {
+		return getMachinesToDeletePrioritized(machines, diff, deletePriorityFunc), nil
+	}
+
+	// Sort a copy of all the Machines by the delete policy, so it can be used for the Machines not selected by the extension.
+	prioritized := append([]*clusterv1.Machine{}, machines...)
+	sort.Sort(sortableMachines{machines: prioritized, priority: deletePriorityFunc})
+
+	machinesByName := map[string]*clusterv1.Machine{}
+	for _, m := range machines {
+		machinesByName[m.Name] = m
+	}
+	names := []string{}
+	for _, m := range machines {
+		if !m.DeletionTimestamp.IsZero() {
+			names = append(names, m.Name)
+		}
+	}
+	names = append(names, selected...)
+	for _, m := range prioritized {
+		names = append(names, m.Name)
+	}
+
+	machinesToDelete := []*clusterv1.Machine{}
+	seen := sets.Set[string]{}
+	for _, name := range names {
+		if len(machinesToDelete) == diff {
+			break
+		}
+		m, ok := machinesByName[name]
+		if !ok || seen.Has(name) {
+			continue
+		}
+		seen.Insert(name)
+		machinesToDelete = append(machinesToDelete, m)
+	}
+	return machinesToDelete, nil
+}
+
+// callSelectMachinesForDeletionHook calls the SelectMachinesForDeletion Runtime Extensions in order,
+// until one of them selects at least one Machine, and returns the names of the selected Machines.
+func (r *Reconciler) callSelectMachinesForDeletionHook(ctx context.Context, s *scope, machines []*clusterv1.Machine, diff int) ([]string, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if r.RuntimeClient == nil || !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return nil, nil
+	}
+
+	candidates := []*clusterv1.Machine{}
+	for _, m := range machines {
+		if m.DeletionTimestamp.IsZero() {
+			candidates = append(candidates, m)
+		}
+	}
+	machinesToDeleteCount := diff - (len(machines) - len(candidates))
+	if machinesToDeleteCount <= 0 {
+		return nil, nil
+	}
+
+	extensionNames, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.SelectMachinesForDeletion, s.machineSet)
+	if err != nil {
+		return nil, err
+	}
+	if len(extensionNames) == 0 {
+		return nil, nil
+	}
+
+	request, err := newSelectMachinesForDeletionRequest(s, candidates, machinesToDeleteCount)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range extensionNames {
+		response := &runtimehooksv1.SelectMachinesForDeletionResponse{}
+		if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.SelectMachinesForDeletion, s.machineSet, name, request, response); err != nil {
+			return nil, errors.Wrapf(err, "failed to call %s hook of extension %s", runtimecatalog.HookName(runtimehooksv1.SelectMachinesForDeletion), name)
+		}
+		if len(response.Machines) > 0 {
+			log.V(4).Info("Extension selected Machines for deletion", "extension", name, "machines", response.Machines)
+			return response.Machines, nil
+		}
+		log.V(4).Info("Extension did not select any Machine for deletion", "extension", name, "message", response.GetMessage())
+	}
+	return nil, nil
+}
+
+// newSelectMachinesForDeletionRequest returns the request for the SelectMachinesForDeletion hook,
+// converting the objects to the API version used in hook requests.
+func newSelectMachinesForDeletionRequest(s *scope, machines []*clusterv1.Machine, machinesToDeleteCount int) (*runtimehooksv1.SelectMachinesForDeletionRequest, error) {
+	request := &runtimehooksv1.SelectMachinesForDeletionRequest{
+		MachinesToDeleteCount: int32(machinesToDeleteCount), //nolint:gosec // the number of Machines fits into an int32
+	}
+	if err := clusterv1beta1.Convert_v1beta2_Cluster_To_v1beta1_Cluster(s.cluster, &request.Cluster, nil); err != nil {
+		return nil, errors.Wrap(err, "error converting Cluster to v1beta1 Cluster")
+	}
+	if s.owningMachineDeployment != nil {
+		request.MachineDeployment = &clusterv1beta1.MachineDeployment{}
+		if err := clusterv1beta1.Convert_v1beta2_MachineDeployment_To_v1beta1_MachineDeployment(s.owningMachineDeployment, request.MachineDeployment, nil); err != nil {
+			return nil, errors.Wrap(err, "error converting MachineDeployment to v1beta1 MachineDeployment")
+		}
+	}
+	if err := clusterv1beta1.Convert_v1beta2_MachineSet_To_v1beta1_MachineSet(s.machineSet, &request.MachineSet, nil); err != nil {
+		return nil, errors.Wrap(err, "error converting MachineSet to v1beta1 MachineSet")
+	}
+	for _, m := range machines {
+		v1beta1Machine := clusterv1beta1.Machine{}
+		if err := clusterv1beta1.Convert_v1beta2_Machine_To_v1beta1_Machine(m, &v1beta1Machine, nil); err != nil {
+			return nil, errors.Wrap(err, "error converting Machine to v1beta1 Machine")
+		}
+		request.Machines = append(request.Machines, v1beta1Machine)
+	}
+	return request, nil
+}
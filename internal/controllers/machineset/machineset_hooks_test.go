@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineset
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	utilfeature "k8s.io/component-base/featuregate/testing"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	"sigs.k8s.io/cluster-api/feature"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
+)
+
+func TestGetMachinesToDelete(t *testing.T) {
+	now := time.Now()
+	machine := func(name string, age time.Duration) *clusterv1.Machine {
+		return &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         metav1.NamespaceDefault,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Status: clusterv1.MachineStatus{NodeRef: &clusterv1.MachineNodeReference{Name: name}},
+		}
+	}
+	deletingMachine := machine("m0", 5*time.Hour)
+	deletingMachine.DeletionTimestamp = &metav1.Time{Time: now}
+	deletingMachine.Finalizers = []string{clusterv1.MachineFinalizer}
+	m1 := machine("m1", 4*time.Hour)
+	m2 := machine("m2", 3*time.Hour)
+	m3 := machine("m3", 2*time.Hour)
+	m4 := machine("m4", 1*time.Hour)
+	machines := []*clusterv1.Machine{m2, m4, deletingMachine, m1, m3}
+
+	success := func(names ...string) runtimehooksv1.ResponseObject {
+		return &runtimehooksv1.SelectMachinesForDeletionResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+			Machines:       names,
+		}
+	}
+	failure := &runtimehooksv1.SelectMachinesForDeletionResponse{
+		CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure},
+	}
+
+	tests := []struct {
+		name               string
+		runtimeSDKDisabled bool
+		extensions         []string
+		responses          map[string]runtimehooksv1.ResponseObject
+		diff               int
+		want               []string
+		wantCalls          map[string]int
+		wantEvent          bool
+	}{
+		{
+			name: "delete policy is used if there are no extensions",
+			diff: 2,
+			want: []string{"m0", "m1"},
+		},
+		{
+			name:               "delete policy is used if the RuntimeSDK feature gate is disabled",
+			runtimeSDKDisabled: true,
+			extensions:         []string{"ext-1"},
+			responses:          map[string]runtimehooksv1.ResponseObject{"ext-1": success("m4")},
+			diff:               2,
+			want:               []string{"m0", "m1"},
+			wantCalls:          map[string]int{"ext-1": 0},
+		},
+		{
+			name:       "hook is not called if enough Machines are already being deleted",
+			extensions: []string{"ext-1"},
+			responses:  map[string]runtimehooksv1.ResponseObject{"ext-1": success("m4")},
+			diff:       1,
+			want:       []string{"m0"},
+			wantCalls:  map[string]int{"ext-1": 0},
+		},
+		{
+			name:       "Machines selected by the extension are deleted after Machines already being deleted",
+			extensions: []string{"ext-1"},
+			responses:  map[string]runtimehooksv1.ResponseObject{"ext-1": success("m4", "m3")},
+			diff:       3,
+			want:       []string{"m0", "m4", "m3"},
+			wantCalls:  map[string]int{"ext-1": 1},
+		},
+		{
+			name:       "delete policy is used for the remaining Machines, unknown and duplicate Machines are ignored",
+			extensions: []string{"ext-1"},
+			responses:  map[string]runtimehooksv1.ResponseObject{"ext-1": success("m4", "unknown", "m4", "m0")},
+			diff:       3,
+			want:       []string{"m0", "m4", "m1"},
+			wantCalls:  map[string]int{"ext-1": 1},
+		},
+		{
+			name:       "next extension is called if an extension does not select any Machine",
+			extensions: []string{"ext-1", "ext-2", "ext-3"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"ext-1": success(),
+				"ext-2": success("m3"),
+				"ext-3": success("m4"),
+			},
+			diff:      2,
+			want:      []string{"m0", "m3"},
+			wantCalls: map[string]int{"ext-1": 1, "ext-2": 1, "ext-3": 0},
+		},
+		{
+			name:       "delete policy is used if the extension fails",
+			extensions: []string{"ext-1", "ext-2"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"ext-1": failure,
+				"ext-2": success("m4"),
+			},
+			diff:      2,
+			want:      []string{"m0", "m1"},
+			wantCalls: map[string]int{"ext-1": 1, "ext-2": 0},
+			wantEvent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, !tt.runtimeSDKDisabled)
+
+			catalog := runtimecatalog.New()
+			g.Expect(runtimehooksv1.AddToCatalog(catalog)).To(Succeed())
+			gvh, err := catalog.GroupVersionHook(runtimehooksv1.SelectMachinesForDeletion)
+			g.Expect(err).ToNot(HaveOccurred())
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{gvh: tt.extensions}).
+				WithCallExtensionResponses(tt.responses).
+				Build()
+
+			recorder := record.NewFakeRecorder(32)
+			r := &Reconciler{
+				RuntimeClient: runtimeClient,
+				recorder:      recorder,
+			}
+			s := &scope{
+				cluster: &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault}},
+				machineSet: &clusterv1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-ms", Namespace: metav1.NamespaceDefault},
+					Spec:       clusterv1.MachineSetSpec{DeletePolicy: string(clusterv1.OldestMachineSetDeletePolicy)},
+				},
+				nodeInfo: &fakeNodeInfo{},
+			}
+
+			machinesToDelete, err := r.getMachinesToDelete(ctx, s, machines, tt.diff)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			names := []string{}
+			for _, m := range machinesToDelete {
+				names = append(names, m.Name)
+			}
+			g.Expect(names).To(Equal(tt.want))
+			for name, calls := range tt.wantCalls {
+				g.Expect(runtimeClient.CallCount(name)).To(Equal(calls), fmt.Sprintf("unexpected number of calls to %s", name))
+			}
+			g.Expect(recorder.Events).To(HaveLen(map[bool]int{true: 1, false: 0}[tt.wantEvent]))
+		})
+	}
+}
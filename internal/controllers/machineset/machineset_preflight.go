@@ -31,12 +31,17 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/core/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	"sigs.k8s.io/cluster-api/controllers/external"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
+	"sigs.k8s.io/cluster-api/internal/controllers/machine/drain"
+	"sigs.k8s.io/cluster-api/util/conditions"
 )
 
 type preflightCheckErrorMessage *string
@@ -54,7 +59,32 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 
 	skipped := skippedPreflightChecks(ms)
 	// If all the preflight checks are skipped then return early.
-	if len(r.PreflightChecks) == 0 || skipped.Has(clusterv1.MachineSetPreflightCheckAll) {
+	if skipped.Has(clusterv1.MachineSetPreflightCheckAll) {
+		return nil, nil
+	}
+
+	preflightCheckErrs, err := r.runBuiltInPreflightChecks(ctx, cluster, ms, skipped)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks", action)
+	}
+
+	extensionPreflightCheckErrs, err := r.runExtensionPreflightChecks(ctx, cluster, ms, skipped)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks", action)
+	}
+	preflightCheckErrs = append(preflightCheckErrs, extensionPreflightCheckErrs...)
+
+	if len(preflightCheckErrs) > 0 {
+		log.Info(fmt.Sprintf("%s on hold because %s. The operation will continue after the preflight check(s) pass", action, strings.Join(preflightCheckErrs, "; ")))
+		return preflightCheckErrs, nil
+	}
+	return nil, nil
+}
+
+// runBuiltInPreflightChecks runs the preflight checks implemented by Cluster API and returns the messages of the
+// preflight checks which did not pass.
+func (r *Reconciler) runBuiltInPreflightChecks(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, skipped sets.Set[clusterv1.MachineSetPreflightCheck]) ([]string, error) {
+	if len(r.PreflightChecks) == 0 {
 		return nil, nil
 	}
 
@@ -66,7 +96,7 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 	// Get the control plane object.
 	controlPlane, err := external.Get(ctx, r.Client, cluster.Spec.ControlPlaneRef)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to get ControlPlane %s", action, klog.KRef(cluster.Spec.ControlPlaneRef.Namespace, cluster.Spec.ControlPlaneRef.Name))
+		return nil, errors.Wrapf(err, "failed to get ControlPlane %s", klog.KRef(cluster.Spec.ControlPlaneRef.Namespace, cluster.Spec.ControlPlaneRef.Name))
 	}
 	cpKlogRef := klog.KRef(controlPlane.GetNamespace(), controlPlane.GetName())
 
@@ -78,11 +108,11 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 		if errors.Is(err, contract.ErrFieldNotFound) {
 			return nil, nil
 		}
-		return nil, errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to get the version of ControlPlane %s", action, cpKlogRef)
+		return nil, errors.Wrapf(err, "failed to get the version of ControlPlane %s", cpKlogRef)
 	}
 	cpSemver, err := semver.ParseTolerant(*cpVersion)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to parse version %q of ControlPlane %s", action, *cpVersion, cpKlogRef)
+		return nil, errors.Wrapf(err, "failed to parse version %q of ControlPlane %s", *cpVersion, cpKlogRef)
 	}
 
 	errList := []error{}
@@ -103,7 +133,7 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 		msVersion := *ms.Spec.Template.Spec.Version
 		msSemver, err := semver.ParseTolerant(msVersion)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to perform %q: failed to perform preflight checks: failed to parse version %q of MachineSet %s", action, msVersion, klog.KObj(ms))
+			return nil, errors.Wrapf(err, "failed to parse version %q of MachineSet %s", msVersion, klog.KObj(ms))
 		}
 
 		// Run the kubernetes-version skew preflight check.
@@ -134,15 +164,106 @@ func (r *Reconciler) runPreflightChecks(ctx context.Context, cluster *clusterv1.
 	}
 
 	if len(errList) > 0 {
-		return nil, errors.Wrapf(kerrors.NewAggregate(errList), "failed to perform %q: failed to perform preflight checks", action)
+		return nil, kerrors.NewAggregate(errList)
 	}
-	if len(preflightCheckErrs) > 0 {
-		preflightCheckErrStrings := []string{}
-		for _, v := range preflightCheckErrs {
-			preflightCheckErrStrings = append(preflightCheckErrStrings, *v)
+	preflightCheckErrStrings := []string{}
+	for _, v := range preflightCheckErrs {
+		preflightCheckErrStrings = append(preflightCheckErrStrings, *v)
+	}
+	return preflightCheckErrStrings, nil
+}
+
+// runExtensionPreflightChecks runs the preflight checks implemented by Runtime Extensions and returns the messages of the
+// preflight checks which did not pass. Every extension handler registered for the MachineSetPreflightCheck hook is a
+// preflight check identified by the name of the extension handler, and it can be skipped like the built-in preflight checks.
+func (r *Reconciler) runExtensionPreflightChecks(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, skipped sets.Set[clusterv1.MachineSetPreflightCheck]) ([]string, error) {
+	if r.RuntimeClient == nil || !feature.Gates.Enabled(feature.RuntimeSDK) {
+		return nil, nil
+	}
+
+	extensionNames, err := r.RuntimeClient.GetAllExtensions(ctx, runtimehooksv1.MachineSetPreflightCheck, ms)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, name := range extensionNames {
+		if !skipped.Has(clusterv1.MachineSetPreflightCheck(name)) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	request := &runtimehooksv1.MachineSetPreflightCheckRequest{}
+	if err := clusterv1beta1.Convert_v1beta2_Cluster_To_v1beta1_Cluster(cluster, &request.Cluster, nil); err != nil {
+		return nil, errors.Wrap(err, "error converting Cluster to v1beta1 Cluster")
+	}
+	if err := clusterv1beta1.Convert_v1beta2_MachineSet_To_v1beta1_MachineSet(ms, &request.MachineSet, nil); err != nil {
+		return nil, errors.Wrap(err, "error converting MachineSet to v1beta1 MachineSet")
+	}
+
+	errList := []error{}
+	preflightCheckErrs := []string{}
+	for _, name := range names {
+		response := &runtimehooksv1.MachineSetPreflightCheckResponse{}
+		if err := r.RuntimeClient.CallExtension(ctx, runtimehooksv1.MachineSetPreflightCheck, ms, name, request, response); err != nil {
+			errList = append(errList, err)
+			continue
+		}
+		if response.RetryAfterSeconds != 0 {
+			message := response.GetMessage()
+			if message == "" {
+				message = "Runtime Extension did not allow to create Machines"
+			}
+			preflightCheckErrs = append(preflightCheckErrs, fmt.Sprintf("%s (%q preflight check failed)", message, name))
 		}
-		log.Info(fmt.Sprintf("%s on hold because %s. The operation will continue after the preflight check(s) pass", action, strings.Join(preflightCheckErrStrings, "; ")))
-		return preflightCheckErrStrings, nil
+	}
+	if len(errList) > 0 {
+		return nil, kerrors.NewAggregate(errList)
+	}
+	return preflightCheckErrs, nil
+}
+
+// runScaleDownPreflightChecks runs the preflight checks that must pass before deleting Machines on scale down.
+// Note: Differently from the other preflight checks, the DrainCapacity preflight check is not enabled by "All"
+// and it must be explicitly enabled.
+func (r *Reconciler) runScaleDownPreflightChecks(ctx context.Context, cluster *clusterv1.Cluster, ms *clusterv1.MachineSet, machinesToDelete []*clusterv1.Machine) ([]string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	// If the MachineSetPreflightChecks feature gate is disabled return early.
+	if !feature.Gates.Enabled(feature.MachineSetPreflightChecks) {
+		return nil, nil
+	}
+
+	skipped := skippedPreflightChecks(ms)
+	if !r.PreflightChecks.Has(clusterv1.MachineSetPreflightCheckDrainCapacity) ||
+		skipped.Has(clusterv1.MachineSetPreflightCheckAll) || skipped.Has(clusterv1.MachineSetPreflightCheckDrainCapacity) {
+		return nil, nil
+	}
+
+	// If the control plane is not initialized it is not possible to connect to the workload cluster. Return early.
+	if !conditions.IsTrue(cluster, clusterv1.ClusterControlPlaneInitializedCondition) {
+		return nil, nil
+	}
+
+	// Only consider Machines with a Node which are not already being deleted.
+	machines := []*clusterv1.Machine{}
+	for _, m := range machinesToDelete {
+		if m.DeletionTimestamp.IsZero() && m.Status.NodeRef != nil {
+			machines = append(machines, m)
+		}
+	}
+	if len(machines) == 0 {
+		return nil, nil
+	}
+
+	preflightCheckErr, err := r.drainCapacityPreflightCheck(ctx, cluster, machines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to perform \"Scale down\": failed to perform preflight checks")
+	}
+	if preflightCheckErr != nil {
+		log.Info(fmt.Sprintf("Scale down on hold because %s. The operation will continue after the preflight check(s) pass", *preflightCheckErr))
+		return []string{*preflightCheckErr}, nil
 	}
 	return nil, nil
 }
@@ -232,6 +353,26 @@ func (r *Reconciler) controlPlaneVersionPreflightCheck(cluster *clusterv1.Cluste
 	return nil
 }
 
+func (r *Reconciler) drainCapacityPreflightCheck(ctx context.Context, cluster *clusterv1.Cluster, machines []*clusterv1.Machine) (preflightCheckErrorMessage, error) {
+	remoteClient, err := r.ClusterCache.GetClient(ctx, client.ObjectKeyFromObject(cluster))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to perform %q preflight check: failed to get client for Cluster %s", clusterv1.MachineSetPreflightCheckDrainCapacity, klog.KObj(cluster))
+	}
+
+	drainer := &drain.Helper{
+		Client:       r.Client,
+		RemoteClient: remoteClient,
+	}
+	res, err := drainer.SimulateDrain(ctx, cluster, machines)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to perform %q preflight check", clusterv1.MachineSetPreflightCheckDrainCapacity)
+	}
+	if !res.CapacityAvailable() {
+		return ptr.To(fmt.Sprintf("%s (%q preflight check failed)", res.SimulationMessage(), clusterv1.MachineSetPreflightCheckDrainCapacity)), nil
+	}
+	return nil, nil
+}
+
 func skippedPreflightChecks(ms *clusterv1.MachineSet) sets.Set[clusterv1.MachineSetPreflightCheck] {
 	skipped := sets.Set[clusterv1.MachineSetPreflightCheck]{}
 	if ms == nil {
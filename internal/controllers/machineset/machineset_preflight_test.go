@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -31,8 +32,12 @@ import (
 
 	bootstrapv1 "sigs.k8s.io/cluster-api/api/bootstrap/kubeadm/v1beta2"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	"sigs.k8s.io/cluster-api/controllers/clustercache"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
 	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/contract"
+	fakeruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client/fake"
 	"sigs.k8s.io/cluster-api/util/test/builder"
 )
 
@@ -726,6 +731,136 @@ func TestMachineSetReconciler_runPreflightChecks(t *testing.T) {
 	})
 }
 
+func TestMachineSetReconciler_runExtensionPreflightChecks(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineSetPreflightChecks, true)
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, true)
+
+	passed := &runtimehooksv1.MachineSetPreflightCheckResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess},
+		},
+	}
+	failed := func(message string) *runtimehooksv1.MachineSetPreflightCheckResponse {
+		return &runtimehooksv1.MachineSetPreflightCheckResponse{
+			CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+				CommonResponse:    runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusSuccess, Message: message},
+				RetryAfterSeconds: 30,
+			},
+		}
+	}
+	failure := &runtimehooksv1.MachineSetPreflightCheckResponse{
+		CommonRetryResponse: runtimehooksv1.CommonRetryResponse{
+			CommonResponse: runtimehooksv1.CommonResponse{Status: runtimehooksv1.ResponseStatusFailure},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		skip         string
+		extensions   []string
+		responses    map[string]runtimehooksv1.ResponseObject
+		wantMessages []string
+		wantErr      bool
+		wantCalls    map[string]int
+	}{
+		{
+			name: "should pass if there are no extensions",
+		},
+		{
+			name:       "should pass if all the extensions pass",
+			extensions: []string{"quota.ext", "maintenance-window.ext"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"quota.ext":              passed,
+				"maintenance-window.ext": passed,
+			},
+			wantCalls: map[string]int{"quota.ext": 1, "maintenance-window.ext": 1},
+		},
+		{
+			name:       "should fail if an extension does not pass",
+			extensions: []string{"quota.ext", "maintenance-window.ext", "incident.ext"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"quota.ext":              failed("Quota exceeded in the cloud account"),
+				"maintenance-window.ext": passed,
+				"incident.ext":           failed(""),
+			},
+			wantMessages: []string{
+				"Quota exceeded in the cloud account (\"quota.ext\" preflight check failed)",
+				"Runtime Extension did not allow to create Machines (\"incident.ext\" preflight check failed)",
+			},
+			wantCalls: map[string]int{"quota.ext": 1, "maintenance-window.ext": 1, "incident.ext": 1},
+		},
+		{
+			name:       "should not call skipped extensions",
+			skip:       "quota.ext",
+			extensions: []string{"quota.ext", "maintenance-window.ext"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"quota.ext":              failed("Quota exceeded in the cloud account"),
+				"maintenance-window.ext": passed,
+			},
+			wantCalls: map[string]int{"quota.ext": 0, "maintenance-window.ext": 1},
+		},
+		{
+			name:       "should not call extensions if all the preflight checks are skipped",
+			skip:       string(clusterv1.MachineSetPreflightCheckAll),
+			extensions: []string{"quota.ext"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"quota.ext": failed("Quota exceeded in the cloud account"),
+			},
+			wantCalls: map[string]int{"quota.ext": 0},
+		},
+		{
+			name:       "should return error if an extension fails",
+			extensions: []string{"quota.ext", "maintenance-window.ext"},
+			responses: map[string]runtimehooksv1.ResponseObject{
+				"quota.ext":              failure,
+				"maintenance-window.ext": failed("Maintenance window is closed"),
+			},
+			wantErr:   true,
+			wantCalls: map[string]int{"quota.ext": 1, "maintenance-window.ext": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			catalog := runtimecatalog.New()
+			g.Expect(runtimehooksv1.AddToCatalog(catalog)).To(Succeed())
+			gvh, err := catalog.GroupVersionHook(runtimehooksv1.MachineSetPreflightCheck)
+			g.Expect(err).ToNot(HaveOccurred())
+			runtimeClient := fakeruntimeclient.NewRuntimeClientBuilder().
+				WithCatalog(catalog).
+				WithGetAllExtensionResponses(map[runtimecatalog.GroupVersionHook][]string{gvh: tt.extensions}).
+				WithCallExtensionResponses(tt.responses).
+				Build()
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: metav1.NamespaceDefault},
+			}
+			machineSet := &clusterv1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-ms", Namespace: metav1.NamespaceDefault},
+			}
+			if tt.skip != "" {
+				machineSet.Annotations = map[string]string{clusterv1.MachineSetSkipPreflightChecksAnnotation: tt.skip}
+			}
+			r := &Reconciler{
+				Client:          fake.NewClientBuilder().Build(),
+				RuntimeClient:   runtimeClient,
+				PreflightChecks: sets.Set[clusterv1.MachineSetPreflightCheck]{}.Insert(clusterv1.MachineSetPreflightCheckAll),
+			}
+			messages, err := r.runPreflightChecks(ctx, cluster, machineSet, "Scale up")
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(messages).To(BeComparableTo(tt.wantMessages))
+			for name, calls := range tt.wantCalls {
+				g.Expect(runtimeClient.CallCount(name)).To(Equal(calls))
+			}
+		})
+	}
+}
+
 func TestMachineSetReconciler_shouldRun(t *testing.T) {
 	tests := []struct {
 		name                   string
@@ -806,3 +941,154 @@ func TestMachineSetReconciler_shouldRun(t *testing.T) {
 		})
 	}
 }
+
+func TestMachineSetReconciler_runScaleDownPreflightChecks(t *testing.T) {
+	ns := "ns1"
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: ns,
+		},
+		Status: clusterv1.ClusterStatus{
+			Conditions: []metav1.Condition{
+				{Type: clusterv1.ClusterControlPlaneInitializedCondition, Status: metav1.ConditionTrue},
+			},
+		},
+	}
+	clusterNotInitialized := cluster.DeepCopy()
+	clusterNotInitialized.Status.Conditions = nil
+
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "machine-1",
+			Namespace: ns,
+		},
+		Status: clusterv1.MachineStatus{
+			NodeRef: &clusterv1.MachineNodeReference{Name: "node-1"},
+		},
+	}
+	node := func(name string, cpu string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.NodeStatus{
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse(cpu),
+					corev1.ResourceMemory: resource.MustParse("8Gi"),
+					corev1.ResourcePods:   resource.MustParse("110"),
+				},
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				},
+			},
+		}
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod-1",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: corev1.PodSpec{
+			NodeName: "node-1",
+			Containers: []corev1.Container{
+				{
+					Name: "container",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("2"),
+						},
+					},
+				},
+			},
+		},
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}}
+
+	tests := []struct {
+		name            string
+		featureGate     bool
+		preflightChecks sets.Set[clusterv1.MachineSetPreflightCheck]
+		cluster         *clusterv1.Cluster
+		machineSet      *clusterv1.MachineSet
+		remoteObjects   []client.Object
+		wantMessages    []string
+	}{
+		{
+			name:            "should pass if the feature gate is disabled",
+			featureGate:     false,
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckDrainCapacity),
+			cluster:         cluster,
+			machineSet:      &clusterv1.MachineSet{},
+			remoteObjects:   []client.Object{namespace, node("node-1", "4"), node("node-2", "1"), pod},
+		},
+		{
+			name:            "should pass if the preflight check is not explicitly enabled",
+			featureGate:     true,
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckAll),
+			cluster:         cluster,
+			machineSet:      &clusterv1.MachineSet{},
+			remoteObjects:   []client.Object{namespace, node("node-1", "4"), node("node-2", "1"), pod},
+		},
+		{
+			name:            "should pass if the preflight check is skipped",
+			featureGate:     true,
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckDrainCapacity),
+			cluster:         cluster,
+			machineSet: &clusterv1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						clusterv1.MachineSetSkipPreflightChecksAnnotation: string(clusterv1.MachineSetPreflightCheckDrainCapacity),
+					},
+				},
+			},
+			remoteObjects: []client.Object{namespace, node("node-1", "4"), node("node-2", "1"), pod},
+		},
+		{
+			name:            "should pass if the control plane is not initialized",
+			featureGate:     true,
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckDrainCapacity),
+			cluster:         clusterNotInitialized,
+			machineSet:      &clusterv1.MachineSet{},
+			remoteObjects:   []client.Object{namespace, node("node-1", "4"), node("node-2", "1"), pod},
+		},
+		{
+			name:            "should pass if the Pods fit on the remaining Nodes",
+			featureGate:     true,
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckDrainCapacity),
+			cluster:         cluster,
+			machineSet:      &clusterv1.MachineSet{},
+			remoteObjects:   []client.Object{namespace, node("node-1", "4"), node("node-2", "4"), pod},
+		},
+		{
+			name:            "should fail if the Pods do not fit on the remaining Nodes",
+			featureGate:     true,
+			preflightChecks: sets.New(clusterv1.MachineSetPreflightCheckDrainCapacity),
+			cluster:         cluster,
+			machineSet:      &clusterv1.MachineSet{},
+			remoteObjects:   []client.Object{namespace, node("node-1", "4"), node("node-2", "1"), pod},
+			wantMessages: []string{
+				"Pod default/pod-1 cannot be rescheduled on the remaining Nodes because of insufficient CPU, memory or Pod capacity (\"DrainCapacity\" preflight check failed)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.MachineSetPreflightChecks, tt.featureGate)
+			g := NewWithT(t)
+
+			remoteClient := fake.NewClientBuilder().
+				WithObjects(tt.remoteObjects...).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", func(o client.Object) []string {
+					return []string{o.(*corev1.Pod).Spec.NodeName}
+				}).
+				Build()
+			r := &Reconciler{
+				Client:          fake.NewClientBuilder().WithScheme(fakeScheme).Build(),
+				ClusterCache:    clustercache.NewFakeClusterCache(remoteClient, client.ObjectKeyFromObject(tt.cluster)),
+				PreflightChecks: tt.preflightChecks,
+			}
+			messages, err := r.runScaleDownPreflightChecks(ctx, tt.cluster, tt.machineSet, []*clusterv1.Machine{machine})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(messages).To(BeComparableTo(tt.wantMessages))
+		})
+	}
+}
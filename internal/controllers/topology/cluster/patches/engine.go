@@ -46,16 +46,40 @@ type Engine interface {
 	Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, desired *scope.ClusterState) error
 }
 
+// EngineOption is a configuration option supplied to NewEngine.
+type EngineOption func(*engine)
+
+// WithExternalPatchesEnabled enables external patches even if the RuntimeSDK feature gate is disabled.
+// This is used when computing the desired state outside of the topology controller, e.g. by clusterctl,
+// where the runtime client is set up only if Runtime Extensions are registered in the management cluster.
+func WithExternalPatchesEnabled() EngineOption {
+	return func(e *engine) {
+		e.externalPatchesEnabled = true
+	}
+}
+
 // NewEngine creates a new patch engine.
-func NewEngine(runtimeClient runtimeclient.Client) Engine {
-	return &engine{
+func NewEngine(runtimeClient runtimeclient.Client, options ...EngineOption) Engine {
+	e := &engine{
 		runtimeClient: runtimeClient,
 	}
+	for _, option := range options {
+		option(e)
+	}
+	return e
 }
 
 // engine implements the Engine interface.
 type engine struct {
 	runtimeClient runtimeclient.Client
+
+	// externalPatchesEnabled enables external patches even if the RuntimeSDK feature gate is disabled.
+	externalPatchesEnabled bool
+}
+
+// isExternalPatchesEnabled returns true if external patches can be used.
+func (e *engine) isExternalPatchesEnabled() bool {
+	return e.externalPatchesEnabled || feature.Gates.Enabled(feature.RuntimeSDK)
 }
 
 // Apply applies patches to the desired state according to the patches from the ClusterClass, variables from the Cluster
@@ -96,7 +120,7 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 		log.V(5).Info("Applying patch to templates")
 
 		// Create patch generator for the current patch.
-		generator, err := createPatchGenerator(e.runtimeClient, &clusterClassPatch)
+		generator, err := e.createPatchGenerator(&clusterClassPatch)
 		if err != nil {
 			return err
 		}
@@ -133,6 +157,9 @@ func (e *engine) Apply(ctx context.Context, blueprint *scope.ClusterBlueprint, d
 
 		log.V(5).Info("Validating topology")
 
+		if !e.isExternalPatchesEnabled() {
+			return errors.Errorf("can not use external patch %q if RuntimeSDK feature flag is disabled", clusterClassPatch.Name)
+		}
 		validator := external.NewValidator(e.runtimeClient, &clusterClassPatch)
 
 		_, err := validator.Validate(ctx, desired.Cluster, validationRequest)
@@ -402,20 +429,20 @@ func lookupMPTopology(topology *clusterv1.Topology, mpTopologyName string) (*clu
 // createPatchGenerator creates a patch generator for the given patch.
 // NOTE: Currently only inline JSON patches are supported; in the future we will add
 // external patches as well.
-func createPatchGenerator(runtimeClient runtimeclient.Client, patch *clusterv1.ClusterClassPatch) (api.Generator, error) {
+func (e *engine) createPatchGenerator(patch *clusterv1.ClusterClassPatch) (api.Generator, error) {
 	// Return a jsonPatchGenerator if there are PatchDefinitions in the patch.
 	if len(patch.Definitions) > 0 {
 		return inline.NewGenerator(patch), nil
 	}
 	// Return an externalPatchGenerator if there is an external configuration in the patch.
 	if patch.External != nil && patch.External.GeneratePatchesExtension != nil {
-		if !feature.Gates.Enabled(feature.RuntimeSDK) {
+		if !e.isExternalPatchesEnabled() {
 			return nil, errors.Errorf("can not use external patch %q if RuntimeSDK feature flag is disabled", patch.Name)
 		}
-		if runtimeClient == nil {
+		if e.runtimeClient == nil {
 			return nil, errors.Errorf("failed to create patch generator for patch %q: runtimeClient is not set up", patch.Name)
 		}
-		return external.NewGenerator(runtimeClient, patch), nil
+		return external.NewGenerator(e.runtimeClient, patch), nil
 	}
 
 	return nil, errors.Errorf("failed to create patch generator for patch %q", patch.Name)
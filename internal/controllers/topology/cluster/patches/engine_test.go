@@ -1033,6 +1033,70 @@ func TestApply(t *testing.T) {
 	}
 }
 
+func TestApplyWithExternalPatchesEnabled(t *testing.T) {
+	utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.RuntimeSDK, false)
+
+	patch := clusterv1.ClusterClassPatch{
+		Name: "fake-patch1",
+		External: &clusterv1.ExternalPatchDefinition{
+			GeneratePatchesExtension: ptr.To("patch-infrastructureCluster"),
+		},
+	}
+	newRuntimeClient := func(g *WithT) *fakeruntimeclient.RuntimeClient {
+		cat := runtimecatalog.New()
+		g.Expect(runtimehooksv1.AddToCatalog(cat)).To(Succeed())
+		return fakeruntimeclient.NewRuntimeClientBuilder().
+			WithCallExtensionResponses(map[string]runtimehooksv1.ResponseObject{
+				"patch-infrastructureCluster": &runtimehooksv1.GeneratePatchesResponse{
+					Items: []runtimehooksv1.GeneratePatchesResponseItem{
+						{
+							UID:       "1",
+							PatchType: runtimehooksv1.JSONPatchType,
+							Patch: bytesPatch([]jsonPatchRFC6902{{
+								Op:    "add",
+								Path:  "/spec/template/spec/resource",
+								Value: &apiextensionsv1.JSON{Raw: []byte(`"infraCluster"`)},
+							}}),
+						},
+					},
+				},
+			}).
+			WithCatalog(cat).
+			Build()
+	}
+	resetUUIDGenerator := func() {
+		var uuid int32
+		uuidGenerator = func() types.UID {
+			uuid++
+			return types.UID(fmt.Sprintf("%d", uuid))
+		}
+	}
+
+	t.Run("fails with external patches if the RuntimeSDK feature gate is disabled", func(t *testing.T) {
+		g := NewWithT(t)
+
+		blueprint, desired := setupTestObjects()
+		blueprint.ClusterClass.Spec.Patches = []clusterv1.ClusterClassPatch{patch}
+		resetUUIDGenerator()
+
+		err := NewEngine(newRuntimeClient(g)).Apply(context.Background(), blueprint, desired)
+		g.Expect(err).To(MatchError(ContainSubstring("RuntimeSDK feature flag is disabled")))
+	})
+
+	t.Run("applies external patches if enabled by option", func(t *testing.T) {
+		g := NewWithT(t)
+
+		blueprint, desired := setupTestObjects()
+		blueprint.ClusterClass.Spec.Patches = []clusterv1.ClusterClassPatch{patch}
+		resetUUIDGenerator()
+
+		g.Expect(NewEngine(newRuntimeClient(g), WithExternalPatchesEnabled()).Apply(context.Background(), blueprint, desired)).To(Succeed())
+		resource, _, err := unstructured.NestedString(desired.InfrastructureCluster.Object, "spec", "resource")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(resource).To(Equal("infraCluster"))
+	})
+}
+
 func setupTestObjects() (*scope.ClusterBlueprint, *scope.ClusterState) {
 	infrastructureClusterTemplate := builder.InfrastructureClusterTemplate(metav1.NamespaceDefault, "infraClusterTemplate1").
 		Build()
@@ -20,13 +20,11 @@ package external
 import (
 	"context"
 
-	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
 	runtimeclient "sigs.k8s.io/cluster-api/exp/runtime/client"
-	"sigs.k8s.io/cluster-api/feature"
 	"sigs.k8s.io/cluster-api/internal/controllers/topology/cluster/patches/api"
 )
 
@@ -45,10 +43,6 @@ func NewValidator(runtimeClient runtimeclient.Client, patch *clusterv1.ClusterCl
 }
 
 func (e externalValidator) Validate(ctx context.Context, forObject client.Object, req *runtimehooksv1.ValidateTopologyRequest) (*runtimehooksv1.ValidateTopologyResponse, error) {
-	if !feature.Gates.Enabled(feature.RuntimeSDK) {
-		return nil, errors.Errorf("can not use external patch %q if RuntimeSDK feature flag is disabled", *e.patch.External.ValidateTopologyExtension)
-	}
-
 	// Set the settings defined in external patch definition on the request object.
 	// These settings will override overlapping keys defined in ExtensionConfig settings.
 	req.Settings = e.patch.External.Settings
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/exp/topology/desiredstate"
+	"sigs.k8s.io/cluster-api/exp/topology/scope"
+	"sigs.k8s.io/cluster-api/internal/webhooks"
+)
+
+// ComputeDesiredState reads the blueprint and the current state of a Cluster topology using the Reconciler's Client,
+// and computes the desired state of the Cluster topology, without persisting any change.
+// NOTE: The ClusterClass is expected to have variables already reconciled in its status.
+// NOTE: This is used to preview changes to a Cluster topology before applying them, e.g. by clusterctl topology plan.
+func (r *Reconciler) ComputeDesiredState(ctx context.Context, cluster *clusterv1.Cluster, clusterClass *clusterv1.ClusterClass) (*scope.Scope, error) {
+	if r.desiredStateGenerator == nil {
+		// External patches are called only if the runtime client is provided, independently of the RuntimeSDK feature gate,
+		// so callers can enable them without changing the global feature gates.
+		var options []desiredstate.GeneratorOption
+		if r.RuntimeClient != nil {
+			options = append(options, desiredstate.WithExternalPatchesEnabled())
+		}
+		r.desiredStateGenerator = desiredstate.NewGenerator(r.Client, r.ClusterCache, r.RuntimeClient, options...)
+	}
+
+	cluster = cluster.DeepCopy()
+	cluster.APIVersion = clusterv1.GroupVersion.String()
+	cluster.Kind = "Cluster"
+
+	s := scope.New(cluster)
+	s.Blueprint.ClusterClass = clusterClass
+
+	// Default and Validate the Cluster variables based on information from the ClusterClass.
+	if errs := webhooks.DefaultAndValidateVariables(ctx, s.Current.Cluster, nil, clusterClass); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("Cluster").GroupKind(), s.Current.Cluster.Name, errs)
+	}
+
+	var err error
+	s.Blueprint, err = r.getBlueprint(ctx, s.Current.Cluster, s.Blueprint.ClusterClass)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading the ClusterClass")
+	}
+
+	s.Current, err = r.getCurrentState(ctx, s)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading current state of the Cluster topology")
+	}
+
+	s.Desired, err = r.desiredStateGenerator.Generate(ctx, s)
+	if err != nil {
+		return nil, errors.Wrap(err, "error computing the desired state of the Cluster topology")
+	}
+	return s, nil
+}
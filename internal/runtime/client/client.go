@@ -68,18 +68,20 @@ type Options struct {
 // New returns a new Client.
 func New(options Options) runtimeclient.Client {
 	return &client{
-		catalog:  options.Catalog,
-		registry: options.Registry,
-		client:   options.Client,
+		catalog:     options.Catalog,
+		registry:    options.Registry,
+		client:      options.Client,
+		credentials: newCredentialsCache(),
 	}
 }
 
 var _ runtimeclient.Client = &client{}
 
 type client struct {
-	catalog  *runtimecatalog.Catalog
-	registry runtimeregistry.ExtensionRegistry
-	client   ctrlclient.Client
+	catalog     *runtimecatalog.Catalog
+	registry    runtimeregistry.ExtensionRegistry
+	client      ctrlclient.Client
+	credentials *credentialsCache
 }
 
 func (c *client) WarmUp(extensionConfigList *runtimev1.ExtensionConfigList) error {
@@ -99,11 +101,18 @@ func (c *client) Discover(ctx context.Context, extensionConfig *runtimev1.Extens
 		return nil, errors.Wrapf(err, "failed to discover extension %q: failed to compute GVH of hook", extensionConfig.Name)
 	}
 
+	// Always read the credentials from the Secrets during discovery, so changes are picked up.
+	credentials, err := loadCredentials(ctx, c.client, extensionConfig.Spec.ClientConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to discover extension %q", extensionConfig.Name)
+	}
+
 	request := &runtimehooksv1.DiscoveryRequest{}
 	response := &runtimehooksv1.DiscoveryResponse{}
 	opts := &httpCallOptions{
 		catalog:         c.catalog,
 		config:          extensionConfig.Spec.ClientConfig,
+		credentials:     credentials,
 		registrationGVH: hookGVH,
 		hookGVH:         hookGVH,
 		timeout:         defaultDiscoveryTimeout,
@@ -154,6 +163,8 @@ func (c *client) Register(extensionConfig *runtimev1.ExtensionConfig) error {
 	if err := c.registry.Add(extensionConfig); err != nil {
 		return errors.Wrapf(err, "failed to register ExtensionConfig %q", extensionConfig.Name)
 	}
+	// Drop cached credentials, so credentials from Secrets that changed are used for the next calls.
+	c.credentials.remove(extensionConfig.Name)
 	return nil
 }
 
@@ -161,9 +172,42 @@ func (c *client) Unregister(extensionConfig *runtimev1.ExtensionConfig) error {
 	if err := c.registry.Remove(extensionConfig); err != nil {
 		return errors.Wrapf(err, "failed to unregister ExtensionConfig %q", extensionConfig.Name)
 	}
+	c.credentials.remove(extensionConfig.Name)
 	return nil
 }
 
+// GetAllExtensions gets the names of all the ExtensionHandlers registered for the hook
+// and matching the namespace of the given object.
+func (c *client) GetAllExtensions(ctx context.Context, hook runtimecatalog.Hook, forObject metav1.Object) ([]string, error) {
+	hookName := runtimecatalog.HookName(hook)
+	log := ctrl.LoggerFrom(ctx).WithValues("hook", hookName)
+	gvh, err := c.catalog.GroupVersionHook(hook)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get extension handlers for hook %q: failed to compute GroupVersionHook", hookName)
+	}
+
+	registrations, err := c.registry.List(gvh.GroupHook())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get extension handlers for hook %q", gvh.GroupHook())
+	}
+
+	names := []string{}
+	for _, registration := range registrations {
+		// Compute whether the object matches the namespaceSelector
+		namespaceMatches, err := c.matchNamespace(ctx, registration.NamespaceSelector, forObject.GetNamespace())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get extension handlers for hook %q: failed to match namespace of extension handler %q", gvh.GroupHook(), registration.Name)
+		}
+		// If the object namespace isn't matched by the registration NamespaceSelector skip the extension.
+		if !namespaceMatches {
+			log.V(5).Info(fmt.Sprintf("skipping extension handler %q as object '%s/%s' does not match selector %q of ExtensionConfig", registration.Name, forObject.GetNamespace(), forObject.GetName(), registration.NamespaceSelector))
+			continue
+		}
+		names = append(names, registration.Name)
+	}
+	return names, nil
+}
+
 // CallAllExtensions calls all the ExtensionHandlers registered for the hook.
 // The ExtensionHandlers are called sequentially. The function exits immediately after any of the ExtensionHandlers return an error.
 // This ensures we don't end up waiting for timeout from multiple unreachable Extensions.
@@ -328,9 +372,15 @@ func (c *client) CallExtension(ctx context.Context, hook runtimecatalog.Hook, fo
 		}
 	}
 
+	credentials, err := c.credentialsFor(ctx, registration.ExtensionConfigName, registration.ClientConfig)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call extension handler %q", name)
+	}
+
 	httpOpts := &httpCallOptions{
 		catalog:         c.catalog,
 		config:          registration.ClientConfig,
+		credentials:     credentials,
 		registrationGVH: registration.GroupVersionHook,
 		hookGVH:         hookGVH,
 		name:            strings.TrimSuffix(registration.Name, "."+registration.ExtensionConfigName),
@@ -398,6 +448,7 @@ func cloneAndAddSettings(request runtimehooksv1.RequestObject, registrationSetti
 type httpCallOptions struct {
 	catalog         *runtimecatalog.Catalog
 	config          runtimev1.ClientConfig
+	credentials     *clientCredentials
 	registrationGVH runtimecatalog.GroupVersionHook
 	hookGVH         runtimecatalog.GroupVersionHook
 	name            string
@@ -480,13 +531,22 @@ func httpCall(ctx context.Context, request, response runtime.Object, opts *httpC
 		return errors.Wrap(err, "http call failed: failed to create http request")
 	}
 
+	tlsClientConfig := transport.TLSConfig{
+		CAData:     opts.config.CABundle,
+		ServerName: extensionURL.Hostname(),
+	}
+	if opts.credentials != nil {
+		tlsClientConfig.CertData = opts.credentials.certData
+		tlsClientConfig.KeyData = opts.credentials.keyData
+		if opts.credentials.token != "" {
+			httpRequest.Header.Set("Authorization", "Bearer "+opts.credentials.token)
+		}
+	}
+
 	// Use client-go's transport.TLSConfigureFor to ensure good defaults for tls
 	client := http.DefaultClient
 	tlsConfig, err := transport.TLSConfigFor(&transport.Config{
-		TLS: transport.TLSConfig{
-			CAData:     opts.config.CABundle,
-			ServerName: extensionURL.Hostname(),
-		},
+		TLS: tlsClientConfig,
 	})
 	if err != nil {
 		return errors.Wrap(err, "http call failed: failed to create tls config")
@@ -930,6 +930,88 @@ func TestPrepareRequest(t *testing.T) {
 	})
 }
 
+func TestClient_GetAllExtensions(t *testing.T) {
+	ns := &corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Namespace",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+
+	extensionConfig := func(name string, namespaceSelector *metav1.LabelSelector, handlers ...string) runtimev1.ExtensionConfig {
+		config := runtimev1.ExtensionConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: runtimev1.ExtensionConfigSpec{
+				ClientConfig: runtimev1.ClientConfig{
+					URL: ptr.To("https://127.0.0.1/"),
+				},
+				NamespaceSelector: namespaceSelector,
+			},
+		}
+		for _, handler := range handlers {
+			config.Status.Handlers = append(config.Status.Handlers, runtimev1.ExtensionHandler{
+				Name: handler,
+				RequestHook: runtimev1.GroupVersionHook{
+					APIVersion: fakev1alpha1.GroupVersion.String(),
+					Hook:       "FakeHook",
+				},
+			})
+		}
+		return config
+	}
+
+	tests := []struct {
+		name                       string
+		registeredExtensionConfigs []runtimev1.ExtensionConfig
+		want                       []string
+	}{
+		{
+			name:                       "should return no extensions when no ExtensionHandlers are registered for the hook",
+			registeredExtensionConfigs: []runtimev1.ExtensionConfig{},
+			want:                       []string{},
+		},
+		{
+			name: "should return all extensions matching the namespace of the object",
+			registeredExtensionConfigs: []runtimev1.ExtensionConfig{
+				extensionConfig("first", &metav1.LabelSelector{}, "first-extension", "second-extension"),
+				extensionConfig("second", &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}, "third-extension"),
+			},
+			want: []string{"first-extension", "second-extension"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cat := runtimecatalog.New()
+			_ = fakev1alpha1.AddToCatalog(cat)
+			fakeClient := fake.NewClientBuilder().
+				WithObjects(ns).
+				Build()
+			c := New(Options{
+				Catalog:  cat,
+				Registry: registry(tt.registeredExtensionConfigs),
+				Client:   fakeClient,
+			})
+
+			obj := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster",
+					Namespace: "foo",
+				},
+			}
+			got, err := c.GetAllExtensions(context.Background(), fakev1alpha1.FakeHook, obj)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(ConsistOf(tt.want))
+		})
+	}
+}
+
 func TestClient_CallAllExtensions(t *testing.T) {
 	ns := &corev1.Namespace{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+)
+
+// clientCredentials are the credentials used to authenticate to an Extension server.
+type clientCredentials struct {
+	// certData and keyData are the PEM encoded client certificate and key used for mutual TLS.
+	certData []byte
+	keyData  []byte
+	// token is the bearer token sent in the Authorization header.
+	token string
+}
+
+// credentialsCache caches the credentials of Extension servers by ExtensionConfig name, so the Secrets
+// they are stored in are not read on every call.
+// NOTE: Entries are invalidated when an ExtensionConfig is registered again, e.g. because a referenced Secret
+// changed, so rotated credentials are picked up on the next call.
+type credentialsCache struct {
+	lock  sync.RWMutex
+	items map[string]*clientCredentials
+}
+
+func newCredentialsCache() *credentialsCache {
+	return &credentialsCache{items: map[string]*clientCredentials{}}
+}
+
+func (c *credentialsCache) get(extensionConfigName string) (*clientCredentials, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	credentials, ok := c.items[extensionConfigName]
+	return credentials, ok
+}
+
+func (c *credentialsCache) add(extensionConfigName string, credentials *clientCredentials) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.items[extensionConfigName] = credentials
+}
+
+func (c *credentialsCache) remove(extensionConfigName string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.items, extensionConfigName)
+}
+
+// credentialsFor returns the credentials for the Extension server of an ExtensionConfig, reading them
+// from the referenced Secrets if they are not cached yet.
+func (c *client) credentialsFor(ctx context.Context, extensionConfigName string, config runtimev1.ClientConfig) (*clientCredentials, error) {
+	if config.ClientCertificate == nil && config.BearerToken == nil {
+		return nil, nil
+	}
+
+	if credentials, ok := c.credentials.get(extensionConfigName); ok {
+		return credentials, nil
+	}
+
+	credentials, err := loadCredentials(ctx, c.client, config)
+	if err != nil {
+		return nil, err
+	}
+	c.credentials.add(extensionConfigName, credentials)
+	return credentials, nil
+}
+
+// loadCredentials reads the credentials for an Extension server from the Secrets referenced in the ClientConfig.
+func loadCredentials(ctx context.Context, c ctrlclient.Reader, config runtimev1.ClientConfig) (*clientCredentials, error) {
+	credentials := &clientCredentials{}
+
+	if config.ClientCertificate != nil {
+		secret, err := getSecret(ctx, c, config.ClientCertificate)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get client certificate")
+		}
+		certData, hasCertData := secret.Data[corev1.TLSCertKey]
+		keyData, hasKeyData := secret.Data[corev1.TLSPrivateKeyKey]
+		if !hasCertData || !hasKeyData {
+			return nil, errors.Errorf("failed to get client certificate: Secret %s does not contain %q and %q entries", klog.KObj(secret), corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+		}
+		credentials.certData = certData
+		credentials.keyData = keyData
+	}
+
+	if config.BearerToken != nil {
+		secret, err := getSecret(ctx, c, config.BearerToken)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get bearer token")
+		}
+		token, hasToken := secret.Data[corev1.ServiceAccountTokenKey]
+		if !hasToken || len(token) == 0 {
+			return nil, errors.Errorf("failed to get bearer token: Secret %s does not contain a %q entry", klog.KObj(secret), corev1.ServiceAccountTokenKey)
+		}
+		credentials.token = string(token)
+	}
+
+	return credentials, nil
+}
+
+func getSecret(ctx context.Context, c ctrlclient.Reader, ref *runtimev1.SecretReference) (*corev1.Secret, error) {
+	if c == nil {
+		return nil, errors.New("client cannot be nil")
+	}
+	secret := &corev1.Secret{}
+	// Note: this is an expensive API call because secrets are explicitly not cached.
+	if err := c.Get(ctx, ctrlclient.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, errors.Wrapf(err, "failed to get Secret %s/%s", ref.Namespace, ref.Name)
+	}
+	return secret, nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	runtimehooksv1 "sigs.k8s.io/cluster-api/api/runtime/hooks/v1alpha1"
+	runtimev1 "sigs.k8s.io/cluster-api/api/runtime/v1beta2"
+	runtimecatalog "sigs.k8s.io/cluster-api/exp/runtime/catalog"
+	fakev1alpha1 "sigs.k8s.io/cluster-api/internal/runtime/test/v1alpha1"
+)
+
+func TestLoadCredentials(t *testing.T) {
+	clientCertSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "client-cert"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       testcerts.ClientCert,
+			corev1.TLSPrivateKeyKey: testcerts.ClientKey,
+		},
+	}
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "token"},
+		Data: map[string][]byte{
+			corev1.ServiceAccountTokenKey: []byte("secret-token"),
+		},
+	}
+	invalidSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "invalid"},
+		Data: map[string][]byte{
+			"foo": []byte("bar"),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		config  runtimev1.ClientConfig
+		want    *clientCredentials
+		wantErr bool
+	}{
+		{
+			name:   "no credentials",
+			config: runtimev1.ClientConfig{},
+			want:   &clientCredentials{},
+		},
+		{
+			name: "client certificate and bearer token",
+			config: runtimev1.ClientConfig{
+				ClientCertificate: &runtimev1.SecretReference{Namespace: "foo", Name: "client-cert"},
+				BearerToken:       &runtimev1.SecretReference{Namespace: "foo", Name: "token"},
+			},
+			want: &clientCredentials{
+				certData: testcerts.ClientCert,
+				keyData:  testcerts.ClientKey,
+				token:    "secret-token",
+			},
+		},
+		{
+			name: "fails if the client certificate Secret does not exist",
+			config: runtimev1.ClientConfig{
+				ClientCertificate: &runtimev1.SecretReference{Namespace: "foo", Name: "does-not-exist"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails if the client certificate Secret has no certificate",
+			config: runtimev1.ClientConfig{
+				ClientCertificate: &runtimev1.SecretReference{Namespace: "foo", Name: "invalid"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "fails if the bearer token Secret has no token",
+			config: runtimev1.ClientConfig{
+				BearerToken: &runtimev1.SecretReference{Namespace: "foo", Name: "invalid"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			fakeClient := fake.NewClientBuilder().WithObjects(clientCertSecret, tokenSecret, invalidSecret).Build()
+
+			got, err := loadCredentials(context.Background(), fakeClient, tt.config)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestClient_CallExtensionWithCredentials(t *testing.T) {
+	g := NewWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo",
+		},
+	}
+	clientCertSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "client-cert"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       testcerts.ClientCert,
+			corev1.TLSPrivateKeyKey: testcerts.ClientKey,
+		},
+	}
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "foo", Name: "token"},
+		Data: map[string][]byte{
+			corev1.ServiceAccountTokenKey: []byte("secret-token"),
+		},
+	}
+
+	// Start a server which requires a client certificate signed by the test CA and a bearer token.
+	var expectedToken atomic.Value
+	expectedToken.Store("secret-token")
+	srv := createSecureTestServer(testServerConfig{
+		start: true,
+		responses: map[string]testServerResponse{
+			"/*": response(runtimehooksv1.ResponseStatusSuccess),
+		},
+	})
+	handler := srv.Config.Handler
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+expectedToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+	clientCAs := x509.NewCertPool()
+	g.Expect(clientCAs.AppendCertsFromPEM(testcerts.CACert)).To(BeTrue())
+	srv.TLS.ClientCAs = clientCAs
+	srv.TLS.ClientAuth = tls.RequireAndVerifyClientCert
+	srv.StartTLS()
+	defer srv.Close()
+
+	extensionConfig := runtimev1.ExtensionConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "extension",
+			ResourceVersion: "15",
+		},
+		Spec: runtimev1.ExtensionConfigSpec{
+			ClientConfig: runtimev1.ClientConfig{
+				URL:               ptr.To(fmt.Sprintf("https://%s/", srv.Listener.Addr().String())),
+				CABundle:          testcerts.CACert,
+				ClientCertificate: &runtimev1.SecretReference{Namespace: "foo", Name: "client-cert"},
+				BearerToken:       &runtimev1.SecretReference{Namespace: "foo", Name: "token"},
+			},
+			NamespaceSelector: &metav1.LabelSelector{},
+		},
+		Status: runtimev1.ExtensionConfigStatus{
+			Handlers: []runtimev1.ExtensionHandler{
+				{
+					Name: "valid-extension.extension",
+					RequestHook: runtimev1.GroupVersionHook{
+						APIVersion: fakev1alpha1.GroupVersion.String(),
+						Hook:       "FakeHook",
+					},
+					TimeoutSeconds: ptr.To[int32](1),
+					FailurePolicy:  ptr.To(runtimev1.FailurePolicyFail),
+				},
+			},
+		},
+	}
+
+	cat := runtimecatalog.New()
+	g.Expect(fakev1alpha1.AddToCatalog(cat)).To(Succeed())
+	fakeClient := fake.NewClientBuilder().WithObjects(ns, clientCertSecret, tokenSecret).Build()
+	c := New(Options{
+		Catalog:  cat,
+		Registry: registry([]runtimev1.ExtensionConfig{extensionConfig}),
+		Client:   fakeClient,
+	})
+
+	obj := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "foo",
+		},
+	}
+	callExtension := func() error {
+		return c.CallExtension(context.Background(), fakev1alpha1.FakeHook, obj, "valid-extension.extension", &fakev1alpha1.FakeRequest{}, &fakev1alpha1.FakeResponse{})
+	}
+	g.Expect(callExtension()).To(Succeed())
+
+	// Rotate the token; the cached credentials are used until the ExtensionConfig is registered again.
+	expectedToken.Store("rotated-token")
+	tokenSecret.Data[corev1.ServiceAccountTokenKey] = []byte("rotated-token")
+	g.Expect(fakeClient.Update(context.Background(), tokenSecret)).To(Succeed())
+	g.Expect(callExtension()).ToNot(Succeed())
+
+	g.Expect(c.Register(&extensionConfig)).To(Succeed())
+	g.Expect(callExtension()).To(Succeed())
+
+	// Calls fail without a client certificate.
+	g.Expect(fakeClient.Delete(context.Background(), clientCertSecret)).To(Succeed())
+	extensionConfig.Spec.ClientConfig.ClientCertificate = nil
+	g.Expect(c.Register(&extensionConfig)).To(Succeed())
+	g.Expect(callExtension()).ToNot(Succeed())
+
+	// Calls fail if the Secret referenced by the ExtensionConfig does not exist.
+	extensionConfig.Spec.ClientConfig.ClientCertificate = &runtimev1.SecretReference{Namespace: "foo", Name: "client-cert"}
+	g.Expect(c.Register(&extensionConfig)).To(Succeed())
+	err := callExtension()
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(err.Error()).To(ContainSubstring("failed to get client certificate"))
+}
@@ -36,6 +36,7 @@ type RuntimeClientBuilder struct {
 	catalog          *runtimecatalog.Catalog
 	callAllResponses map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject
 	callResponses    map[string]runtimehooksv1.ResponseObject
+	getAllResponses  map[runtimecatalog.GroupVersionHook][]string
 }
 
 // NewRuntimeClientBuilder returns a new builder for the fake runtime client.
@@ -61,6 +62,12 @@ func (f *RuntimeClientBuilder) WithCallExtensionResponses(responses map[string]r
 	return f
 }
 
+// WithGetAllExtensionResponses can be used to dictate the responses for GetAllExtensions.
+func (f *RuntimeClientBuilder) WithGetAllExtensionResponses(responses map[runtimecatalog.GroupVersionHook][]string) *RuntimeClientBuilder {
+	f.getAllResponses = responses
+	return f
+}
+
 // MarkReady can be used to mark the fake runtime client as either ready or not ready.
 func (f *RuntimeClientBuilder) MarkReady(ready bool) *RuntimeClientBuilder {
 	f.ready = ready
@@ -73,8 +80,10 @@ func (f *RuntimeClientBuilder) Build() *RuntimeClient {
 		isReady:          f.ready,
 		callAllResponses: f.callAllResponses,
 		callResponses:    f.callResponses,
+		getAllResponses:  f.getAllResponses,
 		catalog:          f.catalog,
 		callAllTracker:   map[string]int{},
+		callTracker:      map[string]int{},
 	}
 }
 
@@ -86,8 +95,19 @@ type RuntimeClient struct {
 	catalog          *runtimecatalog.Catalog
 	callAllResponses map[runtimecatalog.GroupVersionHook]runtimehooksv1.ResponseObject
 	callResponses    map[string]runtimehooksv1.ResponseObject
+	getAllResponses  map[runtimecatalog.GroupVersionHook][]string
 
 	callAllTracker map[string]int
+	callTracker    map[string]int
+}
+
+// GetAllExtensions implements Client.
+func (fc *RuntimeClient) GetAllExtensions(_ context.Context, hook runtimecatalog.Hook, _ metav1.Object) ([]string, error) {
+	gvh, err := fc.catalog.GroupVersionHook(hook)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute GVH")
+	}
+	return fc.getAllResponses[gvh], nil
 }
 
 // CallAllExtensions implements Client.
@@ -120,6 +140,10 @@ func (fc *RuntimeClient) CallAllExtensions(ctx context.Context, hook runtimecata
 
 // CallExtension implements Client.
 func (fc *RuntimeClient) CallExtension(ctx context.Context, _ runtimecatalog.Hook, _ metav1.Object, name string, _ runtimehooksv1.RequestObject, response runtimehooksv1.ResponseObject, _ ...runtimeclient.CallExtensionOption) error {
+	defer func() {
+		fc.callTracker[name]++
+	}()
+
 	expectedResponse, ok := fc.callResponses[name]
 	if !ok {
 		// This should actually panic because an error here would mean a mistake in the test setup.
@@ -167,3 +191,8 @@ func (fc *RuntimeClient) WarmUp(_ *runtimev1.ExtensionConfigList) error {
 func (fc *RuntimeClient) CallAllCount(hook runtimecatalog.Hook) int {
 	return fc.callAllTracker[runtimecatalog.HookName(hook)]
 }
+
+// CallCount return the number of times an extension was called.
+func (fc *RuntimeClient) CallCount(name string) int {
+	return fc.callTracker[name]
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cel provides utils to evaluate CEL expressions on objects.
+package cel
+
+import (
+	celgo "github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+)
+
+// SelfVariable is the name of the variable the object is available as in expressions.
+const SelfVariable = "self"
+
+// BoolProgram is a compiled CEL expression evaluating to a bool.
+type BoolProgram struct {
+	program celgo.Program
+}
+
+// CompileBoolExpression compiles a CEL expression which is evaluated on an object available as self,
+// using the same CEL libraries available in Kubernetes validation rules.
+func CompileBoolExpression(expression string) (*BoolProgram, error) {
+	env, err := environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion(), true).Env(environment.StoredExpressions)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CEL environment")
+	}
+	env, err = env.Extend(celgo.Variable(SelfVariable, celgo.DynType))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create CEL environment")
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.Wrap(issues.Err(), "failed to compile expression")
+	}
+	if ast.OutputType() != celgo.BoolType && ast.OutputType() != celgo.DynType {
+		return nil, errors.Errorf("expression must evaluate to a bool, got %s", ast.OutputType())
+	}
+
+	program, err := env.Program(ast, celgo.CostLimit(celconfig.PerCallLimit))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create program for expression")
+	}
+	return &BoolProgram{program: program}, nil
+}
+
+// Eval evaluates the expression on obj.
+func (p *BoolProgram) Eval(obj map[string]interface{}) (bool, error) {
+	out, _, err := p.program.Eval(map[string]interface{}{SelfVariable: obj})
+	if err != nil {
+		return false, err
+	}
+	value, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("expression evaluated to %v instead of a bool", out.Value())
+	}
+	return value, nil
+}
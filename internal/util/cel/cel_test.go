@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCompileBoolExpression(t *testing.T) {
+	obj := map[string]interface{}{
+		"spec":   map[string]interface{}{"replicas": int64(3)},
+		"status": map[string]interface{}{"readyReplicas": int64(2)},
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		want       bool
+		compileErr bool
+		evalErr    bool
+	}{
+		{
+			name:       "expression evaluating to true",
+			expression: "self.status.readyReplicas > 1",
+			want:       true,
+		},
+		{
+			name:       "expression evaluating to false",
+			expression: "self.status.readyReplicas == self.spec.replicas",
+			want:       false,
+		},
+		{
+			name:       "expression using has for missing fields",
+			expression: "has(self.status.conditions) && self.status.conditions.exists(c, c.type == 'Ready')",
+			want:       false,
+		},
+		{
+			name:       "expression with syntax errors",
+			expression: "self.status.readyReplicas >",
+			compileErr: true,
+		},
+		{
+			name:       "expression not evaluating to a bool",
+			expression: "self.status.readyReplicas + 1",
+			compileErr: true,
+		},
+		{
+			name:       "expression referencing missing fields",
+			expression: "self.status.availableReplicas == 3",
+			evalErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			program, err := CompileBoolExpression(tt.expression)
+			if tt.compileErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := program.Eval(obj)
+			if tt.evalErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
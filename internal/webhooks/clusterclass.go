@@ -478,11 +478,14 @@ func validateMachineHealthCheckClass(fldPath *field.Path, namepace string, m *cl
 			Namespace: namepace,
 		},
 		Spec: clusterv1.MachineHealthCheckSpec{
-			NodeStartupTimeoutSeconds: m.NodeStartupTimeoutSeconds,
-			MaxUnhealthy:              m.MaxUnhealthy,
-			UnhealthyNodeConditions:   m.UnhealthyNodeConditions,
-			UnhealthyRange:            m.UnhealthyRange,
-			RemediationTemplate:       m.RemediationTemplate,
+			NodeStartupTimeoutSeconds:  m.NodeStartupTimeoutSeconds,
+			MaxUnhealthy:               m.MaxUnhealthy,
+			UnhealthyNodeConditions:    m.UnhealthyNodeConditions,
+			UnhealthyMachineConditions: m.UnhealthyMachineConditions,
+			UnhealthyNodeTaints:        m.UnhealthyNodeTaints,
+			UnhealthyRange:             m.UnhealthyRange,
+			RemediationTemplate:        m.RemediationTemplate,
+			RemediationBudget:          m.RemediationBudget,
 		}}
 
 	return (&MachineHealthCheck{}).validateCommonFields(&mhc, fldPath)
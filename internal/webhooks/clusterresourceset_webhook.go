@@ -19,8 +19,12 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"path"
 	"reflect"
+	"strings"
+	"text/template"
 
+	"github.com/Masterminds/sprig/v3"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,6 +35,7 @@ import (
 
 	addonsv1 "sigs.k8s.io/cluster-api/api/addons/v1beta2"
 	"sigs.k8s.io/cluster-api/feature"
+	"sigs.k8s.io/cluster-api/internal/util/cel"
 )
 
 // ClusterResourceSet implements a validation and defaulting webhook for ClusterResourceSet.
@@ -133,9 +138,85 @@ func (webhook *ClusterResourceSet) validate(oldCRS, newCRS *addonsv1.ClusterReso
 		)
 	}
 
+	for i, resource := range newCRS.Spec.Resources {
+		allErrs = append(allErrs, validateResourceRef(resource, field.NewPath("spec", "resources").Index(i))...)
+	}
+
+	for i, dependency := range newCRS.Spec.DependsOn {
+		if dependency.Name == newCRS.Name {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "dependsOn").Index(i).Child("name"), dependency.Name, "a ClusterResourceSet cannot depend on itself"))
+		}
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
 
 	return apierrors.NewInvalid(addonsv1.GroupVersion.WithKind("ClusterResourceSet").GroupKind(), newCRS.Name, allErrs)
 }
+
+// validateResourceRef validates that helmChart and kustomization are only set for the corresponding kinds.
+func validateResourceRef(resource addonsv1.ResourceRef, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch resource.Kind {
+	case string(addonsv1.HelmChartClusterResourceSetResourceKind):
+		if resource.HelmChart == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("helmChart"), "must be set if kind is HelmChart"))
+		} else if resource.HelmChart.Values != "" {
+			if _, err := template.New("values").Funcs(sprig.HermeticTxtFuncMap()).Parse(resource.HelmChart.Values); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("helmChart", "values"), resource.HelmChart.Values, fmt.Sprintf("template must be a valid Go template: %v", err)))
+			}
+		}
+		if resource.Kustomization != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kustomization"), "can be set only if kind is Kustomization"))
+		}
+	case string(addonsv1.KustomizationClusterResourceSetResourceKind):
+		if resource.Kustomization == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kustomization"), "must be set if kind is Kustomization"))
+		} else if resource.Kustomization.Path != "" {
+			if cleanPath := path.Clean(resource.Kustomization.Path); path.IsAbs(cleanPath) || cleanPath == ".." || strings.HasPrefix(cleanPath, "../") {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("kustomization", "path"), resource.Kustomization.Path, "must be a relative path within the kustomization directory"))
+			}
+		}
+		if resource.HelmChart != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("helmChart"), "can be set only if kind is HelmChart"))
+		}
+	default:
+		if resource.HelmChart != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("helmChart"), "can be set only if kind is HelmChart"))
+		}
+		if resource.Kustomization != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kustomization"), "can be set only if kind is Kustomization"))
+		}
+	}
+
+	for i, check := range resource.ReadinessChecks {
+		allErrs = append(allErrs, validateResourceReadinessCheck(check, fldPath.Child("readinessChecks").Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateResourceReadinessCheck validates that kind and expression are only set for Expression readiness checks
+// and that the expression compiles.
+func validateResourceReadinessCheck(check addonsv1.ResourceReadinessCheck, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if check.Type == addonsv1.ExpressionResourceReadinessCheckType {
+		if check.Expression == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("expression"), "must be set if type is Expression"))
+		} else if _, err := cel.CompileBoolExpression(check.Expression); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("expression"), check.Expression, err.Error()))
+		}
+		return allErrs
+	}
+
+	if check.Kind != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kind"), "can be set only if type is Expression"))
+	}
+	if check.Expression != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("expression"), "can be set only if type is Expression"))
+	}
+	return allErrs
+}
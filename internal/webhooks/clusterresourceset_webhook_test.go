@@ -210,3 +210,146 @@ func TestClusterResourceSetSelectorNotEmptyValidation(t *testing.T) {
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("selector must not be empty"))
 }
+
+func TestClusterResourceSetResourcesValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		resource  addonsv1.ResourceRef
+		expectErr bool
+	}{
+		{
+			name:      "should not return error for a ConfigMap",
+			resource:  addonsv1.ResourceRef{Name: "cm", Kind: "ConfigMap"},
+			expectErr: false,
+		},
+		{
+			name: "should return error for a ConfigMap with helmChart",
+			resource: addonsv1.ResourceRef{Name: "cm", Kind: "ConfigMap", HelmChart: &addonsv1.HelmChartResource{
+				SourceKind: "ConfigMap",
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should not return error for a HelmChart with valid values",
+			resource: addonsv1.ResourceRef{Name: "chart", Kind: "HelmChart", HelmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+				Values:     "clusterName: {{ .Cluster.metadata.name }}",
+			}},
+			expectErr: false,
+		},
+		{
+			name:      "should return error for a HelmChart without helmChart",
+			resource:  addonsv1.ResourceRef{Name: "chart", Kind: "HelmChart"},
+			expectErr: true,
+		},
+		{
+			name: "should return error for a HelmChart with invalid values",
+			resource: addonsv1.ResourceRef{Name: "chart", Kind: "HelmChart", HelmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+				Values:     "clusterName: {{ .Cluster.metadata.name",
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for a HelmChart with kustomization",
+			resource: addonsv1.ResourceRef{Name: "chart", Kind: "HelmChart", HelmChart: &addonsv1.HelmChartResource{
+				SourceKind: "Secret",
+			}, Kustomization: &addonsv1.KustomizationResource{
+				SourceKind: "Secret",
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should not return error for a Kustomization with a relative path",
+			resource: addonsv1.ResourceRef{Name: "kustomization", Kind: "Kustomization", Kustomization: &addonsv1.KustomizationResource{
+				SourceKind: "ConfigMap",
+				Path:       "overlays/prod",
+			}},
+			expectErr: false,
+		},
+		{
+			name:      "should return error for a Kustomization without kustomization",
+			resource:  addonsv1.ResourceRef{Name: "kustomization", Kind: "Kustomization"},
+			expectErr: true,
+		},
+		{
+			name: "should return error for a Kustomization with a path outside of the kustomization directory",
+			resource: addonsv1.ResourceRef{Name: "kustomization", Kind: "Kustomization", Kustomization: &addonsv1.KustomizationResource{
+				SourceKind: "ConfigMap",
+				Path:       "overlays/../../prod",
+			}},
+			expectErr: true,
+		}, {
+			name: "should not return error for readiness checks",
+			resource: addonsv1.ResourceRef{Name: "crds", Kind: "ConfigMap", ReadinessChecks: []addonsv1.ResourceReadinessCheck{
+				{Type: addonsv1.CRDEstablishedResourceReadinessCheckType},
+				{Type: addonsv1.DeploymentAvailableResourceReadinessCheckType},
+				{Type: addonsv1.ExpressionResourceReadinessCheckType, Kind: "DaemonSet", Expression: "self.status.numberReady == self.status.desiredNumberScheduled"},
+			}},
+			expectErr: false,
+		},
+		{
+			name: "should return error for an Expression readiness check without expression",
+			resource: addonsv1.ResourceRef{Name: "cni", Kind: "ConfigMap", ReadinessChecks: []addonsv1.ResourceReadinessCheck{
+				{Type: addonsv1.ExpressionResourceReadinessCheckType, Kind: "DaemonSet"},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for an Expression readiness check with an invalid expression",
+			resource: addonsv1.ResourceRef{Name: "cni", Kind: "ConfigMap", ReadinessChecks: []addonsv1.ResourceReadinessCheck{
+				{Type: addonsv1.ExpressionResourceReadinessCheckType, Expression: "size(self.status.conditions)"},
+			}},
+			expectErr: true,
+		},
+		{
+			name: "should return error for a CRDEstablished readiness check with expression",
+			resource: addonsv1.ResourceRef{Name: "crds", Kind: "ConfigMap", ReadinessChecks: []addonsv1.ResourceReadinessCheck{
+				{Type: addonsv1.CRDEstablishedResourceReadinessCheckType, Kind: "CustomResourceDefinition", Expression: "true"},
+			}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterResourceSet := &addonsv1.ClusterResourceSet{
+				Spec: addonsv1.ClusterResourceSetSpec{
+					ClusterSelector: metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+					Resources: []addonsv1.ResourceRef{tt.resource},
+				},
+			}
+			webhook := ClusterResourceSet{}
+			warnings, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+			if tt.expectErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(warnings).To(BeEmpty())
+		})
+	}
+}
+
+func TestClusterResourceSetDependsOnValidation(t *testing.T) {
+	g := NewWithT(t)
+	clusterResourceSet := &addonsv1.ClusterResourceSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "csi"},
+		Spec: addonsv1.ClusterResourceSetSpec{
+			ClusterSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{"foo": "bar"},
+			},
+			DependsOn: []addonsv1.ClusterResourceSetDependency{{Name: "cni"}},
+		},
+	}
+	webhook := ClusterResourceSet{}
+	_, err := webhook.ValidateCreate(ctx, clusterResourceSet)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	clusterResourceSet.Spec.DependsOn = append(clusterResourceSet.Spec.DependsOn, addonsv1.ClusterResourceSetDependency{Name: "csi"})
+	_, err = webhook.ValidateCreate(ctx, clusterResourceSet)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -264,6 +264,40 @@ func (webhook *MachineDeployment) validate(oldMD, newMD *clusterv1.MachineDeploy
 				)
 			}
 		}
+
+		if len(newMD.Spec.Strategy.RollingUpdate.Steps) > 0 && newMD.Spec.Strategy.Type != clusterv1.RollingUpdateMachineDeploymentStrategyType {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(specPath.Child("strategy", "rollingUpdate", "steps"),
+					fmt.Sprintf("can only be set if strategy type is %s", clusterv1.RollingUpdateMachineDeploymentStrategyType)),
+			)
+		}
+
+		for i, step := range newMD.Spec.Strategy.RollingUpdate.Steps {
+			stepPath := specPath.Child("strategy", "rollingUpdate", "steps").Index(i).Child("replicas")
+			replicas, err := intstr.GetScaledValueFromIntOrPercent(&step.Replicas, total, true)
+			if err != nil {
+				allErrs = append(
+					allErrs,
+					field.Invalid(stepPath, step.Replicas.String(), fmt.Sprintf("must be either an int or a percentage: %v", err.Error())),
+				)
+				continue
+			}
+			if replicas <= 0 {
+				allErrs = append(
+					allErrs,
+					field.Invalid(stepPath, step.Replicas.String(), "must be greater than 0"),
+				)
+			}
+		}
+	}
+
+	if newMD.Spec.Strategy != nil && newMD.Spec.Strategy.BlueGreen != nil && newMD.Spec.Strategy.Type != clusterv1.BlueGreenMachineDeploymentStrategyType {
+		allErrs = append(
+			allErrs,
+			field.Forbidden(specPath.Child("strategy", "blueGreen"),
+				fmt.Sprintf("can only be set if strategy type is %s", clusterv1.BlueGreenMachineDeploymentStrategyType)),
+		)
 	}
 
 	if newMD.Spec.Strategy != nil && newMD.Spec.Strategy.Remediation != nil {
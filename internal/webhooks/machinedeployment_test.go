@@ -452,6 +452,80 @@ func TestMachineDeploymentValidation(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name:      "should not return error for blueGreen with BlueGreen strategy type",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			strategy: clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.BlueGreenMachineDeploymentStrategyType,
+				BlueGreen: &clusterv1.MachineBlueGreenDeployment{
+					PromotionDelaySeconds: ptr.To[int32](60),
+					ManualPromotion:       ptr.To(true),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:      "should return error for blueGreen with RollingUpdate strategy type",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			strategy: clusterv1.MachineDeploymentStrategy{
+				Type:      clusterv1.RollingUpdateMachineDeploymentStrategyType,
+				BlueGreen: &clusterv1.MachineBlueGreenDeployment{},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "should not return error for valid rollingUpdate steps",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			strategy: clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+					Steps: []clusterv1.MachineRolloutStep{
+						{Replicas: intstr.FromInt32(1)},
+						{Replicas: intstr.FromString("50%"), AnalysisCondition: "CanaryHealthy"},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:      "should return error for invalid rollingUpdate step replicas",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			strategy: clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+					Steps: []clusterv1.MachineRolloutStep{{Replicas: intstr.FromString("foo")}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "should return error for zero rollingUpdate step replicas",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			strategy: clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.RollingUpdateMachineDeploymentStrategyType,
+				RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+					Steps: []clusterv1.MachineRolloutStep{{Replicas: intstr.FromInt32(0)}},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "should return error for rollingUpdate steps with OnDelete strategy type",
+			selectors: map[string]string{"foo": "bar"},
+			labels:    map[string]string{"foo": "bar"},
+			strategy: clusterv1.MachineDeploymentStrategy{
+				Type: clusterv1.OnDeleteMachineDeploymentStrategyType,
+				RollingUpdate: &clusterv1.MachineRollingUpdateDeployment{
+					Steps: []clusterv1.MachineRolloutStep{{Replicas: intstr.FromInt32(1)}},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "should not return error when MachineNamingStrategy have {{ .random }}",
 			machineNamingStrategy: clusterv1.MachineNamingStrategy{
@@ -86,6 +86,25 @@ func (webhook *MachineDrainRule) validate(newMDR *clusterv1.MachineDrainRule) er
 		}
 	}
 
+	if newMDR.Spec.Drain.Behavior != clusterv1.MachineDrainRuleDrainBehaviorDrain {
+		drainOnlyMsg := fmt.Sprintf("must not be set if drain behavior is not %q", clusterv1.MachineDrainRuleDrainBehaviorDrain)
+		if newMDR.Spec.Drain.EvictionTimeoutSeconds != nil {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "drain", "evictionTimeoutSeconds"), *newMDR.Spec.Drain.EvictionTimeoutSeconds, drainOnlyMsg),
+			)
+		}
+		if newMDR.Spec.Drain.GracePeriodSeconds != nil {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "drain", "gracePeriodSeconds"), *newMDR.Spec.Drain.GracePeriodSeconds, drainOnlyMsg),
+			)
+		}
+		if len(newMDR.Spec.Drain.DeleteOwnerKinds) > 0 {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec", "drain", "deleteOwnerKinds"), newMDR.Spec.Drain.DeleteOwnerKinds, drainOnlyMsg),
+			)
+		}
+	}
+
 	allErrs = append(allErrs, ValidateMachineDrainRulesSelectors(newMDR)...)
 
 	if len(allErrs) == 0 {
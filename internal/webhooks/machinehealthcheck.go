@@ -19,11 +19,13 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -37,6 +39,15 @@ var (
 	minNodeStartupTimeoutSeconds = int32(30)
 	// We allow users to disable the nodeStartupTimeout by setting the duration to 0.
 	disabledNodeStartupTimeoutSeconds = int32(0)
+	// Machine conditions which are computed from the health check itself or from remediation, and thus
+	// cannot be used to determine if a Machine is unhealthy.
+	forbiddenUnhealthyMachineConditionTypes = sets.New[string](
+		clusterv1.MachineReadyCondition,
+		clusterv1.MachineAvailableCondition,
+		clusterv1.MachineHealthCheckSucceededCondition,
+		clusterv1.MachineOwnerRemediatedCondition,
+		clusterv1.MachineExternallyRemediatedCondition,
+	)
 )
 
 // SetMinNodeStartupTimeoutSeconds allows users to optionally set a custom timeout
@@ -164,7 +175,7 @@ func (webhook *MachineHealthCheck) validate(oldMHC, newMHC *clusterv1.MachineHea
 	return apierrors.NewInvalid(clusterv1.GroupVersion.WithKind("MachineHealthCheck").GroupKind(), newMHC.Name, allErrs)
 }
 
-// ValidateCommonFields validates NodeStartupTimeoutSeconds, MaxUnhealthy, and RemediationTemplate of the MHC.
+// ValidateCommonFields validates NodeStartupTimeoutSeconds, MaxUnhealthy, UnhealthyMachineConditions, RemediationBudget, and RemediationTemplate of the MHC.
 // These are the fields in common with other types which define MachineHealthChecks such as MachineHealthCheckClass and MachineHealthCheckTopology.
 func (webhook *MachineHealthCheck) validateCommonFields(m *clusterv1.MachineHealthCheck, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -185,6 +196,30 @@ func (webhook *MachineHealthCheck) validateCommonFields(m *clusterv1.MachineHeal
 			)
 		}
 	}
+	for i, c := range m.Spec.UnhealthyMachineConditions {
+		if forbiddenUnhealthyMachineConditionTypes.Has(c.Type) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					fldPath.Child("unhealthyMachineConditions").Index(i).Child("type"),
+					c.Type,
+					fmt.Sprintf("must not be one of %s", strings.Join(sets.List(forbiddenUnhealthyMachineConditionTypes), ", ")),
+				),
+			)
+		}
+	}
+	if b := m.Spec.RemediationBudget; b != nil && b.BackoffSeconds != nil && b.MaxBackoffSeconds != nil && *b.MaxBackoffSeconds < *b.BackoffSeconds {
+		allErrs = append(
+			allErrs,
+			field.Invalid(fldPath.Child("remediationBudget", "maxBackoffSeconds"), *b.MaxBackoffSeconds, "must be greater than or equal to backoffSeconds"),
+		)
+	}
+	if b := m.Spec.RemediationBudget; b != nil && b.MaxBackoffSeconds != nil && *b.MaxBackoffSeconds > b.WindowSeconds {
+		allErrs = append(
+			allErrs,
+			field.Invalid(fldPath.Child("remediationBudget", "maxBackoffSeconds"), *b.MaxBackoffSeconds, "must be less than or equal to windowSeconds"),
+		)
+	}
 	if m.Spec.RemediationTemplate != nil && m.Spec.RemediationTemplate.Namespace != m.Namespace {
 		allErrs = append(
 			allErrs,
@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/internal/webhooks/util"
@@ -328,6 +329,74 @@ func TestMachineHealthCheckNodeStartupTimeout(t *testing.T) {
 	}
 }
 
+func TestMachineHealthCheckUnhealthyMachineConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		conditionType string
+		expectErr     bool
+	}{
+		{
+			name:          "when the condition type is set by an infrastructure provider",
+			conditionType: "InfrastructureHealthy",
+			expectErr:     false,
+		},
+		{
+			name:          "when the condition type is Ready",
+			conditionType: clusterv1.MachineReadyCondition,
+			expectErr:     true,
+		},
+		{
+			name:          "when the condition type is HealthCheckSucceeded",
+			conditionType: clusterv1.MachineHealthCheckSucceededCondition,
+			expectErr:     true,
+		},
+		{
+			name:          "when the condition type is OwnerRemediated",
+			conditionType: clusterv1.MachineOwnerRemediatedCondition,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mhc := &clusterv1.MachineHealthCheck{
+				Spec: clusterv1.MachineHealthCheckSpec{
+					Selector: metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							"test": "test",
+						},
+					},
+					UnhealthyMachineConditions: []clusterv1.UnhealthyMachineCondition{
+						{
+							Type:   tt.conditionType,
+							Status: metav1.ConditionFalse,
+						},
+					},
+				},
+			}
+			webhook := &MachineHealthCheck{}
+
+			if tt.expectErr {
+				warnings, err := webhook.ValidateCreate(ctx, mhc)
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				warnings, err = webhook.ValidateUpdate(ctx, mhc, mhc)
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+			} else {
+				warnings, err := webhook.ValidateCreate(ctx, mhc)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+				warnings, err = webhook.ValidateUpdate(ctx, mhc, mhc)
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(warnings).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestMachineHealthCheckMaxUnhealthy(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -396,6 +465,89 @@ func TestMachineHealthCheckMaxUnhealthy(t *testing.T) {
 	}
 }
 
+func TestMachineHealthCheckRemediationBudget(t *testing.T) {
+	tests := []struct {
+		name      string
+		budget    *clusterv1.MachineHealthCheckRemediationBudget
+		expectErr bool
+	}{
+		{
+			name:      "when the remediation budget is not set",
+			expectErr: false,
+		},
+		{
+			name: "when the backoff is not set",
+			budget: &clusterv1.MachineHealthCheckRemediationBudget{
+				MaxRemediations: 3,
+				WindowSeconds:   3600,
+			},
+			expectErr: false,
+		},
+		{
+			name: "when maxBackoffSeconds is greater than backoffSeconds",
+			budget: &clusterv1.MachineHealthCheckRemediationBudget{
+				MaxRemediations:   3,
+				WindowSeconds:     3600,
+				BackoffSeconds:    ptr.To[int32](60),
+				MaxBackoffSeconds: ptr.To[int32](600),
+			},
+			expectErr: false,
+		},
+		{
+			name: "when maxBackoffSeconds is less than backoffSeconds",
+			budget: &clusterv1.MachineHealthCheckRemediationBudget{
+				MaxRemediations:   3,
+				WindowSeconds:     3600,
+				BackoffSeconds:    ptr.To[int32](600),
+				MaxBackoffSeconds: ptr.To[int32](60),
+			},
+			expectErr: true,
+		},
+		{
+			name: "when maxBackoffSeconds is greater than windowSeconds",
+			budget: &clusterv1.MachineHealthCheckRemediationBudget{
+				MaxRemediations:   3,
+				WindowSeconds:     600,
+				BackoffSeconds:    ptr.To[int32](60),
+				MaxBackoffSeconds: ptr.To[int32](3600),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		g := NewWithT(t)
+
+		mhc := &clusterv1.MachineHealthCheck{
+			Spec: clusterv1.MachineHealthCheckSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"test": "test",
+					},
+				},
+				RemediationBudget: tt.budget,
+			},
+		}
+		webhook := &MachineHealthCheck{}
+
+		if tt.expectErr {
+			warnings, err := webhook.ValidateCreate(ctx, mhc)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+			warnings, err = webhook.ValidateUpdate(ctx, mhc, mhc)
+			g.Expect(err).To(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+		} else {
+			warnings, err := webhook.ValidateCreate(ctx, mhc)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+			warnings, err = webhook.ValidateUpdate(ctx, mhc, mhc)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(warnings).To(BeEmpty())
+		}
+	}
+}
+
 func TestMachineHealthCheckSelectorValidation(t *testing.T) {
 	g := NewWithT(t)
 	mhc := &clusterv1.MachineHealthCheck{
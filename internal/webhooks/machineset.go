@@ -284,12 +284,18 @@ func validateSkippedMachineSetPreflightChecks(o client.Object) *field.Error {
 		clusterv1.MachineSetPreflightCheckKubernetesVersionSkew,
 		clusterv1.MachineSetPreflightCheckControlPlaneIsStable,
 		clusterv1.MachineSetPreflightCheckControlPlaneVersionSkew,
+		clusterv1.MachineSetPreflightCheckDrainCapacity,
 	)
 
 	skippedList := strings.Split(skip, ",")
 	invalid := []clusterv1.MachineSetPreflightCheck{}
 	for i := range skippedList {
 		skipped := clusterv1.MachineSetPreflightCheck(strings.TrimSpace(skippedList[i]))
+		// Preflight checks implemented by Runtime Extensions are identified by the name of the extension handler,
+		// which always has the "<handler>.<ExtensionConfig>" format.
+		if strings.Contains(string(skipped), ".") && len(validation.IsDNS1123Subdomain(string(skipped))) == 0 {
+			continue
+		}
 		if !supported.Has(skipped) {
 			invalid = append(invalid, skipped)
 		}
@@ -298,7 +304,7 @@ func validateSkippedMachineSetPreflightChecks(o client.Object) *field.Error {
 		return field.Invalid(
 			field.NewPath("metadata", "annotations", clusterv1.MachineSetSkipPreflightChecksAnnotation),
 			invalid,
-			fmt.Sprintf("skipped preflight check(s) must be among: %v", sets.List(supported)),
+			fmt.Sprintf("skipped preflight check(s) must be among: %v or the name of an extension handler", sets.List(supported)),
 		)
 	}
 	return nil
@@ -471,6 +471,28 @@ func TestValidateSkippedMachineSetPreflightChecks(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "should pass if preflight checks implemented by Runtime Extensions are skipped",
+			ms: &clusterv1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						clusterv1.MachineSetSkipPreflightChecksAnnotation: string(clusterv1.MachineSetPreflightCheckKubeadmVersionSkew) + ",quota-check.my-extension",
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "should fail if invalid preflight checks implemented by Runtime Extensions are skipped",
+			ms: &clusterv1.MachineSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						clusterv1.MachineSetSkipPreflightChecksAnnotation: "Quota_Check.my-extension",
+					},
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -49,8 +49,11 @@ func Test_validate(t *testing.T) {
 				},
 				Spec: clusterv1.MachineDrainRuleSpec{
 					Drain: clusterv1.MachineDrainRuleDrainConfig{
-						Behavior: clusterv1.MachineDrainRuleDrainBehaviorDrain,
-						Order:    ptr.To[int32](5),
+						Behavior:               clusterv1.MachineDrainRuleDrainBehaviorDrain,
+						Order:                  ptr.To[int32](5),
+						EvictionTimeoutSeconds: ptr.To[int32](600),
+						GracePeriodSeconds:     ptr.To[int32](30),
+						DeleteOwnerKinds:       []string{"Job"},
 					},
 					Pods: []clusterv1.MachineDrainRulePodSelector{
 						{
@@ -119,6 +122,28 @@ func Test_validate(t *testing.T) {
 				"MachineDrainRule.cluster.x-k8s.io \"mdr\" is invalid: " +
 				"spec.drain.order: Invalid value: 5: order must not be set if drain behavior is \"Skip\" or \"WaitCompleted\"",
 		},
+		{
+			name: "Return error if drain options are set with drain behavior Skip",
+			machineDrainRule: &clusterv1.MachineDrainRule{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mdr",
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: clusterv1.MachineDrainRuleSpec{
+					Drain: clusterv1.MachineDrainRuleDrainConfig{
+						Behavior:               clusterv1.MachineDrainRuleDrainBehaviorSkip,
+						EvictionTimeoutSeconds: ptr.To[int32](600),
+						GracePeriodSeconds:     ptr.To[int32](30),
+						DeleteOwnerKinds:       []string{"Job"},
+					},
+				},
+			},
+			wantErr: "admission webhook \"validation.machinedrainrule.cluster.x-k8s.io\" denied the request: " +
+				"MachineDrainRule.cluster.x-k8s.io \"mdr\" is invalid: [" +
+				"spec.drain.evictionTimeoutSeconds: Invalid value: 600: must not be set if drain behavior is not \"Drain\", " +
+				"spec.drain.gracePeriodSeconds: Invalid value: 30: must not be set if drain behavior is not \"Drain\", " +
+				"spec.drain.deleteOwnerKinds: Invalid value: []string{\"Job\"}: must not be set if drain behavior is not \"Drain\"]",
+		},
 		{
 			name: "Return error for MachineDrainRules with invalid selector",
 			machineDrainRule: &clusterv1.MachineDrainRule{
@@ -79,6 +79,7 @@ import (
 	addonsv1alpha4 "sigs.k8s.io/cluster-api/internal/api/addons/v1alpha4"
 	clusterv1alpha3 "sigs.k8s.io/cluster-api/internal/api/core/v1alpha3"
 	clusterv1alpha4 "sigs.k8s.io/cluster-api/internal/api/core/v1alpha4"
+	clusterresourcesetrender "sigs.k8s.io/cluster-api/internal/controllers/clusterresourceset/render"
 	internalruntimeclient "sigs.k8s.io/cluster-api/internal/runtime/client"
 	runtimeregistry "sigs.k8s.io/cluster-api/internal/runtime/registry"
 	runtimewebhooks "sigs.k8s.io/cluster-api/internal/webhooks/runtime"
@@ -116,23 +117,24 @@ var (
 	managerOptions              = flags.ManagerOptions{}
 	logOptions                  = logs.NewOptions()
 	// core Cluster API specific flags.
-	remoteConnectionGracePeriod      time.Duration
-	remoteConditionsGracePeriod      time.Duration
-	clusterTopologyConcurrency       int
-	clusterCacheConcurrency          int
-	clusterClassConcurrency          int
-	clusterConcurrency               int
-	extensionConfigConcurrency       int
-	machineConcurrency               int
-	machineSetConcurrency            int
-	machineDeploymentConcurrency     int
-	machinePoolConcurrency           int
-	clusterResourceSetConcurrency    int
-	machineHealthCheckConcurrency    int
-	machineSetPreflightChecks        []string
-	skipCRDMigrationPhases           []string
-	additionalSyncMachineLabels      []string
-	additionalSyncMachineAnnotations []string
+	remoteConnectionGracePeriod               time.Duration
+	remoteConditionsGracePeriod               time.Duration
+	clusterTopologyConcurrency                int
+	clusterCacheConcurrency                   int
+	clusterClassConcurrency                   int
+	clusterConcurrency                        int
+	extensionConfigConcurrency                int
+	machineConcurrency                        int
+	machineSetConcurrency                     int
+	machineDeploymentConcurrency              int
+	machinePoolConcurrency                    int
+	clusterResourceSetConcurrency             int
+	clusterResourceSetDriftCorrectionInterval time.Duration
+	machineHealthCheckConcurrency             int
+	machineSetPreflightChecks                 []string
+	skipCRDMigrationPhases                    []string
+	additionalSyncMachineLabels               []string
+	additionalSyncMachineAnnotations          []string
 )
 
 func init() {
@@ -227,6 +229,9 @@ func InitFlags(fs *pflag.FlagSet) {
 	fs.IntVar(&clusterResourceSetConcurrency, "clusterresourceset-concurrency", 10,
 		"Number of cluster resource sets to process simultaneously")
 
+	fs.DurationVar(&clusterResourceSetDriftCorrectionInterval, "clusterresourceset-drift-correction-interval", 10*time.Minute,
+		"Interval at which the objects of ClusterResourceSets with the Reconcile strategy are re-applied to correct out-of-band changes. Set to 0 to disable drift correction.")
+
 	fs.IntVar(&machineHealthCheckConcurrency, "machinehealthcheck-concurrency", 10,
 		"Number of machine health checks to process simultaneously")
 
@@ -235,7 +240,8 @@ func InitFlags(fs *pflag.FlagSet) {
 		"List of MachineSet preflight checks that should be run. Per default all of them are enabled."+
 			"Set this flag to only enable a subset of them. The MachineSet preflight checks can be then also disabled"+
 			"on MachineSets via the 'machineset.cluster.x-k8s.io/skip-preflight-checks' annotation."+
-			"Valid values are: All or a list of KubeadmVersionSkew, KubernetesVersionSkew, ControlPlaneIsStable, ControlPlaneVersionSkew")
+			"Valid values are: All or a list of KubeadmVersionSkew, KubernetesVersionSkew, ControlPlaneIsStable, ControlPlaneVersionSkew, DrainCapacity. "+
+			"Note: DrainCapacity is not enabled by All and must be explicitly added to the list")
 
 	fs.StringSliceVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
@@ -663,6 +669,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		Client:                           mgr.GetClient(),
 		APIReader:                        mgr.GetAPIReader(),
 		ClusterCache:                     clusterCache,
+		RuntimeClient:                    runtimeClient,
 		WatchFilterValue:                 watchFilterValue,
 		RemoteConditionsGracePeriod:      remoteConditionsGracePeriod,
 		AdditionalSyncMachineLabels:      additionalSyncMachineLabelRegexes,
@@ -678,6 +685,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		clusterv1.MachineSetPreflightCheckKubernetesVersionSkew,
 		clusterv1.MachineSetPreflightCheckControlPlaneIsStable,
 		clusterv1.MachineSetPreflightCheckControlPlaneVersionSkew,
+		clusterv1.MachineSetPreflightCheckDrainCapacity,
 	)
 	for _, c := range machineSetPreflightChecks {
 		if c == "" {
@@ -695,6 +703,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 		APIReader:        mgr.GetAPIReader(),
 		ClusterCache:     clusterCache,
 		PreflightChecks:  machineSetPreflightChecksSet,
+		RuntimeClient:    runtimeClient,
 		WatchFilterValue: watchFilterValue,
 	}).SetupWithManager(ctx, mgr, concurrency(machineSetConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineSet")
@@ -703,6 +712,7 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 	if err := (&controllers.MachineDeploymentReconciler{
 		Client:           mgr.GetClient(),
 		APIReader:        mgr.GetAPIReader(),
+		ClusterCache:     clusterCache,
 		WatchFilterValue: watchFilterValue,
 	}).SetupWithManager(ctx, mgr, concurrency(machineDeploymentConcurrency)); err != nil {
 		setupLog.Error(err, "Unable to create controller", "controller", "MachineDeployment")
@@ -723,9 +733,11 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, watchNamespaces map
 
 	if feature.Gates.Enabled(feature.ClusterResourceSet) {
 		if err := (&controllers.ClusterResourceSetReconciler{
-			Client:           mgr.GetClient(),
-			ClusterCache:     clusterCache,
-			WatchFilterValue: watchFilterValue,
+			Client:                  mgr.GetClient(),
+			ClusterCache:            clusterCache,
+			WatchFilterValue:        watchFilterValue,
+			DriftCorrectionInterval: clusterResourceSetDriftCorrectionInterval,
+			Renderer:                clusterresourcesetrender.NewRenderer(),
 		}).SetupWithManager(ctx, mgr, concurrency(clusterResourceSetConcurrency), partialSecretCache); err != nil {
 			setupLog.Error(err, "Unable to create controller", "controller", "ClusterResourceSet")
 			os.Exit(1)
@@ -31,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
+	certutil "k8s.io/client-go/util/cert"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -180,6 +181,41 @@ func NeedsClientCertRotation(configSecret *corev1.Secret, threshold time.Duratio
 	return false, nil
 }
 
+// NeedsCACertUpdate returns whether the CA certificates trusted by the Kubeconfig secret are different from the given CA certificates.
+func NeedsCACertUpdate(configSecret *corev1.Secret, caCert []byte) (bool, error) {
+	data, err := toKubeconfigBytes(configSecret)
+	if err != nil {
+		return false, err
+	}
+
+	config, err := clientcmd.Load(data)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to convert kubeconfig Secret into a clientcmdapi.Config")
+	}
+
+	caCerts, err := certutil.ParseCertsPEM(caCert)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode CA certificates")
+	}
+
+	for _, cluster := range config.Clusters {
+		trustedCerts, err := certutil.ParseCertsPEM(cluster.CertificateAuthorityData)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to decode kubeconfig CA certificates")
+		}
+		if len(trustedCerts) != len(caCerts) {
+			return true, nil
+		}
+		for i := range caCerts {
+			if !trustedCerts[i].Equal(caCerts[i]) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 // RegenerateSecret creates and stores a new Kubeconfig in the given secret.
 func RegenerateSecret(ctx context.Context, c client.Client, configSecret *corev1.Secret) error {
 	clusterName, _, err := secret.ParseSecretName(configSecret.Name)
@@ -233,6 +269,11 @@ func generateKubeconfig(ctx context.Context, c client.Client, clusterName client
 		return nil, errors.Wrap(err, "failed to generate a kubeconfig")
 	}
 
+	// If the CA secret contains a CA bundle, e.g. during a CA rotation, trust all the CAs in the bundle.
+	if caCerts, err := certutil.ParseCertsPEM(clusterCA.Data[secret.TLSCrtDataName]); err == nil && len(caCerts) > 1 {
+		cfg.Clusters[clusterName.Name].CertificateAuthorityData = clusterCA.Data[secret.TLSCrtDataName]
+	}
+
 	out, err := clientcmd.Write(*cfg)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to serialize config to yaml")
@@ -435,6 +435,70 @@ func TestNeedsClientCertRotation(t *testing.T) {
 	g.Expect(NeedsClientCertRotation(kubeconfigSecret, certs.DefaultCertDuration-time.Hour)).To(BeFalse())
 }
 
+func TestNeedsCACertUpdate(t *testing.T) {
+	g := NewWithT(t)
+	caKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	caCert, err := getTestCACert(caKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	otherCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	otherCACert, err := getTestCACert(otherCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	config, err := New("foo", "https://127:0.0.1:4003", caCert, caKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	out, err := clientcmd.Write(*config)
+	g.Expect(err).ToNot(HaveOccurred())
+	kubeconfigSecret := GenerateSecretWithOwner(client.ObjectKey{Name: "test1", Namespace: "test"}, out, metav1.OwnerReference{})
+
+	g.Expect(NeedsCACertUpdate(kubeconfigSecret, certs.EncodeCertPEM(caCert))).To(BeFalse())
+	g.Expect(NeedsCACertUpdate(kubeconfigSecret, certs.EncodeCertPEM(otherCACert))).To(BeTrue())
+	g.Expect(NeedsCACertUpdate(kubeconfigSecret, append(certs.EncodeCertPEM(caCert), certs.EncodeCertPEM(otherCACert)...))).To(BeTrue())
+}
+
+func TestRegenerateSecretWithCABundle(t *testing.T) {
+	g := NewWithT(t)
+	caKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	caCert, err := getTestCACert(caKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	otherCAKey, err := certs.NewPrivateKey()
+	g.Expect(err).ToNot(HaveOccurred())
+	otherCACert, err := getTestCACert(otherCAKey)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// The CA secret contains a CA bundle, e.g. during a CA rotation.
+	caBundle := append(certs.EncodeCertPEM(caCert), certs.EncodeCertPEM(otherCACert)...)
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test1-ca",
+			Namespace: "test",
+		},
+		Data: map[string][]byte{
+			secret.TLSKeyDataName: certs.EncodePrivateKeyPEM(caKey),
+			secret.TLSCrtDataName: caBundle,
+		},
+	}
+
+	kubeconfigSecret := validSecret.DeepCopy()
+	c := fake.NewClientBuilder().WithObjects(kubeconfigSecret, caSecret).Build()
+	g.Expect(RegenerateSecret(ctx, c, kubeconfigSecret)).To(Succeed())
+
+	newSecret := &corev1.Secret{}
+	g.Expect(c.Get(ctx, util.ObjectKey(validSecret), newSecret)).To(Succeed())
+	g.Expect(NeedsCACertUpdate(newSecret, caBundle)).To(BeFalse())
+
+	// The client certificate is signed by the first CA in the bundle.
+	newConfig, err := clientcmd.Load(newSecret.Data[secret.KubeconfigDataName])
+	g.Expect(err).ToNot(HaveOccurred())
+	newCert, err := certs.DecodeCertPEM(newConfig.AuthInfos["test1-admin"].ClientCertificateData)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(newCert.CheckSignatureFrom(caCert)).To(Succeed())
+}
+
 func TestRegenerateClientCerts(t *testing.T) {
 	g := NewWithT(t)
 	caKey, err := certs.NewPrivateKey()
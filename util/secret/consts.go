@@ -25,6 +25,22 @@ const (
 
 	// TLSCrtDataName is the key used to store a TLS certificate in the secret's data field.
 	TLSCrtDataName = "tls.crt"
+
+	// NextTLSKeyDataName is the key used to store the private key of the new CA in the secret's data field
+	// while the CA is being rotated and the new CA is not yet used for signing.
+	NextTLSKeyDataName = "next-tls.key"
+
+	// CARotationPhaseAnnotation is the annotation set on a CA secret to track the phase of an ongoing CA rotation.
+	CARotationPhaseAnnotation = "cluster.x-k8s.io/ca-rotation-phase"
+
+	// CARotationPhaseStartedAnnotation is the annotation set on a CA secret to track when the current phase of an
+	// ongoing CA rotation started. The annotation value is a RFC3339 timestamp.
+	CARotationPhaseStartedAnnotation = "cluster.x-k8s.io/ca-rotation-phase-started"
+
+	// CARotationWorkersRolloutAfterAnnotation is the annotation set on a CA secret to track when the rollout of
+	// worker machines for the current phase of an ongoing CA rotation started, i.e. after all the control plane machines
+	// have been rolled out. The annotation value is a RFC3339 timestamp.
+	CARotationWorkersRolloutAfterAnnotation = "cluster.x-k8s.io/ca-rotation-workers-rollout-after"
 )
 
 // Purpose is the name to append to the secret generated for a cluster.
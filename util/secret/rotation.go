@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/cert"
+
+	"sigs.k8s.io/cluster-api/util/certs"
+)
+
+// CARotationPhase is a phase of a CA rotation.
+//
+// A CA rotation goes through the following phases, and all the machines using the CA must be rolled out
+// before moving to the next phase:
+//   - Trust: the new CA is added to the CA bundle after the current CA, which is still used for signing.
+//   - Sign: the new CA is moved first in the CA bundle and it is used for signing; the old CA is still trusted.
+//   - Cleanup: the old CA is removed from the CA bundle.
+type CARotationPhase string
+
+const (
+	// CARotationPhaseTrust is the phase of a CA rotation when both the old and the new CA are trusted,
+	// and the old CA is used for signing.
+	CARotationPhaseTrust = CARotationPhase("Trust")
+
+	// CARotationPhaseSign is the phase of a CA rotation when both the old and the new CA are trusted,
+	// and the new CA is used for signing.
+	CARotationPhaseSign = CARotationPhase("Sign")
+
+	// CARotationPhaseCleanup is the phase of a CA rotation when only the new CA is trusted.
+	CARotationPhaseCleanup = CARotationPhase("Cleanup")
+)
+
+// GetCARotationPhase returns the phase of the CA rotation in progress for a CA secret, if any, and when the phase started.
+func GetCARotationPhase(s *corev1.Secret) (CARotationPhase, time.Time, error) {
+	phase, ok := s.Annotations[CARotationPhaseAnnotation]
+	if !ok {
+		return "", time.Time{}, nil
+	}
+	switch CARotationPhase(phase) {
+	case CARotationPhaseTrust, CARotationPhaseSign, CARotationPhaseCleanup:
+	default:
+		return "", time.Time{}, errors.Errorf("invalid value %q for annotation %s", phase, CARotationPhaseAnnotation)
+	}
+	started, err := time.Parse(time.RFC3339, s.Annotations[CARotationPhaseStartedAnnotation])
+	if err != nil {
+		return "", time.Time{}, errors.Wrapf(err, "invalid value for annotation %s", CARotationPhaseStartedAnnotation)
+	}
+	return CARotationPhase(phase), started, nil
+}
+
+// StartCARotation generates a new CA and adds it to the CA bundle of the given CA secret after the current CA,
+// so the new CA is trusted but the current CA is still used for signing.
+func StartCARotation(s *corev1.Secret, now time.Time) error {
+	if _, ok := s.Annotations[CARotationPhaseAnnotation]; ok {
+		return errors.Errorf("CA rotation is already in progress for secret %s", s.Name)
+	}
+	currentCerts, err := cert.ParseCertsPEM(s.Data[TLSCrtDataName])
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse CA certificate from secret %s", s.Name)
+	}
+	if len(currentCerts) != 1 {
+		return errors.Errorf("failed to start CA rotation for secret %s: expected exactly one CA certificate, got %d", s.Name, len(currentCerts))
+	}
+	if len(s.Data[TLSKeyDataName]) == 0 {
+		return errors.Errorf("failed to start CA rotation for secret %s: CA private key is missing", s.Name)
+	}
+
+	next, err := generateCACert()
+	if err != nil {
+		return err
+	}
+
+	s.Data[TLSCrtDataName] = caBundle(certs.EncodeCertPEM(currentCerts[0]), next.Cert)
+	s.Data[NextTLSKeyDataName] = next.Key
+	setCARotationPhase(s, CARotationPhaseTrust, now)
+	return nil
+}
+
+// AdvanceCARotation moves the CA rotation in progress for the given CA secret to the next phase.
+// It returns true when the CA rotation is completed.
+func AdvanceCARotation(s *corev1.Secret, now time.Time) (bool, error) {
+	phase, _, err := GetCARotationPhase(s)
+	if err != nil {
+		return false, err
+	}
+
+	switch phase {
+	case CARotationPhaseTrust:
+		currentCerts, err := cert.ParseCertsPEM(s.Data[TLSCrtDataName])
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to parse CA certificates from secret %s", s.Name)
+		}
+		if len(currentCerts) != 2 {
+			return false, errors.Errorf("failed to advance CA rotation for secret %s: expected two CA certificates, got %d", s.Name, len(currentCerts))
+		}
+		nextKey, ok := s.Data[NextTLSKeyDataName]
+		if !ok {
+			return false, errors.Errorf("failed to advance CA rotation for secret %s: missing data for key %s", s.Name, NextTLSKeyDataName)
+		}
+		// Swap the CAs, so the new CA is used for signing.
+		s.Data[TLSCrtDataName] = caBundle(certs.EncodeCertPEM(currentCerts[1]), certs.EncodeCertPEM(currentCerts[0]))
+		s.Data[TLSKeyDataName] = nextKey
+		delete(s.Data, NextTLSKeyDataName)
+		setCARotationPhase(s, CARotationPhaseSign, now)
+		return false, nil
+	case CARotationPhaseSign:
+		currentCerts, err := cert.ParseCertsPEM(s.Data[TLSCrtDataName])
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to parse CA certificates from secret %s", s.Name)
+		}
+		// Drop the old CA.
+		s.Data[TLSCrtDataName] = certs.EncodeCertPEM(currentCerts[0])
+		setCARotationPhase(s, CARotationPhaseCleanup, now)
+		return false, nil
+	case CARotationPhaseCleanup:
+		delete(s.Annotations, CARotationPhaseAnnotation)
+		delete(s.Annotations, CARotationPhaseStartedAnnotation)
+		delete(s.Annotations, CARotationWorkersRolloutAfterAnnotation)
+		return true, nil
+	default:
+		return false, errors.Errorf("CA rotation is not in progress for secret %s", s.Name)
+	}
+}
+
+func setCARotationPhase(s *corev1.Secret, phase CARotationPhase, now time.Time) {
+	if s.Annotations == nil {
+		s.Annotations = map[string]string{}
+	}
+	s.Annotations[CARotationPhaseAnnotation] = string(phase)
+	s.Annotations[CARotationPhaseStartedAnnotation] = now.UTC().Format(time.RFC3339)
+	delete(s.Annotations, CARotationWorkersRolloutAfterAnnotation)
+}
+
+// caBundle returns a CA bundle with the given PEM encoded certificates.
+// NOTE: The first certificate in the bundle must be the one matching the CA private key, because this is
+// the certificate used for signing by kubeadm and by Cluster API.
+func caBundle(pemCerts ...[]byte) []byte {
+	return bytes.Join(pemCerts, nil)
+}
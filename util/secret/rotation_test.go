@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/cert"
+
+	"sigs.k8s.io/cluster-api/util/certs"
+)
+
+func TestCARotation(t *testing.T) {
+	g := NewWithT(t)
+
+	oldCA, err := generateCACert()
+	g.Expect(err).ToNot(HaveOccurred())
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "foo-ca",
+		},
+		Data: map[string][]byte{
+			TLSCrtDataName: oldCA.Cert,
+			TLSKeyDataName: oldCA.Key,
+		},
+	}
+
+	phase, _, err := GetCARotationPhase(s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(phase).To(BeEmpty())
+
+	_, err = AdvanceCARotation(s, time.Now())
+	g.Expect(err).To(HaveOccurred(), "CA rotation cannot be advanced if it is not in progress")
+
+	// Trust phase: old and new CA are trusted, the old CA is used for signing.
+	now := time.Now().Truncate(time.Second)
+	g.Expect(StartCARotation(s, now)).To(Succeed())
+	phase, started, err := GetCARotationPhase(s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(phase).To(Equal(CARotationPhaseTrust))
+	g.Expect(started).To(BeTemporally("==", now))
+
+	bundle := parseCerts(g, s.Data[TLSCrtDataName])
+	g.Expect(bundle).To(HaveLen(2))
+	g.Expect(certs.EncodeCertPEM(bundle[0])).To(Equal(oldCA.Cert))
+	g.Expect(s.Data[TLSKeyDataName]).To(Equal(oldCA.Key))
+	g.Expect(s.Data).To(HaveKey(NextTLSKeyDataName))
+	newCA := bundle[1]
+	newKey := s.Data[NextTLSKeyDataName]
+	g.Expect(StartCARotation(s, now)).ToNot(Succeed(), "CA rotation cannot be started twice")
+
+	// Sign phase: old and new CA are trusted, the new CA is used for signing.
+	s.Annotations[CARotationWorkersRolloutAfterAnnotation] = now.Format(time.RFC3339)
+	completed, err := AdvanceCARotation(s, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(completed).To(BeFalse())
+	phase, _, err = GetCARotationPhase(s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(phase).To(Equal(CARotationPhaseSign))
+	g.Expect(s.Annotations).ToNot(HaveKey(CARotationWorkersRolloutAfterAnnotation))
+
+	bundle = parseCerts(g, s.Data[TLSCrtDataName])
+	g.Expect(bundle).To(HaveLen(2))
+	g.Expect(bundle[0].Equal(newCA)).To(BeTrue())
+	g.Expect(certs.EncodeCertPEM(bundle[1])).To(Equal(oldCA.Cert))
+	g.Expect(s.Data[TLSKeyDataName]).To(Equal(newKey))
+	g.Expect(s.Data).ToNot(HaveKey(NextTLSKeyDataName))
+
+	// Cleanup phase: only the new CA is trusted.
+	completed, err = AdvanceCARotation(s, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(completed).To(BeFalse())
+	phase, _, err = GetCARotationPhase(s)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(phase).To(Equal(CARotationPhaseCleanup))
+
+	bundle = parseCerts(g, s.Data[TLSCrtDataName])
+	g.Expect(bundle).To(HaveLen(1))
+	g.Expect(bundle[0].Equal(newCA)).To(BeTrue())
+
+	// Rotation completed.
+	completed, err = AdvanceCARotation(s, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(completed).To(BeTrue())
+	g.Expect(s.Annotations).ToNot(HaveKey(CARotationPhaseAnnotation))
+	g.Expect(s.Annotations).ToNot(HaveKey(CARotationPhaseStartedAnnotation))
+}
+
+func TestGetCARotationPhase(t *testing.T) {
+	g := NewWithT(t)
+
+	_, _, err := GetCARotationPhase(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				CARotationPhaseAnnotation: "foo",
+			},
+		},
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	_, _, err = GetCARotationPhase(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				CARotationPhaseAnnotation:        string(CARotationPhaseTrust),
+				CARotationPhaseStartedAnnotation: "foo",
+			},
+		},
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func parseCerts(g *WithT, data []byte) []*x509.Certificate {
+	c, err := cert.ParseCertsPEM(data)
+	g.Expect(err).ToNot(HaveOccurred())
+	return c
+}
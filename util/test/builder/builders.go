@@ -2060,13 +2060,15 @@ func setStatusFields(obj *unstructured.Unstructured, fields map[string]interface
 
 // MachineHealthCheckBuilder holds fields for creating a MachineHealthCheck.
 type MachineHealthCheckBuilder struct {
-	name                    string
-	namespace               string
-	ownerRefs               []metav1.OwnerReference
-	selector                metav1.LabelSelector
-	clusterName             string
-	unhealthyNodeConditions []clusterv1.UnhealthyNodeCondition
-	maxUnhealthy            *intstr.IntOrString
+	name                       string
+	namespace                  string
+	ownerRefs                  []metav1.OwnerReference
+	selector                   metav1.LabelSelector
+	clusterName                string
+	unhealthyNodeConditions    []clusterv1.UnhealthyNodeCondition
+	unhealthyMachineConditions []clusterv1.UnhealthyMachineCondition
+	unhealthyNodeTaints        []clusterv1.UnhealthyNodeTaint
+	maxUnhealthy               *intstr.IntOrString
 }
 
 // MachineHealthCheck returns a MachineHealthCheckBuilder with the given name and namespace.
@@ -2095,6 +2097,18 @@ func (m *MachineHealthCheckBuilder) WithUnhealthyNodeConditions(conditions []clu
 	return m
 }
 
+// WithUnhealthyMachineConditions adds the Machine conditions used to build the parameters of the MachineHealthCheck.
+func (m *MachineHealthCheckBuilder) WithUnhealthyMachineConditions(conditions []clusterv1.UnhealthyMachineCondition) *MachineHealthCheckBuilder {
+	m.unhealthyMachineConditions = conditions
+	return m
+}
+
+// WithUnhealthyNodeTaints adds the Node taints used to build the parameters of the MachineHealthCheck.
+func (m *MachineHealthCheckBuilder) WithUnhealthyNodeTaints(taints []clusterv1.UnhealthyNodeTaint) *MachineHealthCheckBuilder {
+	m.unhealthyNodeTaints = taints
+	return m
+}
+
 // WithOwnerReferences adds ownerreferences for the MachineHealthCheck.
 func (m *MachineHealthCheckBuilder) WithOwnerReferences(ownerRefs []metav1.OwnerReference) *MachineHealthCheckBuilder {
 	m.ownerRefs = ownerRefs
@@ -2121,10 +2135,12 @@ func (m *MachineHealthCheckBuilder) Build() *clusterv1.MachineHealthCheck {
 			OwnerReferences: m.ownerRefs,
 		},
 		Spec: clusterv1.MachineHealthCheckSpec{
-			ClusterName:             m.clusterName,
-			Selector:                m.selector,
-			UnhealthyNodeConditions: m.unhealthyNodeConditions,
-			MaxUnhealthy:            m.maxUnhealthy,
+			ClusterName:                m.clusterName,
+			Selector:                   m.selector,
+			UnhealthyNodeConditions:    m.unhealthyNodeConditions,
+			UnhealthyMachineConditions: m.unhealthyMachineConditions,
+			UnhealthyNodeTaints:        m.unhealthyNodeTaints,
+			MaxUnhealthy:               m.maxUnhealthy,
 		},
 	}
 	if m.clusterName != "" {